@@ -0,0 +1,45 @@
+// Command dtdgen generates the go types of the elements of a dtd
+//
+//	go run github.com/max-planck-innovation-competition/go-epo-eps/cmd/dtdgen -dtd 1-5.dtd -pkg v15 -out v15/model.go
+package main
+
+import (
+	"flag"
+	"github.com/max-planck-innovation-competition/go-epo-eps/pkg/dtd"
+	log "github.com/sirupsen/logrus"
+	"os"
+	"path/filepath"
+)
+
+func main() {
+	dtdPath := flag.String("dtd", "", "path of the dtd")
+	pkg := flag.String("pkg", "", "name of the generated package")
+	out := flag.String("out", "", "path of the generated go file")
+	root := flag.String("root", "ep-patent-document", "name of the root element")
+	flag.Parse()
+	if *dtdPath == "" || *pkg == "" || *out == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	d, err := dtd.ParseFile(*dtdPath)
+	if err != nil {
+		log.WithError(err).Fatal("can not parse dtd")
+	}
+	src, err := dtd.GenerateGo(d, dtd.GoOptions{
+		Package: *pkg,
+		Root:    *root,
+		Source:  filepath.Base(*dtdPath),
+	})
+	if err != nil {
+		log.WithError(err).Fatal("can not generate go code")
+	}
+	err = os.MkdirAll(filepath.Dir(*out), 0o755)
+	if err != nil {
+		log.WithError(err).Fatal("can not create directory")
+	}
+	err = os.WriteFile(*out, src, 0o644)
+	if err != nil {
+		log.WithError(err).Fatal("can not write go file")
+	}
+}
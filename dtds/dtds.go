@@ -0,0 +1,9 @@
+// Package dtds embeds the document type definitions of the ep-patent-document
+package dtds
+
+import "embed"
+
+// FS contains the dtd files, e.g. 1-5.dtd
+//
+//go:embed *.dtd
+var FS embed.FS
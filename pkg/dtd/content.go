@@ -0,0 +1,349 @@
+package dtd
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+var ErrInvalidContentModel = errors.New("invalid content model")
+
+// ContentType is the kind of content of an element
+type ContentType int
+
+const (
+	ContentUndeclared ContentType = iota // only an ATTLIST exists
+	ContentEmpty                         // EMPTY
+	ContentAny                           // ANY
+	ContentMixed                         // (#PCDATA | a | b)*
+	ContentElements                      // (a, (b | c)*)
+)
+
+// ParticleKind is the kind of a particle of a content model
+type ParticleKind int
+
+const (
+	NameParticle     ParticleKind = iota // a
+	SequenceParticle                     // (a, b)
+	ChoiceParticle                       // (a | b)
+)
+
+// Unbounded is the maximum number of occurrences of repeatable particles
+const Unbounded = -1
+
+// Particle is a part of a content model
+type Particle struct {
+	Kind       ParticleKind
+	Name       string      // name of the element of a NameParticle
+	Children   []*Particle // particles of a sequence or choice
+	Occurrence string      // "", "?", "*" or "+"
+}
+
+// Optional checks if the particle may be omitted
+func (p *Particle) Optional() bool {
+	return p.Occurrence == "?" || p.Occurrence == "*"
+}
+
+// Repeatable checks if the particle may occur more than once
+func (p *Particle) Repeatable() bool {
+	return p.Occurrence == "*" || p.Occurrence == "+"
+}
+
+// String returns the particle in dtd syntax
+func (p *Particle) String() string {
+	switch p.Kind {
+	case NameParticle:
+		return p.Name + p.Occurrence
+	case SequenceParticle, ChoiceParticle:
+		sep := ","
+		if p.Kind == ChoiceParticle {
+			sep = " | "
+		}
+		parts := make([]string, len(p.Children))
+		for i, c := range p.Children {
+			parts[i] = c.String()
+		}
+		return "(" + strings.Join(parts, sep) + ")" + p.Occurrence
+	}
+	return ""
+}
+
+// Occurs is the minimal and maximal number of occurrences of a child element
+type Occurs struct {
+	Min int
+	Max int // Unbounded if the element is repeatable
+}
+
+// ContentModel returns the content model in dtd syntax
+func (e *Element) ContentModel() string {
+	switch e.Content {
+	case ContentEmpty:
+		return "EMPTY"
+	case ContentAny:
+		return "ANY"
+	case ContentMixed:
+		if e.Model == nil || len(e.Model.Children) == 0 {
+			return "(#PCDATA)"
+		}
+		return "(#PCDATA | " + strings.TrimPrefix(e.Model.String(), "(")
+	case ContentElements:
+		return e.Model.String()
+	}
+	return ""
+}
+
+// ChildNames returns the names of the child elements in the order of their first appearance
+func (e *Element) ChildNames() (names []string) {
+	seen := map[string]bool{}
+	var walk func(p *Particle)
+	walk = func(p *Particle) {
+		if p == nil {
+			return
+		}
+		if p.Kind == NameParticle {
+			if !seen[p.Name] {
+				seen[p.Name] = true
+				names = append(names, p.Name)
+			}
+			return
+		}
+		for _, c := range p.Children {
+			walk(c)
+		}
+	}
+	walk(e.Model)
+	return
+}
+
+// Occurrences returns the number of occurrences of the child elements allowed by the content model
+func (e *Element) Occurrences() map[string]Occurs {
+	if e.Model == nil {
+		return map[string]Occurs{}
+	}
+	if e.Content == ContentMixed {
+		res := map[string]Occurs{}
+		for _, name := range e.ChildNames() {
+			res[name] = Occurs{Min: 0, Max: Unbounded}
+		}
+		return res
+	}
+	return occurrences(e.Model)
+}
+
+// occurrences computes the occurrences of the names of the particle
+func occurrences(p *Particle) (res map[string]Occurs) {
+	res = map[string]Occurs{}
+	switch p.Kind {
+	case NameParticle:
+		res[p.Name] = Occurs{Min: 1, Max: 1}
+	case SequenceParticle:
+		for _, c := range p.Children {
+			for name, o := range occurrences(c) {
+				sum := res[name]
+				sum.Min += o.Min
+				sum.Max = addMax(sum.Max, o.Max)
+				res[name] = sum
+			}
+		}
+	case ChoiceParticle:
+		for i, c := range p.Children {
+			branch := occurrences(c)
+			for name, o := range branch {
+				existing, ok := res[name]
+				if !ok {
+					// names missing in a former branch are optional
+					if i > 0 {
+						o.Min = 0
+					}
+					res[name] = o
+					continue
+				}
+				existing.Min = min(existing.Min, o.Min)
+				existing.Max = maxOccurs(existing.Max, o.Max)
+				res[name] = existing
+			}
+			// names missing in this branch are optional
+			for name, o := range res {
+				if _, ok := branch[name]; !ok {
+					o.Min = 0
+					res[name] = o
+				}
+			}
+		}
+	}
+	for name, o := range res {
+		if p.Optional() {
+			o.Min = 0
+		}
+		if p.Repeatable() && o.Max != 0 {
+			o.Max = Unbounded
+		}
+		res[name] = o
+	}
+	return
+}
+
+// addMax adds two maximal numbers of occurrences
+func addMax(a, b int) int {
+	if a == Unbounded || b == Unbounded {
+		return Unbounded
+	}
+	return a + b
+}
+
+// maxOccurs returns the larger maximal number of occurrences
+func maxOccurs(a, b int) int {
+	if a == Unbounded || b == Unbounded {
+		return Unbounded
+	}
+	return max(a, b)
+}
+
+// contentParser is a recursive descent parser for content models
+type contentParser struct {
+	src string
+	pos int
+}
+
+// parseContentModel parses the content specification of an element declaration
+func parseContentModel(spec string) (content ContentType, model *Particle, err error) {
+	spec = strings.TrimSpace(spec)
+	switch spec {
+	case "EMPTY":
+		return ContentEmpty, nil, nil
+	case "ANY":
+		return ContentAny, nil, nil
+	}
+	p := &contentParser{src: spec}
+	if strings.HasPrefix(strings.TrimLeft(strings.TrimPrefix(spec, "("), " \t\r\n"), "#PCDATA") {
+		model, err = p.mixed()
+		content = ContentMixed
+	} else {
+		model, err = p.group()
+		content = ContentElements
+	}
+	if err != nil {
+		return
+	}
+	p.skipSpace()
+	if p.pos < len(p.src) {
+		err = fmt.Errorf("%w: unexpected %q", ErrInvalidContentModel, p.src[p.pos:])
+	}
+	return
+}
+
+// skipSpace skips the whitespace
+func (p *contentParser) skipSpace() {
+	for p.pos < len(p.src) && strings.IndexByte(" \t\r\n", p.src[p.pos]) >= 0 {
+		p.pos++
+	}
+}
+
+// next returns the next non whitespace byte without consuming it
+func (p *contentParser) next() byte {
+	p.skipSpace()
+	if p.pos >= len(p.src) {
+		return 0
+	}
+	return p.src[p.pos]
+}
+
+// expect consumes the byte
+func (p *contentParser) expect(c byte) error {
+	if p.next() != c {
+		return fmt.Errorf("%w: expected %q at %d in %q", ErrInvalidContentModel, c, p.pos, p.src)
+	}
+	p.pos++
+	return nil
+}
+
+// name reads an element name
+func (p *contentParser) name() (string, error) {
+	p.skipSpace()
+	start := p.pos
+	for p.pos < len(p.src) && strings.IndexByte(" \t\r\n,|()?*+", p.src[p.pos]) < 0 {
+		p.pos++
+	}
+	if start == p.pos {
+		return "", fmt.Errorf("%w: expected name at %d in %q", ErrInvalidContentModel, p.pos, p.src)
+	}
+	return p.src[start:p.pos], nil
+}
+
+// occurrence reads an optional occurrence indicator
+func (p *contentParser) occurrence() string {
+	if p.pos < len(p.src) && strings.IndexByte("?*+", p.src[p.pos]) >= 0 {
+		p.pos++
+		return p.src[p.pos-1 : p.pos]
+	}
+	return ""
+}
+
+// mixed parses (#PCDATA) or (#PCDATA | a | b)*
+func (p *contentParser) mixed() (model *Particle, err error) {
+	if err = p.expect('('); err != nil {
+		return
+	}
+	if _, err = p.name(); err != nil {
+		return
+	}
+	model = &Particle{Kind: ChoiceParticle}
+	for p.next() == '|' {
+		p.pos++
+		var name string
+		if name, err = p.name(); err != nil {
+			return
+		}
+		model.Children = append(model.Children, &Particle{Kind: NameParticle, Name: name})
+	}
+	if err = p.expect(')'); err != nil {
+		return
+	}
+	model.Occurrence = p.occurrence()
+	return
+}
+
+// group parses a sequence or choice with its occurrence indicator
+func (p *contentParser) group() (model *Particle, err error) {
+	if err = p.expect('('); err != nil {
+		return
+	}
+	model = &Particle{Kind: SequenceParticle}
+	separator := byte(0)
+	for {
+		var child *Particle
+		child, err = p.particle()
+		if err != nil {
+			return
+		}
+		model.Children = append(model.Children, child)
+		c := p.next()
+		if c == ')' {
+			p.pos++
+			break
+		}
+		if c != ',' && c != '|' || separator != 0 && c != separator {
+			err = fmt.Errorf("%w: unexpected %q at %d in %q", ErrInvalidContentModel, c, p.pos, p.src)
+			return
+		}
+		separator = c
+		p.pos++
+	}
+	if separator == '|' {
+		model.Kind = ChoiceParticle
+	}
+	model.Occurrence = p.occurrence()
+	return
+}
+
+// particle parses a name or a group
+func (p *contentParser) particle() (*Particle, error) {
+	if p.next() == '(' {
+		return p.group()
+	}
+	name, err := p.name()
+	if err != nil {
+		return nil, err
+	}
+	return &Particle{Kind: NameParticle, Name: name, Occurrence: p.occurrence()}, nil
+}
@@ -0,0 +1,369 @@
+package dtd
+
+import (
+	"errors"
+	"fmt"
+	log "github.com/sirupsen/logrus"
+	"io"
+	"os"
+	"regexp"
+	"strings"
+)
+
+var (
+	ErrUnterminatedDeclaration = errors.New("unterminated declaration")
+	ErrUnknownEntity           = errors.New("unknown parameter entity")
+	ErrEntityRecursion         = errors.New("parameter entity recursion")
+)
+
+// maxEntityDepth limits the nesting of parameter entities
+const maxEntityDepth = 16
+
+// e.g. %name_group;
+var reParameterEntityRef = regexp.MustCompile(`%([A-Za-z_][A-Za-z0-9_.-]*);`)
+
+// DTD is a parsed document type definition
+type DTD struct {
+	// Elements are the element declarations by name
+	Elements map[string]*Element
+	// Names are the element names in the order of their declaration
+	Names []string
+	// Entities are the parameter entities
+	Entities map[string]string
+}
+
+// Element is an element declaration with the declared attributes
+type Element struct {
+	Name       string
+	Content    ContentType
+	Model      *Particle // nil for EMPTY and ANY
+	Attributes []*Attribute
+}
+
+// Attribute is an attribute declaration of an ATTLIST
+type Attribute struct {
+	Name    string
+	Type    string   // CDATA, ID, IDREF, NMTOKEN, ... or ENUMERATION
+	Values  []string // allowed values of an enumeration or notation
+	Default string   // #REQUIRED, #IMPLIED, #FIXED or empty
+	Value   string   // default or fixed value
+}
+
+// Required checks if the attribute has to be present
+func (a *Attribute) Required() bool {
+	return a.Default == "#REQUIRED"
+}
+
+// ParseFile parses the dtd file
+func ParseFile(path string) (d *DTD, err error) {
+	f, err := os.Open(path)
+	if err != nil {
+		log.WithError(err).Error("can not open dtd")
+		return
+	}
+	defer f.Close()
+	return Parse(f)
+}
+
+// Parse parses the declarations of a dtd.
+// Comments, processing instructions and notations are ignored,
+// parameter entities are expanded.
+func Parse(r io.Reader) (d *DTD, err error) {
+	raw, err := io.ReadAll(r)
+	if err != nil {
+		log.WithError(err).Error("can not read dtd")
+		return
+	}
+	d = &DTD{
+		Elements: map[string]*Element{},
+		Entities: map[string]string{},
+	}
+	src := string(raw)
+	for len(src) > 0 {
+		start := strings.IndexByte(src, '<')
+		if start < 0 {
+			break
+		}
+		src = src[start:]
+		switch {
+		case strings.HasPrefix(src, "<!--"):
+			end := strings.Index(src, "-->")
+			if end < 0 {
+				err = fmt.Errorf("%w: comment", ErrUnterminatedDeclaration)
+				return
+			}
+			src = src[end+3:]
+		case strings.HasPrefix(src, "<?"):
+			end := strings.Index(src, "?>")
+			if end < 0 {
+				err = fmt.Errorf("%w: processing instruction", ErrUnterminatedDeclaration)
+				return
+			}
+			src = src[end+2:]
+		case strings.HasPrefix(src, "<!"):
+			end := declarationEnd(src)
+			if end < 0 {
+				err = fmt.Errorf("%w: %.40s", ErrUnterminatedDeclaration, src)
+				return
+			}
+			err = d.declaration(src[2:end])
+			if err != nil {
+				return
+			}
+			src = src[end+1:]
+		default:
+			src = src[1:]
+		}
+	}
+	return
+}
+
+// declarationEnd returns the index of the closing '>' outside of quotes
+func declarationEnd(src string) int {
+	var quote byte
+	for i := 2; i < len(src); i++ {
+		c := src[i]
+		switch {
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			}
+		case c == '"' || c == '\'':
+			quote = c
+		case c == '>':
+			return i
+		}
+	}
+	return -1
+}
+
+// declaration parses the declaration without the surrounding <! and >
+func (d *DTD) declaration(decl string) (err error) {
+	keyword, rest, _ := strings.Cut(decl, " ")
+	keyword = strings.TrimSpace(keyword)
+	// the keyword may be followed by a line break
+	if i := strings.IndexAny(keyword, "\t\r\n"); i >= 0 {
+		rest = keyword[i:] + " " + rest
+		keyword = keyword[:i]
+	}
+	switch keyword {
+	case "ENTITY":
+		return d.entity(rest)
+	case "ELEMENT":
+		rest, err = d.expand(rest, 0)
+		if err != nil {
+			return
+		}
+		return d.element(rest)
+	case "ATTLIST":
+		rest, err = d.expand(rest, 0)
+		if err != nil {
+			return
+		}
+		return d.attlist(rest)
+	}
+	return
+}
+
+// expand replaces the parameter entity references
+func (d *DTD) expand(s string, depth int) (res string, err error) {
+	if depth > maxEntityDepth {
+		err = ErrEntityRecursion
+		return
+	}
+	if !strings.Contains(s, "%") {
+		return s, nil
+	}
+	res = reParameterEntityRef.ReplaceAllStringFunc(s, func(ref string) string {
+		if err != nil {
+			return ref
+		}
+		name := ref[1 : len(ref)-1]
+		value, ok := d.Entities[name]
+		if !ok {
+			err = fmt.Errorf("%w: %s", ErrUnknownEntity, name)
+			return ref
+		}
+		expanded, errExpand := d.expand(value, depth+1)
+		if errExpand != nil {
+			err = errExpand
+			return ref
+		}
+		return " " + expanded + " "
+	})
+	return
+}
+
+// entity parses an entity declaration, only internal parameter entities are kept
+/*
+	<!ENTITY % name_group "((name | (prefix?,(last-name | orgname),first-name?)),registered-number?)">
+*/
+func (d *DTD) entity(decl string) (err error) {
+	tokens := tokenize(decl)
+	if len(tokens) < 3 || tokens[0] != "%" || !isQuoted(tokens[2]) {
+		return
+	}
+	name := tokens[1]
+	if _, ok := d.Entities[name]; ok {
+		// the first declaration is binding
+		return
+	}
+	d.Entities[name] = unquote(tokens[2])
+	return
+}
+
+// element parses an element declaration
+/*
+	<!ELEMENT B540 (B541,B542)+ >
+*/
+func (d *DTD) element(decl string) (err error) {
+	decl = strings.TrimSpace(decl)
+	end := strings.IndexAny(decl, " \t\r\n(")
+	if end < 0 {
+		return fmt.Errorf("%w: element %s", ErrInvalidContentModel, decl)
+	}
+	name := decl[:end]
+	if e, ok := d.Elements[name]; ok && e.Content != ContentUndeclared {
+		log.WithField("element", name).Warn("element declared more than once")
+		return
+	}
+	e := d.declared(name)
+	e.Content, e.Model, err = parseContentModel(decl[end:])
+	if err != nil {
+		err = fmt.Errorf("element %s: %w", name, err)
+		return
+	}
+	d.Names = append(d.Names, name)
+	return
+}
+
+// declared returns the element with the name, attributes may be declared before the element
+func (d *DTD) declared(name string) *Element {
+	e, ok := d.Elements[name]
+	if !ok {
+		e = &Element{Name: name}
+		d.Elements[name] = e
+	}
+	return e
+}
+
+// attlist parses an attribute list declaration
+/*
+	<!ATTLIST ep-patent-document
+		id  		ID    	#IMPLIED
+		lang  		CDATA 	#REQUIRED
+		status  	(n | c) "n" >
+*/
+func (d *DTD) attlist(decl string) (err error) {
+	tokens := tokenize(decl)
+	if len(tokens) == 0 {
+		return
+	}
+	e := d.declared(tokens[0])
+	tokens = tokens[1:]
+	for len(tokens) >= 2 {
+		a := &Attribute{Name: tokens[0], Type: tokens[1]}
+		tokens = tokens[2:]
+		switch {
+		case strings.HasPrefix(a.Type, "("):
+			a.Values = enumeration(a.Type)
+			a.Type = "ENUMERATION"
+		case a.Type == "NOTATION" && len(tokens) > 0:
+			a.Values = enumeration(tokens[0])
+			tokens = tokens[1:]
+		}
+		if len(tokens) == 0 {
+			return fmt.Errorf("%w: attribute %s of %s", ErrUnterminatedDeclaration, a.Name, e.Name)
+		}
+		switch tokens[0] {
+		case "#REQUIRED", "#IMPLIED":
+			a.Default = tokens[0]
+			tokens = tokens[1:]
+		case "#FIXED":
+			a.Default = tokens[0]
+			if len(tokens) > 1 {
+				a.Value = unquote(tokens[1])
+				tokens = tokens[1:]
+			}
+			tokens = tokens[1:]
+		default:
+			a.Value = unquote(tokens[0])
+			tokens = tokens[1:]
+		}
+		if e.Attribute(a.Name) != nil {
+			// the first declaration is binding
+			continue
+		}
+		e.Attributes = append(e.Attributes, a)
+	}
+	return
+}
+
+// Attribute returns the declared attribute with the name
+func (e *Element) Attribute(name string) *Attribute {
+	for _, a := range e.Attributes {
+		if a.Name == name {
+			return a
+		}
+	}
+	return nil
+}
+
+// tokenize splits a declaration into names, quoted strings and parenthesized groups
+func tokenize(s string) (tokens []string) {
+	for i := 0; i < len(s); {
+		c := s[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\r' || c == '\n':
+			i++
+		case c == '"' || c == '\'':
+			end := strings.IndexByte(s[i+1:], c)
+			if end < 0 {
+				tokens = append(tokens, s[i:])
+				return
+			}
+			tokens = append(tokens, s[i:i+end+2])
+			i += end + 2
+		case c == '(':
+			end := strings.IndexByte(s[i:], ')')
+			if end < 0 {
+				tokens = append(tokens, s[i:])
+				return
+			}
+			tokens = append(tokens, s[i:i+end+1])
+			i += end + 1
+		default:
+			end := strings.IndexAny(s[i:], " \t\r\n\"'(")
+			if end < 0 {
+				end = len(s) - i
+			}
+			tokens = append(tokens, s[i:i+end])
+			i += end
+		}
+	}
+	return
+}
+
+// isQuoted checks if the token is a quoted string
+func isQuoted(token string) bool {
+	return len(token) >= 2 && (token[0] == '"' || token[0] == '\'') && token[len(token)-1] == token[0]
+}
+
+// unquote removes the quotes of the token
+func unquote(token string) string {
+	if isQuoted(token) {
+		return token[1 : len(token)-1]
+	}
+	return token
+}
+
+// enumeration splits the values of an enumeration, e.g. (yes | no)
+func enumeration(group string) (values []string) {
+	group = strings.Trim(group, "()")
+	for _, v := range strings.Split(group, "|") {
+		if v = strings.TrimSpace(v); v != "" {
+			values = append(values, v)
+		}
+	}
+	return
+}
@@ -0,0 +1,75 @@
+package dtd
+
+import (
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+)
+
+func TestParseFile(t *testing.T) {
+	ass := assert.New(t)
+	d, err := ParseFile("../../dtds/1-5.dtd")
+	ass.NoError(err)
+	// declarations in comments are ignored
+	ass.Equal(978, len(d.Names))
+	ass.Equal("ep-patent-document", d.Names[0])
+
+	root := d.Elements["ep-patent-document"]
+	ass.Equal(ContentElements, root.Content)
+	ass.Equal(13, len(root.Attributes))
+	ass.True(root.Attribute("lang").Required())
+	ass.False(root.Attribute("id").Required())
+	ass.Equal("ID", root.Attribute("id").Type)
+
+	title := d.Elements["B540"]
+	ass.Equal("(B541?,B542,B542EP?)+", title.ContentModel())
+	ass.Equal(Occurs{Min: 1, Max: Unbounded}, title.Occurrences()["B542"])
+	ass.Equal(Occurs{Min: 0, Max: Unbounded}, title.Occurrences()["B541"])
+
+	ass.Equal(ContentEmpty, d.Elements["doc-page"].Content)
+	ass.Equal(ContentAny, d.Elements["annotation-xml"].Content)
+	ass.Equal(ContentMixed, d.Elements["p"].Content)
+	ass.Contains(d.Elements["p"].ChildNames(), "patcit")
+}
+
+func TestParseEntities(t *testing.T) {
+	ass := assert.New(t)
+	src := `
+	<!-- <!ELEMENT ignored (a) > -->
+	<!ENTITY % inline "b | i">
+	<!ENTITY % group "(%inline; | sup)">
+	<!ATTLIST doc lang CDATA #REQUIRED status (n | c) "n" version CDATA #FIXED "1.0">
+	<!ELEMENT doc (head, (p | %group;)*) >
+	<!ELEMENT head (#PCDATA) >
+	<!ELEMENT p (#PCDATA | %inline;)* >
+	`
+	d, err := Parse(strings.NewReader(src))
+	ass.NoError(err)
+	ass.Equal([]string{"doc", "head", "p"}, d.Names)
+
+	doc := d.Elements["doc"]
+	ass.Equal("(head,(p | (b | i | sup))*)", doc.ContentModel())
+	ass.Equal([]string{"head", "p", "b", "i", "sup"}, doc.ChildNames())
+	ass.Equal(Occurs{Min: 1, Max: 1}, doc.Occurrences()["head"])
+	ass.Equal(Occurs{Min: 0, Max: Unbounded}, doc.Occurrences()["i"])
+
+	// attributes declared before the element
+	ass.Equal(3, len(doc.Attributes))
+	ass.Equal([]string{"n", "c"}, doc.Attribute("status").Values)
+	ass.Equal("n", doc.Attribute("status").Value)
+	ass.Equal("#FIXED", doc.Attribute("version").Default)
+	ass.Equal("1.0", doc.Attribute("version").Value)
+
+	ass.Equal("(#PCDATA)", d.Elements["head"].ContentModel())
+	ass.Equal("(#PCDATA | b | i)*", d.Elements["p"].ContentModel())
+}
+
+func TestParseErrors(t *testing.T) {
+	ass := assert.New(t)
+	_, err := Parse(strings.NewReader(`<!ELEMENT doc (%missing;) >`))
+	ass.ErrorIs(err, ErrUnknownEntity)
+	_, err = Parse(strings.NewReader(`<!ELEMENT doc (a, b | c) >`))
+	ass.ErrorIs(err, ErrInvalidContentModel)
+	_, err = Parse(strings.NewReader(`<!ELEMENT doc (a, b`))
+	ass.ErrorIs(err, ErrUnterminatedDeclaration)
+}
@@ -0,0 +1,273 @@
+package dtd
+
+import (
+	"bytes"
+	"fmt"
+	log "github.com/sirupsen/logrus"
+	"go/format"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// maxCommentWidth is the width at which long content models are wrapped in comments
+const maxCommentWidth = 100
+
+// initialisms are written in upper case in go identifiers
+var initialisms = map[string]bool{
+	"ID":  true,
+	"URL": true,
+	"URI": true,
+}
+
+// GoOptions configures the generated go code
+type GoOptions struct {
+	Package string // name of the package
+	Root    string // name of the root element, which gets a XMLName field
+	Source  string // name of the dtd file mentioned in the header
+}
+
+// goField is a field of a generated struct
+type goField struct {
+	Name    string
+	Type    string
+	Tag     string
+	element string // name of the child element
+	value   bool   // required struct stored as value
+}
+
+// goType is a generated struct of an element
+type goType struct {
+	Name    string
+	Element *Element
+	Fields  []*goField
+}
+
+// GenerateGo generates the go types of the elements of the dtd.
+// Each element gets a struct with its attributes and child elements,
+// elements with text only and without attributes are mapped to strings.
+// Repeatable child elements are slices, optional child elements are pointers.
+// Elements with mixed content keep their text (chardata) and the raw content (innerxml).
+func GenerateGo(d *DTD, opts GoOptions) (src []byte, err error) {
+	names := typeNames(d)
+	types := map[string]*goType{}
+	var ordered []*goType
+	for _, name := range d.Names {
+		e := d.Elements[name]
+		if isSimple(e) {
+			continue
+		}
+		t := newGoType(d, e, names, name == opts.Root)
+		types[name] = t
+		ordered = append(ordered, t)
+	}
+	breakCycles(ordered, types)
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "// Code generated by dtdgen from %s; DO NOT EDIT.\n\n", opts.Source)
+	fmt.Fprintf(&buf, "// Package %s contains the types of the elements of %s\n", opts.Package, opts.Source)
+	fmt.Fprintf(&buf, "package %s\n\n", opts.Package)
+	if opts.Root != "" {
+		buf.WriteString("import \"encoding/xml\"\n\n")
+	}
+	for _, t := range ordered {
+		writeGoType(&buf, t)
+	}
+	src, err = format.Source(buf.Bytes())
+	if err != nil {
+		log.WithError(err).Error("can not format generated code")
+		return
+	}
+	return
+}
+
+// isSimple checks if the element contains text only and has no attributes
+func isSimple(e *Element) bool {
+	return e.Content == ContentMixed && len(e.ChildNames()) == 0 && len(e.Attributes) == 0
+}
+
+// typeNames maps the element names to unique go type names
+func typeNames(d *DTD) map[string]string {
+	res := map[string]string{}
+	used := map[string]bool{}
+	for _, name := range d.Names {
+		goName := GoName(name)
+		for i := 2; used[goName]; i++ {
+			goName = GoName(name) + strconv.Itoa(i)
+		}
+		used[goName] = true
+		res[name] = goName
+	}
+	return res
+}
+
+// GoName converts a xml name into an exported go identifier, e.g. doc-number -> DocNumber
+func GoName(name string) string {
+	parts := strings.FieldsFunc(name, func(r rune) bool {
+		return r == '-' || r == '.' || r == '_' || r == ':'
+	})
+	var sb strings.Builder
+	for _, part := range parts {
+		if initialism := strings.ToUpper(part); initialisms[initialism] {
+			sb.WriteString(initialism)
+			continue
+		}
+		runes := []rune(part)
+		runes[0] = unicode.ToUpper(runes[0])
+		sb.WriteString(string(runes))
+	}
+	res := sb.String()
+	if res == "" || !unicode.IsLetter([]rune(res)[0]) {
+		res = "X" + res
+	}
+	return res
+}
+
+// newGoType creates the struct of the element
+func newGoType(d *DTD, e *Element, names map[string]string, root bool) (t *goType) {
+	t = &goType{Name: names[e.Name], Element: e}
+	used := map[string]bool{}
+	add := func(f *goField) {
+		base := f.Name
+		for i := 2; used[f.Name]; i++ {
+			f.Name = base + strconv.Itoa(i)
+		}
+		used[f.Name] = true
+		t.Fields = append(t.Fields, f)
+	}
+	if root {
+		add(&goField{Name: "XMLName", Type: "xml.Name", Tag: e.Name})
+	}
+	switch e.Content {
+	case ContentMixed:
+		add(&goField{Name: "Text", Type: "string", Tag: ",chardata"})
+		if len(e.ChildNames()) > 0 {
+			add(&goField{Name: "InnerXML", Type: "string", Tag: ",innerxml"})
+		}
+	case ContentAny:
+		add(&goField{Name: "InnerXML", Type: "string", Tag: ",innerxml"})
+	}
+
+	// the child elements take precedence over the attributes
+	children := e.ChildNames()
+	childNames := map[string]bool{}
+	for _, child := range children {
+		childNames[GoName(child)] = true
+	}
+	localNames := map[string]int{}
+	for _, a := range e.Attributes {
+		localNames[localName(a.Name)]++
+	}
+	for _, a := range e.Attributes {
+		name := GoName(a.Name)
+		if childNames[name] || used[name] {
+			name += "Attr"
+		}
+		tag := localName(a.Name)
+		if localNames[tag] > 1 && strings.Contains(a.Name, ":") {
+			// e.g. xlink:type and type
+			tag = strings.Replace(a.Name, ":", " ", 1)
+		}
+		add(&goField{Name: name, Type: "string", Tag: tag + ",attr"})
+	}
+
+	occurrences := e.Occurrences()
+	for _, child := range children {
+		o := occurrences[child]
+		f := &goField{Name: GoName(child), Tag: child, element: child}
+		childElement, declared := d.Elements[child]
+		typeName := "string"
+		if declared && childElement.Content != ContentUndeclared && !isSimple(childElement) {
+			typeName = names[child]
+		} else if !declared || childElement.Content == ContentUndeclared {
+			log.WithField("element", e.Name).WithField("child", child).Warn("child element is not declared")
+		}
+		switch {
+		case o.Max == Unbounded || o.Max > 1:
+			f.Type = "[]" + typeName
+		case typeName == "string":
+			f.Type = typeName
+		case o.Min == 0:
+			f.Type = "*" + typeName
+		default:
+			f.Type = typeName
+			f.value = true
+		}
+		add(f)
+	}
+	return
+}
+
+// localName returns the name without the namespace prefix
+func localName(name string) string {
+	if i := strings.LastIndexByte(name, ':'); i >= 0 {
+		return name[i+1:]
+	}
+	return name
+}
+
+// breakCycles stores required structs as pointers if they would contain themselves
+func breakCycles(ordered []*goType, types map[string]*goType) {
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+	state := map[*goType]int{}
+	var visit func(t *goType)
+	visit = func(t *goType) {
+		state[t] = visiting
+		for _, f := range t.Fields {
+			if !f.value {
+				continue
+			}
+			child := types[f.element]
+			switch state[child] {
+			case visiting:
+				f.value = false
+				f.Type = "*" + f.Type
+			case unvisited:
+				visit(child)
+			}
+		}
+		state[t] = visited
+	}
+	for _, t := range ordered {
+		if state[t] == unvisited {
+			visit(t)
+		}
+	}
+}
+
+// writeGoType writes the struct of the element
+func writeGoType(buf *bytes.Buffer, t *goType) {
+	fmt.Fprintf(buf, "// %s is the element %s\n", t.Name, t.Element.Name)
+	for _, line := range wrap(t.Element.ContentModel(), maxCommentWidth) {
+		fmt.Fprintf(buf, "//\t%s\n", line)
+	}
+	if len(t.Fields) == 0 {
+		fmt.Fprintf(buf, "type %s struct{}\n\n", t.Name)
+		return
+	}
+	fmt.Fprintf(buf, "type %s struct {\n", t.Name)
+	for _, f := range t.Fields {
+		fmt.Fprintf(buf, "%s %s `xml:%q`\n", f.Name, f.Type, f.Tag)
+	}
+	buf.WriteString("}\n\n")
+}
+
+// wrap splits the text into lines at spaces and commas
+func wrap(text string, width int) (lines []string) {
+	for len(text) > width {
+		cut := strings.LastIndexAny(text[:width], " ,|")
+		if cut <= 0 {
+			cut = width - 1
+		}
+		lines = append(lines, strings.TrimSpace(text[:cut+1]))
+		text = text[cut+1:]
+	}
+	if text = strings.TrimSpace(text); text != "" {
+		lines = append(lines, text)
+	}
+	return
+}
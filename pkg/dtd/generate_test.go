@@ -0,0 +1,70 @@
+package dtd
+
+import (
+	"github.com/stretchr/testify/assert"
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestGoName(t *testing.T) {
+	ass := assert.New(t)
+	ass.Equal("EpPatentDocument", GoName("ep-patent-document"))
+	ass.Equal("B540", GoName("B540"))
+	ass.Equal("DocumentID", GoName("document-id"))
+	ass.Equal("XlinkHref", GoName("xlink:href"))
+	ass.Equal("X3d", GoName("3d"))
+}
+
+func TestGenerateGo(t *testing.T) {
+	ass := assert.New(t)
+	src := `
+	<!ELEMENT doc (head, p*, list?) >
+	<!ATTLIST doc lang CDATA #REQUIRED head CDATA #IMPLIED >
+	<!ELEMENT head (#PCDATA) >
+	<!ELEMENT p (#PCDATA | b)* >
+	<!ELEMENT b (#PCDATA) >
+	<!ELEMENT list (item) >
+	<!ELEMENT item (list) >
+	`
+	d, err := Parse(strings.NewReader(src))
+	ass.NoError(err)
+	res, err := GenerateGo(d, GoOptions{Package: "test", Root: "doc", Source: "test.dtd"})
+	ass.NoError(err)
+	// ignore the alignment of gofmt
+	code := strings.Join(strings.Fields(string(res)), " ")
+	ass.Contains(code, "package test")
+	ass.Contains(code, "XMLName xml.Name `xml:\"doc\"`")
+	// attributes with the name of a child element get a suffix
+	ass.Contains(code, "HeadAttr string `xml:\"head,attr\"`")
+	// elements with text only are strings
+	ass.Contains(code, "Head string `xml:\"head\"`")
+	ass.NotContains(code, "type Head struct")
+	ass.Contains(code, "P []P `xml:\"p\"`")
+	ass.Contains(code, "B []string `xml:\"b\"`")
+	ass.Contains(code, "InnerXML string `xml:\",innerxml\"`")
+	// the recursion of required elements is broken by a pointer
+	ass.Contains(code, "type List struct { Item Item `xml:\"item\"` }")
+	ass.Contains(code, "type Item struct { List *List `xml:\"list\"` }")
+}
+
+// TestGeneratedModels checks that the generated models are up to date
+func TestGeneratedModels(t *testing.T) {
+	ass := assert.New(t)
+	versions := map[string]string{
+		"1-0": "v10",
+		"1-1": "v11",
+		"1-3": "v13",
+		"1-4": "v14",
+		"1-5": "v15",
+	}
+	for version, pkg := range versions {
+		d, err := ParseFile("../../dtds/" + version + ".dtd")
+		ass.NoError(err)
+		res, err := GenerateGo(d, GoOptions{Package: pkg, Root: "ep-patent-document", Source: version + ".dtd"})
+		ass.NoError(err)
+		existing, err := os.ReadFile("../eps/model/" + pkg + "/ep_patent_document.go")
+		ass.NoError(err)
+		ass.True(string(existing) == string(res), "run go generate in pkg/eps/model for "+version)
+	}
+}
@@ -0,0 +1,371 @@
+package dtd
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// DiagnosticKind is the kind of a violation of the dtd
+type DiagnosticKind string
+
+const (
+	MalformedXML          DiagnosticKind = "malformed-xml"           // the document is not well-formed
+	UnexpectedRoot        DiagnosticKind = "unexpected-root"         // the root element is not the expected one
+	UnknownElement        DiagnosticKind = "unknown-element"         // the element is not declared
+	UnexpectedElement     DiagnosticKind = "unexpected-element"      // the element is not allowed in its parent
+	MissingElement        DiagnosticKind = "missing-element"         // a required child element is missing
+	InvalidContent        DiagnosticKind = "invalid-content"         // the order or number of the children does not match the content model
+	UnexpectedText        DiagnosticKind = "unexpected-text"         // text in an element without mixed content
+	UnknownAttribute      DiagnosticKind = "unknown-attribute"       // the attribute is not declared
+	MissingAttribute      DiagnosticKind = "missing-attribute"       // a required attribute is missing
+	InvalidAttributeValue DiagnosticKind = "invalid-attribute-value" // the value is not allowed by the enumeration or fixed value
+	DuplicateID           DiagnosticKind = "duplicate-id"            // the value of an ID attribute is used more than once
+	UnknownIDRef          DiagnosticKind = "unknown-idref"           // the value of an IDREF attribute does not refer to an ID
+)
+
+// Diagnostic is a violation of the dtd found in a document
+type Diagnostic struct {
+	Kind      DiagnosticKind
+	Path      string // element path, e.g. /ep-patent-document/SDOBI/B100
+	Line      int
+	Element   string
+	Attribute string
+	Value     string
+	Message   string
+}
+
+// String returns the diagnostic in a readable form
+func (d Diagnostic) String() string {
+	return fmt.Sprintf("line %d: %s: %s: %s", d.Line, d.Path, d.Kind, d.Message)
+}
+
+// validationElement is an open element of the validated document
+type validationElement struct {
+	name     string
+	path     string
+	line     int
+	decl     *Element
+	children []string
+	text     bool
+}
+
+// validator holds the state of a validation
+type validator struct {
+	dtd         *DTD
+	diagnostics []Diagnostic
+	stack       []*validationElement
+	ids         map[string]bool
+	idrefs      []Diagnostic    // pending references, reported if the id is unknown
+	unknown     map[string]bool // paths of reported unknown elements
+}
+
+// Validate validates the xml document against the dtd.
+// The root element has to be the element with the name root, if root is not empty.
+// Violations are returned as diagnostics, a document that is not well-formed results
+// in a MalformedXML diagnostic. The error is only set if the reader fails.
+func (d *DTD) Validate(r io.Reader, root string) (diagnostics []Diagnostic, err error) {
+	decoder := xml.NewDecoder(r)
+	decoder.Entity = xml.HTMLEntity
+	return d.ValidateDecoder(decoder, root)
+}
+
+// ValidateDecoder validates the tokens of the decoder like Validate,
+// e.g. of a decoder that is not strict and accepts unescaped & characters.
+func (d *DTD) ValidateDecoder(decoder *xml.Decoder, root string) (diagnostics []Diagnostic, err error) {
+	v := &validator{dtd: d, ids: map[string]bool{}, unknown: map[string]bool{}}
+	for {
+		token, errToken := decoder.RawToken()
+		if errToken == io.EOF {
+			break
+		}
+		if errToken != nil {
+			var syntaxErr *xml.SyntaxError
+			if !errors.As(errToken, &syntaxErr) {
+				return v.diagnostics, errToken
+			}
+			v.diagnostics = append(v.diagnostics, Diagnostic{
+				Kind:    MalformedXML,
+				Path:    v.path(),
+				Line:    syntaxErr.Line,
+				Message: syntaxErr.Msg,
+			})
+			return v.diagnostics, nil
+		}
+		line, _ := decoder.InputPos()
+		switch t := token.(type) {
+		case xml.StartElement:
+			if len(v.stack) == 0 && root != "" && qualifiedName(t.Name) != root {
+				v.report(UnexpectedRoot, line, qualifiedName(t.Name), "", "", "expected root element "+root)
+			}
+			v.start(t, line)
+		case xml.EndElement:
+			// the raw tokens keep the prefixes, but the end elements have to be checked
+			if len(v.stack) == 0 || v.stack[len(v.stack)-1].name != qualifiedName(t.Name) {
+				v.report(MalformedXML, line, qualifiedName(t.Name), "", "", "unexpected end element "+qualifiedName(t.Name))
+				return v.diagnostics, nil
+			}
+			v.end()
+		case xml.CharData:
+			if len(v.stack) > 0 && len(strings.TrimSpace(string(t))) > 0 {
+				v.stack[len(v.stack)-1].text = true
+			}
+		}
+	}
+	for _, ref := range v.idrefs {
+		if !v.ids[ref.Value] {
+			v.diagnostics = append(v.diagnostics, ref)
+		}
+	}
+	return v.diagnostics, nil
+}
+
+// qualifiedName returns the name with its prefix, e.g. xlink:href
+func qualifiedName(name xml.Name) string {
+	if name.Space == "" {
+		return name.Local
+	}
+	return name.Space + ":" + name.Local
+}
+
+// path returns the path of the current element
+func (v *validator) path() string {
+	if len(v.stack) == 0 {
+		return "/"
+	}
+	return v.stack[len(v.stack)-1].path
+}
+
+// report adds a diagnostic at the current element
+func (v *validator) report(kind DiagnosticKind, line int, element, attribute, value, message string) {
+	v.diagnostics = append(v.diagnostics, Diagnostic{
+		Kind:      kind,
+		Path:      v.path(),
+		Line:      line,
+		Element:   element,
+		Attribute: attribute,
+		Value:     value,
+		Message:   message,
+	})
+}
+
+// start opens an element and validates its attributes
+func (v *validator) start(t xml.StartElement, line int) {
+	name := qualifiedName(t.Name)
+	if len(v.stack) > 0 {
+		parent := v.stack[len(v.stack)-1]
+		parent.children = append(parent.children, name)
+	}
+	e := &validationElement{name: name, path: v.path() + name, line: line}
+	if len(v.stack) > 0 {
+		e.path = v.path() + "/" + name
+	}
+	v.stack = append(v.stack, e)
+
+	decl, ok := v.dtd.Elements[name]
+	if !ok || decl.Content == ContentUndeclared {
+		// repeated unknown elements are reported once
+		if !v.unknown[e.path] {
+			v.unknown[e.path] = true
+			v.report(UnknownElement, line, name, "", "", "element "+name+" is not declared")
+		}
+		return
+	}
+	e.decl = decl
+	v.attributes(decl, t.Attr, line)
+}
+
+// attributes validates the attributes of an element
+func (v *validator) attributes(decl *Element, attrs []xml.Attr, line int) {
+	present := map[string]bool{}
+	for _, a := range attrs {
+		name := qualifiedName(a.Name)
+		if a.Name.Space == "xmlns" || name == "xmlns" {
+			continue
+		}
+		present[name] = true
+		attr := decl.Attribute(name)
+		if attr == nil {
+			v.report(UnknownAttribute, line, decl.Name, name, a.Value, "attribute "+name+" is not declared for "+decl.Name)
+			continue
+		}
+		switch {
+		case attr.Default == "#FIXED" && a.Value != attr.Value:
+			v.report(InvalidAttributeValue, line, decl.Name, name, a.Value, fmt.Sprintf("attribute %s has to be %q", name, attr.Value))
+		case len(attr.Values) > 0 && !containsString(attr.Values, a.Value):
+			v.report(InvalidAttributeValue, line, decl.Name, name, a.Value, fmt.Sprintf("attribute %s has to be one of %s", name, strings.Join(attr.Values, ", ")))
+		}
+		switch attr.Type {
+		case "ID":
+			if v.ids[a.Value] {
+				v.report(DuplicateID, line, decl.Name, name, a.Value, "id "+a.Value+" is used more than once")
+			}
+			v.ids[a.Value] = true
+		case "IDREF", "IDREFS":
+			for _, ref := range strings.Fields(a.Value) {
+				v.idrefs = append(v.idrefs, Diagnostic{
+					Kind:      UnknownIDRef,
+					Path:      v.path(),
+					Line:      line,
+					Element:   decl.Name,
+					Attribute: name,
+					Value:     ref,
+					Message:   "id " + ref + " does not exist",
+				})
+			}
+		}
+	}
+	for _, attr := range decl.Attributes {
+		if attr.Required() && !present[attr.Name] {
+			v.report(MissingAttribute, line, decl.Name, attr.Name, "", "attribute "+attr.Name+" is required for "+decl.Name)
+		}
+	}
+}
+
+// end closes an element and validates its content
+func (v *validator) end() {
+	e := v.stack[len(v.stack)-1]
+	if e.decl != nil {
+		v.content(e)
+	}
+	v.stack = v.stack[:len(v.stack)-1]
+}
+
+// content validates the children and the text of the element
+func (v *validator) content(e *validationElement) {
+	decl := e.decl
+	switch decl.Content {
+	case ContentAny:
+		return
+	case ContentEmpty:
+		if len(e.children) > 0 {
+			v.report(UnexpectedElement, e.line, e.children[0], "", "", "element "+decl.Name+" has to be empty")
+		}
+		if e.text {
+			v.report(UnexpectedText, e.line, decl.Name, "", "", "element "+decl.Name+" has to be empty")
+		}
+		return
+	case ContentElements:
+		if e.text {
+			v.report(UnexpectedText, e.line, decl.Name, "", "", "element "+decl.Name+" can not contain text")
+		}
+	}
+
+	occurrences := decl.Occurrences()
+	counts := map[string]int{}
+	valid := true
+	for _, child := range e.children {
+		counts[child]++
+		if _, ok := occurrences[child]; !ok {
+			if counts[child] == 1 {
+				v.report(UnexpectedElement, e.line, child, "", "", "element "+child+" is not allowed in "+decl.Name)
+			}
+			valid = false
+		}
+	}
+	if decl.Content == ContentMixed {
+		return
+	}
+	for _, name := range decl.ChildNames() {
+		o := occurrences[name]
+		switch {
+		case counts[name] < o.Min:
+			v.report(MissingElement, e.line, name, "", "", "element "+name+" is required in "+decl.Name)
+			valid = false
+		case o.Max != Unbounded && counts[name] > o.Max:
+			v.report(InvalidContent, e.line, name, "", "", fmt.Sprintf("element %s is allowed %d times in %s", name, o.Max, decl.Name))
+			valid = false
+		}
+	}
+	if valid && !matches(decl.Model, e.children) {
+		v.report(InvalidContent, e.line, decl.Name, "", "", fmt.Sprintf("children (%s) do not match %s", strings.Join(e.children, ","), decl.ContentModel()))
+	}
+}
+
+// positions is a set of positions in the sequence of children
+type positions map[int]bool
+
+// matches checks if the sequence of names matches the content model
+func matches(p *Particle, names []string) bool {
+	return match(p, names, positions{0: true})[len(names)]
+}
+
+// match returns the positions after matching the particle at the start positions
+func match(p *Particle, names []string, from positions) positions {
+	switch p.Occurrence {
+	case "?":
+		res := matchOnce(p, names, from)
+		for pos := range from {
+			res[pos] = true
+		}
+		return res
+	case "*":
+		return closure(p, names, from)
+	case "+":
+		return closure(p, names, matchOnce(p, names, from))
+	}
+	return matchOnce(p, names, from)
+}
+
+// closure returns the positions reachable by repeating the particle
+func closure(p *Particle, names []string, from positions) positions {
+	reached := positions{}
+	frontier := positions{}
+	for pos := range from {
+		reached[pos] = true
+		frontier[pos] = true
+	}
+	for len(frontier) > 0 {
+		next := positions{}
+		for pos := range matchOnce(p, names, frontier) {
+			if !reached[pos] {
+				reached[pos] = true
+				next[pos] = true
+			}
+		}
+		frontier = next
+	}
+	return reached
+}
+
+// matchOnce matches the particle once ignoring its occurrence indicator
+func matchOnce(p *Particle, names []string, from positions) positions {
+	res := positions{}
+	switch p.Kind {
+	case NameParticle:
+		for pos := range from {
+			if pos < len(names) && names[pos] == p.Name {
+				res[pos+1] = true
+			}
+		}
+	case SequenceParticle:
+		for pos := range from {
+			res[pos] = true
+		}
+		for _, c := range p.Children {
+			res = match(c, names, res)
+			if len(res) == 0 {
+				break
+			}
+		}
+	case ChoiceParticle:
+		for _, c := range p.Children {
+			for pos := range match(c, names, from) {
+				res[pos] = true
+			}
+		}
+	}
+	return res
+}
+
+// containsString checks if the slice contains the string
+func containsString(slice []string, s string) bool {
+	for _, item := range slice {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,114 @@
+package dtd
+
+import (
+	"github.com/stretchr/testify/assert"
+	"os"
+	"strings"
+	"testing"
+)
+
+const testValidationDTD = `
+<!ELEMENT doc (head, (p | list)*, foot?) >
+<!ATTLIST doc lang CDATA #REQUIRED status (n | c) "n" version CDATA #FIXED "1.0" >
+<!ELEMENT head (#PCDATA) >
+<!ELEMENT p (#PCDATA | b | ref)* >
+<!ATTLIST p id ID #IMPLIED >
+<!ELEMENT b (#PCDATA) >
+<!ELEMENT ref EMPTY >
+<!ATTLIST ref idref IDREF #REQUIRED >
+<!ELEMENT list (item, item+) >
+<!ELEMENT item (#PCDATA) >
+<!ELEMENT foot (#PCDATA) >
+`
+
+// validate validates the document against testValidationDTD
+func validate(t *testing.T, doc string) []Diagnostic {
+	d, err := Parse(strings.NewReader(testValidationDTD))
+	assert.NoError(t, err)
+	diagnostics, err := d.Validate(strings.NewReader(doc), "doc")
+	assert.NoError(t, err)
+	return diagnostics
+}
+
+// kinds returns the kinds of the diagnostics
+func kinds(diagnostics []Diagnostic) (res []DiagnosticKind) {
+	for _, d := range diagnostics {
+		res = append(res, d.Kind)
+	}
+	return
+}
+
+func TestValidateValid(t *testing.T) {
+	ass := assert.New(t)
+	diagnostics := validate(t, `<doc lang="en" status="c">
+		<head>Title</head>
+		<p id="p1">Text <b>bold</b> <ref idref="p2"/></p>
+		<list><item>1</item><item>2</item></list>
+		<p id="p2"/>
+		<foot>end</foot>
+	</doc>`)
+	ass.Empty(diagnostics)
+}
+
+func TestValidateElements(t *testing.T) {
+	ass := assert.New(t)
+	diagnostics := validate(t, `<doc lang="en"><p>text<u>x</u><u>y</u></p><foot/><foot/></doc>`)
+	ass.Equal([]DiagnosticKind{UnknownElement, UnexpectedElement, MissingElement, InvalidContent}, kinds(diagnostics))
+	ass.Equal("/doc/p/u", diagnostics[0].Path)
+	ass.Equal("u", diagnostics[1].Element)
+	ass.Equal("head", diagnostics[2].Element)
+	ass.Equal("foot", diagnostics[3].Element)
+}
+
+func TestValidateContentModel(t *testing.T) {
+	ass := assert.New(t)
+	// list requires at least two items and foot has to be the last element
+	diagnostics := validate(t, `<doc lang="en"><head/><list><item/></list><foot/><p/></doc>`)
+	ass.Equal([]DiagnosticKind{MissingElement, InvalidContent}, kinds(diagnostics))
+	ass.Equal("/doc/list", diagnostics[0].Path)
+	ass.Equal("item", diagnostics[0].Element)
+	ass.Equal("/doc", diagnostics[1].Path)
+}
+
+func TestValidateText(t *testing.T) {
+	ass := assert.New(t)
+	diagnostics := validate(t, `<doc lang="en"><head/>text<p><ref idref="x">text</ref></p></doc>`)
+	ass.Equal([]DiagnosticKind{UnexpectedText, UnexpectedText, UnknownIDRef}, kinds(diagnostics))
+	ass.Equal("ref", diagnostics[0].Element)
+	ass.Equal("doc", diagnostics[1].Element)
+	ass.Equal("x", diagnostics[2].Value)
+}
+
+func TestValidateAttributes(t *testing.T) {
+	ass := assert.New(t)
+	diagnostics := validate(t, `<doc status="x" version="2.0" color="red"><head/><p id="a"/><p id="a"/></doc>`)
+	ass.Equal([]DiagnosticKind{InvalidAttributeValue, InvalidAttributeValue, UnknownAttribute, MissingAttribute, DuplicateID}, kinds(diagnostics))
+	ass.Equal("status", diagnostics[0].Attribute)
+	ass.Equal("x", diagnostics[0].Value)
+	ass.Equal("version", diagnostics[1].Attribute)
+	ass.Equal("color", diagnostics[2].Attribute)
+	ass.Equal("lang", diagnostics[3].Attribute)
+	ass.Equal("a", diagnostics[4].Value)
+}
+
+func TestValidateMalformed(t *testing.T) {
+	ass := assert.New(t)
+	diagnostics := validate(t, `<other lang="en"><head>A & B</head></other>`)
+	ass.Equal([]DiagnosticKind{UnexpectedRoot, UnknownElement, MalformedXML}, kinds(diagnostics))
+	ass.Equal(1, diagnostics[2].Line)
+
+	diagnostics = validate(t, `<doc lang="en"><head></p></doc>`)
+	ass.Equal([]DiagnosticKind{MalformedXML}, kinds(diagnostics))
+}
+
+func TestValidateTestData(t *testing.T) {
+	ass := assert.New(t)
+	d, err := ParseFile("../../dtds/1-5.dtd")
+	ass.NoError(err)
+	f, err := os.Open("../eps/test-data/application/v1-5-A1.xml")
+	ass.NoError(err)
+	defer f.Close()
+	diagnostics, err := d.Validate(f, "ep-patent-document")
+	ass.NoError(err)
+	ass.Empty(diagnostics)
+}
@@ -0,0 +1,57 @@
+package eps
+
+import (
+	"errors"
+)
+
+var ErrUnknownDtdVersion = errors.New("unknown dtd version")
+
+// ErrNoDtdAvailable is returned if the dtd of a known dtd-version is not available
+var ErrNoDtdAvailable = errors.New("no dtd available")
+
+// DtdVersion is a dtd-version of the ep-patent-document, the file of its dtd and its extraction rules
+type DtdVersion struct {
+	Version string // value of the dtd-version attribute, e.g. ep-patent-document-v1-5
+	DTD     string // file of the dtd in the dtds package, empty if the dtd is not available
+	// IPC7 extracts the classifications of the 7th edition of the IPC (B510),
+	// which are replaced by the IPC8 classifications (B510EP) since version 1.3
+	IPC7 bool
+	// CPC extracts the CPC classifications (B520EP), which are added in version 1.5.1
+	CPC bool
+}
+
+// dtdVersions is the registry of the supported dtd-versions, ordered by version.
+// The dtd of version 1.01 is not available, the documents are validated with the dtd of version 1.0.
+// The dtds of version 1.2 and 1.5.1 are not available, see dtds/README.md.
+var dtdVersions = []DtdVersion{
+	{Version: "ep-patent-document-v1-0", DTD: "1-0.dtd", IPC7: true},
+	{Version: "ep-patent-document-v1-01", DTD: "1-0.dtd", IPC7: true},
+	{Version: "ep-patent-document-v1-1", DTD: "1-1.dtd", IPC7: true},
+	{Version: "ep-patent-document-v1-2", IPC7: true},
+	{Version: "ep-patent-document-v1-3", DTD: "1-3.dtd"},
+	{Version: "ep-patent-document-v1-4", DTD: "1-4.dtd"},
+	{Version: "ep-patent-document-v1-5", DTD: "1-5.dtd"},
+	{Version: "ep-patent-document-v1-5-1", CPC: true},
+}
+
+// DtdVersions returns the supported dtd-versions
+func DtdVersions() []DtdVersion {
+	res := make([]DtdVersion, len(dtdVersions))
+	copy(res, dtdVersions)
+	return res
+}
+
+// LatestDtdVersion returns the latest supported dtd-version
+func LatestDtdVersion() DtdVersion {
+	return dtdVersions[len(dtdVersions)-1]
+}
+
+// LookupDtdVersion returns the dtd-version, unknown versions result in ErrUnknownDtdVersion
+func LookupDtdVersion(version string) (DtdVersion, error) {
+	for _, v := range dtdVersions {
+		if v.Version == version {
+			return v, nil
+		}
+	}
+	return DtdVersion{}, ErrUnknownDtdVersion
+}
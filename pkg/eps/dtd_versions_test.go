@@ -0,0 +1,127 @@
+package eps
+
+import (
+	"bytes"
+	"github.com/max-planck-innovation-competition/go-epo-eps/dtds"
+	"github.com/stretchr/testify/assert"
+	"io/fs"
+	"os"
+	"testing"
+)
+
+func TestDtdVersions(t *testing.T) {
+	ass := assert.New(t)
+	for _, v := range DtdVersions() {
+		if v.DTD == "" {
+			continue
+		}
+		_, err := fs.Stat(dtds.FS, v.DTD)
+		ass.NoError(err, v.Version)
+	}
+	ass.Equal("ep-patent-document-v1-5-1", LatestDtdVersion().Version)
+	ass.True(LatestDtdVersion().CPC)
+
+	// all versions of the test data are supported
+	for _, file := range corpus(t) {
+		data, err := os.ReadFile(file)
+		ass.NoError(err)
+		version, err := readDtdVersion(data)
+		ass.NoError(err)
+		_, err = LookupDtdVersion(version)
+		ass.NoError(err, file)
+	}
+
+	v, err := LookupDtdVersion("ep-patent-document-v1-1")
+	ass.NoError(err)
+	ass.Equal("1-1.dtd", v.DTD)
+
+	ass.True(v.IPC7)
+	ass.False(v.CPC)
+
+	v, err = LookupDtdVersion("ep-patent-document-v1-2")
+	ass.NoError(err)
+	ass.Empty(v.DTD)
+	ass.True(v.IPC7)
+	_, err = DTD(v.Version)
+	ass.ErrorIs(err, ErrNoDtdAvailable)
+
+	_, err = LookupDtdVersion("ep-patent-document-v9-9")
+	ass.ErrorIs(err, ErrUnknownDtdVersion)
+}
+
+func TestProcessXMLSimpleUnknownDtdVersion(t *testing.T) {
+	ass := assert.New(t)
+	data, err := os.ReadFile("test-data/application/v1-5-A1.xml")
+	ass.NoError(err)
+	data = bytes.Replace(data, []byte(`dtd-version="ep-patent-document-v1-5"`), []byte(`dtd-version="ep-patent-document-v1-6"`), 1)
+
+	// the document is parsed like the known versions
+	doc, err := ProcessXMLSimple(data)
+	ass.NoError(err)
+	ass.Equal("ep-patent-document-v1-6", doc.DtdVersion)
+	ass.NotEmpty(doc.Abstract)
+	ass.NotEmpty(doc.Classifications)
+	ass.Len(doc.Warnings, 1)
+	ass.Equal("DtdVersion", doc.Warnings[0].Field)
+	ass.Equal("ep-patent-document-v1-6", doc.Warnings[0].Value)
+	ass.Equal("unknown dtd version, parsed as ep-patent-document-v1-5-1", doc.Warnings[0].Message)
+
+	_, err = ProcessXMLSimpleWithOptions(data, ProcessOptions{Strict: true})
+	ass.ErrorIs(err, ErrParseWarnings)
+
+	_, err = ValidateXML(data)
+	ass.ErrorIs(err, ErrUnknownDtdVersion)
+}
+
+// withDtdVersion reads the test data and replaces its dtd-version
+func withDtdVersion(t *testing.T, file, from, to string) []byte {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return bytes.Replace(data, []byte(`dtd-version="`+from+`"`), []byte(`dtd-version="`+to+`"`), 1)
+}
+
+func TestProcessXMLSimpleDtdVersionIPC7(t *testing.T) {
+	ass := assert.New(t)
+	for _, version := range []string{"ep-patent-document-v1-0", "ep-patent-document-v1-01", "ep-patent-document-v1-1", "ep-patent-document-v1-2"} {
+		data := withDtdVersion(t, "test-data/application/v1-1-A1.xml", "ep-patent-document-v1-1", version)
+		doc, err := ProcessXMLSimple(data)
+		ass.NoError(err, version)
+		ass.Len(doc.Classifications, 4, version)
+		ass.Equal("C07D 307/12", doc.Classifications[0].Symbol(), version)
+	}
+	// the B510 element of later versions is not extracted
+	for _, version := range []string{"ep-patent-document-v1-3", "ep-patent-document-v1-4", "ep-patent-document-v1-5", "ep-patent-document-v1-5-1"} {
+		data := withDtdVersion(t, "test-data/application/v1-1-A1.xml", "ep-patent-document-v1-1", version)
+		doc, err := ProcessXMLSimple(data)
+		ass.NoError(err, version)
+		ass.Empty(doc.Classifications, version)
+		ass.Len(doc.Warnings, 1, version)
+		ass.Equal(SeverityInfo, doc.Warnings[0].Severity, version)
+		ass.Equal("/ep-patent-document/SDOBI/B500/B510", doc.Warnings[0].Path, version)
+	}
+}
+
+func TestProcessXMLSimpleDtdVersionCPC(t *testing.T) {
+	ass := assert.New(t)
+	data, err := os.ReadFile("test-data/grant/v1-5-1-B2.xml")
+	ass.NoError(err)
+	doc, err := ProcessXMLSimple(data)
+	ass.NoError(err)
+	ass.Len(doc.Classifications, 2)
+	ass.Equal(IPC, doc.Classifications[0].System)
+	ass.Equal(CPC, doc.Classifications[1].System)
+	// the B520EP element of earlier versions is not extracted
+	for _, version := range []string{"ep-patent-document-v1-2", "ep-patent-document-v1-3", "ep-patent-document-v1-4", "ep-patent-document-v1-5"} {
+		data := withDtdVersion(t, "test-data/grant/v1-5-1-B2.xml", "ep-patent-document-v1-5-1", version)
+		doc, err := ProcessXMLSimple(data)
+		ass.NoError(err, version)
+		ass.Len(doc.Classifications, 1, version)
+		ass.Equal(IPC, doc.Classifications[0].System, version)
+		// the specifications of granted patents do not contain the abstract
+		ass.Len(doc.Warnings, 2, version)
+		ass.Equal(SeverityInfo, doc.Warnings[0].Severity, version)
+		ass.Equal("/ep-patent-document/SDOBI/B500/B520EP", doc.Warnings[0].Path, version)
+	}
+}
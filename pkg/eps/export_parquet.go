@@ -0,0 +1,226 @@
+package eps
+
+import (
+	"errors"
+	"github.com/parquet-go/parquet-go"
+	"github.com/parquet-go/parquet-go/compress"
+	log "github.com/sirupsen/logrus"
+	"io"
+	"time"
+)
+
+// DefaultParquetRowGroupSize is the number of documents of a row group
+const DefaultParquetRowGroupSize = 10000
+
+// ParquetCompression is the compression of the columns
+type ParquetCompression string
+
+const (
+	ParquetSnappy       ParquetCompression = "snappy"
+	ParquetZstd         ParquetCompression = "zstd"
+	ParquetGzip         ParquetCompression = "gzip"
+	ParquetUncompressed ParquetCompression = "uncompressed"
+)
+
+// parquetCodecs are the codecs of the compressions
+var parquetCodecs = map[ParquetCompression]compress.Codec{
+	ParquetSnappy:       &parquet.Snappy,
+	ParquetZstd:         &parquet.Zstd,
+	ParquetGzip:         &parquet.Gzip,
+	ParquetUncompressed: &parquet.Uncompressed,
+}
+
+// ErrUnknownParquetCompression is returned for compressions that are not supported
+var ErrUnknownParquetCompression = errors.New("unknown parquet compression")
+
+// ParquetOptions are the options of the parquet export
+type ParquetOptions struct {
+	RowGroupSize int                // number of documents of a row group, DefaultParquetRowGroupSize by default
+	Compression  ParquetCompression // ParquetSnappy by default
+}
+
+// ParquetDocument is the row of a document in the parquet files
+type ParquetDocument struct {
+	ID                string                  `parquet:"id"`
+	Country           string                  `parquet:"country,dict"`
+	DocNumber         string                  `parquet:"doc_number"`
+	Kind              string                  `parquet:"kind,dict"`
+	Lang              string                  `parquet:"lang,dict"`
+	DatePubl          int32                   `parquet:"date_publ,optional,date"` // days since 1970-01-01
+	Status            string                  `parquet:"status,dict"`
+	DtdVersion        string                  `parquet:"dtd_version,dict"`
+	Titles            []ParquetText           `parquet:"titles,list"`
+	Abstracts         []ParquetText           `parquet:"abstracts,list"`
+	Claims            []ParquetClaim          `parquet:"claims,list"`
+	Inventors         []ParquetParty          `parquet:"inventors,list"`
+	Owners            []ParquetParty          `parquet:"owners,list"`
+	Classifications   []ParquetClassification `parquet:"classifications,list"`
+	ContractingStates []string                `parquet:"contracting_states,list"`
+}
+
+// ParquetText is a text in a language, e.g. a title
+type ParquetText struct {
+	Language string `parquet:"language,dict"`
+	Text     string `parquet:"text"`
+}
+
+// ParquetClaim is a single claim of the claims in a language
+type ParquetClaim struct {
+	Language   string  `parquet:"language,dict"`
+	Number     int32   `parquet:"number"` // 0 if the claim is not numbered
+	Text       string  `parquet:"text"`
+	References []int32 `parquet:"references,list"` // numbers of the claims it refers to
+}
+
+// ParquetParty is an inventor or an owner
+type ParquetParty struct {
+	Name    string `parquet:"name"`
+	Country string `parquet:"country,dict"`
+	City    string `parquet:"city"`
+}
+
+// ParquetClassification is a classification, e.g. B65D 51/18
+type ParquetClassification struct {
+	System    string `parquet:"system,dict"`
+	Symbol    string `parquet:"symbol"`
+	Section   string `parquet:"section,dict"`
+	Class     string `parquet:"class,dict"`
+	SubClass  string `parquet:"sub_class,dict"`
+	MainGroup string `parquet:"main_group"`
+	SubGroup  string `parquet:"sub_group"`
+}
+
+// NewParquetDocument converts the document into the row of the parquet files
+func NewParquetDocument(doc *EpPatentDocumentSimple) (res ParquetDocument) {
+	res = ParquetDocument{
+		ID:         doc.ID,
+		Country:    string(doc.Country),
+		DocNumber:  doc.DocNumber,
+		Kind:       doc.Kind,
+		Lang:       doc.Lang,
+		Status:     doc.Status,
+		DtdVersion: doc.DtdVersion,
+	}
+	if !doc.DatePubl.IsZero() {
+		res.DatePubl = int32(doc.DatePubl.Unix() / int64(24*time.Hour/time.Second))
+	}
+	for _, t := range doc.Title {
+		res.Titles = append(res.Titles, ParquetText{Language: t.Language, Text: t.Text})
+	}
+	for _, a := range doc.Abstract {
+		res.Abstracts = append(res.Abstracts, ParquetText{Language: a.Language, Text: a.Text})
+	}
+	for _, c := range doc.Claims {
+		for _, item := range c.Items {
+			claim := ParquetClaim{Language: c.Language, Number: int32(item.Number()), Text: item.Text}
+			for _, r := range item.References() {
+				claim.References = append(claim.References, int32(r))
+			}
+			res.Claims = append(res.Claims, claim)
+		}
+	}
+	for _, i := range doc.Inventors {
+		res.Inventors = append(res.Inventors, ParquetParty{Name: i.Name, Country: string(i.Country), City: i.City})
+	}
+	for _, o := range doc.Owners {
+		res.Owners = append(res.Owners, ParquetParty{Name: o.Name, Country: string(o.Country), City: o.City})
+	}
+	for _, c := range doc.Classifications {
+		res.Classifications = append(res.Classifications, ParquetClassification{
+			System:    string(c.System),
+			Symbol:    c.Symbol(),
+			Section:   c.Section,
+			Class:     c.Class,
+			SubClass:  c.SubClass,
+			MainGroup: c.MainGroup,
+			SubGroup:  c.SubGroup,
+		})
+	}
+	for _, c := range doc.ContractingStates {
+		res.ContractingStates = append(res.ContractingStates, string(c))
+	}
+	return
+}
+
+// ParquetWriter writes batches of documents into a parquet file
+type ParquetWriter struct {
+	w            *parquet.GenericWriter[ParquetDocument]
+	rowGroupSize int
+	rows         int // rows of the current row group
+}
+
+// NewParquetWriter creates a writer, Close must be called after the last batch to write the footer of the file
+func NewParquetWriter(w io.Writer, opts ParquetOptions) (*ParquetWriter, error) {
+	if opts.RowGroupSize <= 0 {
+		opts.RowGroupSize = DefaultParquetRowGroupSize
+	}
+	if opts.Compression == "" {
+		opts.Compression = ParquetSnappy
+	}
+	codec, ok := parquetCodecs[opts.Compression]
+	if !ok {
+		err := ErrUnknownParquetCompression
+		log.WithError(err).WithField("compression", opts.Compression).Error("can not create parquet writer")
+		return nil, err
+	}
+	return &ParquetWriter{
+		w:            parquet.NewGenericWriter[ParquetDocument](w, parquet.Compression(codec)),
+		rowGroupSize: opts.RowGroupSize,
+	}, nil
+}
+
+// Write writes the documents, a row group is completed after RowGroupSize documents
+func (p *ParquetWriter) Write(docs ...EpPatentDocumentSimple) (err error) {
+	for i := range docs {
+		_, err = p.w.Write([]ParquetDocument{NewParquetDocument(&docs[i])})
+		if err != nil {
+			log.WithError(err).WithField("id", docs[i].ID).Error("can not write parquet row")
+			return
+		}
+		p.rows++
+		if p.rows >= p.rowGroupSize {
+			err = p.Flush()
+			if err != nil {
+				return
+			}
+		}
+	}
+	return
+}
+
+// Flush completes the current row group
+func (p *ParquetWriter) Flush() (err error) {
+	if p.rows == 0 {
+		return
+	}
+	err = p.w.Flush()
+	if err != nil {
+		log.WithError(err).Error("can not write parquet row group")
+		return
+	}
+	p.rows = 0
+	return
+}
+
+// Close completes the row group and writes the footer of the file, the underlying writer is not closed
+func (p *ParquetWriter) Close() (err error) {
+	err = p.w.Close()
+	if err != nil {
+		log.WithError(err).Error("can not close parquet writer")
+		return
+	}
+	return
+}
+
+// WriteParquet writes the documents into a parquet file
+func WriteParquet(w io.Writer, docs []EpPatentDocumentSimple, opts ParquetOptions) (err error) {
+	pw, err := NewParquetWriter(w, opts)
+	if err != nil {
+		return
+	}
+	err = pw.Write(docs...)
+	if err != nil {
+		return
+	}
+	return pw.Close()
+}
@@ -0,0 +1,85 @@
+package eps
+
+import (
+	"bytes"
+	"fmt"
+	"github.com/parquet-go/parquet-go"
+	"github.com/stretchr/testify/assert"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestNewParquetDocument(t *testing.T) {
+	ass := assert.New(t)
+	data, err := os.ReadFile("test-data/grant/v1-5-1-B1.xml")
+	ass.NoError(err)
+	doc, err := ProcessXMLSimple(data)
+	ass.NoError(err)
+	res := NewParquetDocument(&doc)
+	ass.Equal("EP", res.Country)
+	ass.Equal("3383757", res.DocNumber)
+	ass.Equal("B1", res.Kind)
+	ass.Equal(doc.DatePubl, time.Unix(int64(res.DatePubl)*24*60*60, 0).UTC())
+	ass.Contains(res.Titles, ParquetText{Language: "en", Text: "SCREW-TYPE CLOSURE SYSTEMS WITH MAGNETIC FEATURE"})
+	ass.NotEmpty(res.Claims)
+	ass.Equal(int32(1), res.Claims[0].Number)
+	ass.Equal([]int32{1}, res.Claims[1].References)
+	ass.Equal("ELC Management LLC", res.Owners[0].Name)
+	ass.NotEmpty(res.Inventors)
+	ass.NotEmpty(res.Classifications)
+	ass.Equal("B65D 51/18", res.Classifications[0].Symbol)
+	ass.NotEmpty(res.ContractingStates)
+
+	// documents without date of publication
+	ass.Equal(int32(0), NewParquetDocument(&EpPatentDocumentSimple{ID: "EP1"}).DatePubl)
+}
+
+func TestWriteParquet(t *testing.T) {
+	ass := assert.New(t)
+	files, err := filepath.Glob("test-data/*/*.xml")
+	ass.NoError(err)
+	var docs []EpPatentDocumentSimple
+	for _, file := range files {
+		data, err := os.ReadFile(file)
+		ass.NoError(err)
+		doc, err := ProcessXMLSimple(data)
+		ass.NoError(err)
+		docs = append(docs, doc)
+	}
+
+	var buf bytes.Buffer
+	w, err := NewParquetWriter(&buf, ParquetOptions{RowGroupSize: 10, Compression: ParquetZstd})
+	ass.NoError(err)
+	// batches of documents
+	ass.NoError(w.Write(docs[:15]...))
+	ass.NoError(w.Write(docs[15:]...))
+	ass.NoError(w.Close())
+
+	f, err := parquet.OpenFile(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	ass.NoError(err)
+	ass.Equal(int64(len(docs)), f.NumRows())
+	ass.Len(f.RowGroups(), (len(docs)+9)/10)
+	ass.Equal(int64(10), f.RowGroups()[0].NumRows())
+	ass.NotNil(f.Schema().Lookup("claims", "list", "element", "text"))
+	ass.NotNil(f.Schema().Lookup("classifications", "list", "element", "symbol"))
+
+	rows, err := parquet.Read[ParquetDocument](bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	ass.NoError(err)
+	ass.Len(rows, len(docs))
+	for i := range rows {
+		// empty lists are read as empty slices instead of nil
+		ass.Equal(fmt.Sprintf("%+v", NewParquetDocument(&docs[i])), fmt.Sprintf("%+v", rows[i]), files[i])
+	}
+
+	// single batch with the default options
+	buf.Reset()
+	ass.NoError(WriteParquet(&buf, docs[:2], ParquetOptions{}))
+	rows, err = parquet.Read[ParquetDocument](bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	ass.NoError(err)
+	ass.Len(rows, 2)
+
+	_, err = NewParquetWriter(&buf, ParquetOptions{Compression: "lzma"})
+	ass.ErrorIs(err, ErrUnknownParquetCompression)
+}
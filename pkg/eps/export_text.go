@@ -0,0 +1,277 @@
+package eps
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// TextOptions are the options of the markdown and plain text export
+type TextOptions struct {
+	Language      string     // language of the texts, the language of the document by default
+	Bibliographic bool       // adds the publication number, the date, the parties, the classifications and the states
+	Math          MathFormat // format of the formulas of the xml exports, MathText by default
+}
+
+var (
+	// tablePlaceholderPattern matches the placeholders of the tables in the texts, see TablePlaceholder
+	tablePlaceholderPattern = regexp.MustCompile(`\[table:([^\]\s]+)\]`)
+	// markdownMathPattern matches the LaTeX formulas, which are not escaped
+	markdownMathPattern = regexp.MustCompile(`\$\$[^$]+\$\$|\$[^$\n]+\$`)
+	// markdownLineStart matches the starts of lines that markdown reads as headings, quotes or lists
+	markdownLineStart = regexp.MustCompile(`^(#|>|[-+] )`)
+	// markdownOrderedList matches the start of lines that markdown reads as ordered list, e.g. 1. or 1)
+	markdownOrderedList = regexp.MustCompile(`^(\d+)([.)] )`)
+	// markdownEscaper escapes the characters that markdown reads as emphasis, code or html
+	markdownEscaper = strings.NewReplacer(`\`, `\\`, `*`, `\*`, `_`, `\_`, "`", "\\`", `<`, `&lt;`)
+)
+
+// ExportMarkdown renders the document as markdown with the abstract, the numbered paragraphs of the description and the claims.
+// Tables are rendered as markdown tables, unless the document was parsed with the InlineTables option.
+func ExportMarkdown(doc *EpPatentDocumentSimple, opts TextOptions) string {
+	return exportText(doc, opts, true)
+}
+
+// ExportText renders the document as plain text with normalized whitespace,
+// e.g. for natural language processing. Tables are rendered with aligned columns.
+func ExportText(doc *EpPatentDocumentSimple, opts TextOptions) string {
+	return exportText(doc, opts, false)
+}
+
+// ExportMarkdownFromXML parses the xml data with table placeholders and readable formulas and renders it as markdown
+func ExportMarkdownFromXML(raw []byte, opts TextOptions) (string, error) {
+	doc, err := processForExport(raw, opts)
+	if err != nil {
+		return "", err
+	}
+	return ExportMarkdown(&doc, opts), nil
+}
+
+// ExportTextFromXML parses the xml data with table placeholders and readable formulas and renders it as plain text
+func ExportTextFromXML(raw []byte, opts TextOptions) (string, error) {
+	doc, err := processForExport(raw, opts)
+	if err != nil {
+		return "", err
+	}
+	return ExportText(&doc, opts), nil
+}
+
+// processForExport parses the xml data with the options of the exports
+func processForExport(raw []byte, opts TextOptions) (EpPatentDocumentSimple, error) {
+	math := opts.Math
+	if math == MathRaw {
+		math = MathText
+	}
+	return ProcessXMLSimpleWithOptions(raw, ProcessOptions{Math: math})
+}
+
+// textExporter writes the blocks of the document as markdown or plain text, the blocks are separated by empty lines
+type textExporter struct {
+	sb       strings.Builder
+	markdown bool
+	tables   map[string]Table
+}
+
+// exportText renders the document
+func exportText(doc *EpPatentDocumentSimple, opts TextOptions, markdown bool) string {
+	e := &textExporter{markdown: markdown, tables: map[string]Table{}}
+	for _, t := range doc.Tables {
+		if _, ok := e.tables[t.ID]; !ok {
+			e.tables[t.ID] = t
+		}
+	}
+	lang := opts.Language
+	if lang == "" {
+		lang = doc.Lang
+	}
+	if t, ok := doc.TitleIn(lang); ok {
+		e.heading(1, t.Text)
+	}
+	if opts.Bibliographic {
+		e.bibliographic(doc)
+	}
+	if a, ok := doc.AbstractIn(lang); ok && strings.TrimSpace(a.Text) != "" {
+		e.heading(2, "Abstract")
+		e.paragraph("", a.Text, false)
+	}
+	if d, ok := doc.DescriptionIn(lang); ok {
+		e.heading(2, "Description")
+		if len(d.Paragraphs) == 0 {
+			e.paragraph("", d.Text, true)
+		}
+		for _, p := range d.Paragraphs {
+			if p.Heading {
+				e.heading(3, p.Text)
+				continue
+			}
+			label := ""
+			if p.Num != "" {
+				label = "[" + p.Num + "]"
+			}
+			// the lines of the paragraphs are wrapped lines of the xml file
+			e.paragraph(label, p.Text, false)
+		}
+	}
+	if claims := doc.ClaimsIn(lang); len(claims) > 0 {
+		e.heading(2, "Claims")
+		for _, c := range claims {
+			if len(c.Items) == 0 {
+				e.paragraph("", c.Text, true)
+			}
+			for _, item := range c.Items {
+				label := ""
+				if n := item.Number(); n > 0 {
+					label = strconv.Itoa(n) + "."
+				}
+				// the lines of the claims are the parts of the claim (claim-text)
+				e.paragraph(label, item.Text, true)
+			}
+		}
+	}
+	return strings.TrimRight(e.sb.String(), "\n") + "\n"
+}
+
+// bibliographic writes the bibliographic data as list
+func (e *textExporter) bibliographic(doc *EpPatentDocumentSimple) {
+	var lines []string
+	add := func(key string, values []string) {
+		if len(values) == 0 {
+			return
+		}
+		for i, v := range values {
+			values[i] = strings.Join(strings.Fields(v), " ")
+		}
+		line := key + ": " + strings.Join(values, "; ")
+		if e.markdown {
+			line = "- **" + key + "**: " + escapeMarkdown(strings.Join(values, "; "))
+		}
+		lines = append(lines, line)
+	}
+	add("Publication", []string{strings.TrimSpace(string(doc.Country) + " " + doc.DocNumber + " " + doc.Kind)})
+	if !doc.DatePubl.IsZero() {
+		add("Date of publication", []string{doc.DatePubl.Format("2006-01-02")})
+	}
+	var values []string
+	for _, o := range doc.Owners {
+		values = append(values, o.Name)
+	}
+	add("Applicants", values)
+	values = nil
+	for _, i := range doc.Inventors {
+		values = append(values, i.Name)
+	}
+	add("Inventors", values)
+	values = nil
+	for _, r := range doc.Representatives {
+		values = append(values, r.Name)
+	}
+	add("Representatives", values)
+	values = nil
+	for _, c := range doc.Classifications {
+		values = append(values, c.Symbol())
+	}
+	add("Classifications", values)
+	values = nil
+	for _, c := range doc.ContractingStates {
+		values = append(values, string(c))
+	}
+	if len(values) > 0 {
+		add("Designated states", []string{strings.Join(values, " ")})
+	}
+	e.block(strings.Join(lines, "\n"))
+}
+
+// heading writes a heading, the levels are only visible in markdown
+func (e *textExporter) heading(level int, text string) {
+	text = strings.Join(strings.Fields(text), " ")
+	if text == "" {
+		return
+	}
+	if e.markdown {
+		text = strings.Repeat("#", level) + " " + escapeMarkdown(text)
+	}
+	e.block(text)
+}
+
+// paragraph writes the text with the label, e.g. the paragraph number [0001] or the claim number 1.
+// The placeholders of the tables are replaced by the tables.
+func (e *textExporter) paragraph(label, text string, keepLines bool) {
+	if label != "" && e.markdown {
+		label = "**" + label + "**"
+	}
+	last := 0
+	for _, m := range tablePlaceholderPattern.FindAllStringSubmatchIndex(text, -1) {
+		table, ok := e.tables[text[m[2]:m[3]]]
+		if !ok {
+			continue
+		}
+		e.lines(label, text[last:m[0]], keepLines)
+		label = ""
+		if e.markdown {
+			e.block(strings.TrimRight(table.Markdown(), "\n"))
+		} else {
+			e.block(strings.TrimRight(table.Text(), "\n"))
+		}
+		last = m[1]
+	}
+	e.lines(label, text[last:], keepLines)
+}
+
+// lines writes the normalized lines of the text, markdown lines are joined with hard line breaks.
+// Without keepLines, the lines are joined into one line.
+func (e *textExporter) lines(label, text string, keepLines bool) {
+	if !keepLines {
+		text = strings.ReplaceAll(text, "\n", " ")
+	}
+	var lines []string
+	for _, line := range strings.Split(text, "\n") {
+		line = strings.Join(strings.Fields(line), " ")
+		if line == "" {
+			continue
+		}
+		if e.markdown {
+			line = escapeMarkdown(line)
+		}
+		lines = append(lines, line)
+	}
+	if len(lines) == 0 {
+		if label != "" {
+			e.block(label)
+		}
+		return
+	}
+	if label != "" {
+		lines[0] = label + " " + lines[0]
+	}
+	separator := "\n"
+	if e.markdown {
+		separator = "\\\n"
+	}
+	e.block(strings.Join(lines, separator))
+}
+
+// block writes a block followed by an empty line
+func (e *textExporter) block(text string) {
+	if text == "" {
+		return
+	}
+	e.sb.WriteString(text + "\n\n")
+}
+
+// escapeMarkdown escapes the text of a line, LaTeX formulas are kept
+func escapeMarkdown(line string) string {
+	var sb strings.Builder
+	last := 0
+	for _, m := range markdownMathPattern.FindAllStringIndex(line, -1) {
+		sb.WriteString(markdownEscaper.Replace(line[last:m[0]]))
+		sb.WriteString(line[m[0]:m[1]])
+		last = m[1]
+	}
+	sb.WriteString(markdownEscaper.Replace(line[last:]))
+	res := sb.String()
+	if markdownLineStart.MatchString(res) {
+		return `\` + res
+	}
+	// digits can not be escaped
+	return markdownOrderedList.ReplaceAllString(res, `$1\$2`)
+}
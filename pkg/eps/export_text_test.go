@@ -0,0 +1,86 @@
+package eps
+
+import (
+	"github.com/stretchr/testify/assert"
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestExportText(t *testing.T) {
+	ass := assert.New(t)
+	res, err := ExportTextFromXML([]byte(tablesTestDocument), TextOptions{})
+	ass.NoError(err)
+	ass.Equal("Description\n\n"+
+		"[0001] The results are shown in Table 1.\n\n"+
+		"Table 1 | Results\n"+
+		"Sample | Yield A | B\n"+
+		"-------+---------+---------\n"+
+		"1      | 10 %    | 20, 30 %\n"+
+		"       | x | y   | 40 %\n\n"+
+		"The yield is high.\n", res)
+
+	data, err := os.ReadFile("test-data/grant/v1-5-1-B1.xml")
+	ass.NoError(err)
+	res, err = ExportTextFromXML(data, TextOptions{Bibliographic: true})
+	ass.NoError(err)
+	ass.True(strings.HasPrefix(res, "SCREW-TYPE CLOSURE SYSTEMS WITH MAGNETIC FEATURE\n\n"+
+		"Publication: EP 3383757 B1\n"+
+		"Date of publication: 2021-07-07\n"+
+		"Applicants: ELC Management LLC\n"))
+	ass.Contains(res, "\n\nField of the Invention\n\n[0001] The present invention pertains to screw-type container/closure systems,")
+	ass.Contains(res, "\n\nClaims\n\n1. A container-closure system comprising:\n")
+	ass.Contains(res, "\n\n2. The container-closure system of claim 1 wherein:\nthe container (1) comprises:\n")
+	ass.NotContains(res, "  ")
+
+	// without bibliographic data
+	res, err = ExportTextFromXML(data, TextOptions{Language: "de"})
+	ass.NoError(err)
+	ass.True(strings.HasPrefix(res, "SCHRAUBVERSCHLUSSSYSTEME MIT MAGNETISCHEM MERKMAL\n\nDescription\n\n"))
+	ass.NotContains(res, "Publication:")
+
+	// readable formulas
+	data, err = os.ReadFile("test-data/application/v1-5-1-A1-2.xml")
+	ass.NoError(err)
+	res, err = ExportTextFromXML(data, TextOptions{})
+	ass.NoError(err)
+	ass.Contains(res, "Qc = KA(Tc − Ta) = Gr(Hi − Ho)")
+}
+
+func TestExportMarkdown(t *testing.T) {
+	ass := assert.New(t)
+	res, err := ExportMarkdownFromXML([]byte(tablesTestDocument), TextOptions{Bibliographic: true})
+	ass.NoError(err)
+	ass.Equal("- **Publication**: EP 00000001 A1\n"+
+		"- **Date of publication**: 2020-01-01\n\n"+
+		"## Description\n\n"+
+		"**[0001]** The results are shown in Table 1.\n\n"+
+		"**Table 1 \\| Results**\n\n"+
+		"| Sample | Yield A | B |\n"+
+		"| --- | --- | --- |\n"+
+		"| 1 | 10 % | 20, 30 % |\n"+
+		"|  | x \\| y | 40 % |\n\n"+
+		"The yield is high.\n", res)
+
+	data, err := os.ReadFile("test-data/application/v1-5-1-A1-2.xml")
+	ass.NoError(err)
+	res, err = ExportMarkdownFromXML(data, TextOptions{Math: MathLaTeX})
+	ass.NoError(err)
+	// the formulas are not escaped
+	ass.Contains(res, `$$\mathrm{Qc}=\mathrm{KA}\left(\mathrm{Tc}-\mathrm{Ta}\right)=\mathrm{Gr}\left(\mathrm{Hi}-\mathrm{Ho}\right)$$`)
+
+	// documents without paragraphs and claims with several lines
+	doc := EpPatentDocumentSimple{
+		Lang:        "en",
+		Title:       []Title{{Text: "Widget", Language: "en"}},
+		Description: []Description{{Language: "en", Text: "# not a heading\n1. not a list"}},
+		Claims: []Claim{{Language: "en", Items: []ClaimItem{
+			{Num: "0001", Text: "A widget comprising:\n- a *part*,\n- a <b> part_2"},
+		}}},
+	}
+	ass.Equal("# Widget\n\n"+
+		"## Description\n\n"+
+		"\\# not a heading\\\n1\\. not a list\n\n"+
+		"## Claims\n\n"+
+		"**1.** A widget comprising:\\\n\\- a \\*part\\*,\\\n\\- a &lt;b> part\\_2\n", ExportMarkdown(&doc, TextOptions{}))
+}
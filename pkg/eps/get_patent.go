@@ -1,12 +1,11 @@
 package eps
 
 import (
-	"bytes"
 	"errors"
-	"github.com/PuerkitoBio/goquery"
 	log "github.com/sirupsen/logrus"
 	"io"
 	"net/http"
+	"net/url"
 	"strconv"
 	"strings"
 )
@@ -24,10 +23,37 @@ const (
 
 // getPatent executes the http request using the id and the export format
 func getPatent(patentID string, format PatentExportFormat) (res []byte, err error) {
+	res, _, err = getPatentPage(patentID, format)
+	return
+}
+
+// getPatentPage returns the response of the export format and the url of the response,
+// which is the base of the relative links of the html pages
+func getPatentPage(patentID string, format PatentExportFormat) (res []byte, pageURL *url.URL, err error) {
+	reqUrl := EpoEndpointHost + EndpointRoot + "/" + ApiVersion + "/patents/" + patentID + "/document." + strings.ToLower(string(format))
+	res, pageURL, err = download(reqUrl)
+	if err != nil {
+		return
+	}
+	// the html and pdf formats start with an html page that links to the document
+	if format == HTML || format == PDF {
+		err = validatePublicationPage(format, res)
+	} else {
+		err = ValidateContent(format, res)
+	}
+	if err != nil {
+		log.WithError(err).WithField("url", reqUrl).Error("can not get patent")
+		res = nil
+		return
+	}
+	return
+}
+
+// download executes the http request and returns the body and the url of the response after redirects
+func download(reqUrl string) (res []byte, resURL *url.URL, err error) {
 	// init http client
 	client := NewHttpClient()
 	// build req
-	reqUrl := EpoEndpointHost + EndpointRoot + "/" + ApiVersion + "/patents/" + patentID + "/document." + strings.ToLower(string(format))
 	log.Debug("GET: ", reqUrl)
 	req, err := http.NewRequest("GET", reqUrl, nil)
 	if err != nil {
@@ -65,6 +91,10 @@ func getPatent(patentID string, format PatentExportFormat) (res []byte, err erro
 		log.Error(err)
 		return
 	}
+	resURL = req.URL
+	if resp.Request != nil {
+		resURL = resp.Request.URL
+	}
 	return
 }
 
@@ -75,57 +105,7 @@ func GetPatentXML(patentID string) (res []byte, err error) {
 
 // GetPatentHTML returns the patent in the html format
 func GetPatentHTML(patentID string) (res []byte, err error) {
-	initialResponse, err := getPatent(patentID, HTML)
-	if err != nil {
-		log.Error("GetPatentXML: can not get initial response", err)
-		return
-	}
-	doc, err := goquery.NewDocumentFromReader(bytes.NewReader(initialResponse))
-	if err != nil {
-		log.Error("GetPatentXML: can not read document", err)
-		return
-	}
-	// find the iframe on the website
-	iframes := doc.Find("#documentCenter")
-	url, exists := iframes.First().Attr("src")
-	if !exists {
-		err = errors.New("can not find iframe")
-		log.Error("GetPatentXML:", err)
-		return
-	}
-	// now perform the second request
-	// init http client
-	client := NewHttpClient()
-	req, err := http.NewRequest("GET", url, nil)
-	if err != nil {
-		log.Error(err)
-		return
-	}
-	// add header
-	req.Header.Add("user-agent", "raw")
-	// make request
-	resp, err := client.Do(req)
-	if err != nil {
-		log.Error(err)
-		return
-	}
-	if resp.StatusCode != 200 {
-		err = errors.New("No 200 status code: " + strconv.Itoa(resp.StatusCode))
-		log.Errorf("status code error: %d %s", resp.StatusCode, resp.Status)
-		return
-	}
-	res, err = io.ReadAll(resp.Body)
-	if err != nil {
-		log.Error(err)
-		return
-	}
-	// close body
-	err = resp.Body.Close()
-	if err != nil {
-		log.Error(err)
-		return
-	}
-	return
+	return getLinkedPatent(patentID, HTML)
 }
 
 // GetPatentZIP returns the patent in the zip format
@@ -135,60 +115,30 @@ func GetPatentZIP(patentID string) (res []byte, err error) {
 
 // GetPatentPDF returns the patent in the pdf format
 func GetPatentPDF(patentID string) (res []byte, err error) {
-	initialResponse, err := getPatent(patentID, PDF)
+	return getLinkedPatent(patentID, PDF)
+}
+
+// getLinkedPatent loads the html page of the format and then the document the page links to
+func getLinkedPatent(patentID string, format PatentExportFormat) (res []byte, err error) {
+	page, pageURL, err := getPatentPage(patentID, format)
 	if err != nil {
-		log.Error("GetPatentXML: can not get initial response", err)
+		log.WithError(err).WithField("format", format).Error("can not get initial response")
 		return
 	}
-	doc, err := goquery.NewDocumentFromReader(bytes.NewReader(initialResponse))
+	documentURL, err := ResolveDocumentURL(page, pageURL, format)
 	if err != nil {
-		log.Error("GetPatentXML: can not read document", err)
-		return
-	}
-	// find the iframe on the website
-	iframes := doc.Find("#body > div.epoToolBar.document > ul > li > a")
-	url, exists := iframes.First().Attr("href")
-	if !exists {
-		err = errors.New("can not find iframe")
-		log.Error("GetPatentXML:", err)
+		log.WithError(err).WithField("format", format).Error("can not find the document")
 		return
 	}
 	// now perform the second request
-	// init http client
-	client := NewHttpClient()
-	req, err := http.NewRequest("GET", url, nil)
-	if err != nil {
-		log.Error(err)
-		return
-	}
-	// add header
-	req.Header.Add("user-agent", "raw")
-	// make request
-	resp, err := client.Do(req)
-	if err != nil {
-		log.Error(err)
-		return
-	}
-	if resp.StatusCode != 200 {
-		err = errors.New("No 200 status code: " + strconv.Itoa(resp.StatusCode))
-		log.Errorf("status code error: %d %s", resp.StatusCode, resp.Status)
-		return
-	}
-	res, err = io.ReadAll(resp.Body)
+	res, _, err = download(documentURL)
 	if err != nil {
-		log.Error(err)
 		return
 	}
-	// close body
-	err = resp.Body.Close()
+	err = ValidateContent(format, res)
 	if err != nil {
-		log.Error(err)
-		return
-	}
-	// check if blacklisted
-	err = CheckIfBlackListed(res)
-	if err != nil {
-		log.Error(err)
+		log.WithError(err).WithField("url", documentURL).Error("can not get patent")
+		res = nil
 		return
 	}
 	return
@@ -24,7 +24,7 @@ func TestGetPatentHTML(t *testing.T) {
 	ass.NoError(err)
 	ass.NotNil(res)
 	ass.NotEmpty(res)
-	err = SaveFile(res, "./test-data/", string(testID)+".html")
+	err = SaveFile(res, t.TempDir()+"/", string(testID)+".html")
 	ass.NoError(err)
 }
 
@@ -38,7 +38,7 @@ func TestGetPatentZIP(t *testing.T) {
 	ass.NoError(err)
 	ass.NotNil(res)
 	ass.NotEmpty(res)
-	err = SaveFile(res, "./test-data/", string(testID)+".zip")
+	err = SaveFile(res, t.TempDir()+"/", string(testID)+".zip")
 	ass.NoError(err)
 }
 
@@ -52,7 +52,7 @@ func TestGetPatentXML(t *testing.T) {
 	ass.NoError(err)
 	ass.NotNil(res)
 	ass.NotEmpty(res)
-	err = SaveFile(res, "./test-data/", string(testID)+".xml")
+	err = SaveFile(res, t.TempDir()+"/", string(testID)+".xml")
 	ass.NoError(err)
 }
 
@@ -62,7 +62,7 @@ func TestGetPatentPDF(t *testing.T) {
 	ass.NoError(err)
 	ass.NotNil(res)
 	ass.NotEmpty(res)
-	err = SaveFile(res, "./test-data/", string(testID)+".pdf")
+	err = SaveFile(res, t.TempDir()+"/", string(testID)+".pdf")
 	ass.NoError(err)
 }
 
@@ -0,0 +1,11 @@
+// Package model contains the typed models of the ep-patent-document generated from the DTDs.
+// Each DTD version has its own package, e.g. v15 for ep-patent-document-v1-5.dtd.
+// The DTDs of version 1.2 and 1.5.1 are not available, documents of version 1.2 can be unmarshalled
+// with v13 and documents of version 1.5.1 with v15.
+package model
+
+//go:generate go run github.com/max-planck-innovation-competition/go-epo-eps/cmd/dtdgen -dtd ../../../dtds/1-0.dtd -pkg v10 -out v10/ep_patent_document.go
+//go:generate go run github.com/max-planck-innovation-competition/go-epo-eps/cmd/dtdgen -dtd ../../../dtds/1-1.dtd -pkg v11 -out v11/ep_patent_document.go
+//go:generate go run github.com/max-planck-innovation-competition/go-epo-eps/cmd/dtdgen -dtd ../../../dtds/1-3.dtd -pkg v13 -out v13/ep_patent_document.go
+//go:generate go run github.com/max-planck-innovation-competition/go-epo-eps/cmd/dtdgen -dtd ../../../dtds/1-4.dtd -pkg v14 -out v14/ep_patent_document.go
+//go:generate go run github.com/max-planck-innovation-competition/go-epo-eps/cmd/dtdgen -dtd ../../../dtds/1-5.dtd -pkg v15 -out v15/ep_patent_document.go
@@ -0,0 +1,13912 @@
+// Code generated by dtdgen from 1-0.dtd; DO NOT EDIT.
+
+// Package v10 contains the types of the elements of 1-0.dtd
+package v10
+
+import "encoding/xml"
+
+// EpPatentDocument is the element ep-patent-document
+//
+//	(doc-page+ | ((bibliographic-data | SDOBI),abstract*,description?,claims*,amended-claims*,
+//	amended-claims-statement*,drawings?,search-report-data*))
+type EpPatentDocument struct {
+	XMLName                xml.Name                 `xml:"ep-patent-document"`
+	ID                     string                   `xml:"id,attr"`
+	File                   string                   `xml:"file,attr"`
+	Lang                   string                   `xml:"lang,attr"`
+	Country                string                   `xml:"country,attr"`
+	DocNumber              string                   `xml:"doc-number,attr"`
+	Kind                   string                   `xml:"kind,attr"`
+	CorrectionCode         string                   `xml:"correction-code,attr"`
+	DatePubl               string                   `xml:"date-publ,attr"`
+	Status                 string                   `xml:"status,attr"`
+	DtdVersion             string                   `xml:"dtd-version,attr"`
+	DateProduced           string                   `xml:"date-produced,attr"`
+	FileReferenceID        string                   `xml:"file-reference-id,attr"`
+	DocPage                []DocPage                `xml:"doc-page"`
+	BibliographicData      *BibliographicData       `xml:"bibliographic-data"`
+	SDOBI                  *SDOBI                   `xml:"SDOBI"`
+	Abstract               []Abstract               `xml:"abstract"`
+	Description            *Description             `xml:"description"`
+	Claims                 []Claims                 `xml:"claims"`
+	AmendedClaims          []AmendedClaims          `xml:"amended-claims"`
+	AmendedClaimsStatement []AmendedClaimsStatement `xml:"amended-claims-statement"`
+	Drawings               *Drawings                `xml:"drawings"`
+	SearchReportData       []SearchReportData       `xml:"search-report-data"`
+}
+
+// DocPage is the element doc-page
+//
+//	EMPTY
+type DocPage struct {
+	ID          string `xml:"id,attr"`
+	File        string `xml:"file,attr"`
+	Wi          string `xml:"wi,attr"`
+	He          string `xml:"he,attr"`
+	Type        string `xml:"type,attr"`
+	Alt         string `xml:"alt,attr"`
+	Pp          string `xml:"pp,attr"`
+	Ppf         string `xml:"ppf,attr"`
+	Ppl         string `xml:"ppl,attr"`
+	Ocr         string `xml:"ocr,attr"`
+	Color       string `xml:"color,attr"`
+	Orientation string `xml:"orientation,attr"`
+}
+
+// SDOBI is the element SDOBI
+//
+//	(B000?,B100,B200?,B300?,B400?,B500?,B600?,B700?,B800?,B900?)
+type SDOBI struct {
+	Lang string `xml:"lang,attr"`
+	B000 *B000  `xml:"B000"`
+	B100 B100   `xml:"B100"`
+	B200 *B200  `xml:"B200"`
+	B300 *B300  `xml:"B300"`
+	B400 *B400  `xml:"B400"`
+	B500 *B500  `xml:"B500"`
+	B600 *B600  `xml:"B600"`
+	B700 *B700  `xml:"B700"`
+	B800 *B800  `xml:"B800"`
+	B900 *B900  `xml:"B900"`
+}
+
+// B000 is the element B000
+//
+//	(eptags?)
+type B000 struct {
+	Eptags *Eptags `xml:"eptags"`
+}
+
+// Eptags is the element eptags
+//
+//	(B001EP?,B002EP?,B003EP?,B004EP?,B005EP?,B006EP?,B007EP?,B008EP?,B009EP?,B010EP?,B015EP?,B020EP?,
+//	B021EP?,B030EP?,B050EP?,B053EP*,B060EP?,B061EP?,B070EP?,B078EP?,B080EP?)
+type Eptags struct {
+	B001EP string   `xml:"B001EP"`
+	B002EP *B002EP  `xml:"B002EP"`
+	B003EP string   `xml:"B003EP"`
+	B004EP *B004EP  `xml:"B004EP"`
+	B005EP string   `xml:"B005EP"`
+	B006EP *B006EP  `xml:"B006EP"`
+	B007EP string   `xml:"B007EP"`
+	B008EP string   `xml:"B008EP"`
+	B009EP *B009EP  `xml:"B009EP"`
+	B010EP *B010EP  `xml:"B010EP"`
+	B015EP string   `xml:"B015EP"`
+	B020EP string   `xml:"B020EP"`
+	B021EP string   `xml:"B021EP"`
+	B030EP string   `xml:"B030EP"`
+	B050EP *B050EP  `xml:"B050EP"`
+	B053EP []string `xml:"B053EP"`
+	B060EP string   `xml:"B060EP"`
+	B061EP string   `xml:"B061EP"`
+	B070EP string   `xml:"B070EP"`
+	B078EP *B078EP  `xml:"B078EP"`
+	B080EP *B080EP  `xml:"B080EP"`
+}
+
+// B002EP is the element B002EP
+//
+//	(ep-chg-info)
+type B002EP struct {
+	ID        string    `xml:"id,attr"`
+	EpChgInfo EpChgInfo `xml:"ep-chg-info"`
+}
+
+// EpChgInfo is the element ep-chg-info
+//
+//	(ep-chg+)
+type EpChgInfo struct {
+	EpChg []EpChg `xml:"ep-chg"`
+}
+
+// EpChg is the element ep-chg
+//
+//	(ep-chg*)
+type EpChg struct {
+	Idref  string  `xml:"idref,attr"`
+	Btag   string  `xml:"btag,attr"`
+	Date   string  `xml:"date,attr"`
+	Status string  `xml:"status,attr"`
+	EpChg  []EpChg `xml:"ep-chg"`
+}
+
+// B004EP is the element B004EP
+//
+//	(#PCDATA)
+type B004EP struct {
+	Text string `xml:",chardata"`
+	ID   string `xml:"id,attr"`
+}
+
+// B006EP is the element B006EP
+//
+//	(#PCDATA)
+type B006EP struct {
+	Text string `xml:",chardata"`
+	ID   string `xml:"id,attr"`
+}
+
+// B009EP is the element B009EP
+//
+//	(text+)
+type B009EP struct {
+	Text []string `xml:"text"`
+}
+
+// B010EP is the element B010EP
+//
+//	(B011EP+)
+type B010EP struct {
+	ID     string   `xml:"id,attr"`
+	B011EP []B011EP `xml:"B011EP"`
+}
+
+// B011EP is the element B011EP
+//
+//	(date,dnum,ctry*)
+type B011EP struct {
+	Date string   `xml:"date"`
+	Dnum Dnum     `xml:"dnum"`
+	Ctry []string `xml:"ctry"`
+}
+
+// B050EP is the element B050EP
+//
+//	(B051EP,B052EP)+
+type B050EP struct {
+	ID     string   `xml:"id,attr"`
+	B051EP []string `xml:"B051EP"`
+	B052EP []string `xml:"B052EP"`
+}
+
+// B078EP is the element B078EP
+//
+//	(date)
+type B078EP struct {
+	ID   string `xml:"id,attr"`
+	Date string `xml:"date"`
+}
+
+// B080EP is the element B080EP
+//
+//	(B081EP,B082EP,B083EP?)
+type B080EP struct {
+	ID     string `xml:"id,attr"`
+	B081EP string `xml:"B081EP"`
+	B082EP B082EP `xml:"B082EP"`
+	B083EP string `xml:"B083EP"`
+}
+
+// B082EP is the element B082EP
+//
+//	(ttl?,fnm?,snm?,sfx?,iid?,irf?,onm?,syn*,oid?,(odv,did)*,adr?,dtxt*,rctry?,nctry?)
+type B082EP struct {
+	Ttl   string   `xml:"ttl"`
+	Fnm   string   `xml:"fnm"`
+	Snm   string   `xml:"snm"`
+	Sfx   string   `xml:"sfx"`
+	Iid   string   `xml:"iid"`
+	Irf   string   `xml:"irf"`
+	Onm   string   `xml:"onm"`
+	Syn   []string `xml:"syn"`
+	Oid   string   `xml:"oid"`
+	Odv   []string `xml:"odv"`
+	Did   []string `xml:"did"`
+	Adr   *Adr     `xml:"adr"`
+	Dtxt  []string `xml:"dtxt"`
+	Rctry *Rctry   `xml:"rctry"`
+	Nctry *Nctry   `xml:"nctry"`
+}
+
+// B100 is the element B100
+//
+//	(B110,B120?,B130,B131EP?,B132EP?,B133EP?,B140,B150?,B190,B195?)
+type B100 struct {
+	B110   B110   `xml:"B110"`
+	B120   *B120  `xml:"B120"`
+	B130   B130   `xml:"B130"`
+	B131EP string `xml:"B131EP"`
+	B132EP string `xml:"B132EP"`
+	B133EP string `xml:"B133EP"`
+	B140   B140   `xml:"B140"`
+	B150   *B150  `xml:"B150"`
+	B190   string `xml:"B190"`
+	B195   string `xml:"B195"`
+}
+
+// B110 is the element B110
+//
+//	(#PCDATA)
+type B110 struct {
+	Text string `xml:",chardata"`
+	ID   string `xml:"id,attr"`
+}
+
+// B120 is the element B120
+//
+//	(B121,B121EP?)
+type B120 struct {
+	B121   string `xml:"B121"`
+	B121EP string `xml:"B121EP"`
+}
+
+// B130 is the element B130
+//
+//	(#PCDATA)
+type B130 struct {
+	Text string `xml:",chardata"`
+	ID   string `xml:"id,attr"`
+}
+
+// B140 is the element B140
+//
+//	(date)
+type B140 struct {
+	Date string `xml:"date"`
+}
+
+// B150 is the element B150
+//
+//	(B151?,B152?,B153?,B154?,B155?)
+type B150 struct {
+	B151 string `xml:"B151"`
+	B152 *B152  `xml:"B152"`
+	B153 string `xml:"B153"`
+	B154 *B154  `xml:"B154"`
+	B155 *B155  `xml:"B155"`
+}
+
+// B152 is the element B152
+//
+//	(date)
+type B152 struct {
+	Date string `xml:"date"`
+}
+
+// B154 is the element B154
+//
+//	(B1541,B1542)+
+type B154 struct {
+	B1541 []string `xml:"B1541"`
+	B1542 []string `xml:"B1542"`
+}
+
+// B155 is the element B155
+//
+//	(B1551,B1552)+
+type B155 struct {
+	B1551 []string `xml:"B1551"`
+	B1552 []string `xml:"B1552"`
+}
+
+// B200 is the element B200
+//
+//	(B210,B210EP?,B211EP?,B220,B225?,B230?,B240?,B250?,B250EP?,B251EP?,B260?)
+type B200 struct {
+	B210   string  `xml:"B210"`
+	B210EP string  `xml:"B210EP"`
+	B211EP string  `xml:"B211EP"`
+	B220   B220    `xml:"B220"`
+	B225   *B225   `xml:"B225"`
+	B230   *B230   `xml:"B230"`
+	B240   *B240   `xml:"B240"`
+	B250   *B250   `xml:"B250"`
+	B250EP string  `xml:"B250EP"`
+	B251EP *B251EP `xml:"B251EP"`
+	B260   *B260   `xml:"B260"`
+}
+
+// B220 is the element B220
+//
+//	(date)
+type B220 struct {
+	ID   string `xml:"id,attr"`
+	Date string `xml:"date"`
+}
+
+// B225 is the element B225
+//
+//	(dnum,date?,(ttl?,fnm?,snm?,sfx?,iid?,irf?,onm?,syn*,oid?,(odv,did)*),adr?,dtxt*,rctry?,nctry?)
+type B225 struct {
+	Dnum  Dnum     `xml:"dnum"`
+	Date  string   `xml:"date"`
+	Ttl   string   `xml:"ttl"`
+	Fnm   string   `xml:"fnm"`
+	Snm   string   `xml:"snm"`
+	Sfx   string   `xml:"sfx"`
+	Iid   string   `xml:"iid"`
+	Irf   string   `xml:"irf"`
+	Onm   string   `xml:"onm"`
+	Syn   []string `xml:"syn"`
+	Oid   string   `xml:"oid"`
+	Odv   []string `xml:"odv"`
+	Did   []string `xml:"did"`
+	Adr   *Adr     `xml:"adr"`
+	Dtxt  []string `xml:"dtxt"`
+	Rctry *Rctry   `xml:"rctry"`
+	Nctry *Nctry   `xml:"nctry"`
+}
+
+// B230 is the element B230
+//
+//	(B231?,B232?,B233?,B234?,B235?,B236?,B237?,B238EP?,B238?,B239?)
+type B230 struct {
+	B231   *B231   `xml:"B231"`
+	B232   *B232   `xml:"B232"`
+	B233   *B233   `xml:"B233"`
+	B234   *B234   `xml:"B234"`
+	B235   *B235   `xml:"B235"`
+	B236   *B236   `xml:"B236"`
+	B237   *B237   `xml:"B237"`
+	B238EP *B238EP `xml:"B238EP"`
+	B238   *B238   `xml:"B238"`
+	B239   *B239   `xml:"B239"`
+}
+
+// B231 is the element B231
+//
+//	(date)
+type B231 struct {
+	Date string `xml:"date"`
+}
+
+// B232 is the element B232
+//
+//	(date)
+type B232 struct {
+	Date string `xml:"date"`
+}
+
+// B233 is the element B233
+//
+//	(date)
+type B233 struct {
+	Date string `xml:"date"`
+}
+
+// B234 is the element B234
+//
+//	(date)
+type B234 struct {
+	Date string `xml:"date"`
+}
+
+// B235 is the element B235
+//
+//	(date)
+type B235 struct {
+	ID   string `xml:"id,attr"`
+	Date string `xml:"date"`
+}
+
+// B236 is the element B236
+//
+//	(date)
+type B236 struct {
+	ID   string `xml:"id,attr"`
+	Date string `xml:"date"`
+}
+
+// B237 is the element B237
+//
+//	(date)
+type B237 struct {
+	ID   string `xml:"id,attr"`
+	Date string `xml:"date"`
+}
+
+// B238 is the element B238
+//
+//	(date)
+type B238 struct {
+	ID   string `xml:"id,attr"`
+	Date string `xml:"date"`
+}
+
+// B238EP is the element B238EP
+//
+//	(date)
+type B238EP struct {
+	ID   string `xml:"id,attr"`
+	Date string `xml:"date"`
+}
+
+// B239 is the element B239
+//
+//	(date)
+type B239 struct {
+	ID   string `xml:"id,attr"`
+	Date string `xml:"date"`
+}
+
+// B240 is the element B240
+//
+//	(B241?,B242?,B243?,B244?,B245?,B245EP?,B246?,B248?)
+type B240 struct {
+	B241   *B241   `xml:"B241"`
+	B242   *B242   `xml:"B242"`
+	B243   *B243   `xml:"B243"`
+	B244   *B244   `xml:"B244"`
+	B245   *B245   `xml:"B245"`
+	B245EP *B245EP `xml:"B245EP"`
+	B246   *B246   `xml:"B246"`
+	B248   *B248   `xml:"B248"`
+}
+
+// B241 is the element B241
+//
+//	(date)
+type B241 struct {
+	ID   string `xml:"id,attr"`
+	Date string `xml:"date"`
+}
+
+// B242 is the element B242
+//
+//	(date)
+type B242 struct {
+	ID   string `xml:"id,attr"`
+	Date string `xml:"date"`
+}
+
+// B243 is the element B243
+//
+//	(date)
+type B243 struct {
+	ID   string `xml:"id,attr"`
+	Date string `xml:"date"`
+}
+
+// B244 is the element B244
+//
+//	(date,ctry+)
+type B244 struct {
+	ID   string   `xml:"id,attr"`
+	Date string   `xml:"date"`
+	Ctry []string `xml:"ctry"`
+}
+
+// B245 is the element B245
+//
+//	(date)
+type B245 struct {
+	ID   string `xml:"id,attr"`
+	Date string `xml:"date"`
+}
+
+// B245EP is the element B245EP
+//
+//	(#PCDATA)
+type B245EP struct {
+	Text string `xml:",chardata"`
+	ID   string `xml:"id,attr"`
+}
+
+// B246 is the element B246
+//
+//	(date)
+type B246 struct {
+	ID   string `xml:"id,attr"`
+	Date string `xml:"date"`
+}
+
+// B248 is the element B248
+//
+//	(date)
+type B248 struct {
+	Date string `xml:"date"`
+}
+
+// B250 is the element B250
+//
+//	(#PCDATA)
+type B250 struct {
+	Text string `xml:",chardata"`
+	ID   string `xml:"id,attr"`
+}
+
+// B251EP is the element B251EP
+//
+//	(#PCDATA)
+type B251EP struct {
+	Text string `xml:",chardata"`
+	ID   string `xml:"id,attr"`
+}
+
+// B260 is the element B260
+//
+//	(#PCDATA)
+type B260 struct {
+	Text string `xml:",chardata"`
+	ID   string `xml:"id,attr"`
+}
+
+// B300 is the element B300
+//
+//	((B310,B310EP?,B311EP?,B320,B330,B340?)*,(B345?,B345EP?)*)
+type B300 struct {
+	ID     string   `xml:"id,attr"`
+	B310   []string `xml:"B310"`
+	B310EP []string `xml:"B310EP"`
+	B311EP []string `xml:"B311EP"`
+	B320   []B320   `xml:"B320"`
+	B330   []B330   `xml:"B330"`
+	B340   []B340   `xml:"B340"`
+	B345   []B345   `xml:"B345"`
+	B345EP []B345EP `xml:"B345EP"`
+}
+
+// B320 is the element B320
+//
+//	(date)
+type B320 struct {
+	Date string `xml:"date"`
+}
+
+// B330 is the element B330
+//
+//	(ctry)
+type B330 struct {
+	Ctry string `xml:"ctry"`
+}
+
+// B340 is the element B340
+//
+//	(ctry)
+type B340 struct {
+	Ctry string `xml:"ctry"`
+}
+
+// B345 is the element B345
+//
+//	(dnum?,date?,ctry?,kind?,bnum?,dtxt*)
+type B345 struct {
+	Dnum *Dnum    `xml:"dnum"`
+	Date string   `xml:"date"`
+	Ctry string   `xml:"ctry"`
+	Kind string   `xml:"kind"`
+	Bnum string   `xml:"bnum"`
+	Dtxt []string `xml:"dtxt"`
+}
+
+// B345EP is the element B345EP
+//
+//	(dnum?,date?,ctry?,kind?,bnum?,dtxt*)
+type B345EP struct {
+	Dnum *Dnum    `xml:"dnum"`
+	Date string   `xml:"date"`
+	Ctry string   `xml:"ctry"`
+	Kind string   `xml:"kind"`
+	Bnum string   `xml:"bnum"`
+	Dtxt []string `xml:"dtxt"`
+}
+
+// B400 is the element B400
+//
+//	(B405?,B410?,B420?,B430?,B440?,B450?,B451EP?,B452EP?,B460?,B470?,B472?,B476?,B477?,B480?)
+type B400 struct {
+	B405   *B405   `xml:"B405"`
+	B410   *B410   `xml:"B410"`
+	B420   *B420   `xml:"B420"`
+	B430   *B430   `xml:"B430"`
+	B440   *B440   `xml:"B440"`
+	B450   *B450   `xml:"B450"`
+	B451EP *B451EP `xml:"B451EP"`
+	B452EP *B452EP `xml:"B452EP"`
+	B460   *B460   `xml:"B460"`
+	B470   *B470   `xml:"B470"`
+	B472   *B472   `xml:"B472"`
+	B476   *B476   `xml:"B476"`
+	B477   *B477   `xml:"B477"`
+	B480   *B480   `xml:"B480"`
+}
+
+// B405 is the element B405
+//
+//	(dnum?,date?,ctry?,kind?,bnum?,dtxt*)
+type B405 struct {
+	Dnum *Dnum    `xml:"dnum"`
+	Date string   `xml:"date"`
+	Ctry string   `xml:"ctry"`
+	Kind string   `xml:"kind"`
+	Bnum string   `xml:"bnum"`
+	Dtxt []string `xml:"dtxt"`
+}
+
+// B410 is the element B410
+//
+//	(dnum?,date?,ctry?,kind?,bnum?,dtxt*)
+type B410 struct {
+	Dnum *Dnum    `xml:"dnum"`
+	Date string   `xml:"date"`
+	Ctry string   `xml:"ctry"`
+	Kind string   `xml:"kind"`
+	Bnum string   `xml:"bnum"`
+	Dtxt []string `xml:"dtxt"`
+}
+
+// B420 is the element B420
+//
+//	(dnum?,date?,ctry?,kind?,bnum?,dtxt*)
+type B420 struct {
+	Dnum *Dnum    `xml:"dnum"`
+	Date string   `xml:"date"`
+	Ctry string   `xml:"ctry"`
+	Kind string   `xml:"kind"`
+	Bnum string   `xml:"bnum"`
+	Dtxt []string `xml:"dtxt"`
+}
+
+// B430 is the element B430
+//
+//	(dnum?,date?,ctry?,kind?,bnum?,dtxt*)
+type B430 struct {
+	ID   string   `xml:"id,attr"`
+	Dnum *Dnum    `xml:"dnum"`
+	Date string   `xml:"date"`
+	Ctry string   `xml:"ctry"`
+	Kind string   `xml:"kind"`
+	Bnum string   `xml:"bnum"`
+	Dtxt []string `xml:"dtxt"`
+}
+
+// B440 is the element B440
+//
+//	(dnum?,date?,ctry?,kind?,bnum?,dtxt*)
+type B440 struct {
+	Dnum *Dnum    `xml:"dnum"`
+	Date string   `xml:"date"`
+	Ctry string   `xml:"ctry"`
+	Kind string   `xml:"kind"`
+	Bnum string   `xml:"bnum"`
+	Dtxt []string `xml:"dtxt"`
+}
+
+// B450 is the element B450
+//
+//	(dnum?,date?,ctry?,kind?,bnum?,dtxt*)
+type B450 struct {
+	ID   string   `xml:"id,attr"`
+	Dnum *Dnum    `xml:"dnum"`
+	Date string   `xml:"date"`
+	Ctry string   `xml:"ctry"`
+	Kind string   `xml:"kind"`
+	Bnum string   `xml:"bnum"`
+	Dtxt []string `xml:"dtxt"`
+}
+
+// B451EP is the element B451EP
+//
+//	(date)
+type B451EP struct {
+	ID   string `xml:"id,attr"`
+	Date string `xml:"date"`
+}
+
+// B452EP is the element B452EP
+//
+//	(date)
+type B452EP struct {
+	ID   string `xml:"id,attr"`
+	Date string `xml:"date"`
+}
+
+// B460 is the element B460
+//
+//	(dnum?,date?,ctry?,kind?,bnum?,dtxt*)
+type B460 struct {
+	Dnum *Dnum    `xml:"dnum"`
+	Date string   `xml:"date"`
+	Ctry string   `xml:"ctry"`
+	Kind string   `xml:"kind"`
+	Bnum string   `xml:"bnum"`
+	Dtxt []string `xml:"dtxt"`
+}
+
+// B470 is the element B470
+//
+//	(dnum?,date?,ctry?,kind?,bnum?,dtxt*)
+type B470 struct {
+	Dnum *Dnum    `xml:"dnum"`
+	Date string   `xml:"date"`
+	Ctry string   `xml:"ctry"`
+	Kind string   `xml:"kind"`
+	Bnum string   `xml:"bnum"`
+	Dtxt []string `xml:"dtxt"`
+}
+
+// B472 is the element B472
+//
+//	(B473?,B474?,B475?)
+type B472 struct {
+	B473 *B473  `xml:"B473"`
+	B474 string `xml:"B474"`
+	B475 *B475  `xml:"B475"`
+}
+
+// B473 is the element B473
+//
+//	(date)
+type B473 struct {
+	Date string `xml:"date"`
+}
+
+// B475 is the element B475
+//
+//	(dnum?,date?,ctry?,kind?,bnum?,dtxt*)+
+type B475 struct {
+	ID   string   `xml:"id,attr"`
+	Dnum []Dnum   `xml:"dnum"`
+	Date []string `xml:"date"`
+	Ctry []string `xml:"ctry"`
+	Kind []string `xml:"kind"`
+	Bnum []string `xml:"bnum"`
+	Dtxt []string `xml:"dtxt"`
+}
+
+// B476 is the element B476
+//
+//	(dnum?,date?,ctry?,kind?,bnum?,dtxt*)
+type B476 struct {
+	Dnum *Dnum    `xml:"dnum"`
+	Date string   `xml:"date"`
+	Ctry string   `xml:"ctry"`
+	Kind string   `xml:"kind"`
+	Bnum string   `xml:"bnum"`
+	Dtxt []string `xml:"dtxt"`
+}
+
+// B477 is the element B477
+//
+//	(dnum?,date?,ctry?,kind?,bnum?,dtxt*)
+type B477 struct {
+	ID   string   `xml:"id,attr"`
+	Dnum *Dnum    `xml:"dnum"`
+	Date string   `xml:"date"`
+	Ctry string   `xml:"ctry"`
+	Kind string   `xml:"kind"`
+	Bnum string   `xml:"bnum"`
+	Dtxt []string `xml:"dtxt"`
+}
+
+// B480 is the element B480
+//
+//	(date?,bnum?)
+type B480 struct {
+	Date string `xml:"date"`
+	Bnum string `xml:"bnum"`
+}
+
+// B500 is the element B500
+//
+//	(B510?,B510EP?,B520*,B530?,B540?,B550?,B560?,B570?,B580?,B590?,B599EP?)
+type B500 struct {
+	B510   *B510   `xml:"B510"`
+	B510EP *B510EP `xml:"B510EP"`
+	B520   []B520  `xml:"B520"`
+	B530   string  `xml:"B530"`
+	B540   *B540   `xml:"B540"`
+	B550   *B550   `xml:"B550"`
+	B560   *B560   `xml:"B560"`
+	B570   *B570   `xml:"B570"`
+	B580   *B580   `xml:"B580"`
+	B590   *B590   `xml:"B590"`
+	B599EP string  `xml:"B599EP"`
+}
+
+// B510 is the element B510
+//
+//	(B516?,B511,(B512 | B513 | B514 | B515)*,B517EP?)
+type B510 struct {
+	ID     string   `xml:"id,attr"`
+	B516   string   `xml:"B516"`
+	B511   string   `xml:"B511"`
+	B512   []string `xml:"B512"`
+	B513   []string `xml:"B513"`
+	B514   []string `xml:"B514"`
+	B515   []string `xml:"B515"`
+	B517EP string   `xml:"B517EP"`
+}
+
+// B510EP is the element B510EP
+//
+//	(classification-ipcr+)
+type B510EP struct {
+	ID                 string               `xml:"id,attr"`
+	Sequence           string               `xml:"sequence,attr"`
+	ClassificationIpcr []ClassificationIpcr `xml:"classification-ipcr"`
+}
+
+// ClassificationIpcr is the element classification-ipcr
+//
+//	((section,class,subclass,main-group?,subgroup?,ipc-version-indicator,classification-level?,
+//	symbol-position?,classification-value?,action-date?,classification-status?,
+//	classification-data-source?,generating-office?) | text)
+type ClassificationIpcr struct {
+	ID                       string               `xml:"id,attr"`
+	Sequence                 string               `xml:"sequence,attr"`
+	Section                  string               `xml:"section"`
+	Class                    *Class               `xml:"class"`
+	Subclass                 string               `xml:"subclass"`
+	MainGroup                string               `xml:"main-group"`
+	Subgroup                 string               `xml:"subgroup"`
+	IpcVersionIndicator      *IpcVersionIndicator `xml:"ipc-version-indicator"`
+	ClassificationLevel      string               `xml:"classification-level"`
+	SymbolPosition           string               `xml:"symbol-position"`
+	ClassificationValue      string               `xml:"classification-value"`
+	ActionDate               *ActionDate          `xml:"action-date"`
+	ClassificationStatus     string               `xml:"classification-status"`
+	ClassificationDataSource string               `xml:"classification-data-source"`
+	GeneratingOffice         *GeneratingOffice    `xml:"generating-office"`
+	Text                     string               `xml:"text"`
+}
+
+// Class is the element class
+//
+//	(#PCDATA)
+type Class struct {
+	Text      string `xml:",chardata"`
+	ClassType string `xml:"class-type,attr"`
+}
+
+// IpcVersionIndicator is the element ipc-version-indicator
+//
+//	(date)
+type IpcVersionIndicator struct {
+	Date string `xml:"date"`
+}
+
+// ActionDate is the element action-date
+//
+//	(date)
+type ActionDate struct {
+	Date string `xml:"date"`
+}
+
+// GeneratingOffice is the element generating-office
+//
+//	(country)
+type GeneratingOffice struct {
+	Country string `xml:"country"`
+}
+
+// B520 is the element B520
+//
+//	(B527,B526?,B521,(B522 | B523 | B524 | B525)*)
+type B520 struct {
+	B527 string   `xml:"B527"`
+	B526 string   `xml:"B526"`
+	B521 string   `xml:"B521"`
+	B522 []string `xml:"B522"`
+	B523 []string `xml:"B523"`
+	B524 []string `xml:"B524"`
+	B525 []string `xml:"B525"`
+}
+
+// B540 is the element B540
+//
+//	(B541?,B542,B542EP?)+
+type B540 struct {
+	ID     string   `xml:"id,attr"`
+	B541   []string `xml:"B541"`
+	B542   []B542   `xml:"B542"`
+	B542EP []string `xml:"B542EP"`
+}
+
+// B542 is the element B542
+//
+//	(#PCDATA)
+type B542 struct {
+	Text string `xml:",chardata"`
+	ID   string `xml:"id,attr"`
+}
+
+// B550 is the element B550
+//
+//	(B551?,B552*)*
+type B550 struct {
+	B551 []string `xml:"B551"`
+	B552 []string `xml:"B552"`
+}
+
+// B560 is the element B560
+//
+//	((B561,B563?,B564*)*,(B562,B563?,B564*)*,B561EP?,B565?,B565EP?,B566?,B566EP?,B567?,B568?,B569?)
+type B560 struct {
+	B561   []B561   `xml:"B561"`
+	B563   []string `xml:"B563"`
+	B564   []string `xml:"B564"`
+	B562   []B562   `xml:"B562"`
+	B561EP string   `xml:"B561EP"`
+	B565   *B565    `xml:"B565"`
+	B565EP *B565EP  `xml:"B565EP"`
+	B566   *B566    `xml:"B566"`
+	B566EP *B566EP  `xml:"B566EP"`
+	B567   *B567    `xml:"B567"`
+	B568   *B568    `xml:"B568"`
+	B569   *B569    `xml:"B569"`
+}
+
+// B561 is the element B561
+//
+//	(text)
+type B561 struct {
+	Text string `xml:"text"`
+}
+
+// B562 is the element B562
+//
+//	(text)
+type B562 struct {
+	Text string `xml:"text"`
+}
+
+// B565 is the element B565
+//
+//	(date)
+type B565 struct {
+	Date string `xml:"date"`
+}
+
+// B565EP is the element B565EP
+//
+//	(date)
+type B565EP struct {
+	ID   string `xml:"id,attr"`
+	Date string `xml:"date"`
+}
+
+// B566 is the element B566
+//
+//	(date)
+type B566 struct {
+	Date string `xml:"date"`
+}
+
+// B566EP is the element B566EP
+//
+//	(date)
+type B566EP struct {
+	ID   string `xml:"id,attr"`
+	Date string `xml:"date"`
+}
+
+// B567 is the element B567
+//
+//	((ttl?,fnm?,snm?,sfx?,iid?,irf?,onm?,syn*,oid?,(odv,did)*),adr?,dtxt*,rctry?,nctry?)
+type B567 struct {
+	Ttl   string   `xml:"ttl"`
+	Fnm   string   `xml:"fnm"`
+	Snm   string   `xml:"snm"`
+	Sfx   string   `xml:"sfx"`
+	Iid   string   `xml:"iid"`
+	Irf   string   `xml:"irf"`
+	Onm   string   `xml:"onm"`
+	Syn   []string `xml:"syn"`
+	Oid   string   `xml:"oid"`
+	Odv   []string `xml:"odv"`
+	Did   []string `xml:"did"`
+	Adr   *Adr     `xml:"adr"`
+	Dtxt  []string `xml:"dtxt"`
+	Rctry *Rctry   `xml:"rctry"`
+	Nctry *Nctry   `xml:"nctry"`
+}
+
+// B568 is the element B568
+//
+//	(dnum?,date?,ctry?,kind?,bnum?,dtxt*)
+type B568 struct {
+	Dnum *Dnum    `xml:"dnum"`
+	Date string   `xml:"date"`
+	Ctry string   `xml:"ctry"`
+	Kind string   `xml:"kind"`
+	Bnum string   `xml:"bnum"`
+	Dtxt []string `xml:"dtxt"`
+}
+
+// B569 is the element B569
+//
+//	(ttl?,fnm?,snm?,sfx?,iid?,irf?,onm?,syn*,oid?,(odv,did)*)
+type B569 struct {
+	Ttl string   `xml:"ttl"`
+	Fnm string   `xml:"fnm"`
+	Snm string   `xml:"snm"`
+	Sfx string   `xml:"sfx"`
+	Iid string   `xml:"iid"`
+	Irf string   `xml:"irf"`
+	Onm string   `xml:"onm"`
+	Syn []string `xml:"syn"`
+	Oid string   `xml:"oid"`
+	Odv []string `xml:"odv"`
+	Did []string `xml:"did"`
+}
+
+// B570 is the element B570
+//
+//	(B571?,B572?,B575*,B576?,B577)
+type B570 struct {
+	B571 string   `xml:"B571"`
+	B572 *B572    `xml:"B572"`
+	B575 []string `xml:"B575"`
+	B576 *B576    `xml:"B576"`
+	B577 string   `xml:"B577"`
+}
+
+// B572 is the element B572
+//
+//	(dnum?,date?,ctry?,kind?,bnum?,dtxt*)
+type B572 struct {
+	Dnum *Dnum    `xml:"dnum"`
+	Date string   `xml:"date"`
+	Ctry string   `xml:"ctry"`
+	Kind string   `xml:"kind"`
+	Bnum string   `xml:"bnum"`
+	Dtxt []string `xml:"dtxt"`
+}
+
+// B576 is the element B576
+//
+//	(dnum?,date?,ctry?,kind?,bnum?,dtxt*)
+type B576 struct {
+	Dnum *Dnum    `xml:"dnum"`
+	Date string   `xml:"date"`
+	Ctry string   `xml:"ctry"`
+	Kind string   `xml:"kind"`
+	Bnum string   `xml:"bnum"`
+	Dtxt []string `xml:"dtxt"`
+}
+
+// B580 is the element B580
+//
+//	(B581 | B582)*
+type B580 struct {
+	B581 []string `xml:"B581"`
+	B582 []string `xml:"B582"`
+}
+
+// B590 is the element B590
+//
+//	(B591?,B592?,B595?,B596?,B597?,B598*)
+type B590 struct {
+	B591 string   `xml:"B591"`
+	B592 string   `xml:"B592"`
+	B595 string   `xml:"B595"`
+	B596 string   `xml:"B596"`
+	B597 string   `xml:"B597"`
+	B598 []string `xml:"B598"`
+}
+
+// B600 is the element B600
+//
+//	(B610 | B620 | B620EP | B630 | B640 | B645 | B650 | B655 | B660 | B665 | B670)*
+type B600 struct {
+	B610   []B610   `xml:"B610"`
+	B620   []B620   `xml:"B620"`
+	B620EP []B620EP `xml:"B620EP"`
+	B630   []B630   `xml:"B630"`
+	B640   []B640   `xml:"B640"`
+	B645   []B645   `xml:"B645"`
+	B650   []B650   `xml:"B650"`
+	B655   []B655   `xml:"B655"`
+	B660   []B660   `xml:"B660"`
+	B665   []B665   `xml:"B665"`
+	B670   []B670   `xml:"B670"`
+}
+
+// B610 is the element B610
+//
+//	(parent)
+type B610 struct {
+	ID     string `xml:"id,attr"`
+	Parent Parent `xml:"parent"`
+}
+
+// B620 is the element B620
+//
+//	(parent)
+type B620 struct {
+	ID     string `xml:"id,attr"`
+	Parent Parent `xml:"parent"`
+}
+
+// B620EP is the element B620EP
+//
+//	(parent | B621EP | B622EP)
+type B620EP struct {
+	ID     string  `xml:"id,attr"`
+	Parent *Parent `xml:"parent"`
+	B621EP *B621EP `xml:"B621EP"`
+	B622EP *B622EP `xml:"B622EP"`
+}
+
+// B621EP is the element B621EP
+//
+//	(dnum?,date?,ctry?,kind?,bnum?,dtxt*)
+type B621EP struct {
+	Dnum *Dnum    `xml:"dnum"`
+	Date string   `xml:"date"`
+	Ctry string   `xml:"ctry"`
+	Kind string   `xml:"kind"`
+	Bnum string   `xml:"bnum"`
+	Dtxt []string `xml:"dtxt"`
+}
+
+// B622EP is the element B622EP
+//
+//	(dnum?,date?,ctry?,kind?,bnum?,dtxt*)
+type B622EP struct {
+	Dnum *Dnum    `xml:"dnum"`
+	Date string   `xml:"date"`
+	Ctry string   `xml:"ctry"`
+	Kind string   `xml:"kind"`
+	Bnum string   `xml:"bnum"`
+	Dtxt []string `xml:"dtxt"`
+}
+
+// B630 is the element B630
+//
+//	(B631 | B632 | B633)
+type B630 struct {
+	B631 *B631 `xml:"B631"`
+	B632 *B632 `xml:"B632"`
+	B633 *B633 `xml:"B633"`
+}
+
+// B631 is the element B631
+//
+//	(parent)
+type B631 struct {
+	Parent Parent `xml:"parent"`
+}
+
+// B632 is the element B632
+//
+//	(parent)
+type B632 struct {
+	Parent Parent `xml:"parent"`
+}
+
+// B633 is the element B633
+//
+//	(parent)
+type B633 struct {
+	Parent Parent `xml:"parent"`
+}
+
+// B640 is the element B640
+//
+//	(parent)
+type B640 struct {
+	Parent Parent `xml:"parent"`
+}
+
+// B645 is the element B645
+//
+//	(parent)
+type B645 struct {
+	Parent Parent `xml:"parent"`
+}
+
+// B650 is the element B650
+//
+//	(parent)
+type B650 struct {
+	Parent Parent `xml:"parent"`
+}
+
+// B655 is the element B655
+//
+//	(parent)
+type B655 struct {
+	Parent Parent `xml:"parent"`
+}
+
+// B660 is the element B660
+//
+//	(parent)
+type B660 struct {
+	Parent Parent `xml:"parent"`
+}
+
+// B665 is the element B665
+//
+//	(B666,B667,B668?)
+type B665 struct {
+	B666 B666   `xml:"B666"`
+	B667 string `xml:"B667"`
+	B668 string `xml:"B668"`
+}
+
+// B666 is the element B666
+//
+//	(dnum?,date?,ctry?,kind?,bnum?,dtxt*)
+type B666 struct {
+	Dnum *Dnum    `xml:"dnum"`
+	Date string   `xml:"date"`
+	Ctry string   `xml:"ctry"`
+	Kind string   `xml:"kind"`
+	Bnum string   `xml:"bnum"`
+	Dtxt []string `xml:"dtxt"`
+}
+
+// B670 is the element B670
+//
+//	(parent)
+type B670 struct {
+	Parent Parent `xml:"parent"`
+}
+
+// B700 is the element B700
+//
+//	(B710?,B720?,B730?,B740?,B745?,B780?,B790?)
+type B700 struct {
+	B710 *B710 `xml:"B710"`
+	B720 *B720 `xml:"B720"`
+	B730 *B730 `xml:"B730"`
+	B740 *B740 `xml:"B740"`
+	B745 *B745 `xml:"B745"`
+	B780 *B780 `xml:"B780"`
+	B790 *B790 `xml:"B790"`
+}
+
+// B710 is the element B710
+//
+//	(B711+)
+type B710 struct {
+	ID   string `xml:"id,attr"`
+	B711 []B711 `xml:"B711"`
+}
+
+// B711 is the element B711
+//
+//	((ttl?,fnm?,snm?,sfx?,iid?,irf?,onm?,syn*,oid?,(odv,did)*),adr?,dtxt*,rctry?,nctry?,B711EP?,B713EP?,
+//	(B716EP?,B717EP?,B718EP?)*)
+type B711 struct {
+	Ttl    string   `xml:"ttl"`
+	Fnm    string   `xml:"fnm"`
+	Snm    string   `xml:"snm"`
+	Sfx    string   `xml:"sfx"`
+	Iid    string   `xml:"iid"`
+	Irf    string   `xml:"irf"`
+	Onm    string   `xml:"onm"`
+	Syn    []string `xml:"syn"`
+	Oid    string   `xml:"oid"`
+	Odv    []string `xml:"odv"`
+	Did    []string `xml:"did"`
+	Adr    *Adr     `xml:"adr"`
+	Dtxt   []string `xml:"dtxt"`
+	Rctry  *Rctry   `xml:"rctry"`
+	Nctry  *Nctry   `xml:"nctry"`
+	B711EP *B711EP  `xml:"B711EP"`
+	B713EP string   `xml:"B713EP"`
+	B716EP []B716EP `xml:"B716EP"`
+	B717EP []B717EP `xml:"B717EP"`
+	B718EP []B718EP `xml:"B718EP"`
+}
+
+// B711EP is the element B711EP
+//
+//	((ttl?,fnm?,snm?,sfx?,iid?,irf?,onm?,syn*,oid?,(odv,did)*),adr?,dtxt*,rctry?,nctry?)
+type B711EP struct {
+	Ttl   string   `xml:"ttl"`
+	Fnm   string   `xml:"fnm"`
+	Snm   string   `xml:"snm"`
+	Sfx   string   `xml:"sfx"`
+	Iid   string   `xml:"iid"`
+	Irf   string   `xml:"irf"`
+	Onm   string   `xml:"onm"`
+	Syn   []string `xml:"syn"`
+	Oid   string   `xml:"oid"`
+	Odv   []string `xml:"odv"`
+	Did   []string `xml:"did"`
+	Adr   *Adr     `xml:"adr"`
+	Dtxt  []string `xml:"dtxt"`
+	Rctry *Rctry   `xml:"rctry"`
+	Nctry *Nctry   `xml:"nctry"`
+}
+
+// B716EP is the element B716EP
+//
+//	(ctry+)
+type B716EP struct {
+	Ctry []string `xml:"ctry"`
+}
+
+// B717EP is the element B717EP
+//
+//	(ctry+)
+type B717EP struct {
+	Ctry []string `xml:"ctry"`
+}
+
+// B718EP is the element B718EP
+//
+//	(date)
+type B718EP struct {
+	ID   string `xml:"id,attr"`
+	Date string `xml:"date"`
+}
+
+// B720 is the element B720
+//
+//	(B721+,B721EP?)
+type B720 struct {
+	ID     string  `xml:"id,attr"`
+	B721   []B721  `xml:"B721"`
+	B721EP *B721EP `xml:"B721EP"`
+}
+
+// B721 is the element B721
+//
+//	((ttl?,fnm?,snm?,sfx?,iid?,irf?,onm?,syn*,oid?,(odv,did)*),adr?,dtxt*,rctry?,nctry?,B724EP?,B725EP?,
+//	B726EP?,B727EP?,B728EP?,B729EP?)
+type B721 struct {
+	Ttl    string   `xml:"ttl"`
+	Fnm    string   `xml:"fnm"`
+	Snm    string   `xml:"snm"`
+	Sfx    string   `xml:"sfx"`
+	Iid    string   `xml:"iid"`
+	Irf    string   `xml:"irf"`
+	Onm    string   `xml:"onm"`
+	Syn    []string `xml:"syn"`
+	Oid    string   `xml:"oid"`
+	Odv    []string `xml:"odv"`
+	Did    []string `xml:"did"`
+	Adr    *Adr     `xml:"adr"`
+	Dtxt   []string `xml:"dtxt"`
+	Rctry  *Rctry   `xml:"rctry"`
+	Nctry  *Nctry   `xml:"nctry"`
+	B724EP string   `xml:"B724EP"`
+	B725EP *B725EP  `xml:"B725EP"`
+	B726EP string   `xml:"B726EP"`
+	B727EP string   `xml:"B727EP"`
+	B728EP string   `xml:"B728EP"`
+	B729EP string   `xml:"B729EP"`
+}
+
+// B721EP is the element B721EP
+//
+//	((ttl?,fnm?,snm?,sfx?,iid?,irf?,onm?,syn*,oid?,(odv,did)*),adr?,dtxt*,rctry?,nctry?,B724EP?,B725EP?,
+//	B726EP?,B727EP?,B728EP?,B729EP?)
+type B721EP struct {
+	Ttl    string   `xml:"ttl"`
+	Fnm    string   `xml:"fnm"`
+	Snm    string   `xml:"snm"`
+	Sfx    string   `xml:"sfx"`
+	Iid    string   `xml:"iid"`
+	Irf    string   `xml:"irf"`
+	Onm    string   `xml:"onm"`
+	Syn    []string `xml:"syn"`
+	Oid    string   `xml:"oid"`
+	Odv    []string `xml:"odv"`
+	Did    []string `xml:"did"`
+	Adr    *Adr     `xml:"adr"`
+	Dtxt   []string `xml:"dtxt"`
+	Rctry  *Rctry   `xml:"rctry"`
+	Nctry  *Nctry   `xml:"nctry"`
+	B724EP string   `xml:"B724EP"`
+	B725EP *B725EP  `xml:"B725EP"`
+	B726EP string   `xml:"B726EP"`
+	B727EP string   `xml:"B727EP"`
+	B728EP string   `xml:"B728EP"`
+	B729EP string   `xml:"B729EP"`
+}
+
+// B725EP is the element B725EP
+//
+//	(text+)
+type B725EP struct {
+	Text []string `xml:"text"`
+}
+
+// B730 is the element B730
+//
+//	(B731+)
+type B730 struct {
+	ID   string `xml:"id,attr"`
+	B731 []B731 `xml:"B731"`
+}
+
+// B731 is the element B731
+//
+//	((ttl?,fnm?,snm?,sfx?,iid?,irf?,onm?,syn*,oid?,(odv,did)*),adr?,dtxt*,rctry?,nctry?,(B736EP?,
+//	B737EP?,B738EP?)*)
+type B731 struct {
+	Ttl    string   `xml:"ttl"`
+	Fnm    string   `xml:"fnm"`
+	Snm    string   `xml:"snm"`
+	Sfx    string   `xml:"sfx"`
+	Iid    string   `xml:"iid"`
+	Irf    string   `xml:"irf"`
+	Onm    string   `xml:"onm"`
+	Syn    []string `xml:"syn"`
+	Oid    string   `xml:"oid"`
+	Odv    []string `xml:"odv"`
+	Did    []string `xml:"did"`
+	Adr    *Adr     `xml:"adr"`
+	Dtxt   []string `xml:"dtxt"`
+	Rctry  *Rctry   `xml:"rctry"`
+	Nctry  *Nctry   `xml:"nctry"`
+	B736EP []B736EP `xml:"B736EP"`
+	B737EP []B737EP `xml:"B737EP"`
+	B738EP []B738EP `xml:"B738EP"`
+}
+
+// B736EP is the element B736EP
+//
+//	(ctry+)
+type B736EP struct {
+	Ctry []string `xml:"ctry"`
+}
+
+// B737EP is the element B737EP
+//
+//	(ctry+)
+type B737EP struct {
+	Ctry []string `xml:"ctry"`
+}
+
+// B738EP is the element B738EP
+//
+//	(date)
+type B738EP struct {
+	ID   string `xml:"id,attr"`
+	Date string `xml:"date"`
+}
+
+// B740 is the element B740
+//
+//	(B741+)
+type B740 struct {
+	ID   string `xml:"id,attr"`
+	B741 []B741 `xml:"B741"`
+}
+
+// B741 is the element B741
+//
+//	((ttl?,fnm?,snm?,sfx?,iid?,irf?,onm?,syn*,oid?,(odv,did)*),adr?,dtxt*,rctry?,nctry?,B742EP?)
+type B741 struct {
+	Ttl    string   `xml:"ttl"`
+	Fnm    string   `xml:"fnm"`
+	Snm    string   `xml:"snm"`
+	Sfx    string   `xml:"sfx"`
+	Iid    string   `xml:"iid"`
+	Irf    string   `xml:"irf"`
+	Onm    string   `xml:"onm"`
+	Syn    []string `xml:"syn"`
+	Oid    string   `xml:"oid"`
+	Odv    []string `xml:"odv"`
+	Did    []string `xml:"did"`
+	Adr    *Adr     `xml:"adr"`
+	Dtxt   []string `xml:"dtxt"`
+	Rctry  *Rctry   `xml:"rctry"`
+	Nctry  *Nctry   `xml:"nctry"`
+	B742EP string   `xml:"B742EP"`
+}
+
+// B745 is the element B745
+//
+//	(B746,B747*)
+type B745 struct {
+	B746 B746   `xml:"B746"`
+	B747 []B747 `xml:"B747"`
+}
+
+// B746 is the element B746
+//
+//	(ttl?,fnm?,snm?,sfx?,iid?,irf?,onm?,syn*,oid?,(odv,did)*)
+type B746 struct {
+	Ttl string   `xml:"ttl"`
+	Fnm string   `xml:"fnm"`
+	Snm string   `xml:"snm"`
+	Sfx string   `xml:"sfx"`
+	Iid string   `xml:"iid"`
+	Irf string   `xml:"irf"`
+	Onm string   `xml:"onm"`
+	Syn []string `xml:"syn"`
+	Oid string   `xml:"oid"`
+	Odv []string `xml:"odv"`
+	Did []string `xml:"did"`
+}
+
+// B747 is the element B747
+//
+//	(ttl?,fnm?,snm?,sfx?,iid?,irf?,onm?,syn*,oid?,(odv,did)*)
+type B747 struct {
+	Ttl string   `xml:"ttl"`
+	Fnm string   `xml:"fnm"`
+	Snm string   `xml:"snm"`
+	Sfx string   `xml:"sfx"`
+	Iid string   `xml:"iid"`
+	Irf string   `xml:"irf"`
+	Onm string   `xml:"onm"`
+	Syn []string `xml:"syn"`
+	Oid string   `xml:"oid"`
+	Odv []string `xml:"odv"`
+	Did []string `xml:"did"`
+}
+
+// B780 is the element B780
+//
+//	(B781*,(B787 | B788 | B789)?)
+type B780 struct {
+	B781 []B781 `xml:"B781"`
+	B787 *B787  `xml:"B787"`
+	B788 *B788  `xml:"B788"`
+	B789 string `xml:"B789"`
+}
+
+// B781 is the element B781
+//
+//	((dnum,date,kind),((ttl?,fnm?,snm?,sfx?,iid?,irf?,onm?,syn*,oid?,(odv,did)*),adr?,dtxt*,rctry?,
+//	nctry?),B784?,(B785 | B786)?)
+type B781 struct {
+	ID    string   `xml:"id,attr"`
+	Dnum  Dnum     `xml:"dnum"`
+	Date  string   `xml:"date"`
+	Kind  string   `xml:"kind"`
+	Ttl   string   `xml:"ttl"`
+	Fnm   string   `xml:"fnm"`
+	Snm   string   `xml:"snm"`
+	Sfx   string   `xml:"sfx"`
+	Iid   string   `xml:"iid"`
+	Irf   string   `xml:"irf"`
+	Onm   string   `xml:"onm"`
+	Syn   []string `xml:"syn"`
+	Oid   string   `xml:"oid"`
+	Odv   []string `xml:"odv"`
+	Did   []string `xml:"did"`
+	Adr   *Adr     `xml:"adr"`
+	Dtxt  []string `xml:"dtxt"`
+	Rctry *Rctry   `xml:"rctry"`
+	Nctry *Nctry   `xml:"nctry"`
+	B784  *B784    `xml:"B784"`
+	B785  *B785    `xml:"B785"`
+	B786  *B786    `xml:"B786"`
+}
+
+// B784 is the element B784
+//
+//	((ttl?,fnm?,snm?,sfx?,iid?,irf?,onm?,syn*,oid?,(odv,did)*),adr?,dtxt*,rctry?,nctry?)
+type B784 struct {
+	Ttl   string   `xml:"ttl"`
+	Fnm   string   `xml:"fnm"`
+	Snm   string   `xml:"snm"`
+	Sfx   string   `xml:"sfx"`
+	Iid   string   `xml:"iid"`
+	Irf   string   `xml:"irf"`
+	Onm   string   `xml:"onm"`
+	Syn   []string `xml:"syn"`
+	Oid   string   `xml:"oid"`
+	Odv   []string `xml:"odv"`
+	Did   []string `xml:"did"`
+	Adr   *Adr     `xml:"adr"`
+	Dtxt  []string `xml:"dtxt"`
+	Rctry *Rctry   `xml:"rctry"`
+	Nctry *Nctry   `xml:"nctry"`
+}
+
+// B785 is the element B785
+//
+//	(date)
+type B785 struct {
+	Date string `xml:"date"`
+}
+
+// B786 is the element B786
+//
+//	(date)
+type B786 struct {
+	Date string `xml:"date"`
+}
+
+// B787 is the element B787
+//
+//	(date)
+type B787 struct {
+	ID   string `xml:"id,attr"`
+	Date string `xml:"date"`
+}
+
+// B788 is the element B788
+//
+//	(date)
+type B788 struct {
+	ID   string `xml:"id,attr"`
+	Date string `xml:"date"`
+}
+
+// B790 is the element B790
+//
+//	(B791*)
+type B790 struct {
+	ID   string `xml:"id,attr"`
+	B791 []B791 `xml:"B791"`
+}
+
+// B791 is the element B791
+//
+//	((dnum,date,kind?),((ttl?,fnm?,snm?,sfx?,iid?,irf?,onm?,syn*,oid?,(odv,did)*),adr?,dtxt*,rctry?,
+//	nctry?),(B794?,B796?))
+type B791 struct {
+	Dnum  Dnum     `xml:"dnum"`
+	Date  string   `xml:"date"`
+	Kind  string   `xml:"kind"`
+	Ttl   string   `xml:"ttl"`
+	Fnm   string   `xml:"fnm"`
+	Snm   string   `xml:"snm"`
+	Sfx   string   `xml:"sfx"`
+	Iid   string   `xml:"iid"`
+	Irf   string   `xml:"irf"`
+	Onm   string   `xml:"onm"`
+	Syn   []string `xml:"syn"`
+	Oid   string   `xml:"oid"`
+	Odv   []string `xml:"odv"`
+	Did   []string `xml:"did"`
+	Adr   *Adr     `xml:"adr"`
+	Dtxt  []string `xml:"dtxt"`
+	Rctry *Rctry   `xml:"rctry"`
+	Nctry *Nctry   `xml:"nctry"`
+	B794  *B794    `xml:"B794"`
+	B796  *B796    `xml:"B796"`
+}
+
+// B794 is the element B794
+//
+//	((ttl?,fnm?,snm?,sfx?,iid?,irf?,onm?,syn*,oid?,(odv,did)*),adr?,dtxt*,rctry?,nctry?)
+type B794 struct {
+	Ttl   string   `xml:"ttl"`
+	Fnm   string   `xml:"fnm"`
+	Snm   string   `xml:"snm"`
+	Sfx   string   `xml:"sfx"`
+	Iid   string   `xml:"iid"`
+	Irf   string   `xml:"irf"`
+	Onm   string   `xml:"onm"`
+	Syn   []string `xml:"syn"`
+	Oid   string   `xml:"oid"`
+	Odv   []string `xml:"odv"`
+	Did   []string `xml:"did"`
+	Adr   *Adr     `xml:"adr"`
+	Dtxt  []string `xml:"dtxt"`
+	Rctry *Rctry   `xml:"rctry"`
+	Nctry *Nctry   `xml:"nctry"`
+}
+
+// B796 is the element B796
+//
+//	(ctry+)
+type B796 struct {
+	Ctry []string `xml:"ctry"`
+}
+
+// B800 is the element B800
+//
+//	(B810?,B820?,B830?,B840?,B844EP?,B850?,B860?,B870?,B880?,B890?)+
+type B800 struct {
+	B810   []B810   `xml:"B810"`
+	B820   []B820   `xml:"B820"`
+	B830   []B830   `xml:"B830"`
+	B840   []B840   `xml:"B840"`
+	B844EP []B844EP `xml:"B844EP"`
+	B850   []B850   `xml:"B850"`
+	B860   []B860   `xml:"B860"`
+	B870   []B870   `xml:"B870"`
+	B880   []B880   `xml:"B880"`
+	B890   []B890   `xml:"B890"`
+}
+
+// B810 is the element B810
+//
+//	(ctry+)
+type B810 struct {
+	Ctry []string `xml:"ctry"`
+}
+
+// B820 is the element B820
+//
+//	(ctry+)
+type B820 struct {
+	Ctry []string `xml:"ctry"`
+}
+
+// B830 is the element B830
+//
+//	(B831,B832?,B833?)
+type B830 struct {
+	B831 string `xml:"B831"`
+	B832 string `xml:"B832"`
+	B833 *B833  `xml:"B833"`
+}
+
+// B833 is the element B833
+//
+//	(date)
+type B833 struct {
+	Date string `xml:"date"`
+}
+
+// B840 is the element B840
+//
+//	(ctry+)
+type B840 struct {
+	ID   string   `xml:"id,attr"`
+	Ctry []string `xml:"ctry"`
+}
+
+// B844EP is the element B844EP
+//
+//	(B845EP+)
+type B844EP struct {
+	ID     string   `xml:"id,attr"`
+	B845EP []B845EP `xml:"B845EP"`
+}
+
+// B845EP is the element B845EP
+//
+//	(ctry,date?,B846EP?)
+type B845EP struct {
+	Ctry   string  `xml:"ctry"`
+	Date   string  `xml:"date"`
+	B846EP *B846EP `xml:"B846EP"`
+}
+
+// B846EP is the element B846EP
+//
+//	(date)
+type B846EP struct {
+	Date string `xml:"date"`
+}
+
+// B850 is the element B850
+//
+//	(date)
+type B850 struct {
+	Date string `xml:"date"`
+}
+
+// B860 is the element B860
+//
+//	(B861,B862?,B863?,B864?)
+type B860 struct {
+	ID   string `xml:"id,attr"`
+	B861 B861   `xml:"B861"`
+	B862 string `xml:"B862"`
+	B863 *B863  `xml:"B863"`
+	B864 *B864  `xml:"B864"`
+}
+
+// B861 is the element B861
+//
+//	(dnum?,date?,ctry?,kind?,bnum?,dtxt*)
+type B861 struct {
+	Dnum *Dnum    `xml:"dnum"`
+	Date string   `xml:"date"`
+	Ctry string   `xml:"ctry"`
+	Kind string   `xml:"kind"`
+	Bnum string   `xml:"bnum"`
+	Dtxt []string `xml:"dtxt"`
+}
+
+// B863 is the element B863
+//
+//	(date)
+type B863 struct {
+	Date string `xml:"date"`
+}
+
+// B864 is the element B864
+//
+//	(date)
+type B864 struct {
+	Date string `xml:"date"`
+}
+
+// B870 is the element B870
+//
+//	(B871,B872?)
+type B870 struct {
+	ID   string `xml:"id,attr"`
+	B871 B871   `xml:"B871"`
+	B872 string `xml:"B872"`
+}
+
+// B871 is the element B871
+//
+//	(dnum?,date?,ctry?,kind?,bnum?,dtxt*)
+type B871 struct {
+	Dnum *Dnum    `xml:"dnum"`
+	Date string   `xml:"date"`
+	Ctry string   `xml:"ctry"`
+	Kind string   `xml:"kind"`
+	Bnum string   `xml:"bnum"`
+	Dtxt []string `xml:"dtxt"`
+}
+
+// B880 is the element B880
+//
+//	(dnum?,date?,ctry?,kind?,bnum?,dtxt*)
+type B880 struct {
+	ID   string   `xml:"id,attr"`
+	Dnum *Dnum    `xml:"dnum"`
+	Date string   `xml:"date"`
+	Ctry string   `xml:"ctry"`
+	Kind string   `xml:"kind"`
+	Bnum string   `xml:"bnum"`
+	Dtxt []string `xml:"dtxt"`
+}
+
+// B890 is the element B890
+//
+//	(B891,B892?)
+type B890 struct {
+	B891 B891  `xml:"B891"`
+	B892 *B892 `xml:"B892"`
+}
+
+// B891 is the element B891
+//
+//	(dnum?,date?,ctry?,kind?,bnum?,dtxt*)
+type B891 struct {
+	Dnum *Dnum    `xml:"dnum"`
+	Date string   `xml:"date"`
+	Ctry string   `xml:"ctry"`
+	Kind string   `xml:"kind"`
+	Bnum string   `xml:"bnum"`
+	Dtxt []string `xml:"dtxt"`
+}
+
+// B892 is the element B892
+//
+//	(date)
+type B892 struct {
+	Date string `xml:"date"`
+}
+
+// B900 is the element B900
+//
+//	(B910?)
+type B900 struct {
+	B910 *B910 `xml:"B910"`
+}
+
+// B910 is the element B910
+//
+//	(date)
+type B910 struct {
+	Date string `xml:"date"`
+}
+
+// Rctry is the element rctry
+//
+//	(ctry)
+type Rctry struct {
+	Ctry string `xml:"ctry"`
+}
+
+// Parent is the element parent
+//
+//	(dnum?,cdoc*,pdoc*,psta?,ppub?)
+type Parent struct {
+	Dnum *Dnum  `xml:"dnum"`
+	Cdoc []Cdoc `xml:"cdoc"`
+	Pdoc []Pdoc `xml:"pdoc"`
+	Psta string `xml:"psta"`
+	Ppub *Ppub  `xml:"ppub"`
+}
+
+// Ppub is the element ppub
+//
+//	(dnum?,date?,ctry?,kind?,bnum?,dtxt*)+
+type Ppub struct {
+	Dnum []Dnum   `xml:"dnum"`
+	Date []string `xml:"date"`
+	Ctry []string `xml:"ctry"`
+	Kind []string `xml:"kind"`
+	Bnum []string `xml:"bnum"`
+	Dtxt []string `xml:"dtxt"`
+}
+
+// Pdoc is the element pdoc
+//
+//	(dnum?,date?,ctry?,kind?,bnum?,dtxt*)+
+type Pdoc struct {
+	Dnum []Dnum   `xml:"dnum"`
+	Date []string `xml:"date"`
+	Ctry []string `xml:"ctry"`
+	Kind []string `xml:"kind"`
+	Bnum []string `xml:"bnum"`
+	Dtxt []string `xml:"dtxt"`
+}
+
+// Nctry is the element nctry
+//
+//	(ctry)
+type Nctry struct {
+	Ctry string `xml:"ctry"`
+}
+
+// Cdoc is the element cdoc
+//
+//	(dnum?,date?,ctry?,kind?,bnum?,dtxt*)+
+type Cdoc struct {
+	Dnum []Dnum   `xml:"dnum"`
+	Date []string `xml:"date"`
+	Ctry []string `xml:"ctry"`
+	Kind []string `xml:"kind"`
+	Bnum []string `xml:"bnum"`
+	Dtxt []string `xml:"dtxt"`
+}
+
+// Dnum is the element dnum
+//
+//	(text? | (anum?,pnum?))
+type Dnum struct {
+	Text string `xml:"text"`
+	Anum string `xml:"anum"`
+	Pnum string `xml:"pnum"`
+}
+
+// Adr is the element adr
+//
+//	((ttl?,fnm?,snm?,sfx?,iid?,irf?,onm?,syn*,oid?,(odv,did)*)?,omc?,pbox?,str*,city?,cnty?,state?,
+//	ctry?,pcode?,(ead*,tel*,fax*))
+type Adr struct {
+	Ttl   string   `xml:"ttl"`
+	Fnm   string   `xml:"fnm"`
+	Snm   string   `xml:"snm"`
+	Sfx   string   `xml:"sfx"`
+	Iid   string   `xml:"iid"`
+	Irf   string   `xml:"irf"`
+	Onm   string   `xml:"onm"`
+	Syn   []string `xml:"syn"`
+	Oid   string   `xml:"oid"`
+	Odv   []string `xml:"odv"`
+	Did   []string `xml:"did"`
+	Omc   string   `xml:"omc"`
+	Pbox  string   `xml:"pbox"`
+	Str   []string `xml:"str"`
+	City  string   `xml:"city"`
+	Cnty  string   `xml:"cnty"`
+	State string   `xml:"state"`
+	Ctry  string   `xml:"ctry"`
+	Pcode string   `xml:"pcode"`
+	Ead   []string `xml:"ead"`
+	Tel   []string `xml:"tel"`
+	Fax   []string `xml:"fax"`
+}
+
+// Abstract is the element abstract
+//
+//	((abst-problem,abst-solution) | p+)
+type Abstract struct {
+	ID           string        `xml:"id,attr"`
+	Lang         string        `xml:"lang,attr"`
+	Status       string        `xml:"status,attr"`
+	AbstProblem  *AbstProblem  `xml:"abst-problem"`
+	AbstSolution *AbstSolution `xml:"abst-solution"`
+	P            []P           `xml:"p"`
+}
+
+// AbstProblem is the element abst-problem
+//
+//	(p+)
+type AbstProblem struct {
+	ID string `xml:"id,attr"`
+	P  []P    `xml:"p"`
+}
+
+// AbstSolution is the element abst-solution
+//
+//	(p+)
+type AbstSolution struct {
+	ID string `xml:"id,attr"`
+	P  []P    `xml:"p"`
+}
+
+// Description is the element description
+//
+//	(invention-title?,(technical-field | background-art | disclosure | description-of-drawings |
+//	best-mode | mode-for-invention | industrial-applicability | sequence-list-text | (heading*,p+)+)+)
+type Description struct {
+	ID                      string                    `xml:"id,attr"`
+	Lang                    string                    `xml:"lang,attr"`
+	Status                  string                    `xml:"status,attr"`
+	InventionTitle          *InventionTitle           `xml:"invention-title"`
+	TechnicalField          []TechnicalField          `xml:"technical-field"`
+	BackgroundArt           []BackgroundArt           `xml:"background-art"`
+	Disclosure              []Disclosure              `xml:"disclosure"`
+	DescriptionOfDrawings   []DescriptionOfDrawings   `xml:"description-of-drawings"`
+	BestMode                []BestMode                `xml:"best-mode"`
+	ModeForInvention        []ModeForInvention        `xml:"mode-for-invention"`
+	IndustrialApplicability []IndustrialApplicability `xml:"industrial-applicability"`
+	SequenceListText        []SequenceListText        `xml:"sequence-list-text"`
+	Heading                 []Heading                 `xml:"heading"`
+	P                       []P                       `xml:"p"`
+}
+
+// Heading is the element heading
+//
+//	(#PCDATA | b | i | u | sup | sub | smallcaps)*
+type Heading struct {
+	Text      string      `xml:",chardata"`
+	InnerXML  string      `xml:",innerxml"`
+	ID        string      `xml:"id,attr"`
+	Level     string      `xml:"level,attr"`
+	B         []B         `xml:"b"`
+	I         []I         `xml:"i"`
+	U         []U         `xml:"u"`
+	Sup       []Sup       `xml:"sup"`
+	Sub       []Sub       `xml:"sub"`
+	Smallcaps []Smallcaps `xml:"smallcaps"`
+}
+
+// P is the element p
+//
+//	(#PCDATA | b | i | u | sup | sub | smallcaps | br | pre | dl | ul | ol | crossref | figref | patcit
+//	| nplcit | bio-deposit | img | chemistry | maths | tables | table-external-doc)*
+type P struct {
+	Text             string             `xml:",chardata"`
+	InnerXML         string             `xml:",innerxml"`
+	ID               string             `xml:"id,attr"`
+	Num              string             `xml:"num,attr"`
+	B                []B                `xml:"b"`
+	I                []I                `xml:"i"`
+	U                []U                `xml:"u"`
+	Sup              []Sup              `xml:"sup"`
+	Sub              []Sub              `xml:"sub"`
+	Smallcaps        []Smallcaps        `xml:"smallcaps"`
+	Br               []Br               `xml:"br"`
+	Pre              []Pre              `xml:"pre"`
+	Dl               []Dl               `xml:"dl"`
+	Ul               []Ul               `xml:"ul"`
+	Ol               []Ol               `xml:"ol"`
+	Crossref         []Crossref         `xml:"crossref"`
+	Figref           []Figref           `xml:"figref"`
+	Patcit           []Patcit           `xml:"patcit"`
+	Nplcit           []Nplcit           `xml:"nplcit"`
+	BioDeposit       []BioDeposit       `xml:"bio-deposit"`
+	Img              []Img              `xml:"img"`
+	Chemistry        []Chemistry        `xml:"chemistry"`
+	Maths            []Maths            `xml:"maths"`
+	Tables           []Tables           `xml:"tables"`
+	TableExternalDoc []TableExternalDoc `xml:"table-external-doc"`
+}
+
+// B is the element b
+//
+//	(#PCDATA | i | u | smallcaps)*
+type B struct {
+	Text      string      `xml:",chardata"`
+	InnerXML  string      `xml:",innerxml"`
+	I         []I         `xml:"i"`
+	U         []U         `xml:"u"`
+	Smallcaps []Smallcaps `xml:"smallcaps"`
+}
+
+// I is the element i
+//
+//	(#PCDATA | b | u | smallcaps)*
+type I struct {
+	Text      string      `xml:",chardata"`
+	InnerXML  string      `xml:",innerxml"`
+	B         []B         `xml:"b"`
+	U         []U         `xml:"u"`
+	Smallcaps []Smallcaps `xml:"smallcaps"`
+}
+
+// U is the element u
+//
+//	(#PCDATA | b | i | smallcaps)*
+type U struct {
+	Text      string      `xml:",chardata"`
+	InnerXML  string      `xml:",innerxml"`
+	Style     string      `xml:"style,attr"`
+	B         []B         `xml:"b"`
+	I         []I         `xml:"i"`
+	Smallcaps []Smallcaps `xml:"smallcaps"`
+}
+
+// Smallcaps is the element smallcaps
+//
+//	(#PCDATA | b | u | i)*
+type Smallcaps struct {
+	Text     string `xml:",chardata"`
+	InnerXML string `xml:",innerxml"`
+	B        []B    `xml:"b"`
+	U        []U    `xml:"u"`
+	I        []I    `xml:"i"`
+}
+
+// Sup is the element sup
+//
+//	(#PCDATA | b | u | i | sup2 | sub2)*
+type Sup struct {
+	Text     string `xml:",chardata"`
+	InnerXML string `xml:",innerxml"`
+	B        []B    `xml:"b"`
+	U        []U    `xml:"u"`
+	I        []I    `xml:"i"`
+	Sup2     []Sup2 `xml:"sup2"`
+	Sub2     []Sub2 `xml:"sub2"`
+}
+
+// Sup2 is the element sup2
+//
+//	(#PCDATA | b | u | i)*
+type Sup2 struct {
+	Text     string `xml:",chardata"`
+	InnerXML string `xml:",innerxml"`
+	B        []B    `xml:"b"`
+	U        []U    `xml:"u"`
+	I        []I    `xml:"i"`
+}
+
+// Sub is the element sub
+//
+//	(#PCDATA | b | u | i | sup2 | sub2)*
+type Sub struct {
+	Text     string `xml:",chardata"`
+	InnerXML string `xml:",innerxml"`
+	B        []B    `xml:"b"`
+	U        []U    `xml:"u"`
+	I        []I    `xml:"i"`
+	Sup2     []Sup2 `xml:"sup2"`
+	Sub2     []Sub2 `xml:"sub2"`
+}
+
+// Sub2 is the element sub2
+//
+//	(#PCDATA | b | u | i)*
+type Sub2 struct {
+	Text     string `xml:",chardata"`
+	InnerXML string `xml:",innerxml"`
+	B        []B    `xml:"b"`
+	U        []U    `xml:"u"`
+	I        []I    `xml:"i"`
+}
+
+// Br is the element br
+//
+//	EMPTY
+type Br struct{}
+
+// Dl is the element dl
+//
+//	(dt,dd)+
+type Dl struct {
+	ID      string `xml:"id,attr"`
+	Tsize   string `xml:"tsize,attr"`
+	Compact string `xml:"compact,attr"`
+	Dt      []Dt   `xml:"dt"`
+	Dd      []Dd   `xml:"dd"`
+}
+
+// Dt is the element dt
+//
+//	(#PCDATA | b | i | u | sup | sub | smallcaps)*
+type Dt struct {
+	Text      string      `xml:",chardata"`
+	InnerXML  string      `xml:",innerxml"`
+	ID        string      `xml:"id,attr"`
+	B         []B         `xml:"b"`
+	I         []I         `xml:"i"`
+	U         []U         `xml:"u"`
+	Sup       []Sup       `xml:"sup"`
+	Sub       []Sub       `xml:"sub"`
+	Smallcaps []Smallcaps `xml:"smallcaps"`
+}
+
+// Dd is the element dd
+//
+//	(#PCDATA | b | i | u | sup | sub | smallcaps | br | pre | patcit | nplcit | bio-deposit | crossref |
+//	figref | img | ul | ol | chemistry | maths)*
+type Dd struct {
+	Text       string       `xml:",chardata"`
+	InnerXML   string       `xml:",innerxml"`
+	B          []B          `xml:"b"`
+	I          []I          `xml:"i"`
+	U          []U          `xml:"u"`
+	Sup        []Sup        `xml:"sup"`
+	Sub        []Sub        `xml:"sub"`
+	Smallcaps  []Smallcaps  `xml:"smallcaps"`
+	Br         []Br         `xml:"br"`
+	Pre        []Pre        `xml:"pre"`
+	Patcit     []Patcit     `xml:"patcit"`
+	Nplcit     []Nplcit     `xml:"nplcit"`
+	BioDeposit []BioDeposit `xml:"bio-deposit"`
+	Crossref   []Crossref   `xml:"crossref"`
+	Figref     []Figref     `xml:"figref"`
+	Img        []Img        `xml:"img"`
+	Ul         []Ul         `xml:"ul"`
+	Ol         []Ol         `xml:"ol"`
+	Chemistry  []Chemistry  `xml:"chemistry"`
+	Maths      []Maths      `xml:"maths"`
+}
+
+// Ol is the element ol
+//
+//	(li+)
+type Ol struct {
+	ID      string `xml:"id,attr"`
+	Compact string `xml:"compact,attr"`
+	OlStyle string `xml:"ol-style,attr"`
+	Li      []Li   `xml:"li"`
+}
+
+// Ul is the element ul
+//
+//	(li+)
+type Ul struct {
+	ID        string `xml:"id,attr"`
+	ListStyle string `xml:"list-style,attr"`
+	Compact   string `xml:"compact,attr"`
+	Li        []Li   `xml:"li"`
+}
+
+// Li is the element li
+//
+//	(#PCDATA | b | i | u | sup | sub | smallcaps | br | pre | patcit | nplcit | bio-deposit | crossref |
+//	figref | img | dl | ul | ol | chemistry | maths)*
+type Li struct {
+	Text       string       `xml:",chardata"`
+	InnerXML   string       `xml:",innerxml"`
+	ID         string       `xml:"id,attr"`
+	Num        string       `xml:"num,attr"`
+	B          []B          `xml:"b"`
+	I          []I          `xml:"i"`
+	U          []U          `xml:"u"`
+	Sup        []Sup        `xml:"sup"`
+	Sub        []Sub        `xml:"sub"`
+	Smallcaps  []Smallcaps  `xml:"smallcaps"`
+	Br         []Br         `xml:"br"`
+	Pre        []Pre        `xml:"pre"`
+	Patcit     []Patcit     `xml:"patcit"`
+	Nplcit     []Nplcit     `xml:"nplcit"`
+	BioDeposit []BioDeposit `xml:"bio-deposit"`
+	Crossref   []Crossref   `xml:"crossref"`
+	Figref     []Figref     `xml:"figref"`
+	Img        []Img        `xml:"img"`
+	Dl         []Dl         `xml:"dl"`
+	Ul         []Ul         `xml:"ul"`
+	Ol         []Ol         `xml:"ol"`
+	Chemistry  []Chemistry  `xml:"chemistry"`
+	Maths      []Maths      `xml:"maths"`
+}
+
+// Figref is the element figref
+//
+//	(#PCDATA | b | i | u | sup | sub | smallcaps)*
+type Figref struct {
+	Text      string      `xml:",chardata"`
+	InnerXML  string      `xml:",innerxml"`
+	Idref     string      `xml:"idref,attr"`
+	Num       string      `xml:"num,attr"`
+	B         []B         `xml:"b"`
+	I         []I         `xml:"i"`
+	U         []U         `xml:"u"`
+	Sup       []Sup       `xml:"sup"`
+	Sub       []Sub       `xml:"sub"`
+	Smallcaps []Smallcaps `xml:"smallcaps"`
+}
+
+// Crossref is the element crossref
+//
+//	(#PCDATA | b | i | u | sup | sub | smallcaps)*
+type Crossref struct {
+	Text      string      `xml:",chardata"`
+	InnerXML  string      `xml:",innerxml"`
+	Idref     string      `xml:"idref,attr"`
+	B         []B         `xml:"b"`
+	I         []I         `xml:"i"`
+	U         []U         `xml:"u"`
+	Sup       []Sup       `xml:"sup"`
+	Sub       []Sub       `xml:"sub"`
+	Smallcaps []Smallcaps `xml:"smallcaps"`
+}
+
+// Img is the element img
+//
+//	EMPTY
+type Img struct {
+	ID          string `xml:"id,attr"`
+	File        string `xml:"file,attr"`
+	Wi          string `xml:"wi,attr"`
+	He          string `xml:"he,attr"`
+	ImgContent  string `xml:"img-content,attr"`
+	ImgFormat   string `xml:"img-format,attr"`
+	Orientation string `xml:"orientation,attr"`
+	Inline      string `xml:"inline,attr"`
+	Alt         string `xml:"alt,attr"`
+}
+
+// SequenceListText is the element sequence-list-text
+//
+//	(heading*,p+)+
+type SequenceListText struct {
+	ID      string    `xml:"id,attr"`
+	Heading []Heading `xml:"heading"`
+	P       []P       `xml:"p"`
+}
+
+// Claims is the element claims
+//
+//	(claim+)
+type Claims struct {
+	ID        string  `xml:"id,attr"`
+	Lang      string  `xml:"lang,attr"`
+	ClaimType string  `xml:"claim-type,attr"`
+	Status    string  `xml:"status,attr"`
+	Claim     []Claim `xml:"claim"`
+}
+
+// Claim is the element claim
+//
+//	(claim-text+)
+type Claim struct {
+	ID        string      `xml:"id,attr"`
+	Num       string      `xml:"num,attr"`
+	ClaimType string      `xml:"claim-type,attr"`
+	ClaimText []ClaimText `xml:"claim-text"`
+}
+
+// ClaimText is the element claim-text
+//
+//	(#PCDATA | claim-text | claim-ref | b | i | u | sup | sub | smallcaps | br | pre | crossref |
+//	figref | img | chemistry | maths | tables)*
+type ClaimText struct {
+	Text      string      `xml:",chardata"`
+	InnerXML  string      `xml:",innerxml"`
+	ClaimText []ClaimText `xml:"claim-text"`
+	ClaimRef  []ClaimRef  `xml:"claim-ref"`
+	B         []B         `xml:"b"`
+	I         []I         `xml:"i"`
+	U         []U         `xml:"u"`
+	Sup       []Sup       `xml:"sup"`
+	Sub       []Sub       `xml:"sub"`
+	Smallcaps []Smallcaps `xml:"smallcaps"`
+	Br        []Br        `xml:"br"`
+	Pre       []Pre       `xml:"pre"`
+	Crossref  []Crossref  `xml:"crossref"`
+	Figref    []Figref    `xml:"figref"`
+	Img       []Img       `xml:"img"`
+	Chemistry []Chemistry `xml:"chemistry"`
+	Maths     []Maths     `xml:"maths"`
+	Tables    []Tables    `xml:"tables"`
+}
+
+// ClaimRef is the element claim-ref
+//
+//	(#PCDATA)
+type ClaimRef struct {
+	Text  string `xml:",chardata"`
+	Idref string `xml:"idref,attr"`
+}
+
+// AmendedClaims is the element amended-claims
+//
+//	(doc-page+ | (heading?,claim+,amended-claims-statement*)+)
+type AmendedClaims struct {
+	ID                     string                   `xml:"id,attr"`
+	Lang                   string                   `xml:"lang,attr"`
+	Country                string                   `xml:"country,attr"`
+	AmendClaimType         string                   `xml:"amend-claim-type,attr"`
+	Status                 string                   `xml:"status,attr"`
+	DocPage                []DocPage                `xml:"doc-page"`
+	Heading                []Heading                `xml:"heading"`
+	Claim                  []Claim                  `xml:"claim"`
+	AmendedClaimsStatement []AmendedClaimsStatement `xml:"amended-claims-statement"`
+}
+
+// AmendedClaimsStatement is the element amended-claims-statement
+//
+//	(doc-page+ | claims-statement+)
+type AmendedClaimsStatement struct {
+	ID              string            `xml:"id,attr"`
+	Lang            string            `xml:"lang,attr"`
+	Status          string            `xml:"status,attr"`
+	DocPage         []DocPage         `xml:"doc-page"`
+	ClaimsStatement []ClaimsStatement `xml:"claims-statement"`
+}
+
+// ClaimsStatement is the element claims-statement
+//
+//	(heading*,p+)+
+type ClaimsStatement struct {
+	Heading []Heading `xml:"heading"`
+	P       []P       `xml:"p"`
+}
+
+// Drawings is the element drawings
+//
+//	(doc-page+ | figure+)
+type Drawings struct {
+	ID      string    `xml:"id,attr"`
+	Lang    string    `xml:"lang,attr"`
+	Status  string    `xml:"status,attr"`
+	DocPage []DocPage `xml:"doc-page"`
+	Figure  []Figure  `xml:"figure"`
+}
+
+// Figure is the element figure
+//
+//	(img)
+type Figure struct {
+	ID           string `xml:"id,attr"`
+	Num          string `xml:"num,attr"`
+	FigureLabels string `xml:"figure-labels,attr"`
+	Img          Img    `xml:"img"`
+}
+
+// DocumentID is the element document-id
+//
+//	(country,doc-number,kind?,name?,date?)
+type DocumentID struct {
+	Lang      string `xml:"lang,attr"`
+	Country   string `xml:"country"`
+	DocNumber string `xml:"doc-number"`
+	Kind      string `xml:"kind"`
+	Name      *Name  `xml:"name"`
+	Date      string `xml:"date"`
+}
+
+// Name is the element name
+//
+//	(#PCDATA)
+type Name struct {
+	Text     string `xml:",chardata"`
+	NameType string `xml:"name-type,attr"`
+}
+
+// BioDeposit is the element bio-deposit
+//
+//	(depositary,bio-accno,date?,term?,dtext?)
+type BioDeposit struct {
+	ID         string     `xml:"id,attr"`
+	Num        string     `xml:"num,attr"`
+	URL        string     `xml:"url,attr"`
+	Dnum       string     `xml:"dnum,attr"`
+	Depositary Depositary `xml:"depositary"`
+	BioAccno   string     `xml:"bio-accno"`
+	Date       string     `xml:"date"`
+	Term       string     `xml:"term"`
+	Dtext      string     `xml:"dtext"`
+}
+
+// Depositary is the element depositary
+//
+//	(#PCDATA | address)*
+type Depositary struct {
+	Text     string    `xml:",chardata"`
+	InnerXML string    `xml:",innerxml"`
+	Address  []Address `xml:"address"`
+}
+
+// Addressbook is the element addressbook
+//
+//	((((name | (prefix?,(last-name | orgname),first-name?,middle-name?,suffix?,iid?,role?,orgname?,
+//	department?,synonym*)),registered-number?),address,phone*,fax*,email*,url*,ead*,dtext?) | text)
+type Addressbook struct {
+	ID               string   `xml:"id,attr"`
+	Lang             string   `xml:"lang,attr"`
+	Name             *Name    `xml:"name"`
+	Prefix           string   `xml:"prefix"`
+	LastName         string   `xml:"last-name"`
+	Orgname          []string `xml:"orgname"`
+	FirstName        string   `xml:"first-name"`
+	MiddleName       string   `xml:"middle-name"`
+	Suffix           string   `xml:"suffix"`
+	Iid              string   `xml:"iid"`
+	Role             string   `xml:"role"`
+	Department       string   `xml:"department"`
+	Synonym          []string `xml:"synonym"`
+	RegisteredNumber string   `xml:"registered-number"`
+	Address          *Address `xml:"address"`
+	Phone            []string `xml:"phone"`
+	Fax              []string `xml:"fax"`
+	Email            []string `xml:"email"`
+	URL              []string `xml:"url"`
+	Ead              []string `xml:"ead"`
+	Dtext            string   `xml:"dtext"`
+	Text             string   `xml:"text"`
+}
+
+// Address is the element address
+//
+//	((address-1?,address-2?,address-3?,mailcode?,pobox?,room?,address-floor?,building?,street?,city?,
+//	county?,state?,postcode?,country) | text)
+type Address struct {
+	Address1     string `xml:"address-1"`
+	Address2     string `xml:"address-2"`
+	Address3     string `xml:"address-3"`
+	Mailcode     string `xml:"mailcode"`
+	Pobox        string `xml:"pobox"`
+	Room         string `xml:"room"`
+	AddressFloor string `xml:"address-floor"`
+	Building     string `xml:"building"`
+	Street       string `xml:"street"`
+	City         string `xml:"city"`
+	County       string `xml:"county"`
+	State        string `xml:"state"`
+	Postcode     string `xml:"postcode"`
+	Country      string `xml:"country"`
+	Text         string `xml:"text"`
+}
+
+// Chemistry is the element chemistry
+//
+//	(img | (chem,img?))
+type Chemistry struct {
+	ID   string `xml:"id,attr"`
+	Num  string `xml:"num,attr"`
+	Img  *Img   `xml:"img"`
+	Chem *Chem  `xml:"chem"`
+}
+
+// Chem is the element chem
+//
+//	EMPTY
+type Chem struct {
+	ID       string `xml:"id,attr"`
+	File     string `xml:"file,attr"`
+	ChemType string `xml:"chem-type,attr"`
+}
+
+// Tables is the element tables
+//
+//	(img | table)
+type Tables struct {
+	ID    string `xml:"id,attr"`
+	Num   string `xml:"num,attr"`
+	Img   *Img   `xml:"img"`
+	Table *Table `xml:"table"`
+}
+
+// Table is the element table
+//
+//	(title?,tgroup+)
+type Table struct {
+	Frame  string   `xml:"frame,attr"`
+	Title  *Title   `xml:"title"`
+	Tgroup []Tgroup `xml:"tgroup"`
+}
+
+// Title is the element title
+//
+//	(#PCDATA | b | i | u | sup | sub | smallcaps)*
+type Title struct {
+	Text      string      `xml:",chardata"`
+	InnerXML  string      `xml:",innerxml"`
+	B         []B         `xml:"b"`
+	I         []I         `xml:"i"`
+	U         []U         `xml:"u"`
+	Sup       []Sup       `xml:"sup"`
+	Sub       []Sub       `xml:"sub"`
+	Smallcaps []Smallcaps `xml:"smallcaps"`
+}
+
+// Tgroup is the element tgroup
+//
+//	(colspec*,thead?,tbody)
+type Tgroup struct {
+	Cols    string    `xml:"cols,attr"`
+	Colsep  string    `xml:"colsep,attr"`
+	Rowsep  string    `xml:"rowsep,attr"`
+	Align   string    `xml:"align,attr"`
+	Colspec []Colspec `xml:"colspec"`
+	Thead   *Thead    `xml:"thead"`
+	Tbody   Tbody     `xml:"tbody"`
+}
+
+// Colspec is the element colspec
+//
+//	EMPTY
+type Colspec struct {
+	Colnum   string `xml:"colnum,attr"`
+	Colname  string `xml:"colname,attr"`
+	Colwidth string `xml:"colwidth,attr"`
+	Colsep   string `xml:"colsep,attr"`
+	Rowsep   string `xml:"rowsep,attr"`
+	Align    string `xml:"align,attr"`
+	Char     string `xml:"char,attr"`
+	Charoff  string `xml:"charoff,attr"`
+}
+
+// Thead is the element thead
+//
+//	(row+)
+type Thead struct {
+	Valign string `xml:"valign,attr"`
+	Row    []Row  `xml:"row"`
+}
+
+// Row is the element row
+//
+//	(entry+)
+type Row struct {
+	Rowsep string  `xml:"rowsep,attr"`
+	Valign string  `xml:"valign,attr"`
+	Entry  []Entry `xml:"entry"`
+}
+
+// Entry is the element entry
+//
+//	(#PCDATA | b | i | u | sup | sub | smallcaps | br | patcit | nplcit | bio-deposit | crossref |
+//	figref | img | dl | ul | ol | chemistry | maths)*
+type Entry struct {
+	Text       string       `xml:",chardata"`
+	InnerXML   string       `xml:",innerxml"`
+	Colname    string       `xml:"colname,attr"`
+	Namest     string       `xml:"namest,attr"`
+	Nameend    string       `xml:"nameend,attr"`
+	Morerows   string       `xml:"morerows,attr"`
+	Colsep     string       `xml:"colsep,attr"`
+	Rowsep     string       `xml:"rowsep,attr"`
+	Align      string       `xml:"align,attr"`
+	Char       string       `xml:"char,attr"`
+	Charoff    string       `xml:"charoff,attr"`
+	Valign     string       `xml:"valign,attr"`
+	B          []B          `xml:"b"`
+	I          []I          `xml:"i"`
+	U          []U          `xml:"u"`
+	Sup        []Sup        `xml:"sup"`
+	Sub        []Sub        `xml:"sub"`
+	Smallcaps  []Smallcaps  `xml:"smallcaps"`
+	Br         []Br         `xml:"br"`
+	Patcit     []Patcit     `xml:"patcit"`
+	Nplcit     []Nplcit     `xml:"nplcit"`
+	BioDeposit []BioDeposit `xml:"bio-deposit"`
+	Crossref   []Crossref   `xml:"crossref"`
+	Figref     []Figref     `xml:"figref"`
+	Img        []Img        `xml:"img"`
+	Dl         []Dl         `xml:"dl"`
+	Ul         []Ul         `xml:"ul"`
+	Ol         []Ol         `xml:"ol"`
+	Chemistry  []Chemistry  `xml:"chemistry"`
+	Maths      []Maths      `xml:"maths"`
+}
+
+// Tbody is the element tbody
+//
+//	(row+)
+type Tbody struct {
+	Valign string `xml:"valign,attr"`
+	Row    []Row  `xml:"row"`
+}
+
+// SearchReportData is the element search-report-data
+//
+//	(doc-page+ | (srep-info?,srep-for-pub?,srep-written-opinion?))
+type SearchReportData struct {
+	ID                 string              `xml:"id,attr"`
+	Lang               string              `xml:"lang,attr"`
+	SrepOffice         string              `xml:"srep-office,attr"`
+	DateProduced       string              `xml:"date-produced,attr"`
+	SrepType           string              `xml:"srep-type,attr"`
+	Status             string              `xml:"status,attr"`
+	DocPage            []DocPage           `xml:"doc-page"`
+	SrepInfo           *SrepInfo           `xml:"srep-info"`
+	SrepForPub         *SrepForPub         `xml:"srep-for-pub"`
+	SrepWrittenOpinion *SrepWrittenOpinion `xml:"srep-written-opinion"`
+}
+
+// SrepInfo is the element srep-info
+//
+//	(doc-page+ | (correspondence-address?,file-reference-id?,application-reference+,
+//	date-of-earliest-priority?,applicant-name,srep-established?,srep-protest-fees?,srep-request-date?,
+//	srep-request-number?,srep-prior-art-docs*,srep-basis?,srep-claims-info?,srep-unity-of-invention?,
+//	srep-invention-title,srep-abstract,srep-figure-to-publish,srep-info-admin,srep-other-info?,text*))
+type SrepInfo struct {
+	TotalPageCount         string                  `xml:"total-page-count,attr"`
+	DocPage                []DocPage               `xml:"doc-page"`
+	CorrespondenceAddress  *CorrespondenceAddress  `xml:"correspondence-address"`
+	FileReferenceID        string                  `xml:"file-reference-id"`
+	ApplicationReference   []ApplicationReference  `xml:"application-reference"`
+	DateOfEarliestPriority *DateOfEarliestPriority `xml:"date-of-earliest-priority"`
+	ApplicantName          *ApplicantName          `xml:"applicant-name"`
+	SrepEstablished        *SrepEstablished        `xml:"srep-established"`
+	SrepProtestFees        *SrepProtestFees        `xml:"srep-protest-fees"`
+	SrepRequestDate        *SrepRequestDate        `xml:"srep-request-date"`
+	SrepRequestNumber      string                  `xml:"srep-request-number"`
+	SrepPriorArtDocs       []SrepPriorArtDocs      `xml:"srep-prior-art-docs"`
+	SrepBasis              *SrepBasis              `xml:"srep-basis"`
+	SrepClaimsInfo         *SrepClaimsInfo         `xml:"srep-claims-info"`
+	SrepUnityOfInvention   *SrepUnityOfInvention   `xml:"srep-unity-of-invention"`
+	SrepInventionTitle     *SrepInventionTitle     `xml:"srep-invention-title"`
+	SrepAbstract           *SrepAbstract           `xml:"srep-abstract"`
+	SrepFigureToPublish    *SrepFigureToPublish    `xml:"srep-figure-to-publish"`
+	SrepInfoAdmin          *SrepInfoAdmin          `xml:"srep-info-admin"`
+	SrepOtherInfo          *SrepOtherInfo          `xml:"srep-other-info"`
+	Text                   []string                `xml:"text"`
+}
+
+// SrepInfoAdmin is the element srep-info-admin
+//
+//	(authorized-officer?,srep-office?,date-search-report-mailed?)
+type SrepInfoAdmin struct {
+	AuthorizedOfficer      *AuthorizedOfficer      `xml:"authorized-officer"`
+	SrepOffice             *SrepOffice             `xml:"srep-office"`
+	DateSearchReportMailed *DateSearchReportMailed `xml:"date-search-report-mailed"`
+}
+
+// ApplicantName is the element applicant-name
+//
+//	((name | (prefix?,(last-name | orgname),first-name?,middle-name?,suffix?,iid?,role?,orgname?,
+//	department?,synonym*)),registered-number?)
+type ApplicantName struct {
+	Name             *Name    `xml:"name"`
+	Prefix           string   `xml:"prefix"`
+	LastName         string   `xml:"last-name"`
+	Orgname          []string `xml:"orgname"`
+	FirstName        string   `xml:"first-name"`
+	MiddleName       string   `xml:"middle-name"`
+	Suffix           string   `xml:"suffix"`
+	Iid              string   `xml:"iid"`
+	Role             string   `xml:"role"`
+	Department       string   `xml:"department"`
+	Synonym          []string `xml:"synonym"`
+	RegisteredNumber string   `xml:"registered-number"`
+}
+
+// SrepEstablished is the element srep-established
+//
+//	EMPTY
+type SrepEstablished struct {
+	SrepEstablished string `xml:"srep-established,attr"`
+}
+
+// SrepAbstract is the element srep-abstract
+//
+//	(abstract?)
+type SrepAbstract struct {
+	AbsApproval string    `xml:"abs-approval,attr"`
+	Abstract    *Abstract `xml:"abstract"`
+}
+
+// SrepInventionTitle is the element srep-invention-title
+//
+//	(invention-title?)
+type SrepInventionTitle struct {
+	TitleApproval  string          `xml:"title-approval,attr"`
+	InventionTitle *InventionTitle `xml:"invention-title"`
+}
+
+// SrepFigureToPublish is the element srep-figure-to-publish
+//
+//	(figure-to-publish?)
+type SrepFigureToPublish struct {
+	Figinfo         string           `xml:"figinfo,attr"`
+	FigureToPublish *FigureToPublish `xml:"figure-to-publish"`
+}
+
+// FigureToPublish is the element figure-to-publish
+//
+//	(fig-number+)
+type FigureToPublish struct {
+	FigNumber []string `xml:"fig-number"`
+}
+
+// SrepClaimsInfo is the element srep-claims-info
+//
+//	(clms-reason-1?,clms-reason-2?,clms-reason-3?,clms-incurring-fees-1?,clms-incurring-fees-2?)
+type SrepClaimsInfo struct {
+	ClmsReason1        *ClmsReason1        `xml:"clms-reason-1"`
+	ClmsReason2        *ClmsReason2        `xml:"clms-reason-2"`
+	ClmsReason3        *ClmsReason3        `xml:"clms-reason-3"`
+	ClmsIncurringFees1 *ClmsIncurringFees1 `xml:"clms-incurring-fees-1"`
+	ClmsIncurringFees2 *ClmsIncurringFees2 `xml:"clms-incurring-fees-2"`
+}
+
+// ClmsReason1 is the element clms-reason-1
+//
+//	(claim-num+,claim-remark)
+type ClmsReason1 struct {
+	ClaimNum    []string `xml:"claim-num"`
+	ClaimRemark string   `xml:"claim-remark"`
+}
+
+// ClmsReason2 is the element clms-reason-2
+//
+//	(claim-num+,claim-remark)
+type ClmsReason2 struct {
+	ClaimNum    []string `xml:"claim-num"`
+	ClaimRemark string   `xml:"claim-remark"`
+}
+
+// ClmsReason3 is the element clms-reason-3
+//
+//	(claim-num+)
+type ClmsReason3 struct {
+	ClaimNum []string `xml:"claim-num"`
+}
+
+// ClmsIncurringFees1 is the element clms-incurring-fees-1
+//
+//	(claim-num+)
+type ClmsIncurringFees1 struct {
+	ClaimNum []string `xml:"claim-num"`
+}
+
+// ClmsIncurringFees2 is the element clms-incurring-fees-2
+//
+//	EMPTY
+type ClmsIncurringFees2 struct{}
+
+// SrepUnityOfInvention is the element srep-unity-of-invention
+//
+//	(p*,srep-search-fees,search-fee-protest)
+type SrepUnityOfInvention struct {
+	P                []P              `xml:"p"`
+	SrepSearchFees   SrepSearchFees   `xml:"srep-search-fees"`
+	SearchFeeProtest SearchFeeProtest `xml:"search-fee-protest"`
+}
+
+// SrepSearchFees is the element srep-search-fees
+//
+//	(srep-fee-1?,srep-fee-2?,srep-fee-3?,srep-fee-4?)
+type SrepSearchFees struct {
+	SrepFee1 *SrepFee1 `xml:"srep-fee-1"`
+	SrepFee2 *SrepFee2 `xml:"srep-fee-2"`
+	SrepFee3 *SrepFee3 `xml:"srep-fee-3"`
+	SrepFee4 *SrepFee4 `xml:"srep-fee-4"`
+}
+
+// SrepFee1 is the element srep-fee-1
+//
+//	EMPTY
+type SrepFee1 struct{}
+
+// SrepFee2 is the element srep-fee-2
+//
+//	EMPTY
+type SrepFee2 struct{}
+
+// SrepFee3 is the element srep-fee-3
+//
+//	(claim-num+)
+type SrepFee3 struct {
+	ClaimNum []string `xml:"claim-num"`
+}
+
+// SrepFee4 is the element srep-fee-4
+//
+//	(claim-num+)
+type SrepFee4 struct {
+	ClaimNum []string `xml:"claim-num"`
+}
+
+// SearchFeeProtest is the element search-fee-protest
+//
+//	EMPTY
+type SearchFeeProtest struct {
+	Protest string `xml:"protest,attr"`
+}
+
+// SrepForPub is the element srep-for-pub
+//
+//	(doc-page+ | (classification-ipc?,classifications-ipcr?,classification-national?,
+//	srep-fields-searched,srep-citations,incomplete-search?,srep-admin?,srep-patent-family?))
+type SrepForPub struct {
+	DocPage                []DocPage               `xml:"doc-page"`
+	ClassificationIpc      *ClassificationIpc      `xml:"classification-ipc"`
+	ClassificationsIpcr    *ClassificationsIpcr    `xml:"classifications-ipcr"`
+	ClassificationNational *ClassificationNational `xml:"classification-national"`
+	SrepFieldsSearched     *SrepFieldsSearched     `xml:"srep-fields-searched"`
+	SrepCitations          *SrepCitations          `xml:"srep-citations"`
+	IncompleteSearch       *IncompleteSearch       `xml:"incomplete-search"`
+	SrepAdmin              *SrepAdmin              `xml:"srep-admin"`
+	SrepPatentFamily       *SrepPatentFamily       `xml:"srep-patent-family"`
+}
+
+// SrepFieldsSearched is the element srep-fields-searched
+//
+//	(minimum-documentation,other-documentation*,database-searched?)
+type SrepFieldsSearched struct {
+	MinimumDocumentation MinimumDocumentation `xml:"minimum-documentation"`
+	OtherDocumentation   []string             `xml:"other-documentation"`
+	DatabaseSearched     *DatabaseSearched    `xml:"database-searched"`
+}
+
+// MinimumDocumentation is the element minimum-documentation
+//
+//	(classification-ipc,classifications-ipcr?,classification-national?)
+type MinimumDocumentation struct {
+	ClassificationIpc      ClassificationIpc       `xml:"classification-ipc"`
+	ClassificationsIpcr    *ClassificationsIpcr    `xml:"classifications-ipcr"`
+	ClassificationNational *ClassificationNational `xml:"classification-national"`
+}
+
+// DatabaseSearched is the element database-searched
+//
+//	(text | nplcit)
+type DatabaseSearched struct {
+	Text   string  `xml:"text"`
+	Nplcit *Nplcit `xml:"nplcit"`
+}
+
+// SrepCitations is the element srep-citations
+//
+//	(text | citation+)
+type SrepCitations struct {
+	Text     string     `xml:"text"`
+	Citation []Citation `xml:"citation"`
+}
+
+// Citation is the element citation
+//
+//	((patcit | nplcit),corresponding-docs*,rel-passage*,category*,rel-claims*,classification-ipc?,
+//	classifications-ipcr?,classification-national?)
+type Citation struct {
+	ID                     string                  `xml:"id,attr"`
+	SrepPhase              string                  `xml:"srep-phase,attr"`
+	Patcit                 *Patcit                 `xml:"patcit"`
+	Nplcit                 *Nplcit                 `xml:"nplcit"`
+	CorrespondingDocs      []CorrespondingDocs     `xml:"corresponding-docs"`
+	RelPassage             []RelPassage            `xml:"rel-passage"`
+	Category               []string                `xml:"category"`
+	RelClaims              []string                `xml:"rel-claims"`
+	ClassificationIpc      *ClassificationIpc      `xml:"classification-ipc"`
+	ClassificationsIpcr    *ClassificationsIpcr    `xml:"classifications-ipcr"`
+	ClassificationNational *ClassificationNational `xml:"classification-national"`
+}
+
+// Patcit is the element patcit
+//
+//	(text | (document-id,rel-passage*))
+type Patcit struct {
+	ID         string       `xml:"id,attr"`
+	Num        string       `xml:"num,attr"`
+	Dnum       string       `xml:"dnum,attr"`
+	DnumType   string       `xml:"dnum-type,attr"`
+	File       string       `xml:"file,attr"`
+	URL        string       `xml:"url,attr"`
+	Text       string       `xml:"text"`
+	DocumentID *DocumentID  `xml:"document-id"`
+	RelPassage []RelPassage `xml:"rel-passage"`
+}
+
+// RelPassage is the element rel-passage
+//
+//	(text | (passage+,category*,rel-claims*)+)
+type RelPassage struct {
+	Text      string   `xml:"text"`
+	Passage   []string `xml:"passage"`
+	Category  []string `xml:"category"`
+	RelClaims []string `xml:"rel-claims"`
+}
+
+// Nplcit is the element nplcit
+//
+//	(text | article | book | online | othercit)
+type Nplcit struct {
+	ID       string    `xml:"id,attr"`
+	Num      string    `xml:"num,attr"`
+	Lang     string    `xml:"lang,attr"`
+	File     string    `xml:"file,attr"`
+	NplType  string    `xml:"npl-type,attr"`
+	Medium   string    `xml:"medium,attr"`
+	URL      string    `xml:"url,attr"`
+	Text     string    `xml:"text"`
+	Article  *Article  `xml:"article"`
+	Book     *Book     `xml:"book"`
+	Online   *Online   `xml:"online"`
+	Othercit *Othercit `xml:"othercit"`
+}
+
+// Article is the element article
+//
+//	(text | (author*,atl?,subname*,(serial | book),absno?,location?,class*,keyword*,cpyrt?,artid?,
+//	refno*))
+type Article struct {
+	Text     string    `xml:"text"`
+	Author   []Author  `xml:"author"`
+	Atl      string    `xml:"atl"`
+	Subname  []Subname `xml:"subname"`
+	Serial   *Serial   `xml:"serial"`
+	Book     *Book     `xml:"book"`
+	Absno    string    `xml:"absno"`
+	Location *Location `xml:"location"`
+	Class    []Class   `xml:"class"`
+	Keyword  []string  `xml:"keyword"`
+	Cpyrt    string    `xml:"cpyrt"`
+	Artid    string    `xml:"artid"`
+	Refno    []Refno   `xml:"refno"`
+}
+
+// Author is the element author
+//
+//	(((name | (prefix?,(last-name | orgname),first-name?,middle-name?,suffix?,iid?,role?,orgname?,
+//	department?,synonym*)),registered-number?) | addressbook)
+type Author struct {
+	ID               string       `xml:"id,attr"`
+	Name             *Name        `xml:"name"`
+	Prefix           string       `xml:"prefix"`
+	LastName         string       `xml:"last-name"`
+	Orgname          []string     `xml:"orgname"`
+	FirstName        string       `xml:"first-name"`
+	MiddleName       string       `xml:"middle-name"`
+	Suffix           string       `xml:"suffix"`
+	Iid              string       `xml:"iid"`
+	Role             string       `xml:"role"`
+	Department       string       `xml:"department"`
+	Synonym          []string     `xml:"synonym"`
+	RegisteredNumber string       `xml:"registered-number"`
+	Addressbook      *Addressbook `xml:"addressbook"`
+}
+
+// Subname is the element subname
+//
+//	(((name | (prefix?,(last-name | orgname),first-name?,middle-name?,suffix?,iid?,role?,orgname?,
+//	department?,synonym*)),registered-number?) | addressbook)
+type Subname struct {
+	SubnameType      string       `xml:"subname-type,attr"`
+	Name             *Name        `xml:"name"`
+	Prefix           string       `xml:"prefix"`
+	LastName         string       `xml:"last-name"`
+	Orgname          []string     `xml:"orgname"`
+	FirstName        string       `xml:"first-name"`
+	MiddleName       string       `xml:"middle-name"`
+	Suffix           string       `xml:"suffix"`
+	Iid              string       `xml:"iid"`
+	Role             string       `xml:"role"`
+	Department       string       `xml:"department"`
+	Synonym          []string     `xml:"synonym"`
+	RegisteredNumber string       `xml:"registered-number"`
+	Addressbook      *Addressbook `xml:"addressbook"`
+}
+
+// Serial is the element serial
+//
+//	(sertitle,alttitle?,subname*,issue?,imprint?,pubdate?,descrip?,notes?,pubid?,vid?,ino?,issn?,isbn?,
+//	cpyrt?)
+type Serial struct {
+	Sertitle string    `xml:"sertitle"`
+	Alttitle string    `xml:"alttitle"`
+	Subname  []Subname `xml:"subname"`
+	Issue    string    `xml:"issue"`
+	Imprint  *Imprint  `xml:"imprint"`
+	Pubdate  *Pubdate  `xml:"pubdate"`
+	Descrip  string    `xml:"descrip"`
+	Notes    string    `xml:"notes"`
+	Pubid    string    `xml:"pubid"`
+	Vid      string    `xml:"vid"`
+	Ino      string    `xml:"ino"`
+	Issn     string    `xml:"issn"`
+	Isbn     string    `xml:"isbn"`
+	Cpyrt    string    `xml:"cpyrt"`
+}
+
+// Imprint is the element imprint
+//
+//	(text | (address?,name,pubdate*))
+type Imprint struct {
+	Text    string    `xml:"text"`
+	Address *Address  `xml:"address"`
+	Name    *Name     `xml:"name"`
+	Pubdate []Pubdate `xml:"pubdate"`
+}
+
+// Pubdate is the element pubdate
+//
+//	(#PCDATA | sdate | edate | time)*
+type Pubdate struct {
+	Text     string   `xml:",chardata"`
+	InnerXML string   `xml:",innerxml"`
+	Sdate    []string `xml:"sdate"`
+	Edate    []string `xml:"edate"`
+	Time     []string `xml:"time"`
+}
+
+// Book is the element book
+//
+//	(text | (author*,(book-title+ | conference),(subtitle?,subname*,edition?,imprint?,vid?,descrip?,
+//	series?,notes?,absno?,location*,pubid?,bookno?,class*,keyword*,cpyrt?,isbn*,refno*)))
+type Book struct {
+	Text       string      `xml:"text"`
+	Author     []Author    `xml:"author"`
+	BookTitle  []string    `xml:"book-title"`
+	Conference *Conference `xml:"conference"`
+	Subtitle   string      `xml:"subtitle"`
+	Subname    []Subname   `xml:"subname"`
+	Edition    string      `xml:"edition"`
+	Imprint    *Imprint    `xml:"imprint"`
+	Vid        string      `xml:"vid"`
+	Descrip    string      `xml:"descrip"`
+	Series     *Series     `xml:"series"`
+	Notes      string      `xml:"notes"`
+	Absno      string      `xml:"absno"`
+	Location   []Location  `xml:"location"`
+	Pubid      string      `xml:"pubid"`
+	Bookno     string      `xml:"bookno"`
+	Class      []Class     `xml:"class"`
+	Keyword    []string    `xml:"keyword"`
+	Cpyrt      string      `xml:"cpyrt"`
+	Isbn       []string    `xml:"isbn"`
+	Refno      []Refno     `xml:"refno"`
+}
+
+// Conference is the element conference
+//
+//	(text | (conftitle,confdate?,confno?,confplace?,confsponsor*))
+type Conference struct {
+	Text        string        `xml:"text"`
+	Conftitle   string        `xml:"conftitle"`
+	Confdate    *Confdate     `xml:"confdate"`
+	Confno      string        `xml:"confno"`
+	Confplace   *Confplace    `xml:"confplace"`
+	Confsponsor []Confsponsor `xml:"confsponsor"`
+}
+
+// Confdate is the element confdate
+//
+//	(date | (sdate,edate))
+type Confdate struct {
+	Date  string `xml:"date"`
+	Sdate string `xml:"sdate"`
+	Edate string `xml:"edate"`
+}
+
+// Confplace is the element confplace
+//
+//	(#PCDATA | address)*
+type Confplace struct {
+	Text     string    `xml:",chardata"`
+	InnerXML string    `xml:",innerxml"`
+	Address  []Address `xml:"address"`
+}
+
+// Confsponsor is the element confsponsor
+//
+//	(#PCDATA | addressbook)*
+type Confsponsor struct {
+	Text        string        `xml:",chardata"`
+	InnerXML    string        `xml:",innerxml"`
+	Addressbook []Addressbook `xml:"addressbook"`
+}
+
+// Series is the element series
+//
+//	(text | (mst,msn?,issn?))
+type Series struct {
+	Text string `xml:"text"`
+	Mst  string `xml:"mst"`
+	Msn  string `xml:"msn"`
+	Issn string `xml:"issn"`
+}
+
+// Location is the element location
+//
+//	(text | (serpart?,sersect?,chapter?,pp*,column?,para?,line?))
+type Location struct {
+	Text    string  `xml:"text"`
+	Serpart string  `xml:"serpart"`
+	Sersect string  `xml:"sersect"`
+	Chapter string  `xml:"chapter"`
+	Pp      []Pp    `xml:"pp"`
+	Column  *Column `xml:"column"`
+	Para    *Para   `xml:"para"`
+	Line    *Line   `xml:"line"`
+}
+
+// Pp is the element pp
+//
+//	(#PCDATA | ppf | ppl)*
+type Pp struct {
+	Text     string   `xml:",chardata"`
+	InnerXML string   `xml:",innerxml"`
+	Ppf      []string `xml:"ppf"`
+	Ppl      []string `xml:"ppl"`
+}
+
+// Column is the element column
+//
+//	(#PCDATA | colf | coll)*
+type Column struct {
+	Text     string   `xml:",chardata"`
+	InnerXML string   `xml:",innerxml"`
+	Colf     []string `xml:"colf"`
+	Coll     []string `xml:"coll"`
+}
+
+// Para is the element para
+//
+//	(#PCDATA | paraf | paral)*
+type Para struct {
+	Text     string   `xml:",chardata"`
+	InnerXML string   `xml:",innerxml"`
+	Paraf    []string `xml:"paraf"`
+	Paral    []string `xml:"paral"`
+}
+
+// Line is the element line
+//
+//	(#PCDATA | linef | linel)*
+type Line struct {
+	Text     string   `xml:",chardata"`
+	InnerXML string   `xml:",innerxml"`
+	Linef    []string `xml:"linef"`
+	Linel    []string `xml:"linel"`
+}
+
+// Refno is the element refno
+//
+//	(#PCDATA)
+type Refno struct {
+	Text string `xml:",chardata"`
+	ID   string `xml:"id,attr"`
+}
+
+// Online is the element online
+//
+//	(text | (author*,online-title*,hosttitle?,subname*,edition?,(serial | book)?,imprint?,pubdate?,
+//	history?,series?,hostno?,absno?,location?,notes?,avail,class*,keyword*,cpyrt?,issn?,isbn?,datecit?,
+//	srchterm*,srchdate?,refno*))
+type Online struct {
+	Text        string    `xml:"text"`
+	Author      []Author  `xml:"author"`
+	OnlineTitle []string  `xml:"online-title"`
+	Hosttitle   string    `xml:"hosttitle"`
+	Subname     []Subname `xml:"subname"`
+	Edition     string    `xml:"edition"`
+	Serial      *Serial   `xml:"serial"`
+	Book        *Book     `xml:"book"`
+	Imprint     *Imprint  `xml:"imprint"`
+	Pubdate     *Pubdate  `xml:"pubdate"`
+	History     *History  `xml:"history"`
+	Series      *Series   `xml:"series"`
+	Hostno      string    `xml:"hostno"`
+	Absno       string    `xml:"absno"`
+	Location    *Location `xml:"location"`
+	Notes       string    `xml:"notes"`
+	Avail       string    `xml:"avail"`
+	Class       []Class   `xml:"class"`
+	Keyword     []string  `xml:"keyword"`
+	Cpyrt       string    `xml:"cpyrt"`
+	Issn        string    `xml:"issn"`
+	Isbn        string    `xml:"isbn"`
+	Datecit     *Datecit  `xml:"datecit"`
+	Srchterm    []string  `xml:"srchterm"`
+	Srchdate    *Srchdate `xml:"srchdate"`
+	Refno       []Refno   `xml:"refno"`
+}
+
+// History is the element history
+//
+//	(text | (received | accepted | revised | misc))
+type History struct {
+	Text     string    `xml:"text"`
+	Received *Received `xml:"received"`
+	Accepted *Accepted `xml:"accepted"`
+	Revised  *Revised  `xml:"revised"`
+	Misc     *Misc     `xml:"misc"`
+}
+
+// Received is the element received
+//
+//	(date)
+type Received struct {
+	Date string `xml:"date"`
+}
+
+// Accepted is the element accepted
+//
+//	(date)
+type Accepted struct {
+	Date string `xml:"date"`
+}
+
+// Revised is the element revised
+//
+//	(date)
+type Revised struct {
+	Date string `xml:"date"`
+}
+
+// Misc is the element misc
+//
+//	(#PCDATA | date)*
+type Misc struct {
+	Text     string   `xml:",chardata"`
+	InnerXML string   `xml:",innerxml"`
+	Date     []string `xml:"date"`
+}
+
+// Datecit is the element datecit
+//
+//	(date)
+type Datecit struct {
+	Date string `xml:"date"`
+}
+
+// Srchdate is the element srchdate
+//
+//	(date)
+type Srchdate struct {
+	Date string `xml:"date"`
+}
+
+// Othercit is the element othercit
+//
+//	(#PCDATA | b | i | u | sup | sub)*
+type Othercit struct {
+	Text     string `xml:",chardata"`
+	InnerXML string `xml:",innerxml"`
+	B        []B    `xml:"b"`
+	I        []I    `xml:"i"`
+	U        []U    `xml:"u"`
+	Sup      []Sup  `xml:"sup"`
+	Sub      []Sub  `xml:"sub"`
+}
+
+// IncompleteSearch is the element incomplete-search
+//
+//	(text | limitation-of-search+)
+type IncompleteSearch struct {
+	Text               string               `xml:"text"`
+	LimitationOfSearch []LimitationOfSearch `xml:"limitation-of-search"`
+}
+
+// LimitationOfSearch is the element limitation-of-search
+//
+//	(text?,claims-searched*,claims-searched-incompletely*,claims-not-searched*,reason-limited-search*)
+type LimitationOfSearch struct {
+	Sequence                   string                `xml:"sequence,attr"`
+	ReasonType                 string                `xml:"reason-type,attr"`
+	Text                       string                `xml:"text"`
+	ClaimsSearched             []string              `xml:"claims-searched"`
+	ClaimsSearchedIncompletely []string              `xml:"claims-searched-incompletely"`
+	ClaimsNotSearched          []string              `xml:"claims-not-searched"`
+	ReasonLimitedSearch        []ReasonLimitedSearch `xml:"reason-limited-search"`
+}
+
+// ReasonLimitedSearch is the element reason-limited-search
+//
+//	(heading?,p+)
+type ReasonLimitedSearch struct {
+	Heading *Heading `xml:"heading"`
+	P       []P      `xml:"p"`
+}
+
+// SrepAdmin is the element srep-admin
+//
+//	(examiners?,srep-office?,date-search-completed,date-search-report-mailed?)
+type SrepAdmin struct {
+	Examiners              *Examiners              `xml:"examiners"`
+	SrepOffice             *SrepOffice             `xml:"srep-office"`
+	DateSearchCompleted    DateSearchCompleted     `xml:"date-search-completed"`
+	DateSearchReportMailed *DateSearchReportMailed `xml:"date-search-report-mailed"`
+}
+
+// SrepOffice is the element srep-office
+//
+//	(addressbook)
+type SrepOffice struct {
+	Addressbook Addressbook `xml:"addressbook"`
+}
+
+// DateSearchCompleted is the element date-search-completed
+//
+//	(date)
+type DateSearchCompleted struct {
+	Date string `xml:"date"`
+}
+
+// DateSearchReportMailed is the element date-search-report-mailed
+//
+//	(date)
+type DateSearchReportMailed struct {
+	Date string `xml:"date"`
+}
+
+// SearchReportPublication is the element search-report-publication
+//
+//	(document-id+)
+type SearchReportPublication struct {
+	DocumentID []DocumentID `xml:"document-id"`
+}
+
+// Searcher is the element searcher
+//
+//	(((name | (prefix?,(last-name | orgname),first-name?,middle-name?,suffix?,iid?,role?,orgname?,
+//	department?,synonym*)),registered-number?) | addressbook)
+type Searcher struct {
+	Name             *Name        `xml:"name"`
+	Prefix           string       `xml:"prefix"`
+	LastName         string       `xml:"last-name"`
+	Orgname          []string     `xml:"orgname"`
+	FirstName        string       `xml:"first-name"`
+	MiddleName       string       `xml:"middle-name"`
+	Suffix           string       `xml:"suffix"`
+	Iid              string       `xml:"iid"`
+	Role             string       `xml:"role"`
+	Department       string       `xml:"department"`
+	Synonym          []string     `xml:"synonym"`
+	RegisteredNumber string       `xml:"registered-number"`
+	Addressbook      *Addressbook `xml:"addressbook"`
+}
+
+// Examiners is the element examiners
+//
+//	(primary-examiner?,assistant-examiner?,authorized-officer?)
+type Examiners struct {
+	PrimaryExaminer   *PrimaryExaminer   `xml:"primary-examiner"`
+	AssistantExaminer *AssistantExaminer `xml:"assistant-examiner"`
+	AuthorizedOfficer *AuthorizedOfficer `xml:"authorized-officer"`
+}
+
+// PrimaryExaminer is the element primary-examiner
+//
+//	(((name | (prefix?,(last-name | orgname),first-name?,middle-name?,suffix?,iid?,role?,orgname?,
+//	department?,synonym*)),registered-number?),electronic-signature?)
+type PrimaryExaminer struct {
+	Name                *Name                `xml:"name"`
+	Prefix              string               `xml:"prefix"`
+	LastName            string               `xml:"last-name"`
+	Orgname             []string             `xml:"orgname"`
+	FirstName           string               `xml:"first-name"`
+	MiddleName          string               `xml:"middle-name"`
+	Suffix              string               `xml:"suffix"`
+	Iid                 string               `xml:"iid"`
+	Role                string               `xml:"role"`
+	Department          string               `xml:"department"`
+	Synonym             []string             `xml:"synonym"`
+	RegisteredNumber    string               `xml:"registered-number"`
+	ElectronicSignature *ElectronicSignature `xml:"electronic-signature"`
+}
+
+// SrepPatentFamily is the element srep-patent-family
+//
+//	(text?,patent-family+)
+type SrepPatentFamily struct {
+	Text         string         `xml:"text"`
+	PatentFamily []PatentFamily `xml:"patent-family"`
+}
+
+// CorrespondingDocs is the element corresponding-docs
+//
+//	(document-id | nplcit)*
+type CorrespondingDocs struct {
+	DocumentID []DocumentID `xml:"document-id"`
+	Nplcit     []Nplcit     `xml:"nplcit"`
+}
+
+// SrepProtestFees is the element srep-protest-fees
+//
+//	EMPTY
+type SrepProtestFees struct {
+	SrepProtest string `xml:"srep-protest,attr"`
+}
+
+// SrepRequestDate is the element srep-request-date
+//
+//	(date)
+type SrepRequestDate struct {
+	Date string `xml:"date"`
+}
+
+// SrepPriorArtDocs is the element srep-prior-art-docs
+//
+//	(doc-page | prior-art-xml-doc)*
+type SrepPriorArtDocs struct {
+	PriorArt       string           `xml:"prior-art,attr"`
+	DocPage        []DocPage        `xml:"doc-page"`
+	PriorArtXmlDoc []PriorArtXmlDoc `xml:"prior-art-xml-doc"`
+}
+
+// PriorArtXmlDoc is the element prior-art-xml-doc
+//
+//	EMPTY
+type PriorArtXmlDoc struct {
+	ID       string `xml:"id,attr"`
+	File     string `xml:"file,attr"`
+	Lang     string `xml:"lang,attr"`
+	Carriers string `xml:"carriers,attr"`
+	Status   string `xml:"status,attr"`
+}
+
+// SrepBasis is the element srep-basis
+//
+//	(basis-language-for-search,sequence-list-basis?)
+type SrepBasis struct {
+	BasisLanguageForSearch BasisLanguageForSearch `xml:"basis-language-for-search"`
+	SequenceListBasis      *SequenceListBasis     `xml:"sequence-list-basis"`
+}
+
+// BasisLanguageForSearch is the element basis-language-for-search
+//
+//	EMPTY
+type BasisLanguageForSearch struct {
+	Translation string `xml:"translation,attr"`
+}
+
+// SequenceListBasis is the element sequence-list-basis
+//
+//	(material-of-sequence-list,presentation-of-statements?,additional-info?)
+type SequenceListBasis struct {
+	MaterialOfSequenceList   MaterialOfSequenceList    `xml:"material-of-sequence-list"`
+	PresentationOfStatements *PresentationOfStatements `xml:"presentation-of-statements"`
+	AdditionalInfo           string                    `xml:"additional-info"`
+}
+
+// MaterialOfSequenceList is the element material-of-sequence-list
+//
+//	(sequence-listing-material-type,sequence-listing-material-format,sequence-listing-filing-time)
+type MaterialOfSequenceList struct {
+	SequenceListingMaterialType   SequenceListingMaterialType   `xml:"sequence-listing-material-type"`
+	SequenceListingMaterialFormat SequenceListingMaterialFormat `xml:"sequence-listing-material-format"`
+	SequenceListingFilingTime     SequenceListingFilingTime     `xml:"sequence-listing-filing-time"`
+}
+
+// SequenceListingMaterialType is the element sequence-listing-material-type
+//
+//	EMPTY
+type SequenceListingMaterialType struct {
+	MaterialType string `xml:"material-type,attr"`
+}
+
+// SequenceListingMaterialFormat is the element sequence-listing-material-format
+//
+//	EMPTY
+type SequenceListingMaterialFormat struct {
+	MaterialFormat string `xml:"material-format,attr"`
+}
+
+// SequenceListingFilingTime is the element sequence-listing-filing-time
+//
+//	(#PCDATA)
+type SequenceListingFilingTime struct {
+	Text         string `xml:",chardata"`
+	TimeOfFiling string `xml:"time-of-filing,attr"`
+}
+
+// PresentationOfStatements is the element presentation-of-statements
+//
+//	EMPTY
+type PresentationOfStatements struct{}
+
+// SrepOtherInfo is the element srep-other-info
+//
+//	(srep-information+)
+type SrepOtherInfo struct {
+	SrepInformation []SrepInformation `xml:"srep-information"`
+}
+
+// SrepInformation is the element srep-information
+//
+//	(#PCDATA)
+type SrepInformation struct {
+	Text     string `xml:",chardata"`
+	SrepCode string `xml:"srep-code,attr"`
+}
+
+// SrepWrittenOpinion is the element srep-written-opinion
+//
+//	(doc-page+ | (basis-of-srep-opinion,priority-opinion?,non-establishment-of-opinion?,
+//	unity-of-invention?,pct-rule43bis-statement,opinion-citations,defect-in-application?,
+//	observation-on-application?,text*))
+type SrepWrittenOpinion struct {
+	DocPage                   []DocPage                  `xml:"doc-page"`
+	BasisOfSrepOpinion        *BasisOfSrepOpinion        `xml:"basis-of-srep-opinion"`
+	PriorityOpinion           *PriorityOpinion           `xml:"priority-opinion"`
+	NonEstablishmentOfOpinion *NonEstablishmentOfOpinion `xml:"non-establishment-of-opinion"`
+	UnityOfInvention          *UnityOfInvention          `xml:"unity-of-invention"`
+	PctRule43bisStatement     *PctRule43bisStatement     `xml:"pct-rule43bis-statement"`
+	OpinionCitations          *OpinionCitations          `xml:"opinion-citations"`
+	DefectInApplication       *DefectInApplication       `xml:"defect-in-application"`
+	ObservationOnApplication  *ObservationOnApplication  `xml:"observation-on-application"`
+	Text                      []string                   `xml:"text"`
+}
+
+// BasisOfSrepOpinion is the element basis-of-srep-opinion
+//
+//	(translation-of-appl?,sequence-list-basis?)
+type BasisOfSrepOpinion struct {
+	TranslationOfAppl *TranslationOfAppl `xml:"translation-of-appl"`
+	SequenceListBasis *SequenceListBasis `xml:"sequence-list-basis"`
+}
+
+// TranslationOfAppl is the element translation-of-appl
+//
+//	EMPTY
+type TranslationOfAppl struct {
+	Lang string `xml:"lang,attr"`
+}
+
+// PriorityOpinion is the element priority-opinion
+//
+//	(earlier-appl?,claim-invalid?,additional-info?)
+type PriorityOpinion struct {
+	Varid          string        `xml:"varid,attr"`
+	EarlierAppl    *EarlierAppl  `xml:"earlier-appl"`
+	ClaimInvalid   *ClaimInvalid `xml:"claim-invalid"`
+	AdditionalInfo string        `xml:"additional-info"`
+}
+
+// EarlierAppl is the element earlier-appl
+//
+//	EMPTY
+type EarlierAppl struct {
+	PrioCode string `xml:"prio-code,attr"`
+}
+
+// ClaimInvalid is the element claim-invalid
+//
+//	EMPTY
+type ClaimInvalid struct{}
+
+// NonEstablishmentOfOpinion is the element non-establishment-of-opinion
+//
+//	(invention-not-examined,not-annex-c-compliant)
+type NonEstablishmentOfOpinion struct {
+	InventionNotExamined InventionNotExamined `xml:"invention-not-examined"`
+	NotAnnexCCompliant   NotAnnexCCompliant   `xml:"not-annex-c-compliant"`
+}
+
+// InventionNotExamined is the element invention-not-examined
+//
+//	((entire-application | claim-num+),non-estab-reason-1?,non-estab-reason-2?,non-estab-reason-3?,
+//	non-estab-reason-4?)
+type InventionNotExamined struct {
+	EntireApplication *EntireApplication `xml:"entire-application"`
+	ClaimNum          []string           `xml:"claim-num"`
+	NonEstabReason1   *NonEstabReason1   `xml:"non-estab-reason-1"`
+	NonEstabReason2   *NonEstabReason2   `xml:"non-estab-reason-2"`
+	NonEstabReason3   *NonEstabReason3   `xml:"non-estab-reason-3"`
+	NonEstabReason4   *NonEstabReason4   `xml:"non-estab-reason-4"`
+}
+
+// EntireApplication is the element entire-application
+//
+//	EMPTY
+type EntireApplication struct{}
+
+// NonEstabReason1 is the element non-estab-reason-1
+//
+//	(claim-num*,p+)
+type NonEstabReason1 struct {
+	ClaimNum []string `xml:"claim-num"`
+	P        []P      `xml:"p"`
+}
+
+// NonEstabReason2 is the element non-estab-reason-2
+//
+//	(claim-num*,p+)
+type NonEstabReason2 struct {
+	ClaimNum []string `xml:"claim-num"`
+	P        []P      `xml:"p"`
+}
+
+// NonEstabReason3 is the element non-estab-reason-3
+//
+//	(claim-num+)
+type NonEstabReason3 struct {
+	ClaimNum []string `xml:"claim-num"`
+}
+
+// NonEstabReason4 is the element non-estab-reason-4
+//
+//	(claim-num+)
+type NonEstabReason4 struct {
+	ClaimNum []string `xml:"claim-num"`
+}
+
+// NotAnnexCCompliant is the element not-annex-c-compliant
+//
+//	(annex-c?,annex-c-bis?,additional-info?)
+type NotAnnexCCompliant struct {
+	AnnexC         *AnnexC    `xml:"annex-c"`
+	AnnexCBis      *AnnexCBis `xml:"annex-c-bis"`
+	AdditionalInfo string     `xml:"additional-info"`
+}
+
+// AnnexC is the element annex-c
+//
+//	(sequence-listing-written-form?,sequence-listing-computer-readable-form?)
+type AnnexC struct {
+	SequenceListingWrittenForm          *SequenceListingWrittenForm          `xml:"sequence-listing-written-form"`
+	SequenceListingComputerReadableForm *SequenceListingComputerReadableForm `xml:"sequence-listing-computer-readable-form"`
+}
+
+// SequenceListingWrittenForm is the element sequence-listing-written-form
+//
+//	EMPTY
+type SequenceListingWrittenForm struct {
+	NotCompliant string `xml:"not-compliant,attr"`
+}
+
+// SequenceListingComputerReadableForm is the element sequence-listing-computer-readable-form
+//
+//	EMPTY
+type SequenceListingComputerReadableForm struct {
+	NotCompliant string `xml:"not-compliant,attr"`
+}
+
+// AnnexCBis is the element annex-c-bis
+//
+//	EMPTY
+type AnnexCBis struct{}
+
+// UnityOfInvention is the element unity-of-invention
+//
+//	(invitation-pay-additional-fees?,not-unity-of-invention?,compliance-unity-invention,
+//	opinion-established-regarding)
+type UnityOfInvention struct {
+	InvitationPayAdditionalFees *InvitationPayAdditionalFees `xml:"invitation-pay-additional-fees"`
+	NotUnityOfInvention         *NotUnityOfInvention         `xml:"not-unity-of-invention"`
+	ComplianceUnityInvention    ComplianceUnityInvention     `xml:"compliance-unity-invention"`
+	OpinionEstablishedRegarding OpinionEstablishedRegarding  `xml:"opinion-established-regarding"`
+}
+
+// InvitationPayAdditionalFees is the element invitation-pay-additional-fees
+//
+//	EMPTY
+type InvitationPayAdditionalFees struct {
+	UnityFeeCode string `xml:"unity-fee-code,attr"`
+}
+
+// NotUnityOfInvention is the element not-unity-of-invention
+//
+//	EMPTY
+type NotUnityOfInvention struct{}
+
+// ComplianceUnityInvention is the element compliance-unity-invention
+//
+//	(p*)
+type ComplianceUnityInvention struct {
+	UnityComplied string `xml:"unity-complied,attr"`
+	P             []P    `xml:"p"`
+}
+
+// OpinionEstablishedRegarding is the element opinion-established-regarding
+//
+//	(entire-application | claim-num+)
+type OpinionEstablishedRegarding struct {
+	EntireApplication *EntireApplication `xml:"entire-application"`
+	ClaimNum          []string           `xml:"claim-num"`
+}
+
+// PctRule43bisStatement is the element pct-rule43bis-statement
+//
+//	(novelty-valid,novelty-not-valid,inventive-step-valid,inventive-step-not-valid,applicability-valid,
+//	applicability-not-valid,citations-explanations?)
+type PctRule43bisStatement struct {
+	NoveltyValid          NoveltyValid           `xml:"novelty-valid"`
+	NoveltyNotValid       NoveltyNotValid        `xml:"novelty-not-valid"`
+	InventiveStepValid    InventiveStepValid     `xml:"inventive-step-valid"`
+	InventiveStepNotValid InventiveStepNotValid  `xml:"inventive-step-not-valid"`
+	ApplicabilityValid    ApplicabilityValid     `xml:"applicability-valid"`
+	ApplicabilityNotValid ApplicabilityNotValid  `xml:"applicability-not-valid"`
+	CitationsExplanations *CitationsExplanations `xml:"citations-explanations"`
+}
+
+// NoveltyValid is the element novelty-valid
+//
+//	(claim-num*)
+type NoveltyValid struct {
+	ClaimNum []string `xml:"claim-num"`
+}
+
+// NoveltyNotValid is the element novelty-not-valid
+//
+//	(claim-num*)
+type NoveltyNotValid struct {
+	ClaimNum []string `xml:"claim-num"`
+}
+
+// InventiveStepValid is the element inventive-step-valid
+//
+//	(claim-num*)
+type InventiveStepValid struct {
+	ClaimNum []string `xml:"claim-num"`
+}
+
+// InventiveStepNotValid is the element inventive-step-not-valid
+//
+//	(claim-num*)
+type InventiveStepNotValid struct {
+	ClaimNum []string `xml:"claim-num"`
+}
+
+// ApplicabilityValid is the element applicability-valid
+//
+//	(claim-num*)
+type ApplicabilityValid struct {
+	ClaimNum []string `xml:"claim-num"`
+}
+
+// ApplicabilityNotValid is the element applicability-not-valid
+//
+//	(claim-num*)
+type ApplicabilityNotValid struct {
+	ClaimNum []string `xml:"claim-num"`
+}
+
+// CitationsExplanations is the element citations-explanations
+//
+//	((citation+,filing-date,priority-date?) | text)
+type CitationsExplanations struct {
+	Citation     []Citation    `xml:"citation"`
+	FilingDate   *FilingDate   `xml:"filing-date"`
+	PriorityDate *PriorityDate `xml:"priority-date"`
+	Text         string        `xml:"text"`
+}
+
+// FilingDate is the element filing-date
+//
+//	(date)
+type FilingDate struct {
+	Date string `xml:"date"`
+}
+
+// PriorityDate is the element priority-date
+//
+//	(#PCDATA)
+type PriorityDate struct {
+	Text          string `xml:",chardata"`
+	PriorityValid string `xml:"priority-valid,attr"`
+}
+
+// OpinionCitations is the element opinion-citations
+//
+//	(certain-published-documents | non-written-disclosures)*
+type OpinionCitations struct {
+	CertainPublishedDocuments []CertainPublishedDocuments `xml:"certain-published-documents"`
+	NonWrittenDisclosures     []NonWrittenDisclosures     `xml:"non-written-disclosures"`
+}
+
+// CertainPublishedDocuments is the element certain-published-documents
+//
+//	((citation+,filing-date,priority-date?) | text)
+type CertainPublishedDocuments struct {
+	Citation     []Citation    `xml:"citation"`
+	FilingDate   *FilingDate   `xml:"filing-date"`
+	PriorityDate *PriorityDate `xml:"priority-date"`
+	Text         string        `xml:"text"`
+}
+
+// NonWrittenDisclosures is the element non-written-disclosures
+//
+//	(kind-of-disclosure,date,date-of-written-disclosure)
+type NonWrittenDisclosures struct {
+	KindOfDisclosure        string                  `xml:"kind-of-disclosure"`
+	Date                    string                  `xml:"date"`
+	DateOfWrittenDisclosure DateOfWrittenDisclosure `xml:"date-of-written-disclosure"`
+}
+
+// DateOfWrittenDisclosure is the element date-of-written-disclosure
+//
+//	(date)
+type DateOfWrittenDisclosure struct {
+	Date string `xml:"date"`
+}
+
+// DefectInApplication is the element defect-in-application
+//
+//	(p+)
+type DefectInApplication struct {
+	P []P `xml:"p"`
+}
+
+// ObservationOnApplication is the element observation-on-application
+//
+//	(p+)
+type ObservationOnApplication struct {
+	P []P `xml:"p"`
+}
+
+// Maths is the element maths
+//
+//	(img | (math,img?))
+type Maths struct {
+	ID   string `xml:"id,attr"`
+	Num  string `xml:"num,attr"`
+	Img  *Img   `xml:"img"`
+	Math *Math  `xml:"math"`
+}
+
+// Math is the element math
+//
+//	(mi | mn | mo | mtext | ms | mspace | mrow | mfrac | msqrt | mroot | menclose | mstyle | merror |
+//	mpadded | mphantom | mfenced | msub | msup | msubsup | munder | mover | munderover | mmultiscripts |
+//	mtable | mtr | mlabeledtr | mtd | maligngroup | malignmark | maction | ci | csymbol | cn |
+//	integers | reals | rationals | naturalnumbers | complexes | primes | exponentiale | imaginaryi |
+//	notanumber | true | false | emptyset | pi | eulergamma | infinity | apply | fn | lambda | reln |
+//	interval | list | matrix | matrixrow | set | vector | piecewise | semantics | declare)*
+type Math struct {
+	Display        string           `xml:"display,attr"`
+	Mi             []Mi             `xml:"mi"`
+	Mn             []Mn             `xml:"mn"`
+	Mo             []Mo             `xml:"mo"`
+	Mtext          []Mtext          `xml:"mtext"`
+	Ms             []Ms             `xml:"ms"`
+	Mspace         []Mspace         `xml:"mspace"`
+	Mrow           []Mrow           `xml:"mrow"`
+	Mfrac          []Mfrac          `xml:"mfrac"`
+	Msqrt          []Msqrt          `xml:"msqrt"`
+	Mroot          []Mroot          `xml:"mroot"`
+	Menclose       []Menclose       `xml:"menclose"`
+	Mstyle         []Mstyle         `xml:"mstyle"`
+	Merror         []Merror         `xml:"merror"`
+	Mpadded        []Mpadded        `xml:"mpadded"`
+	Mphantom       []Mphantom       `xml:"mphantom"`
+	Mfenced        []Mfenced        `xml:"mfenced"`
+	Msub           []Msub           `xml:"msub"`
+	Msup           []Msup           `xml:"msup"`
+	Msubsup        []Msubsup        `xml:"msubsup"`
+	Munder         []Munder         `xml:"munder"`
+	Mover          []Mover          `xml:"mover"`
+	Munderover     []Munderover     `xml:"munderover"`
+	Mmultiscripts  []Mmultiscripts  `xml:"mmultiscripts"`
+	Mtable         []Mtable         `xml:"mtable"`
+	Mtr            []Mtr            `xml:"mtr"`
+	Mlabeledtr     []Mlabeledtr     `xml:"mlabeledtr"`
+	Mtd            []Mtd            `xml:"mtd"`
+	Maligngroup    []Maligngroup    `xml:"maligngroup"`
+	Malignmark     []Malignmark     `xml:"malignmark"`
+	Maction        []Maction        `xml:"maction"`
+	Ci             []Ci             `xml:"ci"`
+	Csymbol        []Csymbol        `xml:"csymbol"`
+	Cn             []Cn             `xml:"cn"`
+	Integers       []Integers       `xml:"integers"`
+	Reals          []Reals          `xml:"reals"`
+	Rationals      []Rationals      `xml:"rationals"`
+	Naturalnumbers []Naturalnumbers `xml:"naturalnumbers"`
+	Complexes      []Complexes      `xml:"complexes"`
+	Primes         []Primes         `xml:"primes"`
+	Exponentiale   []Exponentiale   `xml:"exponentiale"`
+	Imaginaryi     []Imaginaryi     `xml:"imaginaryi"`
+	Notanumber     []Notanumber     `xml:"notanumber"`
+	True           []True           `xml:"true"`
+	False          []False          `xml:"false"`
+	Emptyset       []Emptyset       `xml:"emptyset"`
+	Pi             []Pi             `xml:"pi"`
+	Eulergamma     []Eulergamma     `xml:"eulergamma"`
+	Infinity       []Infinity       `xml:"infinity"`
+	Apply          []Apply          `xml:"apply"`
+	Fn             []Fn             `xml:"fn"`
+	Lambda         []Lambda         `xml:"lambda"`
+	Reln           []Reln           `xml:"reln"`
+	Interval       []Interval       `xml:"interval"`
+	List           []List           `xml:"list"`
+	Matrix         []Matrix         `xml:"matrix"`
+	Matrixrow      []Matrixrow      `xml:"matrixrow"`
+	Set            []Set            `xml:"set"`
+	Vector         []Vector         `xml:"vector"`
+	Piecewise      []Piecewise      `xml:"piecewise"`
+	Semantics      []Semantics      `xml:"semantics"`
+	Declare        []Declare        `xml:"declare"`
+}
+
+// Mi is the element mi
+//
+//	(#PCDATA | mglyph | malignmark)*
+type Mi struct {
+	Text           string       `xml:",chardata"`
+	InnerXML       string       `xml:",innerxml"`
+	XlinkHref      string       `xml:"href,attr"`
+	XlinkType      string       `xml:"type,attr"`
+	Class          string       `xml:"class,attr"`
+	Style          string       `xml:"style,attr"`
+	ID             string       `xml:"id,attr"`
+	Xref           string       `xml:"xref,attr"`
+	Other          string       `xml:"other,attr"`
+	Fontsize       string       `xml:"fontsize,attr"`
+	Fontweight     string       `xml:"fontweight,attr"`
+	Fontstyle      string       `xml:"fontstyle,attr"`
+	Fontfamily     string       `xml:"fontfamily,attr"`
+	Color          string       `xml:"color,attr"`
+	Mathvariant    string       `xml:"mathvariant,attr"`
+	Mathsize       string       `xml:"mathsize,attr"`
+	Mathcolor      string       `xml:"mathcolor,attr"`
+	Mathbackground string       `xml:"mathbackground,attr"`
+	Mglyph         []Mglyph     `xml:"mglyph"`
+	Malignmark     []Malignmark `xml:"malignmark"`
+}
+
+// Mglyph is the element mglyph
+//
+//	EMPTY
+type Mglyph struct {
+	Alt        string `xml:"alt,attr"`
+	Fontfamily string `xml:"fontfamily,attr"`
+	Index      string `xml:"index,attr"`
+}
+
+// Malignmark is the element malignmark
+//
+//	EMPTY
+type Malignmark struct {
+	Edge string `xml:"edge,attr"`
+}
+
+// Mn is the element mn
+//
+//	(#PCDATA | mglyph | malignmark)*
+type Mn struct {
+	Text           string       `xml:",chardata"`
+	InnerXML       string       `xml:",innerxml"`
+	XlinkHref      string       `xml:"href,attr"`
+	XlinkType      string       `xml:"type,attr"`
+	Class          string       `xml:"class,attr"`
+	Style          string       `xml:"style,attr"`
+	ID             string       `xml:"id,attr"`
+	Xref           string       `xml:"xref,attr"`
+	Other          string       `xml:"other,attr"`
+	Fontsize       string       `xml:"fontsize,attr"`
+	Fontweight     string       `xml:"fontweight,attr"`
+	Fontstyle      string       `xml:"fontstyle,attr"`
+	Fontfamily     string       `xml:"fontfamily,attr"`
+	Color          string       `xml:"color,attr"`
+	Mathvariant    string       `xml:"mathvariant,attr"`
+	Mathsize       string       `xml:"mathsize,attr"`
+	Mathcolor      string       `xml:"mathcolor,attr"`
+	Mathbackground string       `xml:"mathbackground,attr"`
+	Mglyph         []Mglyph     `xml:"mglyph"`
+	Malignmark     []Malignmark `xml:"malignmark"`
+}
+
+// Mo is the element mo
+//
+//	(#PCDATA | mglyph | malignmark)*
+type Mo struct {
+	Text           string       `xml:",chardata"`
+	InnerXML       string       `xml:",innerxml"`
+	XlinkHref      string       `xml:"href,attr"`
+	XlinkType      string       `xml:"type,attr"`
+	Class          string       `xml:"class,attr"`
+	Style          string       `xml:"style,attr"`
+	ID             string       `xml:"id,attr"`
+	Xref           string       `xml:"xref,attr"`
+	Other          string       `xml:"other,attr"`
+	Fontsize       string       `xml:"fontsize,attr"`
+	Fontweight     string       `xml:"fontweight,attr"`
+	Fontstyle      string       `xml:"fontstyle,attr"`
+	Fontfamily     string       `xml:"fontfamily,attr"`
+	Color          string       `xml:"color,attr"`
+	Mathvariant    string       `xml:"mathvariant,attr"`
+	Mathsize       string       `xml:"mathsize,attr"`
+	Mathcolor      string       `xml:"mathcolor,attr"`
+	Mathbackground string       `xml:"mathbackground,attr"`
+	Form           string       `xml:"form,attr"`
+	Fence          string       `xml:"fence,attr"`
+	Separator      string       `xml:"separator,attr"`
+	Lspace         string       `xml:"lspace,attr"`
+	Rspace         string       `xml:"rspace,attr"`
+	Stretchy       string       `xml:"stretchy,attr"`
+	Symmetric      string       `xml:"symmetric,attr"`
+	Maxsize        string       `xml:"maxsize,attr"`
+	Minsize        string       `xml:"minsize,attr"`
+	Largeop        string       `xml:"largeop,attr"`
+	Movablelimits  string       `xml:"movablelimits,attr"`
+	Accent         string       `xml:"accent,attr"`
+	Mglyph         []Mglyph     `xml:"mglyph"`
+	Malignmark     []Malignmark `xml:"malignmark"`
+}
+
+// Mtext is the element mtext
+//
+//	(#PCDATA | mglyph | malignmark)*
+type Mtext struct {
+	Text           string       `xml:",chardata"`
+	InnerXML       string       `xml:",innerxml"`
+	XlinkHref      string       `xml:"href,attr"`
+	XlinkType      string       `xml:"type,attr"`
+	Class          string       `xml:"class,attr"`
+	Style          string       `xml:"style,attr"`
+	ID             string       `xml:"id,attr"`
+	Xref           string       `xml:"xref,attr"`
+	Other          string       `xml:"other,attr"`
+	Fontsize       string       `xml:"fontsize,attr"`
+	Fontweight     string       `xml:"fontweight,attr"`
+	Fontstyle      string       `xml:"fontstyle,attr"`
+	Fontfamily     string       `xml:"fontfamily,attr"`
+	Color          string       `xml:"color,attr"`
+	Mathvariant    string       `xml:"mathvariant,attr"`
+	Mathsize       string       `xml:"mathsize,attr"`
+	Mathcolor      string       `xml:"mathcolor,attr"`
+	Mathbackground string       `xml:"mathbackground,attr"`
+	Mglyph         []Mglyph     `xml:"mglyph"`
+	Malignmark     []Malignmark `xml:"malignmark"`
+}
+
+// Ms is the element ms
+//
+//	(#PCDATA | mglyph | malignmark)*
+type Ms struct {
+	Text           string       `xml:",chardata"`
+	InnerXML       string       `xml:",innerxml"`
+	XlinkHref      string       `xml:"href,attr"`
+	XlinkType      string       `xml:"type,attr"`
+	Class          string       `xml:"class,attr"`
+	Style          string       `xml:"style,attr"`
+	ID             string       `xml:"id,attr"`
+	Xref           string       `xml:"xref,attr"`
+	Other          string       `xml:"other,attr"`
+	Fontsize       string       `xml:"fontsize,attr"`
+	Fontweight     string       `xml:"fontweight,attr"`
+	Fontstyle      string       `xml:"fontstyle,attr"`
+	Fontfamily     string       `xml:"fontfamily,attr"`
+	Color          string       `xml:"color,attr"`
+	Mathvariant    string       `xml:"mathvariant,attr"`
+	Mathsize       string       `xml:"mathsize,attr"`
+	Mathcolor      string       `xml:"mathcolor,attr"`
+	Mathbackground string       `xml:"mathbackground,attr"`
+	Lquote         string       `xml:"lquote,attr"`
+	Rquote         string       `xml:"rquote,attr"`
+	Mglyph         []Mglyph     `xml:"mglyph"`
+	Malignmark     []Malignmark `xml:"malignmark"`
+}
+
+// Mspace is the element mspace
+//
+//	EMPTY
+type Mspace struct {
+	Width     string `xml:"width,attr"`
+	Height    string `xml:"height,attr"`
+	Depth     string `xml:"depth,attr"`
+	Linebreak string `xml:"linebreak,attr"`
+	XlinkHref string `xml:"href,attr"`
+	XlinkType string `xml:"type,attr"`
+	Class     string `xml:"class,attr"`
+	Style     string `xml:"style,attr"`
+	ID        string `xml:"id,attr"`
+	Xref      string `xml:"xref,attr"`
+	Other     string `xml:"other,attr"`
+}
+
+// Mrow is the element mrow
+//
+//	(mi | mn | mo | mtext | ms | mspace | mprescripts | none | mrow | mfrac | msqrt | mroot | menclose |
+//	mstyle | merror | mpadded | mphantom | mfenced | msub | msup | msubsup | munder | mover |
+//	munderover | mmultiscripts | mtable | mtr | mlabeledtr | mtd | maligngroup | malignmark | maction |
+//	ci | csymbol | cn | integers | reals | rationals | naturalnumbers | complexes | primes |
+//	exponentiale | imaginaryi | notanumber | true | false | emptyset | pi | eulergamma | infinity |
+//	apply | fn | lambda | reln | interval | list | matrix | matrixrow | set | vector | piecewise |
+//	semantics | declare)*
+type Mrow struct {
+	XlinkHref      string           `xml:"href,attr"`
+	XlinkType      string           `xml:"type,attr"`
+	Class          string           `xml:"class,attr"`
+	Style          string           `xml:"style,attr"`
+	ID             string           `xml:"id,attr"`
+	Xref           string           `xml:"xref,attr"`
+	Other          string           `xml:"other,attr"`
+	Mi             []Mi             `xml:"mi"`
+	Mn             []Mn             `xml:"mn"`
+	Mo             []Mo             `xml:"mo"`
+	Mtext          []Mtext          `xml:"mtext"`
+	Ms             []Ms             `xml:"ms"`
+	Mspace         []Mspace         `xml:"mspace"`
+	Mprescripts    []Mprescripts    `xml:"mprescripts"`
+	None           []None           `xml:"none"`
+	Mrow           []Mrow           `xml:"mrow"`
+	Mfrac          []Mfrac          `xml:"mfrac"`
+	Msqrt          []Msqrt          `xml:"msqrt"`
+	Mroot          []Mroot          `xml:"mroot"`
+	Menclose       []Menclose       `xml:"menclose"`
+	Mstyle         []Mstyle         `xml:"mstyle"`
+	Merror         []Merror         `xml:"merror"`
+	Mpadded        []Mpadded        `xml:"mpadded"`
+	Mphantom       []Mphantom       `xml:"mphantom"`
+	Mfenced        []Mfenced        `xml:"mfenced"`
+	Msub           []Msub           `xml:"msub"`
+	Msup           []Msup           `xml:"msup"`
+	Msubsup        []Msubsup        `xml:"msubsup"`
+	Munder         []Munder         `xml:"munder"`
+	Mover          []Mover          `xml:"mover"`
+	Munderover     []Munderover     `xml:"munderover"`
+	Mmultiscripts  []Mmultiscripts  `xml:"mmultiscripts"`
+	Mtable         []Mtable         `xml:"mtable"`
+	Mtr            []Mtr            `xml:"mtr"`
+	Mlabeledtr     []Mlabeledtr     `xml:"mlabeledtr"`
+	Mtd            []Mtd            `xml:"mtd"`
+	Maligngroup    []Maligngroup    `xml:"maligngroup"`
+	Malignmark     []Malignmark     `xml:"malignmark"`
+	Maction        []Maction        `xml:"maction"`
+	Ci             []Ci             `xml:"ci"`
+	Csymbol        []Csymbol        `xml:"csymbol"`
+	Cn             []Cn             `xml:"cn"`
+	Integers       []Integers       `xml:"integers"`
+	Reals          []Reals          `xml:"reals"`
+	Rationals      []Rationals      `xml:"rationals"`
+	Naturalnumbers []Naturalnumbers `xml:"naturalnumbers"`
+	Complexes      []Complexes      `xml:"complexes"`
+	Primes         []Primes         `xml:"primes"`
+	Exponentiale   []Exponentiale   `xml:"exponentiale"`
+	Imaginaryi     []Imaginaryi     `xml:"imaginaryi"`
+	Notanumber     []Notanumber     `xml:"notanumber"`
+	True           []True           `xml:"true"`
+	False          []False          `xml:"false"`
+	Emptyset       []Emptyset       `xml:"emptyset"`
+	Pi             []Pi             `xml:"pi"`
+	Eulergamma     []Eulergamma     `xml:"eulergamma"`
+	Infinity       []Infinity       `xml:"infinity"`
+	Apply          []Apply          `xml:"apply"`
+	Fn             []Fn             `xml:"fn"`
+	Lambda         []Lambda         `xml:"lambda"`
+	Reln           []Reln           `xml:"reln"`
+	Interval       []Interval       `xml:"interval"`
+	List           []List           `xml:"list"`
+	Matrix         []Matrix         `xml:"matrix"`
+	Matrixrow      []Matrixrow      `xml:"matrixrow"`
+	Set            []Set            `xml:"set"`
+	Vector         []Vector         `xml:"vector"`
+	Piecewise      []Piecewise      `xml:"piecewise"`
+	Semantics      []Semantics      `xml:"semantics"`
+	Declare        []Declare        `xml:"declare"`
+}
+
+// Mprescripts is the element mprescripts
+//
+//	EMPTY
+type Mprescripts struct{}
+
+// None is the element none
+//
+//	EMPTY
+type None struct{}
+
+// Mfrac is the element mfrac
+//
+//	(mi | mn | mo | mtext | ms | mspace | mprescripts | none | mrow | mfrac | msqrt | mroot | menclose |
+//	mstyle | merror | mpadded | mphantom | mfenced | msub | msup | msubsup | munder | mover |
+//	munderover | mmultiscripts | mtable | mtr | mlabeledtr | mtd | maligngroup | malignmark | maction |
+//	ci | csymbol | cn | integers | reals | rationals | naturalnumbers | complexes | primes |
+//	exponentiale | imaginaryi | notanumber | true | false | emptyset | pi | eulergamma | infinity |
+//	apply | fn | lambda | reln | interval | list | matrix | matrixrow | set | vector | piecewise |
+//	semantics | declare)*
+type Mfrac struct {
+	XlinkHref      string           `xml:"href,attr"`
+	XlinkType      string           `xml:"type,attr"`
+	Class          string           `xml:"class,attr"`
+	Style          string           `xml:"style,attr"`
+	ID             string           `xml:"id,attr"`
+	Xref           string           `xml:"xref,attr"`
+	Other          string           `xml:"other,attr"`
+	Bevelled       string           `xml:"bevelled,attr"`
+	Numalign       string           `xml:"numalign,attr"`
+	Denomalign     string           `xml:"denomalign,attr"`
+	Linethickness  string           `xml:"linethickness,attr"`
+	Mi             []Mi             `xml:"mi"`
+	Mn             []Mn             `xml:"mn"`
+	Mo             []Mo             `xml:"mo"`
+	Mtext          []Mtext          `xml:"mtext"`
+	Ms             []Ms             `xml:"ms"`
+	Mspace         []Mspace         `xml:"mspace"`
+	Mprescripts    []Mprescripts    `xml:"mprescripts"`
+	None           []None           `xml:"none"`
+	Mrow           []Mrow           `xml:"mrow"`
+	Mfrac          []Mfrac          `xml:"mfrac"`
+	Msqrt          []Msqrt          `xml:"msqrt"`
+	Mroot          []Mroot          `xml:"mroot"`
+	Menclose       []Menclose       `xml:"menclose"`
+	Mstyle         []Mstyle         `xml:"mstyle"`
+	Merror         []Merror         `xml:"merror"`
+	Mpadded        []Mpadded        `xml:"mpadded"`
+	Mphantom       []Mphantom       `xml:"mphantom"`
+	Mfenced        []Mfenced        `xml:"mfenced"`
+	Msub           []Msub           `xml:"msub"`
+	Msup           []Msup           `xml:"msup"`
+	Msubsup        []Msubsup        `xml:"msubsup"`
+	Munder         []Munder         `xml:"munder"`
+	Mover          []Mover          `xml:"mover"`
+	Munderover     []Munderover     `xml:"munderover"`
+	Mmultiscripts  []Mmultiscripts  `xml:"mmultiscripts"`
+	Mtable         []Mtable         `xml:"mtable"`
+	Mtr            []Mtr            `xml:"mtr"`
+	Mlabeledtr     []Mlabeledtr     `xml:"mlabeledtr"`
+	Mtd            []Mtd            `xml:"mtd"`
+	Maligngroup    []Maligngroup    `xml:"maligngroup"`
+	Malignmark     []Malignmark     `xml:"malignmark"`
+	Maction        []Maction        `xml:"maction"`
+	Ci             []Ci             `xml:"ci"`
+	Csymbol        []Csymbol        `xml:"csymbol"`
+	Cn             []Cn             `xml:"cn"`
+	Integers       []Integers       `xml:"integers"`
+	Reals          []Reals          `xml:"reals"`
+	Rationals      []Rationals      `xml:"rationals"`
+	Naturalnumbers []Naturalnumbers `xml:"naturalnumbers"`
+	Complexes      []Complexes      `xml:"complexes"`
+	Primes         []Primes         `xml:"primes"`
+	Exponentiale   []Exponentiale   `xml:"exponentiale"`
+	Imaginaryi     []Imaginaryi     `xml:"imaginaryi"`
+	Notanumber     []Notanumber     `xml:"notanumber"`
+	True           []True           `xml:"true"`
+	False          []False          `xml:"false"`
+	Emptyset       []Emptyset       `xml:"emptyset"`
+	Pi             []Pi             `xml:"pi"`
+	Eulergamma     []Eulergamma     `xml:"eulergamma"`
+	Infinity       []Infinity       `xml:"infinity"`
+	Apply          []Apply          `xml:"apply"`
+	Fn             []Fn             `xml:"fn"`
+	Lambda         []Lambda         `xml:"lambda"`
+	Reln           []Reln           `xml:"reln"`
+	Interval       []Interval       `xml:"interval"`
+	List           []List           `xml:"list"`
+	Matrix         []Matrix         `xml:"matrix"`
+	Matrixrow      []Matrixrow      `xml:"matrixrow"`
+	Set            []Set            `xml:"set"`
+	Vector         []Vector         `xml:"vector"`
+	Piecewise      []Piecewise      `xml:"piecewise"`
+	Semantics      []Semantics      `xml:"semantics"`
+	Declare        []Declare        `xml:"declare"`
+}
+
+// Msqrt is the element msqrt
+//
+//	(mi | mn | mo | mtext | ms | mspace | mprescripts | none | mrow | mfrac | msqrt | mroot | menclose |
+//	mstyle | merror | mpadded | mphantom | mfenced | msub | msup | msubsup | munder | mover |
+//	munderover | mmultiscripts | mtable | mtr | mlabeledtr | mtd | maligngroup | malignmark | maction |
+//	ci | csymbol | cn | integers | reals | rationals | naturalnumbers | complexes | primes |
+//	exponentiale | imaginaryi | notanumber | true | false | emptyset | pi | eulergamma | infinity |
+//	apply | fn | lambda | reln | interval | list | matrix | matrixrow | set | vector | piecewise |
+//	semantics | declare)*
+type Msqrt struct {
+	XlinkHref      string           `xml:"href,attr"`
+	XlinkType      string           `xml:"type,attr"`
+	Class          string           `xml:"class,attr"`
+	Style          string           `xml:"style,attr"`
+	ID             string           `xml:"id,attr"`
+	Xref           string           `xml:"xref,attr"`
+	Other          string           `xml:"other,attr"`
+	Mi             []Mi             `xml:"mi"`
+	Mn             []Mn             `xml:"mn"`
+	Mo             []Mo             `xml:"mo"`
+	Mtext          []Mtext          `xml:"mtext"`
+	Ms             []Ms             `xml:"ms"`
+	Mspace         []Mspace         `xml:"mspace"`
+	Mprescripts    []Mprescripts    `xml:"mprescripts"`
+	None           []None           `xml:"none"`
+	Mrow           []Mrow           `xml:"mrow"`
+	Mfrac          []Mfrac          `xml:"mfrac"`
+	Msqrt          []Msqrt          `xml:"msqrt"`
+	Mroot          []Mroot          `xml:"mroot"`
+	Menclose       []Menclose       `xml:"menclose"`
+	Mstyle         []Mstyle         `xml:"mstyle"`
+	Merror         []Merror         `xml:"merror"`
+	Mpadded        []Mpadded        `xml:"mpadded"`
+	Mphantom       []Mphantom       `xml:"mphantom"`
+	Mfenced        []Mfenced        `xml:"mfenced"`
+	Msub           []Msub           `xml:"msub"`
+	Msup           []Msup           `xml:"msup"`
+	Msubsup        []Msubsup        `xml:"msubsup"`
+	Munder         []Munder         `xml:"munder"`
+	Mover          []Mover          `xml:"mover"`
+	Munderover     []Munderover     `xml:"munderover"`
+	Mmultiscripts  []Mmultiscripts  `xml:"mmultiscripts"`
+	Mtable         []Mtable         `xml:"mtable"`
+	Mtr            []Mtr            `xml:"mtr"`
+	Mlabeledtr     []Mlabeledtr     `xml:"mlabeledtr"`
+	Mtd            []Mtd            `xml:"mtd"`
+	Maligngroup    []Maligngroup    `xml:"maligngroup"`
+	Malignmark     []Malignmark     `xml:"malignmark"`
+	Maction        []Maction        `xml:"maction"`
+	Ci             []Ci             `xml:"ci"`
+	Csymbol        []Csymbol        `xml:"csymbol"`
+	Cn             []Cn             `xml:"cn"`
+	Integers       []Integers       `xml:"integers"`
+	Reals          []Reals          `xml:"reals"`
+	Rationals      []Rationals      `xml:"rationals"`
+	Naturalnumbers []Naturalnumbers `xml:"naturalnumbers"`
+	Complexes      []Complexes      `xml:"complexes"`
+	Primes         []Primes         `xml:"primes"`
+	Exponentiale   []Exponentiale   `xml:"exponentiale"`
+	Imaginaryi     []Imaginaryi     `xml:"imaginaryi"`
+	Notanumber     []Notanumber     `xml:"notanumber"`
+	True           []True           `xml:"true"`
+	False          []False          `xml:"false"`
+	Emptyset       []Emptyset       `xml:"emptyset"`
+	Pi             []Pi             `xml:"pi"`
+	Eulergamma     []Eulergamma     `xml:"eulergamma"`
+	Infinity       []Infinity       `xml:"infinity"`
+	Apply          []Apply          `xml:"apply"`
+	Fn             []Fn             `xml:"fn"`
+	Lambda         []Lambda         `xml:"lambda"`
+	Reln           []Reln           `xml:"reln"`
+	Interval       []Interval       `xml:"interval"`
+	List           []List           `xml:"list"`
+	Matrix         []Matrix         `xml:"matrix"`
+	Matrixrow      []Matrixrow      `xml:"matrixrow"`
+	Set            []Set            `xml:"set"`
+	Vector         []Vector         `xml:"vector"`
+	Piecewise      []Piecewise      `xml:"piecewise"`
+	Semantics      []Semantics      `xml:"semantics"`
+	Declare        []Declare        `xml:"declare"`
+}
+
+// Mroot is the element mroot
+//
+//	(mi | mn | mo | mtext | ms | mspace | mprescripts | none | mrow | mfrac | msqrt | mroot | menclose |
+//	mstyle | merror | mpadded | mphantom | mfenced | msub | msup | msubsup | munder | mover |
+//	munderover | mmultiscripts | mtable | mtr | mlabeledtr | mtd | maligngroup | malignmark | maction |
+//	ci | csymbol | cn | integers | reals | rationals | naturalnumbers | complexes | primes |
+//	exponentiale | imaginaryi | notanumber | true | false | emptyset | pi | eulergamma | infinity |
+//	apply | fn | lambda | reln | interval | list | matrix | matrixrow | set | vector | piecewise |
+//	semantics | declare)*
+type Mroot struct {
+	XlinkHref      string           `xml:"href,attr"`
+	XlinkType      string           `xml:"type,attr"`
+	Class          string           `xml:"class,attr"`
+	Style          string           `xml:"style,attr"`
+	ID             string           `xml:"id,attr"`
+	Xref           string           `xml:"xref,attr"`
+	Other          string           `xml:"other,attr"`
+	Mi             []Mi             `xml:"mi"`
+	Mn             []Mn             `xml:"mn"`
+	Mo             []Mo             `xml:"mo"`
+	Mtext          []Mtext          `xml:"mtext"`
+	Ms             []Ms             `xml:"ms"`
+	Mspace         []Mspace         `xml:"mspace"`
+	Mprescripts    []Mprescripts    `xml:"mprescripts"`
+	None           []None           `xml:"none"`
+	Mrow           []Mrow           `xml:"mrow"`
+	Mfrac          []Mfrac          `xml:"mfrac"`
+	Msqrt          []Msqrt          `xml:"msqrt"`
+	Mroot          []Mroot          `xml:"mroot"`
+	Menclose       []Menclose       `xml:"menclose"`
+	Mstyle         []Mstyle         `xml:"mstyle"`
+	Merror         []Merror         `xml:"merror"`
+	Mpadded        []Mpadded        `xml:"mpadded"`
+	Mphantom       []Mphantom       `xml:"mphantom"`
+	Mfenced        []Mfenced        `xml:"mfenced"`
+	Msub           []Msub           `xml:"msub"`
+	Msup           []Msup           `xml:"msup"`
+	Msubsup        []Msubsup        `xml:"msubsup"`
+	Munder         []Munder         `xml:"munder"`
+	Mover          []Mover          `xml:"mover"`
+	Munderover     []Munderover     `xml:"munderover"`
+	Mmultiscripts  []Mmultiscripts  `xml:"mmultiscripts"`
+	Mtable         []Mtable         `xml:"mtable"`
+	Mtr            []Mtr            `xml:"mtr"`
+	Mlabeledtr     []Mlabeledtr     `xml:"mlabeledtr"`
+	Mtd            []Mtd            `xml:"mtd"`
+	Maligngroup    []Maligngroup    `xml:"maligngroup"`
+	Malignmark     []Malignmark     `xml:"malignmark"`
+	Maction        []Maction        `xml:"maction"`
+	Ci             []Ci             `xml:"ci"`
+	Csymbol        []Csymbol        `xml:"csymbol"`
+	Cn             []Cn             `xml:"cn"`
+	Integers       []Integers       `xml:"integers"`
+	Reals          []Reals          `xml:"reals"`
+	Rationals      []Rationals      `xml:"rationals"`
+	Naturalnumbers []Naturalnumbers `xml:"naturalnumbers"`
+	Complexes      []Complexes      `xml:"complexes"`
+	Primes         []Primes         `xml:"primes"`
+	Exponentiale   []Exponentiale   `xml:"exponentiale"`
+	Imaginaryi     []Imaginaryi     `xml:"imaginaryi"`
+	Notanumber     []Notanumber     `xml:"notanumber"`
+	True           []True           `xml:"true"`
+	False          []False          `xml:"false"`
+	Emptyset       []Emptyset       `xml:"emptyset"`
+	Pi             []Pi             `xml:"pi"`
+	Eulergamma     []Eulergamma     `xml:"eulergamma"`
+	Infinity       []Infinity       `xml:"infinity"`
+	Apply          []Apply          `xml:"apply"`
+	Fn             []Fn             `xml:"fn"`
+	Lambda         []Lambda         `xml:"lambda"`
+	Reln           []Reln           `xml:"reln"`
+	Interval       []Interval       `xml:"interval"`
+	List           []List           `xml:"list"`
+	Matrix         []Matrix         `xml:"matrix"`
+	Matrixrow      []Matrixrow      `xml:"matrixrow"`
+	Set            []Set            `xml:"set"`
+	Vector         []Vector         `xml:"vector"`
+	Piecewise      []Piecewise      `xml:"piecewise"`
+	Semantics      []Semantics      `xml:"semantics"`
+	Declare        []Declare        `xml:"declare"`
+}
+
+// Menclose is the element menclose
+//
+//	(mi | mn | mo | mtext | ms | mspace | mprescripts | none | mrow | mfrac | msqrt | mroot | menclose |
+//	mstyle | merror | mpadded | mphantom | mfenced | msub | msup | msubsup | munder | mover |
+//	munderover | mmultiscripts | mtable | mtr | mlabeledtr | mtd | maligngroup | malignmark | maction |
+//	ci | csymbol | cn | integers | reals | rationals | naturalnumbers | complexes | primes |
+//	exponentiale | imaginaryi | notanumber | true | false | emptyset | pi | eulergamma | infinity |
+//	apply | fn | lambda | reln | interval | list | matrix | matrixrow | set | vector | piecewise |
+//	semantics | declare)*
+type Menclose struct {
+	XlinkHref      string           `xml:"href,attr"`
+	XlinkType      string           `xml:"type,attr"`
+	Class          string           `xml:"class,attr"`
+	Style          string           `xml:"style,attr"`
+	ID             string           `xml:"id,attr"`
+	Xref           string           `xml:"xref,attr"`
+	Other          string           `xml:"other,attr"`
+	Notation       string           `xml:"notation,attr"`
+	Mi             []Mi             `xml:"mi"`
+	Mn             []Mn             `xml:"mn"`
+	Mo             []Mo             `xml:"mo"`
+	Mtext          []Mtext          `xml:"mtext"`
+	Ms             []Ms             `xml:"ms"`
+	Mspace         []Mspace         `xml:"mspace"`
+	Mprescripts    []Mprescripts    `xml:"mprescripts"`
+	None           []None           `xml:"none"`
+	Mrow           []Mrow           `xml:"mrow"`
+	Mfrac          []Mfrac          `xml:"mfrac"`
+	Msqrt          []Msqrt          `xml:"msqrt"`
+	Mroot          []Mroot          `xml:"mroot"`
+	Menclose       []Menclose       `xml:"menclose"`
+	Mstyle         []Mstyle         `xml:"mstyle"`
+	Merror         []Merror         `xml:"merror"`
+	Mpadded        []Mpadded        `xml:"mpadded"`
+	Mphantom       []Mphantom       `xml:"mphantom"`
+	Mfenced        []Mfenced        `xml:"mfenced"`
+	Msub           []Msub           `xml:"msub"`
+	Msup           []Msup           `xml:"msup"`
+	Msubsup        []Msubsup        `xml:"msubsup"`
+	Munder         []Munder         `xml:"munder"`
+	Mover          []Mover          `xml:"mover"`
+	Munderover     []Munderover     `xml:"munderover"`
+	Mmultiscripts  []Mmultiscripts  `xml:"mmultiscripts"`
+	Mtable         []Mtable         `xml:"mtable"`
+	Mtr            []Mtr            `xml:"mtr"`
+	Mlabeledtr     []Mlabeledtr     `xml:"mlabeledtr"`
+	Mtd            []Mtd            `xml:"mtd"`
+	Maligngroup    []Maligngroup    `xml:"maligngroup"`
+	Malignmark     []Malignmark     `xml:"malignmark"`
+	Maction        []Maction        `xml:"maction"`
+	Ci             []Ci             `xml:"ci"`
+	Csymbol        []Csymbol        `xml:"csymbol"`
+	Cn             []Cn             `xml:"cn"`
+	Integers       []Integers       `xml:"integers"`
+	Reals          []Reals          `xml:"reals"`
+	Rationals      []Rationals      `xml:"rationals"`
+	Naturalnumbers []Naturalnumbers `xml:"naturalnumbers"`
+	Complexes      []Complexes      `xml:"complexes"`
+	Primes         []Primes         `xml:"primes"`
+	Exponentiale   []Exponentiale   `xml:"exponentiale"`
+	Imaginaryi     []Imaginaryi     `xml:"imaginaryi"`
+	Notanumber     []Notanumber     `xml:"notanumber"`
+	True           []True           `xml:"true"`
+	False          []False          `xml:"false"`
+	Emptyset       []Emptyset       `xml:"emptyset"`
+	Pi             []Pi             `xml:"pi"`
+	Eulergamma     []Eulergamma     `xml:"eulergamma"`
+	Infinity       []Infinity       `xml:"infinity"`
+	Apply          []Apply          `xml:"apply"`
+	Fn             []Fn             `xml:"fn"`
+	Lambda         []Lambda         `xml:"lambda"`
+	Reln           []Reln           `xml:"reln"`
+	Interval       []Interval       `xml:"interval"`
+	List           []List           `xml:"list"`
+	Matrix         []Matrix         `xml:"matrix"`
+	Matrixrow      []Matrixrow      `xml:"matrixrow"`
+	Set            []Set            `xml:"set"`
+	Vector         []Vector         `xml:"vector"`
+	Piecewise      []Piecewise      `xml:"piecewise"`
+	Semantics      []Semantics      `xml:"semantics"`
+	Declare        []Declare        `xml:"declare"`
+}
+
+// Mstyle is the element mstyle
+//
+//	(mi | mn | mo | mtext | ms | mspace | mprescripts | none | mrow | mfrac | msqrt | mroot | menclose |
+//	mstyle | merror | mpadded | mphantom | mfenced | msub | msup | msubsup | munder | mover |
+//	munderover | mmultiscripts | mtable | mtr | mlabeledtr | mtd | maligngroup | malignmark | maction |
+//	ci | csymbol | cn | integers | reals | rationals | naturalnumbers | complexes | primes |
+//	exponentiale | imaginaryi | notanumber | true | false | emptyset | pi | eulergamma | infinity |
+//	apply | fn | lambda | reln | interval | list | matrix | matrixrow | set | vector | piecewise |
+//	semantics | declare)*
+type Mstyle struct {
+	XlinkHref              string           `xml:"href,attr"`
+	XlinkType              string           `xml:"type,attr"`
+	Class                  string           `xml:"class,attr"`
+	Style                  string           `xml:"style,attr"`
+	ID                     string           `xml:"id,attr"`
+	Xref                   string           `xml:"xref,attr"`
+	Other                  string           `xml:"other,attr"`
+	Fontsize               string           `xml:"fontsize,attr"`
+	Fontweight             string           `xml:"fontweight,attr"`
+	Fontstyle              string           `xml:"fontstyle,attr"`
+	Fontfamily             string           `xml:"fontfamily,attr"`
+	Color                  string           `xml:"color,attr"`
+	Mathvariant            string           `xml:"mathvariant,attr"`
+	Mathsize               string           `xml:"mathsize,attr"`
+	Mathcolor              string           `xml:"mathcolor,attr"`
+	Mathbackground         string           `xml:"mathbackground,attr"`
+	Form                   string           `xml:"form,attr"`
+	Fence                  string           `xml:"fence,attr"`
+	Separator              string           `xml:"separator,attr"`
+	Lspace                 string           `xml:"lspace,attr"`
+	Rspace                 string           `xml:"rspace,attr"`
+	Stretchy               string           `xml:"stretchy,attr"`
+	Symmetric              string           `xml:"symmetric,attr"`
+	Maxsize                string           `xml:"maxsize,attr"`
+	Minsize                string           `xml:"minsize,attr"`
+	Largeop                string           `xml:"largeop,attr"`
+	Movablelimits          string           `xml:"movablelimits,attr"`
+	Accent                 string           `xml:"accent,attr"`
+	Lquote                 string           `xml:"lquote,attr"`
+	Rquote                 string           `xml:"rquote,attr"`
+	Linethickness          string           `xml:"linethickness,attr"`
+	Scriptlevel            string           `xml:"scriptlevel,attr"`
+	Scriptsizemultiplier   string           `xml:"scriptsizemultiplier,attr"`
+	Scriptminsize          string           `xml:"scriptminsize,attr"`
+	Background             string           `xml:"background,attr"`
+	Veryverythinmathspace  string           `xml:"veryverythinmathspace,attr"`
+	Verythinmathspace      string           `xml:"verythinmathspace,attr"`
+	Thinmathspace          string           `xml:"thinmathspace,attr"`
+	Mediummathspace        string           `xml:"mediummathspace,attr"`
+	Thickmathspace         string           `xml:"thickmathspace,attr"`
+	Verythickmathspace     string           `xml:"verythickmathspace,attr"`
+	Veryverythickmathspace string           `xml:"veryverythickmathspace,attr"`
+	Open                   string           `xml:"open,attr"`
+	Close                  string           `xml:"close,attr"`
+	Separators             string           `xml:"separators,attr"`
+	Subscriptshift         string           `xml:"subscriptshift,attr"`
+	Superscriptshift       string           `xml:"superscriptshift,attr"`
+	Accentunder            string           `xml:"accentunder,attr"`
+	Align                  string           `xml:"align,attr"`
+	Rowalign               string           `xml:"rowalign,attr"`
+	Columnalign            string           `xml:"columnalign,attr"`
+	Columnwidth            string           `xml:"columnwidth,attr"`
+	Groupalign             string           `xml:"groupalign,attr"`
+	Alignmentscope         string           `xml:"alignmentscope,attr"`
+	Side                   string           `xml:"side,attr"`
+	Rowspacing             string           `xml:"rowspacing,attr"`
+	Columnspacing          string           `xml:"columnspacing,attr"`
+	Rowlines               string           `xml:"rowlines,attr"`
+	Columnlines            string           `xml:"columnlines,attr"`
+	Width                  string           `xml:"width,attr"`
+	Frame                  string           `xml:"frame,attr"`
+	Framespacing           string           `xml:"framespacing,attr"`
+	Minlabelspacing        string           `xml:"minlabelspacing,attr"`
+	Equalrows              string           `xml:"equalrows,attr"`
+	Equalcolumns           string           `xml:"equalcolumns,attr"`
+	Displaystyle           string           `xml:"displaystyle,attr"`
+	Rowspan                string           `xml:"rowspan,attr"`
+	Columnspan             string           `xml:"columnspan,attr"`
+	Edge                   string           `xml:"edge,attr"`
+	Selection              string           `xml:"selection,attr"`
+	Bevelled               string           `xml:"bevelled,attr"`
+	Height                 string           `xml:"height,attr"`
+	Depth                  string           `xml:"depth,attr"`
+	Mi                     []Mi             `xml:"mi"`
+	Mn                     []Mn             `xml:"mn"`
+	Mo                     []Mo             `xml:"mo"`
+	Mtext                  []Mtext          `xml:"mtext"`
+	Ms                     []Ms             `xml:"ms"`
+	Mspace                 []Mspace         `xml:"mspace"`
+	Mprescripts            []Mprescripts    `xml:"mprescripts"`
+	None                   []None           `xml:"none"`
+	Mrow                   []Mrow           `xml:"mrow"`
+	Mfrac                  []Mfrac          `xml:"mfrac"`
+	Msqrt                  []Msqrt          `xml:"msqrt"`
+	Mroot                  []Mroot          `xml:"mroot"`
+	Menclose               []Menclose       `xml:"menclose"`
+	Mstyle                 []Mstyle         `xml:"mstyle"`
+	Merror                 []Merror         `xml:"merror"`
+	Mpadded                []Mpadded        `xml:"mpadded"`
+	Mphantom               []Mphantom       `xml:"mphantom"`
+	Mfenced                []Mfenced        `xml:"mfenced"`
+	Msub                   []Msub           `xml:"msub"`
+	Msup                   []Msup           `xml:"msup"`
+	Msubsup                []Msubsup        `xml:"msubsup"`
+	Munder                 []Munder         `xml:"munder"`
+	Mover                  []Mover          `xml:"mover"`
+	Munderover             []Munderover     `xml:"munderover"`
+	Mmultiscripts          []Mmultiscripts  `xml:"mmultiscripts"`
+	Mtable                 []Mtable         `xml:"mtable"`
+	Mtr                    []Mtr            `xml:"mtr"`
+	Mlabeledtr             []Mlabeledtr     `xml:"mlabeledtr"`
+	Mtd                    []Mtd            `xml:"mtd"`
+	Maligngroup            []Maligngroup    `xml:"maligngroup"`
+	Malignmark             []Malignmark     `xml:"malignmark"`
+	Maction                []Maction        `xml:"maction"`
+	Ci                     []Ci             `xml:"ci"`
+	Csymbol                []Csymbol        `xml:"csymbol"`
+	Cn                     []Cn             `xml:"cn"`
+	Integers               []Integers       `xml:"integers"`
+	Reals                  []Reals          `xml:"reals"`
+	Rationals              []Rationals      `xml:"rationals"`
+	Naturalnumbers         []Naturalnumbers `xml:"naturalnumbers"`
+	Complexes              []Complexes      `xml:"complexes"`
+	Primes                 []Primes         `xml:"primes"`
+	Exponentiale           []Exponentiale   `xml:"exponentiale"`
+	Imaginaryi             []Imaginaryi     `xml:"imaginaryi"`
+	Notanumber             []Notanumber     `xml:"notanumber"`
+	True                   []True           `xml:"true"`
+	False                  []False          `xml:"false"`
+	Emptyset               []Emptyset       `xml:"emptyset"`
+	Pi                     []Pi             `xml:"pi"`
+	Eulergamma             []Eulergamma     `xml:"eulergamma"`
+	Infinity               []Infinity       `xml:"infinity"`
+	Apply                  []Apply          `xml:"apply"`
+	Fn                     []Fn             `xml:"fn"`
+	Lambda                 []Lambda         `xml:"lambda"`
+	Reln                   []Reln           `xml:"reln"`
+	Interval               []Interval       `xml:"interval"`
+	List                   []List           `xml:"list"`
+	Matrix                 []Matrix         `xml:"matrix"`
+	Matrixrow              []Matrixrow      `xml:"matrixrow"`
+	Set                    []Set            `xml:"set"`
+	Vector                 []Vector         `xml:"vector"`
+	Piecewise              []Piecewise      `xml:"piecewise"`
+	Semantics              []Semantics      `xml:"semantics"`
+	Declare                []Declare        `xml:"declare"`
+}
+
+// Merror is the element merror
+//
+//	(mi | mn | mo | mtext | ms | mspace | mprescripts | none | mrow | mfrac | msqrt | mroot | menclose |
+//	mstyle | merror | mpadded | mphantom | mfenced | msub | msup | msubsup | munder | mover |
+//	munderover | mmultiscripts | mtable | mtr | mlabeledtr | mtd | maligngroup | malignmark | maction |
+//	ci | csymbol | cn | integers | reals | rationals | naturalnumbers | complexes | primes |
+//	exponentiale | imaginaryi | notanumber | true | false | emptyset | pi | eulergamma | infinity |
+//	apply | fn | lambda | reln | interval | list | matrix | matrixrow | set | vector | piecewise |
+//	semantics | declare)*
+type Merror struct {
+	XlinkHref      string           `xml:"href,attr"`
+	XlinkType      string           `xml:"type,attr"`
+	Class          string           `xml:"class,attr"`
+	Style          string           `xml:"style,attr"`
+	ID             string           `xml:"id,attr"`
+	Xref           string           `xml:"xref,attr"`
+	Other          string           `xml:"other,attr"`
+	Mi             []Mi             `xml:"mi"`
+	Mn             []Mn             `xml:"mn"`
+	Mo             []Mo             `xml:"mo"`
+	Mtext          []Mtext          `xml:"mtext"`
+	Ms             []Ms             `xml:"ms"`
+	Mspace         []Mspace         `xml:"mspace"`
+	Mprescripts    []Mprescripts    `xml:"mprescripts"`
+	None           []None           `xml:"none"`
+	Mrow           []Mrow           `xml:"mrow"`
+	Mfrac          []Mfrac          `xml:"mfrac"`
+	Msqrt          []Msqrt          `xml:"msqrt"`
+	Mroot          []Mroot          `xml:"mroot"`
+	Menclose       []Menclose       `xml:"menclose"`
+	Mstyle         []Mstyle         `xml:"mstyle"`
+	Merror         []Merror         `xml:"merror"`
+	Mpadded        []Mpadded        `xml:"mpadded"`
+	Mphantom       []Mphantom       `xml:"mphantom"`
+	Mfenced        []Mfenced        `xml:"mfenced"`
+	Msub           []Msub           `xml:"msub"`
+	Msup           []Msup           `xml:"msup"`
+	Msubsup        []Msubsup        `xml:"msubsup"`
+	Munder         []Munder         `xml:"munder"`
+	Mover          []Mover          `xml:"mover"`
+	Munderover     []Munderover     `xml:"munderover"`
+	Mmultiscripts  []Mmultiscripts  `xml:"mmultiscripts"`
+	Mtable         []Mtable         `xml:"mtable"`
+	Mtr            []Mtr            `xml:"mtr"`
+	Mlabeledtr     []Mlabeledtr     `xml:"mlabeledtr"`
+	Mtd            []Mtd            `xml:"mtd"`
+	Maligngroup    []Maligngroup    `xml:"maligngroup"`
+	Malignmark     []Malignmark     `xml:"malignmark"`
+	Maction        []Maction        `xml:"maction"`
+	Ci             []Ci             `xml:"ci"`
+	Csymbol        []Csymbol        `xml:"csymbol"`
+	Cn             []Cn             `xml:"cn"`
+	Integers       []Integers       `xml:"integers"`
+	Reals          []Reals          `xml:"reals"`
+	Rationals      []Rationals      `xml:"rationals"`
+	Naturalnumbers []Naturalnumbers `xml:"naturalnumbers"`
+	Complexes      []Complexes      `xml:"complexes"`
+	Primes         []Primes         `xml:"primes"`
+	Exponentiale   []Exponentiale   `xml:"exponentiale"`
+	Imaginaryi     []Imaginaryi     `xml:"imaginaryi"`
+	Notanumber     []Notanumber     `xml:"notanumber"`
+	True           []True           `xml:"true"`
+	False          []False          `xml:"false"`
+	Emptyset       []Emptyset       `xml:"emptyset"`
+	Pi             []Pi             `xml:"pi"`
+	Eulergamma     []Eulergamma     `xml:"eulergamma"`
+	Infinity       []Infinity       `xml:"infinity"`
+	Apply          []Apply          `xml:"apply"`
+	Fn             []Fn             `xml:"fn"`
+	Lambda         []Lambda         `xml:"lambda"`
+	Reln           []Reln           `xml:"reln"`
+	Interval       []Interval       `xml:"interval"`
+	List           []List           `xml:"list"`
+	Matrix         []Matrix         `xml:"matrix"`
+	Matrixrow      []Matrixrow      `xml:"matrixrow"`
+	Set            []Set            `xml:"set"`
+	Vector         []Vector         `xml:"vector"`
+	Piecewise      []Piecewise      `xml:"piecewise"`
+	Semantics      []Semantics      `xml:"semantics"`
+	Declare        []Declare        `xml:"declare"`
+}
+
+// Mpadded is the element mpadded
+//
+//	(mi | mn | mo | mtext | ms | mspace | mprescripts | none | mrow | mfrac | msqrt | mroot | menclose |
+//	mstyle | merror | mpadded | mphantom | mfenced | msub | msup | msubsup | munder | mover |
+//	munderover | mmultiscripts | mtable | mtr | mlabeledtr | mtd | maligngroup | malignmark | maction |
+//	ci | csymbol | cn | integers | reals | rationals | naturalnumbers | complexes | primes |
+//	exponentiale | imaginaryi | notanumber | true | false | emptyset | pi | eulergamma | infinity |
+//	apply | fn | lambda | reln | interval | list | matrix | matrixrow | set | vector | piecewise |
+//	semantics | declare)*
+type Mpadded struct {
+	XlinkHref      string           `xml:"href,attr"`
+	XlinkType      string           `xml:"type,attr"`
+	Class          string           `xml:"class,attr"`
+	Style          string           `xml:"style,attr"`
+	ID             string           `xml:"id,attr"`
+	Xref           string           `xml:"xref,attr"`
+	Other          string           `xml:"other,attr"`
+	Width          string           `xml:"width,attr"`
+	Height         string           `xml:"height,attr"`
+	Depth          string           `xml:"depth,attr"`
+	Lspace         string           `xml:"lspace,attr"`
+	Mi             []Mi             `xml:"mi"`
+	Mn             []Mn             `xml:"mn"`
+	Mo             []Mo             `xml:"mo"`
+	Mtext          []Mtext          `xml:"mtext"`
+	Ms             []Ms             `xml:"ms"`
+	Mspace         []Mspace         `xml:"mspace"`
+	Mprescripts    []Mprescripts    `xml:"mprescripts"`
+	None           []None           `xml:"none"`
+	Mrow           []Mrow           `xml:"mrow"`
+	Mfrac          []Mfrac          `xml:"mfrac"`
+	Msqrt          []Msqrt          `xml:"msqrt"`
+	Mroot          []Mroot          `xml:"mroot"`
+	Menclose       []Menclose       `xml:"menclose"`
+	Mstyle         []Mstyle         `xml:"mstyle"`
+	Merror         []Merror         `xml:"merror"`
+	Mpadded        []Mpadded        `xml:"mpadded"`
+	Mphantom       []Mphantom       `xml:"mphantom"`
+	Mfenced        []Mfenced        `xml:"mfenced"`
+	Msub           []Msub           `xml:"msub"`
+	Msup           []Msup           `xml:"msup"`
+	Msubsup        []Msubsup        `xml:"msubsup"`
+	Munder         []Munder         `xml:"munder"`
+	Mover          []Mover          `xml:"mover"`
+	Munderover     []Munderover     `xml:"munderover"`
+	Mmultiscripts  []Mmultiscripts  `xml:"mmultiscripts"`
+	Mtable         []Mtable         `xml:"mtable"`
+	Mtr            []Mtr            `xml:"mtr"`
+	Mlabeledtr     []Mlabeledtr     `xml:"mlabeledtr"`
+	Mtd            []Mtd            `xml:"mtd"`
+	Maligngroup    []Maligngroup    `xml:"maligngroup"`
+	Malignmark     []Malignmark     `xml:"malignmark"`
+	Maction        []Maction        `xml:"maction"`
+	Ci             []Ci             `xml:"ci"`
+	Csymbol        []Csymbol        `xml:"csymbol"`
+	Cn             []Cn             `xml:"cn"`
+	Integers       []Integers       `xml:"integers"`
+	Reals          []Reals          `xml:"reals"`
+	Rationals      []Rationals      `xml:"rationals"`
+	Naturalnumbers []Naturalnumbers `xml:"naturalnumbers"`
+	Complexes      []Complexes      `xml:"complexes"`
+	Primes         []Primes         `xml:"primes"`
+	Exponentiale   []Exponentiale   `xml:"exponentiale"`
+	Imaginaryi     []Imaginaryi     `xml:"imaginaryi"`
+	Notanumber     []Notanumber     `xml:"notanumber"`
+	True           []True           `xml:"true"`
+	False          []False          `xml:"false"`
+	Emptyset       []Emptyset       `xml:"emptyset"`
+	Pi             []Pi             `xml:"pi"`
+	Eulergamma     []Eulergamma     `xml:"eulergamma"`
+	Infinity       []Infinity       `xml:"infinity"`
+	Apply          []Apply          `xml:"apply"`
+	Fn             []Fn             `xml:"fn"`
+	Lambda         []Lambda         `xml:"lambda"`
+	Reln           []Reln           `xml:"reln"`
+	Interval       []Interval       `xml:"interval"`
+	List           []List           `xml:"list"`
+	Matrix         []Matrix         `xml:"matrix"`
+	Matrixrow      []Matrixrow      `xml:"matrixrow"`
+	Set            []Set            `xml:"set"`
+	Vector         []Vector         `xml:"vector"`
+	Piecewise      []Piecewise      `xml:"piecewise"`
+	Semantics      []Semantics      `xml:"semantics"`
+	Declare        []Declare        `xml:"declare"`
+}
+
+// Mphantom is the element mphantom
+//
+//	(mi | mn | mo | mtext | ms | mspace | mprescripts | none | mrow | mfrac | msqrt | mroot | menclose |
+//	mstyle | merror | mpadded | mphantom | mfenced | msub | msup | msubsup | munder | mover |
+//	munderover | mmultiscripts | mtable | mtr | mlabeledtr | mtd | maligngroup | malignmark | maction |
+//	ci | csymbol | cn | integers | reals | rationals | naturalnumbers | complexes | primes |
+//	exponentiale | imaginaryi | notanumber | true | false | emptyset | pi | eulergamma | infinity |
+//	apply | fn | lambda | reln | interval | list | matrix | matrixrow | set | vector | piecewise |
+//	semantics | declare)*
+type Mphantom struct {
+	XlinkHref      string           `xml:"href,attr"`
+	XlinkType      string           `xml:"type,attr"`
+	Class          string           `xml:"class,attr"`
+	Style          string           `xml:"style,attr"`
+	ID             string           `xml:"id,attr"`
+	Xref           string           `xml:"xref,attr"`
+	Other          string           `xml:"other,attr"`
+	Mi             []Mi             `xml:"mi"`
+	Mn             []Mn             `xml:"mn"`
+	Mo             []Mo             `xml:"mo"`
+	Mtext          []Mtext          `xml:"mtext"`
+	Ms             []Ms             `xml:"ms"`
+	Mspace         []Mspace         `xml:"mspace"`
+	Mprescripts    []Mprescripts    `xml:"mprescripts"`
+	None           []None           `xml:"none"`
+	Mrow           []Mrow           `xml:"mrow"`
+	Mfrac          []Mfrac          `xml:"mfrac"`
+	Msqrt          []Msqrt          `xml:"msqrt"`
+	Mroot          []Mroot          `xml:"mroot"`
+	Menclose       []Menclose       `xml:"menclose"`
+	Mstyle         []Mstyle         `xml:"mstyle"`
+	Merror         []Merror         `xml:"merror"`
+	Mpadded        []Mpadded        `xml:"mpadded"`
+	Mphantom       []Mphantom       `xml:"mphantom"`
+	Mfenced        []Mfenced        `xml:"mfenced"`
+	Msub           []Msub           `xml:"msub"`
+	Msup           []Msup           `xml:"msup"`
+	Msubsup        []Msubsup        `xml:"msubsup"`
+	Munder         []Munder         `xml:"munder"`
+	Mover          []Mover          `xml:"mover"`
+	Munderover     []Munderover     `xml:"munderover"`
+	Mmultiscripts  []Mmultiscripts  `xml:"mmultiscripts"`
+	Mtable         []Mtable         `xml:"mtable"`
+	Mtr            []Mtr            `xml:"mtr"`
+	Mlabeledtr     []Mlabeledtr     `xml:"mlabeledtr"`
+	Mtd            []Mtd            `xml:"mtd"`
+	Maligngroup    []Maligngroup    `xml:"maligngroup"`
+	Malignmark     []Malignmark     `xml:"malignmark"`
+	Maction        []Maction        `xml:"maction"`
+	Ci             []Ci             `xml:"ci"`
+	Csymbol        []Csymbol        `xml:"csymbol"`
+	Cn             []Cn             `xml:"cn"`
+	Integers       []Integers       `xml:"integers"`
+	Reals          []Reals          `xml:"reals"`
+	Rationals      []Rationals      `xml:"rationals"`
+	Naturalnumbers []Naturalnumbers `xml:"naturalnumbers"`
+	Complexes      []Complexes      `xml:"complexes"`
+	Primes         []Primes         `xml:"primes"`
+	Exponentiale   []Exponentiale   `xml:"exponentiale"`
+	Imaginaryi     []Imaginaryi     `xml:"imaginaryi"`
+	Notanumber     []Notanumber     `xml:"notanumber"`
+	True           []True           `xml:"true"`
+	False          []False          `xml:"false"`
+	Emptyset       []Emptyset       `xml:"emptyset"`
+	Pi             []Pi             `xml:"pi"`
+	Eulergamma     []Eulergamma     `xml:"eulergamma"`
+	Infinity       []Infinity       `xml:"infinity"`
+	Apply          []Apply          `xml:"apply"`
+	Fn             []Fn             `xml:"fn"`
+	Lambda         []Lambda         `xml:"lambda"`
+	Reln           []Reln           `xml:"reln"`
+	Interval       []Interval       `xml:"interval"`
+	List           []List           `xml:"list"`
+	Matrix         []Matrix         `xml:"matrix"`
+	Matrixrow      []Matrixrow      `xml:"matrixrow"`
+	Set            []Set            `xml:"set"`
+	Vector         []Vector         `xml:"vector"`
+	Piecewise      []Piecewise      `xml:"piecewise"`
+	Semantics      []Semantics      `xml:"semantics"`
+	Declare        []Declare        `xml:"declare"`
+}
+
+// Mfenced is the element mfenced
+//
+//	(mi | mn | mo | mtext | ms | mspace | mprescripts | none | mrow | mfrac | msqrt | mroot | menclose |
+//	mstyle | merror | mpadded | mphantom | mfenced | msub | msup | msubsup | munder | mover |
+//	munderover | mmultiscripts | mtable | mtr | mlabeledtr | mtd | maligngroup | malignmark | maction |
+//	ci | csymbol | cn | integers | reals | rationals | naturalnumbers | complexes | primes |
+//	exponentiale | imaginaryi | notanumber | true | false | emptyset | pi | eulergamma | infinity |
+//	apply | fn | lambda | reln | interval | list | matrix | matrixrow | set | vector | piecewise |
+//	semantics | declare)*
+type Mfenced struct {
+	XlinkHref      string           `xml:"href,attr"`
+	XlinkType      string           `xml:"type,attr"`
+	Class          string           `xml:"class,attr"`
+	Style          string           `xml:"style,attr"`
+	ID             string           `xml:"id,attr"`
+	Xref           string           `xml:"xref,attr"`
+	Other          string           `xml:"other,attr"`
+	Open           string           `xml:"open,attr"`
+	Close          string           `xml:"close,attr"`
+	Separators     string           `xml:"separators,attr"`
+	Mi             []Mi             `xml:"mi"`
+	Mn             []Mn             `xml:"mn"`
+	Mo             []Mo             `xml:"mo"`
+	Mtext          []Mtext          `xml:"mtext"`
+	Ms             []Ms             `xml:"ms"`
+	Mspace         []Mspace         `xml:"mspace"`
+	Mprescripts    []Mprescripts    `xml:"mprescripts"`
+	None           []None           `xml:"none"`
+	Mrow           []Mrow           `xml:"mrow"`
+	Mfrac          []Mfrac          `xml:"mfrac"`
+	Msqrt          []Msqrt          `xml:"msqrt"`
+	Mroot          []Mroot          `xml:"mroot"`
+	Menclose       []Menclose       `xml:"menclose"`
+	Mstyle         []Mstyle         `xml:"mstyle"`
+	Merror         []Merror         `xml:"merror"`
+	Mpadded        []Mpadded        `xml:"mpadded"`
+	Mphantom       []Mphantom       `xml:"mphantom"`
+	Mfenced        []Mfenced        `xml:"mfenced"`
+	Msub           []Msub           `xml:"msub"`
+	Msup           []Msup           `xml:"msup"`
+	Msubsup        []Msubsup        `xml:"msubsup"`
+	Munder         []Munder         `xml:"munder"`
+	Mover          []Mover          `xml:"mover"`
+	Munderover     []Munderover     `xml:"munderover"`
+	Mmultiscripts  []Mmultiscripts  `xml:"mmultiscripts"`
+	Mtable         []Mtable         `xml:"mtable"`
+	Mtr            []Mtr            `xml:"mtr"`
+	Mlabeledtr     []Mlabeledtr     `xml:"mlabeledtr"`
+	Mtd            []Mtd            `xml:"mtd"`
+	Maligngroup    []Maligngroup    `xml:"maligngroup"`
+	Malignmark     []Malignmark     `xml:"malignmark"`
+	Maction        []Maction        `xml:"maction"`
+	Ci             []Ci             `xml:"ci"`
+	Csymbol        []Csymbol        `xml:"csymbol"`
+	Cn             []Cn             `xml:"cn"`
+	Integers       []Integers       `xml:"integers"`
+	Reals          []Reals          `xml:"reals"`
+	Rationals      []Rationals      `xml:"rationals"`
+	Naturalnumbers []Naturalnumbers `xml:"naturalnumbers"`
+	Complexes      []Complexes      `xml:"complexes"`
+	Primes         []Primes         `xml:"primes"`
+	Exponentiale   []Exponentiale   `xml:"exponentiale"`
+	Imaginaryi     []Imaginaryi     `xml:"imaginaryi"`
+	Notanumber     []Notanumber     `xml:"notanumber"`
+	True           []True           `xml:"true"`
+	False          []False          `xml:"false"`
+	Emptyset       []Emptyset       `xml:"emptyset"`
+	Pi             []Pi             `xml:"pi"`
+	Eulergamma     []Eulergamma     `xml:"eulergamma"`
+	Infinity       []Infinity       `xml:"infinity"`
+	Apply          []Apply          `xml:"apply"`
+	Fn             []Fn             `xml:"fn"`
+	Lambda         []Lambda         `xml:"lambda"`
+	Reln           []Reln           `xml:"reln"`
+	Interval       []Interval       `xml:"interval"`
+	List           []List           `xml:"list"`
+	Matrix         []Matrix         `xml:"matrix"`
+	Matrixrow      []Matrixrow      `xml:"matrixrow"`
+	Set            []Set            `xml:"set"`
+	Vector         []Vector         `xml:"vector"`
+	Piecewise      []Piecewise      `xml:"piecewise"`
+	Semantics      []Semantics      `xml:"semantics"`
+	Declare        []Declare        `xml:"declare"`
+}
+
+// Msub is the element msub
+//
+//	(mi | mn | mo | mtext | ms | mspace | mprescripts | none | mrow | mfrac | msqrt | mroot | menclose |
+//	mstyle | merror | mpadded | mphantom | mfenced | msub | msup | msubsup | munder | mover |
+//	munderover | mmultiscripts | mtable | mtr | mlabeledtr | mtd | maligngroup | malignmark | maction |
+//	ci | csymbol | cn | integers | reals | rationals | naturalnumbers | complexes | primes |
+//	exponentiale | imaginaryi | notanumber | true | false | emptyset | pi | eulergamma | infinity |
+//	apply | fn | lambda | reln | interval | list | matrix | matrixrow | set | vector | piecewise |
+//	semantics | declare)*
+type Msub struct {
+	XlinkHref      string           `xml:"href,attr"`
+	XlinkType      string           `xml:"type,attr"`
+	Class          string           `xml:"class,attr"`
+	Style          string           `xml:"style,attr"`
+	ID             string           `xml:"id,attr"`
+	Xref           string           `xml:"xref,attr"`
+	Other          string           `xml:"other,attr"`
+	Subscriptshift string           `xml:"subscriptshift,attr"`
+	Mi             []Mi             `xml:"mi"`
+	Mn             []Mn             `xml:"mn"`
+	Mo             []Mo             `xml:"mo"`
+	Mtext          []Mtext          `xml:"mtext"`
+	Ms             []Ms             `xml:"ms"`
+	Mspace         []Mspace         `xml:"mspace"`
+	Mprescripts    []Mprescripts    `xml:"mprescripts"`
+	None           []None           `xml:"none"`
+	Mrow           []Mrow           `xml:"mrow"`
+	Mfrac          []Mfrac          `xml:"mfrac"`
+	Msqrt          []Msqrt          `xml:"msqrt"`
+	Mroot          []Mroot          `xml:"mroot"`
+	Menclose       []Menclose       `xml:"menclose"`
+	Mstyle         []Mstyle         `xml:"mstyle"`
+	Merror         []Merror         `xml:"merror"`
+	Mpadded        []Mpadded        `xml:"mpadded"`
+	Mphantom       []Mphantom       `xml:"mphantom"`
+	Mfenced        []Mfenced        `xml:"mfenced"`
+	Msub           []Msub           `xml:"msub"`
+	Msup           []Msup           `xml:"msup"`
+	Msubsup        []Msubsup        `xml:"msubsup"`
+	Munder         []Munder         `xml:"munder"`
+	Mover          []Mover          `xml:"mover"`
+	Munderover     []Munderover     `xml:"munderover"`
+	Mmultiscripts  []Mmultiscripts  `xml:"mmultiscripts"`
+	Mtable         []Mtable         `xml:"mtable"`
+	Mtr            []Mtr            `xml:"mtr"`
+	Mlabeledtr     []Mlabeledtr     `xml:"mlabeledtr"`
+	Mtd            []Mtd            `xml:"mtd"`
+	Maligngroup    []Maligngroup    `xml:"maligngroup"`
+	Malignmark     []Malignmark     `xml:"malignmark"`
+	Maction        []Maction        `xml:"maction"`
+	Ci             []Ci             `xml:"ci"`
+	Csymbol        []Csymbol        `xml:"csymbol"`
+	Cn             []Cn             `xml:"cn"`
+	Integers       []Integers       `xml:"integers"`
+	Reals          []Reals          `xml:"reals"`
+	Rationals      []Rationals      `xml:"rationals"`
+	Naturalnumbers []Naturalnumbers `xml:"naturalnumbers"`
+	Complexes      []Complexes      `xml:"complexes"`
+	Primes         []Primes         `xml:"primes"`
+	Exponentiale   []Exponentiale   `xml:"exponentiale"`
+	Imaginaryi     []Imaginaryi     `xml:"imaginaryi"`
+	Notanumber     []Notanumber     `xml:"notanumber"`
+	True           []True           `xml:"true"`
+	False          []False          `xml:"false"`
+	Emptyset       []Emptyset       `xml:"emptyset"`
+	Pi             []Pi             `xml:"pi"`
+	Eulergamma     []Eulergamma     `xml:"eulergamma"`
+	Infinity       []Infinity       `xml:"infinity"`
+	Apply          []Apply          `xml:"apply"`
+	Fn             []Fn             `xml:"fn"`
+	Lambda         []Lambda         `xml:"lambda"`
+	Reln           []Reln           `xml:"reln"`
+	Interval       []Interval       `xml:"interval"`
+	List           []List           `xml:"list"`
+	Matrix         []Matrix         `xml:"matrix"`
+	Matrixrow      []Matrixrow      `xml:"matrixrow"`
+	Set            []Set            `xml:"set"`
+	Vector         []Vector         `xml:"vector"`
+	Piecewise      []Piecewise      `xml:"piecewise"`
+	Semantics      []Semantics      `xml:"semantics"`
+	Declare        []Declare        `xml:"declare"`
+}
+
+// Msup is the element msup
+//
+//	(mi | mn | mo | mtext | ms | mspace | mprescripts | none | mrow | mfrac | msqrt | mroot | menclose |
+//	mstyle | merror | mpadded | mphantom | mfenced | msub | msup | msubsup | munder | mover |
+//	munderover | mmultiscripts | mtable | mtr | mlabeledtr | mtd | maligngroup | malignmark | maction |
+//	ci | csymbol | cn | integers | reals | rationals | naturalnumbers | complexes | primes |
+//	exponentiale | imaginaryi | notanumber | true | false | emptyset | pi | eulergamma | infinity |
+//	apply | fn | lambda | reln | interval | list | matrix | matrixrow | set | vector | piecewise |
+//	semantics | declare)*
+type Msup struct {
+	XlinkHref        string           `xml:"href,attr"`
+	XlinkType        string           `xml:"type,attr"`
+	Class            string           `xml:"class,attr"`
+	Style            string           `xml:"style,attr"`
+	ID               string           `xml:"id,attr"`
+	Xref             string           `xml:"xref,attr"`
+	Other            string           `xml:"other,attr"`
+	Superscriptshift string           `xml:"superscriptshift,attr"`
+	Mi               []Mi             `xml:"mi"`
+	Mn               []Mn             `xml:"mn"`
+	Mo               []Mo             `xml:"mo"`
+	Mtext            []Mtext          `xml:"mtext"`
+	Ms               []Ms             `xml:"ms"`
+	Mspace           []Mspace         `xml:"mspace"`
+	Mprescripts      []Mprescripts    `xml:"mprescripts"`
+	None             []None           `xml:"none"`
+	Mrow             []Mrow           `xml:"mrow"`
+	Mfrac            []Mfrac          `xml:"mfrac"`
+	Msqrt            []Msqrt          `xml:"msqrt"`
+	Mroot            []Mroot          `xml:"mroot"`
+	Menclose         []Menclose       `xml:"menclose"`
+	Mstyle           []Mstyle         `xml:"mstyle"`
+	Merror           []Merror         `xml:"merror"`
+	Mpadded          []Mpadded        `xml:"mpadded"`
+	Mphantom         []Mphantom       `xml:"mphantom"`
+	Mfenced          []Mfenced        `xml:"mfenced"`
+	Msub             []Msub           `xml:"msub"`
+	Msup             []Msup           `xml:"msup"`
+	Msubsup          []Msubsup        `xml:"msubsup"`
+	Munder           []Munder         `xml:"munder"`
+	Mover            []Mover          `xml:"mover"`
+	Munderover       []Munderover     `xml:"munderover"`
+	Mmultiscripts    []Mmultiscripts  `xml:"mmultiscripts"`
+	Mtable           []Mtable         `xml:"mtable"`
+	Mtr              []Mtr            `xml:"mtr"`
+	Mlabeledtr       []Mlabeledtr     `xml:"mlabeledtr"`
+	Mtd              []Mtd            `xml:"mtd"`
+	Maligngroup      []Maligngroup    `xml:"maligngroup"`
+	Malignmark       []Malignmark     `xml:"malignmark"`
+	Maction          []Maction        `xml:"maction"`
+	Ci               []Ci             `xml:"ci"`
+	Csymbol          []Csymbol        `xml:"csymbol"`
+	Cn               []Cn             `xml:"cn"`
+	Integers         []Integers       `xml:"integers"`
+	Reals            []Reals          `xml:"reals"`
+	Rationals        []Rationals      `xml:"rationals"`
+	Naturalnumbers   []Naturalnumbers `xml:"naturalnumbers"`
+	Complexes        []Complexes      `xml:"complexes"`
+	Primes           []Primes         `xml:"primes"`
+	Exponentiale     []Exponentiale   `xml:"exponentiale"`
+	Imaginaryi       []Imaginaryi     `xml:"imaginaryi"`
+	Notanumber       []Notanumber     `xml:"notanumber"`
+	True             []True           `xml:"true"`
+	False            []False          `xml:"false"`
+	Emptyset         []Emptyset       `xml:"emptyset"`
+	Pi               []Pi             `xml:"pi"`
+	Eulergamma       []Eulergamma     `xml:"eulergamma"`
+	Infinity         []Infinity       `xml:"infinity"`
+	Apply            []Apply          `xml:"apply"`
+	Fn               []Fn             `xml:"fn"`
+	Lambda           []Lambda         `xml:"lambda"`
+	Reln             []Reln           `xml:"reln"`
+	Interval         []Interval       `xml:"interval"`
+	List             []List           `xml:"list"`
+	Matrix           []Matrix         `xml:"matrix"`
+	Matrixrow        []Matrixrow      `xml:"matrixrow"`
+	Set              []Set            `xml:"set"`
+	Vector           []Vector         `xml:"vector"`
+	Piecewise        []Piecewise      `xml:"piecewise"`
+	Semantics        []Semantics      `xml:"semantics"`
+	Declare          []Declare        `xml:"declare"`
+}
+
+// Msubsup is the element msubsup
+//
+//	(mi | mn | mo | mtext | ms | mspace | mprescripts | none | mrow | mfrac | msqrt | mroot | menclose |
+//	mstyle | merror | mpadded | mphantom | mfenced | msub | msup | msubsup | munder | mover |
+//	munderover | mmultiscripts | mtable | mtr | mlabeledtr | mtd | maligngroup | malignmark | maction |
+//	ci | csymbol | cn | integers | reals | rationals | naturalnumbers | complexes | primes |
+//	exponentiale | imaginaryi | notanumber | true | false | emptyset | pi | eulergamma | infinity |
+//	apply | fn | lambda | reln | interval | list | matrix | matrixrow | set | vector | piecewise |
+//	semantics | declare)*
+type Msubsup struct {
+	XlinkHref        string           `xml:"href,attr"`
+	XlinkType        string           `xml:"type,attr"`
+	Class            string           `xml:"class,attr"`
+	Style            string           `xml:"style,attr"`
+	ID               string           `xml:"id,attr"`
+	Xref             string           `xml:"xref,attr"`
+	Other            string           `xml:"other,attr"`
+	Subscriptshift   string           `xml:"subscriptshift,attr"`
+	Superscriptshift string           `xml:"superscriptshift,attr"`
+	Mi               []Mi             `xml:"mi"`
+	Mn               []Mn             `xml:"mn"`
+	Mo               []Mo             `xml:"mo"`
+	Mtext            []Mtext          `xml:"mtext"`
+	Ms               []Ms             `xml:"ms"`
+	Mspace           []Mspace         `xml:"mspace"`
+	Mprescripts      []Mprescripts    `xml:"mprescripts"`
+	None             []None           `xml:"none"`
+	Mrow             []Mrow           `xml:"mrow"`
+	Mfrac            []Mfrac          `xml:"mfrac"`
+	Msqrt            []Msqrt          `xml:"msqrt"`
+	Mroot            []Mroot          `xml:"mroot"`
+	Menclose         []Menclose       `xml:"menclose"`
+	Mstyle           []Mstyle         `xml:"mstyle"`
+	Merror           []Merror         `xml:"merror"`
+	Mpadded          []Mpadded        `xml:"mpadded"`
+	Mphantom         []Mphantom       `xml:"mphantom"`
+	Mfenced          []Mfenced        `xml:"mfenced"`
+	Msub             []Msub           `xml:"msub"`
+	Msup             []Msup           `xml:"msup"`
+	Msubsup          []Msubsup        `xml:"msubsup"`
+	Munder           []Munder         `xml:"munder"`
+	Mover            []Mover          `xml:"mover"`
+	Munderover       []Munderover     `xml:"munderover"`
+	Mmultiscripts    []Mmultiscripts  `xml:"mmultiscripts"`
+	Mtable           []Mtable         `xml:"mtable"`
+	Mtr              []Mtr            `xml:"mtr"`
+	Mlabeledtr       []Mlabeledtr     `xml:"mlabeledtr"`
+	Mtd              []Mtd            `xml:"mtd"`
+	Maligngroup      []Maligngroup    `xml:"maligngroup"`
+	Malignmark       []Malignmark     `xml:"malignmark"`
+	Maction          []Maction        `xml:"maction"`
+	Ci               []Ci             `xml:"ci"`
+	Csymbol          []Csymbol        `xml:"csymbol"`
+	Cn               []Cn             `xml:"cn"`
+	Integers         []Integers       `xml:"integers"`
+	Reals            []Reals          `xml:"reals"`
+	Rationals        []Rationals      `xml:"rationals"`
+	Naturalnumbers   []Naturalnumbers `xml:"naturalnumbers"`
+	Complexes        []Complexes      `xml:"complexes"`
+	Primes           []Primes         `xml:"primes"`
+	Exponentiale     []Exponentiale   `xml:"exponentiale"`
+	Imaginaryi       []Imaginaryi     `xml:"imaginaryi"`
+	Notanumber       []Notanumber     `xml:"notanumber"`
+	True             []True           `xml:"true"`
+	False            []False          `xml:"false"`
+	Emptyset         []Emptyset       `xml:"emptyset"`
+	Pi               []Pi             `xml:"pi"`
+	Eulergamma       []Eulergamma     `xml:"eulergamma"`
+	Infinity         []Infinity       `xml:"infinity"`
+	Apply            []Apply          `xml:"apply"`
+	Fn               []Fn             `xml:"fn"`
+	Lambda           []Lambda         `xml:"lambda"`
+	Reln             []Reln           `xml:"reln"`
+	Interval         []Interval       `xml:"interval"`
+	List             []List           `xml:"list"`
+	Matrix           []Matrix         `xml:"matrix"`
+	Matrixrow        []Matrixrow      `xml:"matrixrow"`
+	Set              []Set            `xml:"set"`
+	Vector           []Vector         `xml:"vector"`
+	Piecewise        []Piecewise      `xml:"piecewise"`
+	Semantics        []Semantics      `xml:"semantics"`
+	Declare          []Declare        `xml:"declare"`
+}
+
+// Munder is the element munder
+//
+//	(mi | mn | mo | mtext | ms | mspace | mprescripts | none | mrow | mfrac | msqrt | mroot | menclose |
+//	mstyle | merror | mpadded | mphantom | mfenced | msub | msup | msubsup | munder | mover |
+//	munderover | mmultiscripts | mtable | mtr | mlabeledtr | mtd | maligngroup | malignmark | maction |
+//	ci | csymbol | cn | integers | reals | rationals | naturalnumbers | complexes | primes |
+//	exponentiale | imaginaryi | notanumber | true | false | emptyset | pi | eulergamma | infinity |
+//	apply | fn | lambda | reln | interval | list | matrix | matrixrow | set | vector | piecewise |
+//	semantics | declare)*
+type Munder struct {
+	XlinkHref      string           `xml:"href,attr"`
+	XlinkType      string           `xml:"type,attr"`
+	Class          string           `xml:"class,attr"`
+	Style          string           `xml:"style,attr"`
+	ID             string           `xml:"id,attr"`
+	Xref           string           `xml:"xref,attr"`
+	Other          string           `xml:"other,attr"`
+	Accentunder    string           `xml:"accentunder,attr"`
+	Mi             []Mi             `xml:"mi"`
+	Mn             []Mn             `xml:"mn"`
+	Mo             []Mo             `xml:"mo"`
+	Mtext          []Mtext          `xml:"mtext"`
+	Ms             []Ms             `xml:"ms"`
+	Mspace         []Mspace         `xml:"mspace"`
+	Mprescripts    []Mprescripts    `xml:"mprescripts"`
+	None           []None           `xml:"none"`
+	Mrow           []Mrow           `xml:"mrow"`
+	Mfrac          []Mfrac          `xml:"mfrac"`
+	Msqrt          []Msqrt          `xml:"msqrt"`
+	Mroot          []Mroot          `xml:"mroot"`
+	Menclose       []Menclose       `xml:"menclose"`
+	Mstyle         []Mstyle         `xml:"mstyle"`
+	Merror         []Merror         `xml:"merror"`
+	Mpadded        []Mpadded        `xml:"mpadded"`
+	Mphantom       []Mphantom       `xml:"mphantom"`
+	Mfenced        []Mfenced        `xml:"mfenced"`
+	Msub           []Msub           `xml:"msub"`
+	Msup           []Msup           `xml:"msup"`
+	Msubsup        []Msubsup        `xml:"msubsup"`
+	Munder         []Munder         `xml:"munder"`
+	Mover          []Mover          `xml:"mover"`
+	Munderover     []Munderover     `xml:"munderover"`
+	Mmultiscripts  []Mmultiscripts  `xml:"mmultiscripts"`
+	Mtable         []Mtable         `xml:"mtable"`
+	Mtr            []Mtr            `xml:"mtr"`
+	Mlabeledtr     []Mlabeledtr     `xml:"mlabeledtr"`
+	Mtd            []Mtd            `xml:"mtd"`
+	Maligngroup    []Maligngroup    `xml:"maligngroup"`
+	Malignmark     []Malignmark     `xml:"malignmark"`
+	Maction        []Maction        `xml:"maction"`
+	Ci             []Ci             `xml:"ci"`
+	Csymbol        []Csymbol        `xml:"csymbol"`
+	Cn             []Cn             `xml:"cn"`
+	Integers       []Integers       `xml:"integers"`
+	Reals          []Reals          `xml:"reals"`
+	Rationals      []Rationals      `xml:"rationals"`
+	Naturalnumbers []Naturalnumbers `xml:"naturalnumbers"`
+	Complexes      []Complexes      `xml:"complexes"`
+	Primes         []Primes         `xml:"primes"`
+	Exponentiale   []Exponentiale   `xml:"exponentiale"`
+	Imaginaryi     []Imaginaryi     `xml:"imaginaryi"`
+	Notanumber     []Notanumber     `xml:"notanumber"`
+	True           []True           `xml:"true"`
+	False          []False          `xml:"false"`
+	Emptyset       []Emptyset       `xml:"emptyset"`
+	Pi             []Pi             `xml:"pi"`
+	Eulergamma     []Eulergamma     `xml:"eulergamma"`
+	Infinity       []Infinity       `xml:"infinity"`
+	Apply          []Apply          `xml:"apply"`
+	Fn             []Fn             `xml:"fn"`
+	Lambda         []Lambda         `xml:"lambda"`
+	Reln           []Reln           `xml:"reln"`
+	Interval       []Interval       `xml:"interval"`
+	List           []List           `xml:"list"`
+	Matrix         []Matrix         `xml:"matrix"`
+	Matrixrow      []Matrixrow      `xml:"matrixrow"`
+	Set            []Set            `xml:"set"`
+	Vector         []Vector         `xml:"vector"`
+	Piecewise      []Piecewise      `xml:"piecewise"`
+	Semantics      []Semantics      `xml:"semantics"`
+	Declare        []Declare        `xml:"declare"`
+}
+
+// Mover is the element mover
+//
+//	(mi | mn | mo | mtext | ms | mspace | mprescripts | none | mrow | mfrac | msqrt | mroot | menclose |
+//	mstyle | merror | mpadded | mphantom | mfenced | msub | msup | msubsup | munder | mover |
+//	munderover | mmultiscripts | mtable | mtr | mlabeledtr | mtd | maligngroup | malignmark | maction |
+//	ci | csymbol | cn | integers | reals | rationals | naturalnumbers | complexes | primes |
+//	exponentiale | imaginaryi | notanumber | true | false | emptyset | pi | eulergamma | infinity |
+//	apply | fn | lambda | reln | interval | list | matrix | matrixrow | set | vector | piecewise |
+//	semantics | declare)*
+type Mover struct {
+	XlinkHref      string           `xml:"href,attr"`
+	XlinkType      string           `xml:"type,attr"`
+	Class          string           `xml:"class,attr"`
+	Style          string           `xml:"style,attr"`
+	ID             string           `xml:"id,attr"`
+	Xref           string           `xml:"xref,attr"`
+	Other          string           `xml:"other,attr"`
+	Accent         string           `xml:"accent,attr"`
+	Mi             []Mi             `xml:"mi"`
+	Mn             []Mn             `xml:"mn"`
+	Mo             []Mo             `xml:"mo"`
+	Mtext          []Mtext          `xml:"mtext"`
+	Ms             []Ms             `xml:"ms"`
+	Mspace         []Mspace         `xml:"mspace"`
+	Mprescripts    []Mprescripts    `xml:"mprescripts"`
+	None           []None           `xml:"none"`
+	Mrow           []Mrow           `xml:"mrow"`
+	Mfrac          []Mfrac          `xml:"mfrac"`
+	Msqrt          []Msqrt          `xml:"msqrt"`
+	Mroot          []Mroot          `xml:"mroot"`
+	Menclose       []Menclose       `xml:"menclose"`
+	Mstyle         []Mstyle         `xml:"mstyle"`
+	Merror         []Merror         `xml:"merror"`
+	Mpadded        []Mpadded        `xml:"mpadded"`
+	Mphantom       []Mphantom       `xml:"mphantom"`
+	Mfenced        []Mfenced        `xml:"mfenced"`
+	Msub           []Msub           `xml:"msub"`
+	Msup           []Msup           `xml:"msup"`
+	Msubsup        []Msubsup        `xml:"msubsup"`
+	Munder         []Munder         `xml:"munder"`
+	Mover          []Mover          `xml:"mover"`
+	Munderover     []Munderover     `xml:"munderover"`
+	Mmultiscripts  []Mmultiscripts  `xml:"mmultiscripts"`
+	Mtable         []Mtable         `xml:"mtable"`
+	Mtr            []Mtr            `xml:"mtr"`
+	Mlabeledtr     []Mlabeledtr     `xml:"mlabeledtr"`
+	Mtd            []Mtd            `xml:"mtd"`
+	Maligngroup    []Maligngroup    `xml:"maligngroup"`
+	Malignmark     []Malignmark     `xml:"malignmark"`
+	Maction        []Maction        `xml:"maction"`
+	Ci             []Ci             `xml:"ci"`
+	Csymbol        []Csymbol        `xml:"csymbol"`
+	Cn             []Cn             `xml:"cn"`
+	Integers       []Integers       `xml:"integers"`
+	Reals          []Reals          `xml:"reals"`
+	Rationals      []Rationals      `xml:"rationals"`
+	Naturalnumbers []Naturalnumbers `xml:"naturalnumbers"`
+	Complexes      []Complexes      `xml:"complexes"`
+	Primes         []Primes         `xml:"primes"`
+	Exponentiale   []Exponentiale   `xml:"exponentiale"`
+	Imaginaryi     []Imaginaryi     `xml:"imaginaryi"`
+	Notanumber     []Notanumber     `xml:"notanumber"`
+	True           []True           `xml:"true"`
+	False          []False          `xml:"false"`
+	Emptyset       []Emptyset       `xml:"emptyset"`
+	Pi             []Pi             `xml:"pi"`
+	Eulergamma     []Eulergamma     `xml:"eulergamma"`
+	Infinity       []Infinity       `xml:"infinity"`
+	Apply          []Apply          `xml:"apply"`
+	Fn             []Fn             `xml:"fn"`
+	Lambda         []Lambda         `xml:"lambda"`
+	Reln           []Reln           `xml:"reln"`
+	Interval       []Interval       `xml:"interval"`
+	List           []List           `xml:"list"`
+	Matrix         []Matrix         `xml:"matrix"`
+	Matrixrow      []Matrixrow      `xml:"matrixrow"`
+	Set            []Set            `xml:"set"`
+	Vector         []Vector         `xml:"vector"`
+	Piecewise      []Piecewise      `xml:"piecewise"`
+	Semantics      []Semantics      `xml:"semantics"`
+	Declare        []Declare        `xml:"declare"`
+}
+
+// Munderover is the element munderover
+//
+//	(mi | mn | mo | mtext | ms | mspace | mprescripts | none | mrow | mfrac | msqrt | mroot | menclose |
+//	mstyle | merror | mpadded | mphantom | mfenced | msub | msup | msubsup | munder | mover |
+//	munderover | mmultiscripts | mtable | mtr | mlabeledtr | mtd | maligngroup | malignmark | maction |
+//	ci | csymbol | cn | integers | reals | rationals | naturalnumbers | complexes | primes |
+//	exponentiale | imaginaryi | notanumber | true | false | emptyset | pi | eulergamma | infinity |
+//	apply | fn | lambda | reln | interval | list | matrix | matrixrow | set | vector | piecewise |
+//	semantics | declare)*
+type Munderover struct {
+	XlinkHref      string           `xml:"href,attr"`
+	XlinkType      string           `xml:"type,attr"`
+	Class          string           `xml:"class,attr"`
+	Style          string           `xml:"style,attr"`
+	ID             string           `xml:"id,attr"`
+	Xref           string           `xml:"xref,attr"`
+	Other          string           `xml:"other,attr"`
+	Accent         string           `xml:"accent,attr"`
+	Accentunder    string           `xml:"accentunder,attr"`
+	Mi             []Mi             `xml:"mi"`
+	Mn             []Mn             `xml:"mn"`
+	Mo             []Mo             `xml:"mo"`
+	Mtext          []Mtext          `xml:"mtext"`
+	Ms             []Ms             `xml:"ms"`
+	Mspace         []Mspace         `xml:"mspace"`
+	Mprescripts    []Mprescripts    `xml:"mprescripts"`
+	None           []None           `xml:"none"`
+	Mrow           []Mrow           `xml:"mrow"`
+	Mfrac          []Mfrac          `xml:"mfrac"`
+	Msqrt          []Msqrt          `xml:"msqrt"`
+	Mroot          []Mroot          `xml:"mroot"`
+	Menclose       []Menclose       `xml:"menclose"`
+	Mstyle         []Mstyle         `xml:"mstyle"`
+	Merror         []Merror         `xml:"merror"`
+	Mpadded        []Mpadded        `xml:"mpadded"`
+	Mphantom       []Mphantom       `xml:"mphantom"`
+	Mfenced        []Mfenced        `xml:"mfenced"`
+	Msub           []Msub           `xml:"msub"`
+	Msup           []Msup           `xml:"msup"`
+	Msubsup        []Msubsup        `xml:"msubsup"`
+	Munder         []Munder         `xml:"munder"`
+	Mover          []Mover          `xml:"mover"`
+	Munderover     []Munderover     `xml:"munderover"`
+	Mmultiscripts  []Mmultiscripts  `xml:"mmultiscripts"`
+	Mtable         []Mtable         `xml:"mtable"`
+	Mtr            []Mtr            `xml:"mtr"`
+	Mlabeledtr     []Mlabeledtr     `xml:"mlabeledtr"`
+	Mtd            []Mtd            `xml:"mtd"`
+	Maligngroup    []Maligngroup    `xml:"maligngroup"`
+	Malignmark     []Malignmark     `xml:"malignmark"`
+	Maction        []Maction        `xml:"maction"`
+	Ci             []Ci             `xml:"ci"`
+	Csymbol        []Csymbol        `xml:"csymbol"`
+	Cn             []Cn             `xml:"cn"`
+	Integers       []Integers       `xml:"integers"`
+	Reals          []Reals          `xml:"reals"`
+	Rationals      []Rationals      `xml:"rationals"`
+	Naturalnumbers []Naturalnumbers `xml:"naturalnumbers"`
+	Complexes      []Complexes      `xml:"complexes"`
+	Primes         []Primes         `xml:"primes"`
+	Exponentiale   []Exponentiale   `xml:"exponentiale"`
+	Imaginaryi     []Imaginaryi     `xml:"imaginaryi"`
+	Notanumber     []Notanumber     `xml:"notanumber"`
+	True           []True           `xml:"true"`
+	False          []False          `xml:"false"`
+	Emptyset       []Emptyset       `xml:"emptyset"`
+	Pi             []Pi             `xml:"pi"`
+	Eulergamma     []Eulergamma     `xml:"eulergamma"`
+	Infinity       []Infinity       `xml:"infinity"`
+	Apply          []Apply          `xml:"apply"`
+	Fn             []Fn             `xml:"fn"`
+	Lambda         []Lambda         `xml:"lambda"`
+	Reln           []Reln           `xml:"reln"`
+	Interval       []Interval       `xml:"interval"`
+	List           []List           `xml:"list"`
+	Matrix         []Matrix         `xml:"matrix"`
+	Matrixrow      []Matrixrow      `xml:"matrixrow"`
+	Set            []Set            `xml:"set"`
+	Vector         []Vector         `xml:"vector"`
+	Piecewise      []Piecewise      `xml:"piecewise"`
+	Semantics      []Semantics      `xml:"semantics"`
+	Declare        []Declare        `xml:"declare"`
+}
+
+// Mmultiscripts is the element mmultiscripts
+//
+//	(mi | mn | mo | mtext | ms | mspace | mprescripts | none | mrow | mfrac | msqrt | mroot | menclose |
+//	mstyle | merror | mpadded | mphantom | mfenced | msub | msup | msubsup | munder | mover |
+//	munderover | mmultiscripts | mtable | mtr | mlabeledtr | mtd | maligngroup | malignmark | maction |
+//	ci | csymbol | cn | integers | reals | rationals | naturalnumbers | complexes | primes |
+//	exponentiale | imaginaryi | notanumber | true | false | emptyset | pi | eulergamma | infinity |
+//	apply | fn | lambda | reln | interval | list | matrix | matrixrow | set | vector | piecewise |
+//	semantics | declare)*
+type Mmultiscripts struct {
+	XlinkHref        string           `xml:"href,attr"`
+	XlinkType        string           `xml:"type,attr"`
+	Class            string           `xml:"class,attr"`
+	Style            string           `xml:"style,attr"`
+	ID               string           `xml:"id,attr"`
+	Xref             string           `xml:"xref,attr"`
+	Other            string           `xml:"other,attr"`
+	Subscriptshift   string           `xml:"subscriptshift,attr"`
+	Superscriptshift string           `xml:"superscriptshift,attr"`
+	Mi               []Mi             `xml:"mi"`
+	Mn               []Mn             `xml:"mn"`
+	Mo               []Mo             `xml:"mo"`
+	Mtext            []Mtext          `xml:"mtext"`
+	Ms               []Ms             `xml:"ms"`
+	Mspace           []Mspace         `xml:"mspace"`
+	Mprescripts      []Mprescripts    `xml:"mprescripts"`
+	None             []None           `xml:"none"`
+	Mrow             []Mrow           `xml:"mrow"`
+	Mfrac            []Mfrac          `xml:"mfrac"`
+	Msqrt            []Msqrt          `xml:"msqrt"`
+	Mroot            []Mroot          `xml:"mroot"`
+	Menclose         []Menclose       `xml:"menclose"`
+	Mstyle           []Mstyle         `xml:"mstyle"`
+	Merror           []Merror         `xml:"merror"`
+	Mpadded          []Mpadded        `xml:"mpadded"`
+	Mphantom         []Mphantom       `xml:"mphantom"`
+	Mfenced          []Mfenced        `xml:"mfenced"`
+	Msub             []Msub           `xml:"msub"`
+	Msup             []Msup           `xml:"msup"`
+	Msubsup          []Msubsup        `xml:"msubsup"`
+	Munder           []Munder         `xml:"munder"`
+	Mover            []Mover          `xml:"mover"`
+	Munderover       []Munderover     `xml:"munderover"`
+	Mmultiscripts    []Mmultiscripts  `xml:"mmultiscripts"`
+	Mtable           []Mtable         `xml:"mtable"`
+	Mtr              []Mtr            `xml:"mtr"`
+	Mlabeledtr       []Mlabeledtr     `xml:"mlabeledtr"`
+	Mtd              []Mtd            `xml:"mtd"`
+	Maligngroup      []Maligngroup    `xml:"maligngroup"`
+	Malignmark       []Malignmark     `xml:"malignmark"`
+	Maction          []Maction        `xml:"maction"`
+	Ci               []Ci             `xml:"ci"`
+	Csymbol          []Csymbol        `xml:"csymbol"`
+	Cn               []Cn             `xml:"cn"`
+	Integers         []Integers       `xml:"integers"`
+	Reals            []Reals          `xml:"reals"`
+	Rationals        []Rationals      `xml:"rationals"`
+	Naturalnumbers   []Naturalnumbers `xml:"naturalnumbers"`
+	Complexes        []Complexes      `xml:"complexes"`
+	Primes           []Primes         `xml:"primes"`
+	Exponentiale     []Exponentiale   `xml:"exponentiale"`
+	Imaginaryi       []Imaginaryi     `xml:"imaginaryi"`
+	Notanumber       []Notanumber     `xml:"notanumber"`
+	True             []True           `xml:"true"`
+	False            []False          `xml:"false"`
+	Emptyset         []Emptyset       `xml:"emptyset"`
+	Pi               []Pi             `xml:"pi"`
+	Eulergamma       []Eulergamma     `xml:"eulergamma"`
+	Infinity         []Infinity       `xml:"infinity"`
+	Apply            []Apply          `xml:"apply"`
+	Fn               []Fn             `xml:"fn"`
+	Lambda           []Lambda         `xml:"lambda"`
+	Reln             []Reln           `xml:"reln"`
+	Interval         []Interval       `xml:"interval"`
+	List             []List           `xml:"list"`
+	Matrix           []Matrix         `xml:"matrix"`
+	Matrixrow        []Matrixrow      `xml:"matrixrow"`
+	Set              []Set            `xml:"set"`
+	Vector           []Vector         `xml:"vector"`
+	Piecewise        []Piecewise      `xml:"piecewise"`
+	Semantics        []Semantics      `xml:"semantics"`
+	Declare          []Declare        `xml:"declare"`
+}
+
+// Mtable is the element mtable
+//
+//	(mi | mn | mo | mtext | ms | mspace | mprescripts | none | mrow | mfrac | msqrt | mroot | menclose |
+//	mstyle | merror | mpadded | mphantom | mfenced | msub | msup | msubsup | munder | mover |
+//	munderover | mmultiscripts | mtable | mtr | mlabeledtr | mtd | maligngroup | malignmark | maction |
+//	ci | csymbol | cn | integers | reals | rationals | naturalnumbers | complexes | primes |
+//	exponentiale | imaginaryi | notanumber | true | false | emptyset | pi | eulergamma | infinity |
+//	apply | fn | lambda | reln | interval | list | matrix | matrixrow | set | vector | piecewise |
+//	semantics | declare)*
+type Mtable struct {
+	XlinkHref       string           `xml:"href,attr"`
+	XlinkType       string           `xml:"type,attr"`
+	Class           string           `xml:"class,attr"`
+	Style           string           `xml:"style,attr"`
+	ID              string           `xml:"id,attr"`
+	Xref            string           `xml:"xref,attr"`
+	Other           string           `xml:"other,attr"`
+	Align           string           `xml:"align,attr"`
+	Rowalign        string           `xml:"rowalign,attr"`
+	Columnalign     string           `xml:"columnalign,attr"`
+	Columnwidth     string           `xml:"columnwidth,attr"`
+	Groupalign      string           `xml:"groupalign,attr"`
+	Alignmentscope  string           `xml:"alignmentscope,attr"`
+	Side            string           `xml:"side,attr"`
+	Rowspacing      string           `xml:"rowspacing,attr"`
+	Columnspacing   string           `xml:"columnspacing,attr"`
+	Rowlines        string           `xml:"rowlines,attr"`
+	Columnlines     string           `xml:"columnlines,attr"`
+	Width           string           `xml:"width,attr"`
+	Frame           string           `xml:"frame,attr"`
+	Framespacing    string           `xml:"framespacing,attr"`
+	Minlabelspacing string           `xml:"minlabelspacing,attr"`
+	Equalrows       string           `xml:"equalrows,attr"`
+	Equalcolumns    string           `xml:"equalcolumns,attr"`
+	Displaystyle    string           `xml:"displaystyle,attr"`
+	Mi              []Mi             `xml:"mi"`
+	Mn              []Mn             `xml:"mn"`
+	Mo              []Mo             `xml:"mo"`
+	Mtext           []Mtext          `xml:"mtext"`
+	Ms              []Ms             `xml:"ms"`
+	Mspace          []Mspace         `xml:"mspace"`
+	Mprescripts     []Mprescripts    `xml:"mprescripts"`
+	None            []None           `xml:"none"`
+	Mrow            []Mrow           `xml:"mrow"`
+	Mfrac           []Mfrac          `xml:"mfrac"`
+	Msqrt           []Msqrt          `xml:"msqrt"`
+	Mroot           []Mroot          `xml:"mroot"`
+	Menclose        []Menclose       `xml:"menclose"`
+	Mstyle          []Mstyle         `xml:"mstyle"`
+	Merror          []Merror         `xml:"merror"`
+	Mpadded         []Mpadded        `xml:"mpadded"`
+	Mphantom        []Mphantom       `xml:"mphantom"`
+	Mfenced         []Mfenced        `xml:"mfenced"`
+	Msub            []Msub           `xml:"msub"`
+	Msup            []Msup           `xml:"msup"`
+	Msubsup         []Msubsup        `xml:"msubsup"`
+	Munder          []Munder         `xml:"munder"`
+	Mover           []Mover          `xml:"mover"`
+	Munderover      []Munderover     `xml:"munderover"`
+	Mmultiscripts   []Mmultiscripts  `xml:"mmultiscripts"`
+	Mtable          []Mtable         `xml:"mtable"`
+	Mtr             []Mtr            `xml:"mtr"`
+	Mlabeledtr      []Mlabeledtr     `xml:"mlabeledtr"`
+	Mtd             []Mtd            `xml:"mtd"`
+	Maligngroup     []Maligngroup    `xml:"maligngroup"`
+	Malignmark      []Malignmark     `xml:"malignmark"`
+	Maction         []Maction        `xml:"maction"`
+	Ci              []Ci             `xml:"ci"`
+	Csymbol         []Csymbol        `xml:"csymbol"`
+	Cn              []Cn             `xml:"cn"`
+	Integers        []Integers       `xml:"integers"`
+	Reals           []Reals          `xml:"reals"`
+	Rationals       []Rationals      `xml:"rationals"`
+	Naturalnumbers  []Naturalnumbers `xml:"naturalnumbers"`
+	Complexes       []Complexes      `xml:"complexes"`
+	Primes          []Primes         `xml:"primes"`
+	Exponentiale    []Exponentiale   `xml:"exponentiale"`
+	Imaginaryi      []Imaginaryi     `xml:"imaginaryi"`
+	Notanumber      []Notanumber     `xml:"notanumber"`
+	True            []True           `xml:"true"`
+	False           []False          `xml:"false"`
+	Emptyset        []Emptyset       `xml:"emptyset"`
+	Pi              []Pi             `xml:"pi"`
+	Eulergamma      []Eulergamma     `xml:"eulergamma"`
+	Infinity        []Infinity       `xml:"infinity"`
+	Apply           []Apply          `xml:"apply"`
+	Fn              []Fn             `xml:"fn"`
+	Lambda          []Lambda         `xml:"lambda"`
+	Reln            []Reln           `xml:"reln"`
+	Interval        []Interval       `xml:"interval"`
+	List            []List           `xml:"list"`
+	Matrix          []Matrix         `xml:"matrix"`
+	Matrixrow       []Matrixrow      `xml:"matrixrow"`
+	Set             []Set            `xml:"set"`
+	Vector          []Vector         `xml:"vector"`
+	Piecewise       []Piecewise      `xml:"piecewise"`
+	Semantics       []Semantics      `xml:"semantics"`
+	Declare         []Declare        `xml:"declare"`
+}
+
+// Mtr is the element mtr
+//
+//	(mi | mn | mo | mtext | ms | mspace | mprescripts | none | mrow | mfrac | msqrt | mroot | menclose |
+//	mstyle | merror | mpadded | mphantom | mfenced | msub | msup | msubsup | munder | mover |
+//	munderover | mmultiscripts | mtable | mtr | mlabeledtr | mtd | maligngroup | malignmark | maction |
+//	ci | csymbol | cn | integers | reals | rationals | naturalnumbers | complexes | primes |
+//	exponentiale | imaginaryi | notanumber | true | false | emptyset | pi | eulergamma | infinity |
+//	apply | fn | lambda | reln | interval | list | matrix | matrixrow | set | vector | piecewise |
+//	semantics | declare)*
+type Mtr struct {
+	XlinkHref      string           `xml:"href,attr"`
+	XlinkType      string           `xml:"type,attr"`
+	Class          string           `xml:"class,attr"`
+	Style          string           `xml:"style,attr"`
+	ID             string           `xml:"id,attr"`
+	Xref           string           `xml:"xref,attr"`
+	Other          string           `xml:"other,attr"`
+	Rowalign       string           `xml:"rowalign,attr"`
+	Columnalign    string           `xml:"columnalign,attr"`
+	Groupalign     string           `xml:"groupalign,attr"`
+	Mi             []Mi             `xml:"mi"`
+	Mn             []Mn             `xml:"mn"`
+	Mo             []Mo             `xml:"mo"`
+	Mtext          []Mtext          `xml:"mtext"`
+	Ms             []Ms             `xml:"ms"`
+	Mspace         []Mspace         `xml:"mspace"`
+	Mprescripts    []Mprescripts    `xml:"mprescripts"`
+	None           []None           `xml:"none"`
+	Mrow           []Mrow           `xml:"mrow"`
+	Mfrac          []Mfrac          `xml:"mfrac"`
+	Msqrt          []Msqrt          `xml:"msqrt"`
+	Mroot          []Mroot          `xml:"mroot"`
+	Menclose       []Menclose       `xml:"menclose"`
+	Mstyle         []Mstyle         `xml:"mstyle"`
+	Merror         []Merror         `xml:"merror"`
+	Mpadded        []Mpadded        `xml:"mpadded"`
+	Mphantom       []Mphantom       `xml:"mphantom"`
+	Mfenced        []Mfenced        `xml:"mfenced"`
+	Msub           []Msub           `xml:"msub"`
+	Msup           []Msup           `xml:"msup"`
+	Msubsup        []Msubsup        `xml:"msubsup"`
+	Munder         []Munder         `xml:"munder"`
+	Mover          []Mover          `xml:"mover"`
+	Munderover     []Munderover     `xml:"munderover"`
+	Mmultiscripts  []Mmultiscripts  `xml:"mmultiscripts"`
+	Mtable         []Mtable         `xml:"mtable"`
+	Mtr            []Mtr            `xml:"mtr"`
+	Mlabeledtr     []Mlabeledtr     `xml:"mlabeledtr"`
+	Mtd            []Mtd            `xml:"mtd"`
+	Maligngroup    []Maligngroup    `xml:"maligngroup"`
+	Malignmark     []Malignmark     `xml:"malignmark"`
+	Maction        []Maction        `xml:"maction"`
+	Ci             []Ci             `xml:"ci"`
+	Csymbol        []Csymbol        `xml:"csymbol"`
+	Cn             []Cn             `xml:"cn"`
+	Integers       []Integers       `xml:"integers"`
+	Reals          []Reals          `xml:"reals"`
+	Rationals      []Rationals      `xml:"rationals"`
+	Naturalnumbers []Naturalnumbers `xml:"naturalnumbers"`
+	Complexes      []Complexes      `xml:"complexes"`
+	Primes         []Primes         `xml:"primes"`
+	Exponentiale   []Exponentiale   `xml:"exponentiale"`
+	Imaginaryi     []Imaginaryi     `xml:"imaginaryi"`
+	Notanumber     []Notanumber     `xml:"notanumber"`
+	True           []True           `xml:"true"`
+	False          []False          `xml:"false"`
+	Emptyset       []Emptyset       `xml:"emptyset"`
+	Pi             []Pi             `xml:"pi"`
+	Eulergamma     []Eulergamma     `xml:"eulergamma"`
+	Infinity       []Infinity       `xml:"infinity"`
+	Apply          []Apply          `xml:"apply"`
+	Fn             []Fn             `xml:"fn"`
+	Lambda         []Lambda         `xml:"lambda"`
+	Reln           []Reln           `xml:"reln"`
+	Interval       []Interval       `xml:"interval"`
+	List           []List           `xml:"list"`
+	Matrix         []Matrix         `xml:"matrix"`
+	Matrixrow      []Matrixrow      `xml:"matrixrow"`
+	Set            []Set            `xml:"set"`
+	Vector         []Vector         `xml:"vector"`
+	Piecewise      []Piecewise      `xml:"piecewise"`
+	Semantics      []Semantics      `xml:"semantics"`
+	Declare        []Declare        `xml:"declare"`
+}
+
+// Mlabeledtr is the element mlabeledtr
+//
+//	(mi | mn | mo | mtext | ms | mspace | mprescripts | none | mrow | mfrac | msqrt | mroot | menclose |
+//	mstyle | merror | mpadded | mphantom | mfenced | msub | msup | msubsup | munder | mover |
+//	munderover | mmultiscripts | mtable | mtr | mlabeledtr | mtd | maligngroup | malignmark | maction |
+//	ci | csymbol | cn | integers | reals | rationals | naturalnumbers | complexes | primes |
+//	exponentiale | imaginaryi | notanumber | true | false | emptyset | pi | eulergamma | infinity |
+//	apply | fn | lambda | reln | interval | list | matrix | matrixrow | set | vector | piecewise |
+//	semantics | declare)*
+type Mlabeledtr struct {
+	XlinkHref      string           `xml:"href,attr"`
+	XlinkType      string           `xml:"type,attr"`
+	Class          string           `xml:"class,attr"`
+	Style          string           `xml:"style,attr"`
+	ID             string           `xml:"id,attr"`
+	Xref           string           `xml:"xref,attr"`
+	Other          string           `xml:"other,attr"`
+	Rowalign       string           `xml:"rowalign,attr"`
+	Columnalign    string           `xml:"columnalign,attr"`
+	Groupalign     string           `xml:"groupalign,attr"`
+	Mi             []Mi             `xml:"mi"`
+	Mn             []Mn             `xml:"mn"`
+	Mo             []Mo             `xml:"mo"`
+	Mtext          []Mtext          `xml:"mtext"`
+	Ms             []Ms             `xml:"ms"`
+	Mspace         []Mspace         `xml:"mspace"`
+	Mprescripts    []Mprescripts    `xml:"mprescripts"`
+	None           []None           `xml:"none"`
+	Mrow           []Mrow           `xml:"mrow"`
+	Mfrac          []Mfrac          `xml:"mfrac"`
+	Msqrt          []Msqrt          `xml:"msqrt"`
+	Mroot          []Mroot          `xml:"mroot"`
+	Menclose       []Menclose       `xml:"menclose"`
+	Mstyle         []Mstyle         `xml:"mstyle"`
+	Merror         []Merror         `xml:"merror"`
+	Mpadded        []Mpadded        `xml:"mpadded"`
+	Mphantom       []Mphantom       `xml:"mphantom"`
+	Mfenced        []Mfenced        `xml:"mfenced"`
+	Msub           []Msub           `xml:"msub"`
+	Msup           []Msup           `xml:"msup"`
+	Msubsup        []Msubsup        `xml:"msubsup"`
+	Munder         []Munder         `xml:"munder"`
+	Mover          []Mover          `xml:"mover"`
+	Munderover     []Munderover     `xml:"munderover"`
+	Mmultiscripts  []Mmultiscripts  `xml:"mmultiscripts"`
+	Mtable         []Mtable         `xml:"mtable"`
+	Mtr            []Mtr            `xml:"mtr"`
+	Mlabeledtr     []Mlabeledtr     `xml:"mlabeledtr"`
+	Mtd            []Mtd            `xml:"mtd"`
+	Maligngroup    []Maligngroup    `xml:"maligngroup"`
+	Malignmark     []Malignmark     `xml:"malignmark"`
+	Maction        []Maction        `xml:"maction"`
+	Ci             []Ci             `xml:"ci"`
+	Csymbol        []Csymbol        `xml:"csymbol"`
+	Cn             []Cn             `xml:"cn"`
+	Integers       []Integers       `xml:"integers"`
+	Reals          []Reals          `xml:"reals"`
+	Rationals      []Rationals      `xml:"rationals"`
+	Naturalnumbers []Naturalnumbers `xml:"naturalnumbers"`
+	Complexes      []Complexes      `xml:"complexes"`
+	Primes         []Primes         `xml:"primes"`
+	Exponentiale   []Exponentiale   `xml:"exponentiale"`
+	Imaginaryi     []Imaginaryi     `xml:"imaginaryi"`
+	Notanumber     []Notanumber     `xml:"notanumber"`
+	True           []True           `xml:"true"`
+	False          []False          `xml:"false"`
+	Emptyset       []Emptyset       `xml:"emptyset"`
+	Pi             []Pi             `xml:"pi"`
+	Eulergamma     []Eulergamma     `xml:"eulergamma"`
+	Infinity       []Infinity       `xml:"infinity"`
+	Apply          []Apply          `xml:"apply"`
+	Fn             []Fn             `xml:"fn"`
+	Lambda         []Lambda         `xml:"lambda"`
+	Reln           []Reln           `xml:"reln"`
+	Interval       []Interval       `xml:"interval"`
+	List           []List           `xml:"list"`
+	Matrix         []Matrix         `xml:"matrix"`
+	Matrixrow      []Matrixrow      `xml:"matrixrow"`
+	Set            []Set            `xml:"set"`
+	Vector         []Vector         `xml:"vector"`
+	Piecewise      []Piecewise      `xml:"piecewise"`
+	Semantics      []Semantics      `xml:"semantics"`
+	Declare        []Declare        `xml:"declare"`
+}
+
+// Mtd is the element mtd
+//
+//	(mi | mn | mo | mtext | ms | mspace | mprescripts | none | mrow | mfrac | msqrt | mroot | menclose |
+//	mstyle | merror | mpadded | mphantom | mfenced | msub | msup | msubsup | munder | mover |
+//	munderover | mmultiscripts | mtable | mtr | mlabeledtr | mtd | maligngroup | malignmark | maction |
+//	ci | csymbol | cn | integers | reals | rationals | naturalnumbers | complexes | primes |
+//	exponentiale | imaginaryi | notanumber | true | false | emptyset | pi | eulergamma | infinity |
+//	apply | fn | lambda | reln | interval | list | matrix | matrixrow | set | vector | piecewise |
+//	semantics | declare)*
+type Mtd struct {
+	XlinkHref      string           `xml:"href,attr"`
+	XlinkType      string           `xml:"type,attr"`
+	Class          string           `xml:"class,attr"`
+	Style          string           `xml:"style,attr"`
+	ID             string           `xml:"id,attr"`
+	Xref           string           `xml:"xref,attr"`
+	Other          string           `xml:"other,attr"`
+	Rowalign       string           `xml:"rowalign,attr"`
+	Columnalign    string           `xml:"columnalign,attr"`
+	Groupalign     string           `xml:"groupalign,attr"`
+	Rowspan        string           `xml:"rowspan,attr"`
+	Columnspan     string           `xml:"columnspan,attr"`
+	Mi             []Mi             `xml:"mi"`
+	Mn             []Mn             `xml:"mn"`
+	Mo             []Mo             `xml:"mo"`
+	Mtext          []Mtext          `xml:"mtext"`
+	Ms             []Ms             `xml:"ms"`
+	Mspace         []Mspace         `xml:"mspace"`
+	Mprescripts    []Mprescripts    `xml:"mprescripts"`
+	None           []None           `xml:"none"`
+	Mrow           []Mrow           `xml:"mrow"`
+	Mfrac          []Mfrac          `xml:"mfrac"`
+	Msqrt          []Msqrt          `xml:"msqrt"`
+	Mroot          []Mroot          `xml:"mroot"`
+	Menclose       []Menclose       `xml:"menclose"`
+	Mstyle         []Mstyle         `xml:"mstyle"`
+	Merror         []Merror         `xml:"merror"`
+	Mpadded        []Mpadded        `xml:"mpadded"`
+	Mphantom       []Mphantom       `xml:"mphantom"`
+	Mfenced        []Mfenced        `xml:"mfenced"`
+	Msub           []Msub           `xml:"msub"`
+	Msup           []Msup           `xml:"msup"`
+	Msubsup        []Msubsup        `xml:"msubsup"`
+	Munder         []Munder         `xml:"munder"`
+	Mover          []Mover          `xml:"mover"`
+	Munderover     []Munderover     `xml:"munderover"`
+	Mmultiscripts  []Mmultiscripts  `xml:"mmultiscripts"`
+	Mtable         []Mtable         `xml:"mtable"`
+	Mtr            []Mtr            `xml:"mtr"`
+	Mlabeledtr     []Mlabeledtr     `xml:"mlabeledtr"`
+	Mtd            []Mtd            `xml:"mtd"`
+	Maligngroup    []Maligngroup    `xml:"maligngroup"`
+	Malignmark     []Malignmark     `xml:"malignmark"`
+	Maction        []Maction        `xml:"maction"`
+	Ci             []Ci             `xml:"ci"`
+	Csymbol        []Csymbol        `xml:"csymbol"`
+	Cn             []Cn             `xml:"cn"`
+	Integers       []Integers       `xml:"integers"`
+	Reals          []Reals          `xml:"reals"`
+	Rationals      []Rationals      `xml:"rationals"`
+	Naturalnumbers []Naturalnumbers `xml:"naturalnumbers"`
+	Complexes      []Complexes      `xml:"complexes"`
+	Primes         []Primes         `xml:"primes"`
+	Exponentiale   []Exponentiale   `xml:"exponentiale"`
+	Imaginaryi     []Imaginaryi     `xml:"imaginaryi"`
+	Notanumber     []Notanumber     `xml:"notanumber"`
+	True           []True           `xml:"true"`
+	False          []False          `xml:"false"`
+	Emptyset       []Emptyset       `xml:"emptyset"`
+	Pi             []Pi             `xml:"pi"`
+	Eulergamma     []Eulergamma     `xml:"eulergamma"`
+	Infinity       []Infinity       `xml:"infinity"`
+	Apply          []Apply          `xml:"apply"`
+	Fn             []Fn             `xml:"fn"`
+	Lambda         []Lambda         `xml:"lambda"`
+	Reln           []Reln           `xml:"reln"`
+	Interval       []Interval       `xml:"interval"`
+	List           []List           `xml:"list"`
+	Matrix         []Matrix         `xml:"matrix"`
+	Matrixrow      []Matrixrow      `xml:"matrixrow"`
+	Set            []Set            `xml:"set"`
+	Vector         []Vector         `xml:"vector"`
+	Piecewise      []Piecewise      `xml:"piecewise"`
+	Semantics      []Semantics      `xml:"semantics"`
+	Declare        []Declare        `xml:"declare"`
+}
+
+// Maligngroup is the element maligngroup
+//
+//	EMPTY
+type Maligngroup struct {
+	XlinkHref  string `xml:"href,attr"`
+	XlinkType  string `xml:"type,attr"`
+	Class      string `xml:"class,attr"`
+	Style      string `xml:"style,attr"`
+	ID         string `xml:"id,attr"`
+	Xref       string `xml:"xref,attr"`
+	Other      string `xml:"other,attr"`
+	Groupalign string `xml:"groupalign,attr"`
+}
+
+// Maction is the element maction
+//
+//	(mi | mn | mo | mtext | ms | mspace | mprescripts | none | mrow | mfrac | msqrt | mroot | menclose |
+//	mstyle | merror | mpadded | mphantom | mfenced | msub | msup | msubsup | munder | mover |
+//	munderover | mmultiscripts | mtable | mtr | mlabeledtr | mtd | maligngroup | malignmark | maction |
+//	ci | csymbol | cn | integers | reals | rationals | naturalnumbers | complexes | primes |
+//	exponentiale | imaginaryi | notanumber | true | false | emptyset | pi | eulergamma | infinity |
+//	apply | fn | lambda | reln | interval | list | matrix | matrixrow | set | vector | piecewise |
+//	semantics | declare)*
+type Maction struct {
+	XlinkHref      string           `xml:"href,attr"`
+	XlinkType      string           `xml:"type,attr"`
+	Class          string           `xml:"class,attr"`
+	Style          string           `xml:"style,attr"`
+	ID             string           `xml:"id,attr"`
+	Xref           string           `xml:"xref,attr"`
+	Other          string           `xml:"other,attr"`
+	Actiontype     string           `xml:"actiontype,attr"`
+	Selection      string           `xml:"selection,attr"`
+	Mi             []Mi             `xml:"mi"`
+	Mn             []Mn             `xml:"mn"`
+	Mo             []Mo             `xml:"mo"`
+	Mtext          []Mtext          `xml:"mtext"`
+	Ms             []Ms             `xml:"ms"`
+	Mspace         []Mspace         `xml:"mspace"`
+	Mprescripts    []Mprescripts    `xml:"mprescripts"`
+	None           []None           `xml:"none"`
+	Mrow           []Mrow           `xml:"mrow"`
+	Mfrac          []Mfrac          `xml:"mfrac"`
+	Msqrt          []Msqrt          `xml:"msqrt"`
+	Mroot          []Mroot          `xml:"mroot"`
+	Menclose       []Menclose       `xml:"menclose"`
+	Mstyle         []Mstyle         `xml:"mstyle"`
+	Merror         []Merror         `xml:"merror"`
+	Mpadded        []Mpadded        `xml:"mpadded"`
+	Mphantom       []Mphantom       `xml:"mphantom"`
+	Mfenced        []Mfenced        `xml:"mfenced"`
+	Msub           []Msub           `xml:"msub"`
+	Msup           []Msup           `xml:"msup"`
+	Msubsup        []Msubsup        `xml:"msubsup"`
+	Munder         []Munder         `xml:"munder"`
+	Mover          []Mover          `xml:"mover"`
+	Munderover     []Munderover     `xml:"munderover"`
+	Mmultiscripts  []Mmultiscripts  `xml:"mmultiscripts"`
+	Mtable         []Mtable         `xml:"mtable"`
+	Mtr            []Mtr            `xml:"mtr"`
+	Mlabeledtr     []Mlabeledtr     `xml:"mlabeledtr"`
+	Mtd            []Mtd            `xml:"mtd"`
+	Maligngroup    []Maligngroup    `xml:"maligngroup"`
+	Malignmark     []Malignmark     `xml:"malignmark"`
+	Maction        []Maction        `xml:"maction"`
+	Ci             []Ci             `xml:"ci"`
+	Csymbol        []Csymbol        `xml:"csymbol"`
+	Cn             []Cn             `xml:"cn"`
+	Integers       []Integers       `xml:"integers"`
+	Reals          []Reals          `xml:"reals"`
+	Rationals      []Rationals      `xml:"rationals"`
+	Naturalnumbers []Naturalnumbers `xml:"naturalnumbers"`
+	Complexes      []Complexes      `xml:"complexes"`
+	Primes         []Primes         `xml:"primes"`
+	Exponentiale   []Exponentiale   `xml:"exponentiale"`
+	Imaginaryi     []Imaginaryi     `xml:"imaginaryi"`
+	Notanumber     []Notanumber     `xml:"notanumber"`
+	True           []True           `xml:"true"`
+	False          []False          `xml:"false"`
+	Emptyset       []Emptyset       `xml:"emptyset"`
+	Pi             []Pi             `xml:"pi"`
+	Eulergamma     []Eulergamma     `xml:"eulergamma"`
+	Infinity       []Infinity       `xml:"infinity"`
+	Apply          []Apply          `xml:"apply"`
+	Fn             []Fn             `xml:"fn"`
+	Lambda         []Lambda         `xml:"lambda"`
+	Reln           []Reln           `xml:"reln"`
+	Interval       []Interval       `xml:"interval"`
+	List           []List           `xml:"list"`
+	Matrix         []Matrix         `xml:"matrix"`
+	Matrixrow      []Matrixrow      `xml:"matrixrow"`
+	Set            []Set            `xml:"set"`
+	Vector         []Vector         `xml:"vector"`
+	Piecewise      []Piecewise      `xml:"piecewise"`
+	Semantics      []Semantics      `xml:"semantics"`
+	Declare        []Declare        `xml:"declare"`
+}
+
+// Ci is the element ci
+//
+//	(#PCDATA | mglyph | mi | mn | mo | mtext | ms | mspace | mrow | mfrac | msqrt | mroot | menclose |
+//	mstyle | merror | mpadded | mphantom | mfenced | msub | msup | msubsup | munder | mover |
+//	munderover | mmultiscripts | mtable | mtr | mlabeledtr | mtd | maligngroup | malignmark | maction)*
+type Ci struct {
+	Text          string          `xml:",chardata"`
+	InnerXML      string          `xml:",innerxml"`
+	XlinkHref     string          `xml:"href,attr"`
+	XlinkType     string          `xml:"xlink type,attr"`
+	Class         string          `xml:"class,attr"`
+	Style         string          `xml:"style,attr"`
+	ID            string          `xml:"id,attr"`
+	Xref          string          `xml:"xref,attr"`
+	Other         string          `xml:"other,attr"`
+	Type          string          `xml:"type,attr"`
+	DefinitionURL string          `xml:"definitionURL,attr"`
+	Encoding      string          `xml:"encoding,attr"`
+	Mglyph        []Mglyph        `xml:"mglyph"`
+	Mi            []Mi            `xml:"mi"`
+	Mn            []Mn            `xml:"mn"`
+	Mo            []Mo            `xml:"mo"`
+	Mtext         []Mtext         `xml:"mtext"`
+	Ms            []Ms            `xml:"ms"`
+	Mspace        []Mspace        `xml:"mspace"`
+	Mrow          []Mrow          `xml:"mrow"`
+	Mfrac         []Mfrac         `xml:"mfrac"`
+	Msqrt         []Msqrt         `xml:"msqrt"`
+	Mroot         []Mroot         `xml:"mroot"`
+	Menclose      []Menclose      `xml:"menclose"`
+	Mstyle        []Mstyle        `xml:"mstyle"`
+	Merror        []Merror        `xml:"merror"`
+	Mpadded       []Mpadded       `xml:"mpadded"`
+	Mphantom      []Mphantom      `xml:"mphantom"`
+	Mfenced       []Mfenced       `xml:"mfenced"`
+	Msub          []Msub          `xml:"msub"`
+	Msup          []Msup          `xml:"msup"`
+	Msubsup       []Msubsup       `xml:"msubsup"`
+	Munder        []Munder        `xml:"munder"`
+	Mover         []Mover         `xml:"mover"`
+	Munderover    []Munderover    `xml:"munderover"`
+	Mmultiscripts []Mmultiscripts `xml:"mmultiscripts"`
+	Mtable        []Mtable        `xml:"mtable"`
+	Mtr           []Mtr           `xml:"mtr"`
+	Mlabeledtr    []Mlabeledtr    `xml:"mlabeledtr"`
+	Mtd           []Mtd           `xml:"mtd"`
+	Maligngroup   []Maligngroup   `xml:"maligngroup"`
+	Malignmark    []Malignmark    `xml:"malignmark"`
+	Maction       []Maction       `xml:"maction"`
+}
+
+// Csymbol is the element csymbol
+//
+//	(#PCDATA | mglyph | mi | mn | mo | mtext | ms | mspace | mrow | mfrac | msqrt | mroot | menclose |
+//	mstyle | merror | mpadded | mphantom | mfenced | msub | msup | msubsup | munder | mover |
+//	munderover | mmultiscripts | mtable | mtr | mlabeledtr | mtd | maligngroup | malignmark | maction)*
+type Csymbol struct {
+	Text          string          `xml:",chardata"`
+	InnerXML      string          `xml:",innerxml"`
+	XlinkHref     string          `xml:"href,attr"`
+	XlinkType     string          `xml:"xlink type,attr"`
+	Class         string          `xml:"class,attr"`
+	Style         string          `xml:"style,attr"`
+	ID            string          `xml:"id,attr"`
+	Xref          string          `xml:"xref,attr"`
+	Other         string          `xml:"other,attr"`
+	Encoding      string          `xml:"encoding,attr"`
+	Type          string          `xml:"type,attr"`
+	DefinitionURL string          `xml:"definitionURL,attr"`
+	Mglyph        []Mglyph        `xml:"mglyph"`
+	Mi            []Mi            `xml:"mi"`
+	Mn            []Mn            `xml:"mn"`
+	Mo            []Mo            `xml:"mo"`
+	Mtext         []Mtext         `xml:"mtext"`
+	Ms            []Ms            `xml:"ms"`
+	Mspace        []Mspace        `xml:"mspace"`
+	Mrow          []Mrow          `xml:"mrow"`
+	Mfrac         []Mfrac         `xml:"mfrac"`
+	Msqrt         []Msqrt         `xml:"msqrt"`
+	Mroot         []Mroot         `xml:"mroot"`
+	Menclose      []Menclose      `xml:"menclose"`
+	Mstyle        []Mstyle        `xml:"mstyle"`
+	Merror        []Merror        `xml:"merror"`
+	Mpadded       []Mpadded       `xml:"mpadded"`
+	Mphantom      []Mphantom      `xml:"mphantom"`
+	Mfenced       []Mfenced       `xml:"mfenced"`
+	Msub          []Msub          `xml:"msub"`
+	Msup          []Msup          `xml:"msup"`
+	Msubsup       []Msubsup       `xml:"msubsup"`
+	Munder        []Munder        `xml:"munder"`
+	Mover         []Mover         `xml:"mover"`
+	Munderover    []Munderover    `xml:"munderover"`
+	Mmultiscripts []Mmultiscripts `xml:"mmultiscripts"`
+	Mtable        []Mtable        `xml:"mtable"`
+	Mtr           []Mtr           `xml:"mtr"`
+	Mlabeledtr    []Mlabeledtr    `xml:"mlabeledtr"`
+	Mtd           []Mtd           `xml:"mtd"`
+	Maligngroup   []Maligngroup   `xml:"maligngroup"`
+	Malignmark    []Malignmark    `xml:"malignmark"`
+	Maction       []Maction       `xml:"maction"`
+}
+
+// Cn is the element cn
+//
+//	(#PCDATA | mglyph | sep | mi | mn | mo | mtext | ms | mspace | mrow | mfrac | msqrt | mroot |
+//	menclose | mstyle | merror | mpadded | mphantom | mfenced | msub | msup | msubsup | munder | mover |
+//	munderover | mmultiscripts | mtable | mtr | mlabeledtr | mtd | maligngroup | malignmark | maction)*
+type Cn struct {
+	Text          string          `xml:",chardata"`
+	InnerXML      string          `xml:",innerxml"`
+	XlinkHref     string          `xml:"href,attr"`
+	XlinkType     string          `xml:"xlink type,attr"`
+	Class         string          `xml:"class,attr"`
+	Style         string          `xml:"style,attr"`
+	ID            string          `xml:"id,attr"`
+	Xref          string          `xml:"xref,attr"`
+	Other         string          `xml:"other,attr"`
+	Type          string          `xml:"type,attr"`
+	Base          string          `xml:"base,attr"`
+	DefinitionURL string          `xml:"definitionURL,attr"`
+	Encoding      string          `xml:"encoding,attr"`
+	Mglyph        []Mglyph        `xml:"mglyph"`
+	Sep           []Sep           `xml:"sep"`
+	Mi            []Mi            `xml:"mi"`
+	Mn            []Mn            `xml:"mn"`
+	Mo            []Mo            `xml:"mo"`
+	Mtext         []Mtext         `xml:"mtext"`
+	Ms            []Ms            `xml:"ms"`
+	Mspace        []Mspace        `xml:"mspace"`
+	Mrow          []Mrow          `xml:"mrow"`
+	Mfrac         []Mfrac         `xml:"mfrac"`
+	Msqrt         []Msqrt         `xml:"msqrt"`
+	Mroot         []Mroot         `xml:"mroot"`
+	Menclose      []Menclose      `xml:"menclose"`
+	Mstyle        []Mstyle        `xml:"mstyle"`
+	Merror        []Merror        `xml:"merror"`
+	Mpadded       []Mpadded       `xml:"mpadded"`
+	Mphantom      []Mphantom      `xml:"mphantom"`
+	Mfenced       []Mfenced       `xml:"mfenced"`
+	Msub          []Msub          `xml:"msub"`
+	Msup          []Msup          `xml:"msup"`
+	Msubsup       []Msubsup       `xml:"msubsup"`
+	Munder        []Munder        `xml:"munder"`
+	Mover         []Mover         `xml:"mover"`
+	Munderover    []Munderover    `xml:"munderover"`
+	Mmultiscripts []Mmultiscripts `xml:"mmultiscripts"`
+	Mtable        []Mtable        `xml:"mtable"`
+	Mtr           []Mtr           `xml:"mtr"`
+	Mlabeledtr    []Mlabeledtr    `xml:"mlabeledtr"`
+	Mtd           []Mtd           `xml:"mtd"`
+	Maligngroup   []Maligngroup   `xml:"maligngroup"`
+	Malignmark    []Malignmark    `xml:"malignmark"`
+	Maction       []Maction       `xml:"maction"`
+}
+
+// Sep is the element sep
+//
+//	EMPTY
+type Sep struct{}
+
+// Integers is the element integers
+//
+//	EMPTY
+type Integers struct {
+	XlinkHref     string `xml:"href,attr"`
+	XlinkType     string `xml:"type,attr"`
+	Class         string `xml:"class,attr"`
+	Style         string `xml:"style,attr"`
+	ID            string `xml:"id,attr"`
+	Xref          string `xml:"xref,attr"`
+	Other         string `xml:"other,attr"`
+	DefinitionURL string `xml:"definitionURL,attr"`
+	Encoding      string `xml:"encoding,attr"`
+}
+
+// Reals is the element reals
+//
+//	EMPTY
+type Reals struct {
+	XlinkHref     string `xml:"href,attr"`
+	XlinkType     string `xml:"type,attr"`
+	Class         string `xml:"class,attr"`
+	Style         string `xml:"style,attr"`
+	ID            string `xml:"id,attr"`
+	Xref          string `xml:"xref,attr"`
+	Other         string `xml:"other,attr"`
+	DefinitionURL string `xml:"definitionURL,attr"`
+	Encoding      string `xml:"encoding,attr"`
+}
+
+// Rationals is the element rationals
+//
+//	EMPTY
+type Rationals struct {
+	XlinkHref     string `xml:"href,attr"`
+	XlinkType     string `xml:"type,attr"`
+	Class         string `xml:"class,attr"`
+	Style         string `xml:"style,attr"`
+	ID            string `xml:"id,attr"`
+	Xref          string `xml:"xref,attr"`
+	Other         string `xml:"other,attr"`
+	DefinitionURL string `xml:"definitionURL,attr"`
+	Encoding      string `xml:"encoding,attr"`
+}
+
+// Naturalnumbers is the element naturalnumbers
+//
+//	EMPTY
+type Naturalnumbers struct {
+	XlinkHref     string `xml:"href,attr"`
+	XlinkType     string `xml:"type,attr"`
+	Class         string `xml:"class,attr"`
+	Style         string `xml:"style,attr"`
+	ID            string `xml:"id,attr"`
+	Xref          string `xml:"xref,attr"`
+	Other         string `xml:"other,attr"`
+	DefinitionURL string `xml:"definitionURL,attr"`
+	Encoding      string `xml:"encoding,attr"`
+}
+
+// Complexes is the element complexes
+//
+//	EMPTY
+type Complexes struct {
+	XlinkHref     string `xml:"href,attr"`
+	XlinkType     string `xml:"type,attr"`
+	Class         string `xml:"class,attr"`
+	Style         string `xml:"style,attr"`
+	ID            string `xml:"id,attr"`
+	Xref          string `xml:"xref,attr"`
+	Other         string `xml:"other,attr"`
+	DefinitionURL string `xml:"definitionURL,attr"`
+	Encoding      string `xml:"encoding,attr"`
+}
+
+// Primes is the element primes
+//
+//	EMPTY
+type Primes struct {
+	XlinkHref     string `xml:"href,attr"`
+	XlinkType     string `xml:"type,attr"`
+	Class         string `xml:"class,attr"`
+	Style         string `xml:"style,attr"`
+	ID            string `xml:"id,attr"`
+	Xref          string `xml:"xref,attr"`
+	Other         string `xml:"other,attr"`
+	DefinitionURL string `xml:"definitionURL,attr"`
+	Encoding      string `xml:"encoding,attr"`
+}
+
+// Exponentiale is the element exponentiale
+//
+//	EMPTY
+type Exponentiale struct {
+	XlinkHref     string `xml:"href,attr"`
+	XlinkType     string `xml:"type,attr"`
+	Class         string `xml:"class,attr"`
+	Style         string `xml:"style,attr"`
+	ID            string `xml:"id,attr"`
+	Xref          string `xml:"xref,attr"`
+	Other         string `xml:"other,attr"`
+	DefinitionURL string `xml:"definitionURL,attr"`
+	Encoding      string `xml:"encoding,attr"`
+}
+
+// Imaginaryi is the element imaginaryi
+//
+//	EMPTY
+type Imaginaryi struct {
+	XlinkHref     string `xml:"href,attr"`
+	XlinkType     string `xml:"type,attr"`
+	Class         string `xml:"class,attr"`
+	Style         string `xml:"style,attr"`
+	ID            string `xml:"id,attr"`
+	Xref          string `xml:"xref,attr"`
+	Other         string `xml:"other,attr"`
+	DefinitionURL string `xml:"definitionURL,attr"`
+	Encoding      string `xml:"encoding,attr"`
+}
+
+// Notanumber is the element notanumber
+//
+//	EMPTY
+type Notanumber struct {
+	XlinkHref     string `xml:"href,attr"`
+	XlinkType     string `xml:"type,attr"`
+	Class         string `xml:"class,attr"`
+	Style         string `xml:"style,attr"`
+	ID            string `xml:"id,attr"`
+	Xref          string `xml:"xref,attr"`
+	Other         string `xml:"other,attr"`
+	DefinitionURL string `xml:"definitionURL,attr"`
+	Encoding      string `xml:"encoding,attr"`
+}
+
+// True is the element true
+//
+//	EMPTY
+type True struct {
+	XlinkHref     string `xml:"href,attr"`
+	XlinkType     string `xml:"type,attr"`
+	Class         string `xml:"class,attr"`
+	Style         string `xml:"style,attr"`
+	ID            string `xml:"id,attr"`
+	Xref          string `xml:"xref,attr"`
+	Other         string `xml:"other,attr"`
+	DefinitionURL string `xml:"definitionURL,attr"`
+	Encoding      string `xml:"encoding,attr"`
+}
+
+// False is the element false
+//
+//	EMPTY
+type False struct {
+	XlinkHref     string `xml:"href,attr"`
+	XlinkType     string `xml:"type,attr"`
+	Class         string `xml:"class,attr"`
+	Style         string `xml:"style,attr"`
+	ID            string `xml:"id,attr"`
+	Xref          string `xml:"xref,attr"`
+	Other         string `xml:"other,attr"`
+	DefinitionURL string `xml:"definitionURL,attr"`
+	Encoding      string `xml:"encoding,attr"`
+}
+
+// Emptyset is the element emptyset
+//
+//	EMPTY
+type Emptyset struct {
+	XlinkHref     string `xml:"href,attr"`
+	XlinkType     string `xml:"type,attr"`
+	Class         string `xml:"class,attr"`
+	Style         string `xml:"style,attr"`
+	ID            string `xml:"id,attr"`
+	Xref          string `xml:"xref,attr"`
+	Other         string `xml:"other,attr"`
+	DefinitionURL string `xml:"definitionURL,attr"`
+	Encoding      string `xml:"encoding,attr"`
+}
+
+// Pi is the element pi
+//
+//	EMPTY
+type Pi struct {
+	XlinkHref     string `xml:"href,attr"`
+	XlinkType     string `xml:"type,attr"`
+	Class         string `xml:"class,attr"`
+	Style         string `xml:"style,attr"`
+	ID            string `xml:"id,attr"`
+	Xref          string `xml:"xref,attr"`
+	Other         string `xml:"other,attr"`
+	DefinitionURL string `xml:"definitionURL,attr"`
+	Encoding      string `xml:"encoding,attr"`
+}
+
+// Eulergamma is the element eulergamma
+//
+//	EMPTY
+type Eulergamma struct {
+	XlinkHref     string `xml:"href,attr"`
+	XlinkType     string `xml:"type,attr"`
+	Class         string `xml:"class,attr"`
+	Style         string `xml:"style,attr"`
+	ID            string `xml:"id,attr"`
+	Xref          string `xml:"xref,attr"`
+	Other         string `xml:"other,attr"`
+	DefinitionURL string `xml:"definitionURL,attr"`
+	Encoding      string `xml:"encoding,attr"`
+}
+
+// Infinity is the element infinity
+//
+//	EMPTY
+type Infinity struct {
+	XlinkHref     string `xml:"href,attr"`
+	XlinkType     string `xml:"type,attr"`
+	Class         string `xml:"class,attr"`
+	Style         string `xml:"style,attr"`
+	ID            string `xml:"id,attr"`
+	Xref          string `xml:"xref,attr"`
+	Other         string `xml:"other,attr"`
+	DefinitionURL string `xml:"definitionURL,attr"`
+	Encoding      string `xml:"encoding,attr"`
+}
+
+// Apply is the element apply
+//
+//	(csymbol | ci | cn | apply | reln | lambda | condition | declare | sep | semantics | annotation |
+//	annotation-xml | integers | reals | rationals | naturalnumbers | complexes | primes | exponentiale |
+//	imaginaryi | notanumber | true | false | emptyset | pi | eulergamma | infinity | interval | list |
+//	matrix | matrixrow | set | vector | piecewise | lowlimit | uplimit | bvar | degree | logbase |
+//	momentabout | domainofapplication | inverse | ident | domain | codomain | image | abs | conjugate |
+//	exp | factorial | arg | real | imaginary | floor | ceiling | not | ln | sin | cos | tan | sec | csc
+//	| cot | sinh | cosh | tanh | sech | csch | coth | arcsin | arccos | arctan | arccosh | arccot |
+//	arccoth | arccsc | arccsch | arcsec | arcsech | arcsinh | arctanh | determinant | transpose | card |
+//	quotient | divide | power | rem | implies | vectorproduct | scalarproduct | outerproduct | setdiff
+//	| fn | compose | plus | times | max | min | gcd | lcm | and | or | xor | union | intersect |
+//	cartesianproduct | mean | sdev | variance | median | mode | selector | root | minus | log | int |
+//	diff | partialdiff | divergence | grad | curl | laplacian | sum | product | limit | moment | exists
+//	| forall | neq | factorof | in | notin | notsubset | notprsubset | tendsto | eq | leq | lt | geq |
+//	gt | equivalent | approx | subset | prsubset | mi | mn | mo | mtext | ms | mspace | mrow | mfrac |
+//	msqrt | mroot | menclose | mstyle | merror | mpadded | mphantom | mfenced | msub | msup | msubsup |
+//	munder | mover | munderover | mmultiscripts | mtable | mtr | mlabeledtr | mtd | maligngroup |
+//	malignmark | maction)*
+type Apply struct {
+	XlinkHref           string                `xml:"href,attr"`
+	XlinkType           string                `xml:"type,attr"`
+	Class               string                `xml:"class,attr"`
+	Style               string                `xml:"style,attr"`
+	ID                  string                `xml:"id,attr"`
+	Xref                string                `xml:"xref,attr"`
+	Other               string                `xml:"other,attr"`
+	Csymbol             []Csymbol             `xml:"csymbol"`
+	Ci                  []Ci                  `xml:"ci"`
+	Cn                  []Cn                  `xml:"cn"`
+	Apply               []Apply               `xml:"apply"`
+	Reln                []Reln                `xml:"reln"`
+	Lambda              []Lambda              `xml:"lambda"`
+	Condition           []Condition           `xml:"condition"`
+	Declare             []Declare             `xml:"declare"`
+	Sep                 []Sep                 `xml:"sep"`
+	Semantics           []Semantics           `xml:"semantics"`
+	Annotation          []Annotation          `xml:"annotation"`
+	AnnotationXml       []AnnotationXml       `xml:"annotation-xml"`
+	Integers            []Integers            `xml:"integers"`
+	Reals               []Reals               `xml:"reals"`
+	Rationals           []Rationals           `xml:"rationals"`
+	Naturalnumbers      []Naturalnumbers      `xml:"naturalnumbers"`
+	Complexes           []Complexes           `xml:"complexes"`
+	Primes              []Primes              `xml:"primes"`
+	Exponentiale        []Exponentiale        `xml:"exponentiale"`
+	Imaginaryi          []Imaginaryi          `xml:"imaginaryi"`
+	Notanumber          []Notanumber          `xml:"notanumber"`
+	True                []True                `xml:"true"`
+	False               []False               `xml:"false"`
+	Emptyset            []Emptyset            `xml:"emptyset"`
+	Pi                  []Pi                  `xml:"pi"`
+	Eulergamma          []Eulergamma          `xml:"eulergamma"`
+	Infinity            []Infinity            `xml:"infinity"`
+	Interval            []Interval            `xml:"interval"`
+	List                []List                `xml:"list"`
+	Matrix              []Matrix              `xml:"matrix"`
+	Matrixrow           []Matrixrow           `xml:"matrixrow"`
+	Set                 []Set                 `xml:"set"`
+	Vector              []Vector              `xml:"vector"`
+	Piecewise           []Piecewise           `xml:"piecewise"`
+	Lowlimit            []Lowlimit            `xml:"lowlimit"`
+	Uplimit             []Uplimit             `xml:"uplimit"`
+	Bvar                []Bvar                `xml:"bvar"`
+	Degree              []Degree              `xml:"degree"`
+	Logbase             []Logbase             `xml:"logbase"`
+	Momentabout         []Momentabout         `xml:"momentabout"`
+	Domainofapplication []Domainofapplication `xml:"domainofapplication"`
+	Inverse             []Inverse             `xml:"inverse"`
+	Ident               []Ident               `xml:"ident"`
+	Domain              []Domain              `xml:"domain"`
+	Codomain            []Codomain            `xml:"codomain"`
+	Image               []Image               `xml:"image"`
+	Abs                 []Abs                 `xml:"abs"`
+	Conjugate           []Conjugate           `xml:"conjugate"`
+	Exp                 []Exp                 `xml:"exp"`
+	Factorial           []Factorial           `xml:"factorial"`
+	Arg                 []Arg                 `xml:"arg"`
+	Real                []Real                `xml:"real"`
+	Imaginary           []Imaginary           `xml:"imaginary"`
+	Floor               []Floor               `xml:"floor"`
+	Ceiling             []Ceiling             `xml:"ceiling"`
+	Not                 []Not                 `xml:"not"`
+	Ln                  []Ln                  `xml:"ln"`
+	Sin                 []Sin                 `xml:"sin"`
+	Cos                 []Cos                 `xml:"cos"`
+	Tan                 []Tan                 `xml:"tan"`
+	Sec                 []Sec                 `xml:"sec"`
+	Csc                 []Csc                 `xml:"csc"`
+	Cot                 []Cot                 `xml:"cot"`
+	Sinh                []Sinh                `xml:"sinh"`
+	Cosh                []Cosh                `xml:"cosh"`
+	Tanh                []Tanh                `xml:"tanh"`
+	Sech                []Sech                `xml:"sech"`
+	Csch                []Csch                `xml:"csch"`
+	Coth                []Coth                `xml:"coth"`
+	Arcsin              []Arcsin              `xml:"arcsin"`
+	Arccos              []Arccos              `xml:"arccos"`
+	Arctan              []Arctan              `xml:"arctan"`
+	Arccosh             []Arccosh             `xml:"arccosh"`
+	Arccot              []Arccot              `xml:"arccot"`
+	Arccoth             []Arccoth             `xml:"arccoth"`
+	Arccsc              []Arccsc              `xml:"arccsc"`
+	Arccsch             []Arccsch             `xml:"arccsch"`
+	Arcsec              []Arcsec              `xml:"arcsec"`
+	Arcsech             []Arcsech             `xml:"arcsech"`
+	Arcsinh             []Arcsinh             `xml:"arcsinh"`
+	Arctanh             []Arctanh             `xml:"arctanh"`
+	Determinant         []Determinant         `xml:"determinant"`
+	Transpose           []Transpose           `xml:"transpose"`
+	Card                []Card                `xml:"card"`
+	Quotient            []Quotient            `xml:"quotient"`
+	Divide              []Divide              `xml:"divide"`
+	Power               []Power               `xml:"power"`
+	Rem                 []Rem                 `xml:"rem"`
+	Implies             []Implies             `xml:"implies"`
+	Vectorproduct       []Vectorproduct       `xml:"vectorproduct"`
+	Scalarproduct       []Scalarproduct       `xml:"scalarproduct"`
+	Outerproduct        []Outerproduct        `xml:"outerproduct"`
+	Setdiff             []Setdiff             `xml:"setdiff"`
+	Fn                  []Fn                  `xml:"fn"`
+	Compose             []Compose             `xml:"compose"`
+	Plus                []Plus                `xml:"plus"`
+	Times               []Times               `xml:"times"`
+	Max                 []Max                 `xml:"max"`
+	Min                 []Min                 `xml:"min"`
+	Gcd                 []Gcd                 `xml:"gcd"`
+	Lcm                 []Lcm                 `xml:"lcm"`
+	And                 []And                 `xml:"and"`
+	Or                  []Or                  `xml:"or"`
+	Xor                 []Xor                 `xml:"xor"`
+	Union               []Union               `xml:"union"`
+	Intersect           []Intersect           `xml:"intersect"`
+	Cartesianproduct    []Cartesianproduct    `xml:"cartesianproduct"`
+	Mean                []Mean                `xml:"mean"`
+	Sdev                []Sdev                `xml:"sdev"`
+	Variance            []Variance            `xml:"variance"`
+	Median              []Median              `xml:"median"`
+	Mode                []Mode                `xml:"mode"`
+	Selector            []Selector            `xml:"selector"`
+	Root                []Root                `xml:"root"`
+	Minus               []Minus               `xml:"minus"`
+	Log                 []Log                 `xml:"log"`
+	Int                 []Int                 `xml:"int"`
+	Diff                []Diff                `xml:"diff"`
+	Partialdiff         []Partialdiff         `xml:"partialdiff"`
+	Divergence          []Divergence          `xml:"divergence"`
+	Grad                []Grad                `xml:"grad"`
+	Curl                []Curl                `xml:"curl"`
+	Laplacian           []Laplacian           `xml:"laplacian"`
+	Sum                 []Sum                 `xml:"sum"`
+	Product             []Product             `xml:"product"`
+	Limit               []Limit               `xml:"limit"`
+	Moment              []Moment              `xml:"moment"`
+	Exists              []Exists              `xml:"exists"`
+	Forall              []Forall              `xml:"forall"`
+	Neq                 []Neq                 `xml:"neq"`
+	Factorof            []Factorof            `xml:"factorof"`
+	In                  []In                  `xml:"in"`
+	Notin               []Notin               `xml:"notin"`
+	Notsubset           []Notsubset           `xml:"notsubset"`
+	Notprsubset         []Notprsubset         `xml:"notprsubset"`
+	Tendsto             []Tendsto             `xml:"tendsto"`
+	Eq                  []Eq                  `xml:"eq"`
+	Leq                 []Leq                 `xml:"leq"`
+	Lt                  []Lt                  `xml:"lt"`
+	Geq                 []Geq                 `xml:"geq"`
+	Gt                  []Gt                  `xml:"gt"`
+	Equivalent          []Equivalent          `xml:"equivalent"`
+	Approx              []Approx              `xml:"approx"`
+	Subset              []Subset              `xml:"subset"`
+	Prsubset            []Prsubset            `xml:"prsubset"`
+	Mi                  []Mi                  `xml:"mi"`
+	Mn                  []Mn                  `xml:"mn"`
+	Mo                  []Mo                  `xml:"mo"`
+	Mtext               []Mtext               `xml:"mtext"`
+	Ms                  []Ms                  `xml:"ms"`
+	Mspace              []Mspace              `xml:"mspace"`
+	Mrow                []Mrow                `xml:"mrow"`
+	Mfrac               []Mfrac               `xml:"mfrac"`
+	Msqrt               []Msqrt               `xml:"msqrt"`
+	Mroot               []Mroot               `xml:"mroot"`
+	Menclose            []Menclose            `xml:"menclose"`
+	Mstyle              []Mstyle              `xml:"mstyle"`
+	Merror              []Merror              `xml:"merror"`
+	Mpadded             []Mpadded             `xml:"mpadded"`
+	Mphantom            []Mphantom            `xml:"mphantom"`
+	Mfenced             []Mfenced             `xml:"mfenced"`
+	Msub                []Msub                `xml:"msub"`
+	Msup                []Msup                `xml:"msup"`
+	Msubsup             []Msubsup             `xml:"msubsup"`
+	Munder              []Munder              `xml:"munder"`
+	Mover               []Mover               `xml:"mover"`
+	Munderover          []Munderover          `xml:"munderover"`
+	Mmultiscripts       []Mmultiscripts       `xml:"mmultiscripts"`
+	Mtable              []Mtable              `xml:"mtable"`
+	Mtr                 []Mtr                 `xml:"mtr"`
+	Mlabeledtr          []Mlabeledtr          `xml:"mlabeledtr"`
+	Mtd                 []Mtd                 `xml:"mtd"`
+	Maligngroup         []Maligngroup         `xml:"maligngroup"`
+	Malignmark          []Malignmark          `xml:"malignmark"`
+	Maction             []Maction             `xml:"maction"`
+}
+
+// Reln is the element reln
+//
+//	(csymbol | ci | cn | apply | reln | lambda | condition | declare | sep | semantics | annotation |
+//	annotation-xml | integers | reals | rationals | naturalnumbers | complexes | primes | exponentiale |
+//	imaginaryi | notanumber | true | false | emptyset | pi | eulergamma | infinity | interval | list |
+//	matrix | matrixrow | set | vector | piecewise | lowlimit | uplimit | bvar | degree | logbase |
+//	momentabout | domainofapplication | inverse | ident | domain | codomain | image | abs | conjugate |
+//	exp | factorial | arg | real | imaginary | floor | ceiling | not | ln | sin | cos | tan | sec | csc
+//	| cot | sinh | cosh | tanh | sech | csch | coth | arcsin | arccos | arctan | arccosh | arccot |
+//	arccoth | arccsc | arccsch | arcsec | arcsech | arcsinh | arctanh | determinant | transpose | card |
+//	quotient | divide | power | rem | implies | vectorproduct | scalarproduct | outerproduct | setdiff
+//	| fn | compose | plus | times | max | min | gcd | lcm | and | or | xor | union | intersect |
+//	cartesianproduct | mean | sdev | variance | median | mode | selector | root | minus | log | int |
+//	diff | partialdiff | divergence | grad | curl | laplacian | sum | product | limit | moment | exists
+//	| forall | neq | factorof | in | notin | notsubset | notprsubset | tendsto | eq | leq | lt | geq |
+//	gt | equivalent | approx | subset | prsubset | mi | mn | mo | mtext | ms | mspace | mrow | mfrac |
+//	msqrt | mroot | menclose | mstyle | merror | mpadded | mphantom | mfenced | msub | msup | msubsup |
+//	munder | mover | munderover | mmultiscripts | mtable | mtr | mlabeledtr | mtd | maligngroup |
+//	malignmark | maction)*
+type Reln struct {
+	XlinkHref           string                `xml:"href,attr"`
+	XlinkType           string                `xml:"type,attr"`
+	Class               string                `xml:"class,attr"`
+	Style               string                `xml:"style,attr"`
+	ID                  string                `xml:"id,attr"`
+	Xref                string                `xml:"xref,attr"`
+	Other               string                `xml:"other,attr"`
+	Csymbol             []Csymbol             `xml:"csymbol"`
+	Ci                  []Ci                  `xml:"ci"`
+	Cn                  []Cn                  `xml:"cn"`
+	Apply               []Apply               `xml:"apply"`
+	Reln                []Reln                `xml:"reln"`
+	Lambda              []Lambda              `xml:"lambda"`
+	Condition           []Condition           `xml:"condition"`
+	Declare             []Declare             `xml:"declare"`
+	Sep                 []Sep                 `xml:"sep"`
+	Semantics           []Semantics           `xml:"semantics"`
+	Annotation          []Annotation          `xml:"annotation"`
+	AnnotationXml       []AnnotationXml       `xml:"annotation-xml"`
+	Integers            []Integers            `xml:"integers"`
+	Reals               []Reals               `xml:"reals"`
+	Rationals           []Rationals           `xml:"rationals"`
+	Naturalnumbers      []Naturalnumbers      `xml:"naturalnumbers"`
+	Complexes           []Complexes           `xml:"complexes"`
+	Primes              []Primes              `xml:"primes"`
+	Exponentiale        []Exponentiale        `xml:"exponentiale"`
+	Imaginaryi          []Imaginaryi          `xml:"imaginaryi"`
+	Notanumber          []Notanumber          `xml:"notanumber"`
+	True                []True                `xml:"true"`
+	False               []False               `xml:"false"`
+	Emptyset            []Emptyset            `xml:"emptyset"`
+	Pi                  []Pi                  `xml:"pi"`
+	Eulergamma          []Eulergamma          `xml:"eulergamma"`
+	Infinity            []Infinity            `xml:"infinity"`
+	Interval            []Interval            `xml:"interval"`
+	List                []List                `xml:"list"`
+	Matrix              []Matrix              `xml:"matrix"`
+	Matrixrow           []Matrixrow           `xml:"matrixrow"`
+	Set                 []Set                 `xml:"set"`
+	Vector              []Vector              `xml:"vector"`
+	Piecewise           []Piecewise           `xml:"piecewise"`
+	Lowlimit            []Lowlimit            `xml:"lowlimit"`
+	Uplimit             []Uplimit             `xml:"uplimit"`
+	Bvar                []Bvar                `xml:"bvar"`
+	Degree              []Degree              `xml:"degree"`
+	Logbase             []Logbase             `xml:"logbase"`
+	Momentabout         []Momentabout         `xml:"momentabout"`
+	Domainofapplication []Domainofapplication `xml:"domainofapplication"`
+	Inverse             []Inverse             `xml:"inverse"`
+	Ident               []Ident               `xml:"ident"`
+	Domain              []Domain              `xml:"domain"`
+	Codomain            []Codomain            `xml:"codomain"`
+	Image               []Image               `xml:"image"`
+	Abs                 []Abs                 `xml:"abs"`
+	Conjugate           []Conjugate           `xml:"conjugate"`
+	Exp                 []Exp                 `xml:"exp"`
+	Factorial           []Factorial           `xml:"factorial"`
+	Arg                 []Arg                 `xml:"arg"`
+	Real                []Real                `xml:"real"`
+	Imaginary           []Imaginary           `xml:"imaginary"`
+	Floor               []Floor               `xml:"floor"`
+	Ceiling             []Ceiling             `xml:"ceiling"`
+	Not                 []Not                 `xml:"not"`
+	Ln                  []Ln                  `xml:"ln"`
+	Sin                 []Sin                 `xml:"sin"`
+	Cos                 []Cos                 `xml:"cos"`
+	Tan                 []Tan                 `xml:"tan"`
+	Sec                 []Sec                 `xml:"sec"`
+	Csc                 []Csc                 `xml:"csc"`
+	Cot                 []Cot                 `xml:"cot"`
+	Sinh                []Sinh                `xml:"sinh"`
+	Cosh                []Cosh                `xml:"cosh"`
+	Tanh                []Tanh                `xml:"tanh"`
+	Sech                []Sech                `xml:"sech"`
+	Csch                []Csch                `xml:"csch"`
+	Coth                []Coth                `xml:"coth"`
+	Arcsin              []Arcsin              `xml:"arcsin"`
+	Arccos              []Arccos              `xml:"arccos"`
+	Arctan              []Arctan              `xml:"arctan"`
+	Arccosh             []Arccosh             `xml:"arccosh"`
+	Arccot              []Arccot              `xml:"arccot"`
+	Arccoth             []Arccoth             `xml:"arccoth"`
+	Arccsc              []Arccsc              `xml:"arccsc"`
+	Arccsch             []Arccsch             `xml:"arccsch"`
+	Arcsec              []Arcsec              `xml:"arcsec"`
+	Arcsech             []Arcsech             `xml:"arcsech"`
+	Arcsinh             []Arcsinh             `xml:"arcsinh"`
+	Arctanh             []Arctanh             `xml:"arctanh"`
+	Determinant         []Determinant         `xml:"determinant"`
+	Transpose           []Transpose           `xml:"transpose"`
+	Card                []Card                `xml:"card"`
+	Quotient            []Quotient            `xml:"quotient"`
+	Divide              []Divide              `xml:"divide"`
+	Power               []Power               `xml:"power"`
+	Rem                 []Rem                 `xml:"rem"`
+	Implies             []Implies             `xml:"implies"`
+	Vectorproduct       []Vectorproduct       `xml:"vectorproduct"`
+	Scalarproduct       []Scalarproduct       `xml:"scalarproduct"`
+	Outerproduct        []Outerproduct        `xml:"outerproduct"`
+	Setdiff             []Setdiff             `xml:"setdiff"`
+	Fn                  []Fn                  `xml:"fn"`
+	Compose             []Compose             `xml:"compose"`
+	Plus                []Plus                `xml:"plus"`
+	Times               []Times               `xml:"times"`
+	Max                 []Max                 `xml:"max"`
+	Min                 []Min                 `xml:"min"`
+	Gcd                 []Gcd                 `xml:"gcd"`
+	Lcm                 []Lcm                 `xml:"lcm"`
+	And                 []And                 `xml:"and"`
+	Or                  []Or                  `xml:"or"`
+	Xor                 []Xor                 `xml:"xor"`
+	Union               []Union               `xml:"union"`
+	Intersect           []Intersect           `xml:"intersect"`
+	Cartesianproduct    []Cartesianproduct    `xml:"cartesianproduct"`
+	Mean                []Mean                `xml:"mean"`
+	Sdev                []Sdev                `xml:"sdev"`
+	Variance            []Variance            `xml:"variance"`
+	Median              []Median              `xml:"median"`
+	Mode                []Mode                `xml:"mode"`
+	Selector            []Selector            `xml:"selector"`
+	Root                []Root                `xml:"root"`
+	Minus               []Minus               `xml:"minus"`
+	Log                 []Log                 `xml:"log"`
+	Int                 []Int                 `xml:"int"`
+	Diff                []Diff                `xml:"diff"`
+	Partialdiff         []Partialdiff         `xml:"partialdiff"`
+	Divergence          []Divergence          `xml:"divergence"`
+	Grad                []Grad                `xml:"grad"`
+	Curl                []Curl                `xml:"curl"`
+	Laplacian           []Laplacian           `xml:"laplacian"`
+	Sum                 []Sum                 `xml:"sum"`
+	Product             []Product             `xml:"product"`
+	Limit               []Limit               `xml:"limit"`
+	Moment              []Moment              `xml:"moment"`
+	Exists              []Exists              `xml:"exists"`
+	Forall              []Forall              `xml:"forall"`
+	Neq                 []Neq                 `xml:"neq"`
+	Factorof            []Factorof            `xml:"factorof"`
+	In                  []In                  `xml:"in"`
+	Notin               []Notin               `xml:"notin"`
+	Notsubset           []Notsubset           `xml:"notsubset"`
+	Notprsubset         []Notprsubset         `xml:"notprsubset"`
+	Tendsto             []Tendsto             `xml:"tendsto"`
+	Eq                  []Eq                  `xml:"eq"`
+	Leq                 []Leq                 `xml:"leq"`
+	Lt                  []Lt                  `xml:"lt"`
+	Geq                 []Geq                 `xml:"geq"`
+	Gt                  []Gt                  `xml:"gt"`
+	Equivalent          []Equivalent          `xml:"equivalent"`
+	Approx              []Approx              `xml:"approx"`
+	Subset              []Subset              `xml:"subset"`
+	Prsubset            []Prsubset            `xml:"prsubset"`
+	Mi                  []Mi                  `xml:"mi"`
+	Mn                  []Mn                  `xml:"mn"`
+	Mo                  []Mo                  `xml:"mo"`
+	Mtext               []Mtext               `xml:"mtext"`
+	Ms                  []Ms                  `xml:"ms"`
+	Mspace              []Mspace              `xml:"mspace"`
+	Mrow                []Mrow                `xml:"mrow"`
+	Mfrac               []Mfrac               `xml:"mfrac"`
+	Msqrt               []Msqrt               `xml:"msqrt"`
+	Mroot               []Mroot               `xml:"mroot"`
+	Menclose            []Menclose            `xml:"menclose"`
+	Mstyle              []Mstyle              `xml:"mstyle"`
+	Merror              []Merror              `xml:"merror"`
+	Mpadded             []Mpadded             `xml:"mpadded"`
+	Mphantom            []Mphantom            `xml:"mphantom"`
+	Mfenced             []Mfenced             `xml:"mfenced"`
+	Msub                []Msub                `xml:"msub"`
+	Msup                []Msup                `xml:"msup"`
+	Msubsup             []Msubsup             `xml:"msubsup"`
+	Munder              []Munder              `xml:"munder"`
+	Mover               []Mover               `xml:"mover"`
+	Munderover          []Munderover          `xml:"munderover"`
+	Mmultiscripts       []Mmultiscripts       `xml:"mmultiscripts"`
+	Mtable              []Mtable              `xml:"mtable"`
+	Mtr                 []Mtr                 `xml:"mtr"`
+	Mlabeledtr          []Mlabeledtr          `xml:"mlabeledtr"`
+	Mtd                 []Mtd                 `xml:"mtd"`
+	Maligngroup         []Maligngroup         `xml:"maligngroup"`
+	Malignmark          []Malignmark          `xml:"malignmark"`
+	Maction             []Maction             `xml:"maction"`
+}
+
+// Lambda is the element lambda
+//
+//	(csymbol | ci | cn | apply | reln | lambda | condition | declare | sep | semantics | annotation |
+//	annotation-xml | integers | reals | rationals | naturalnumbers | complexes | primes | exponentiale |
+//	imaginaryi | notanumber | true | false | emptyset | pi | eulergamma | infinity | interval | list |
+//	matrix | matrixrow | set | vector | piecewise | lowlimit | uplimit | bvar | degree | logbase |
+//	momentabout | domainofapplication | inverse | ident | domain | codomain | image | abs | conjugate |
+//	exp | factorial | arg | real | imaginary | floor | ceiling | not | ln | sin | cos | tan | sec | csc
+//	| cot | sinh | cosh | tanh | sech | csch | coth | arcsin | arccos | arctan | arccosh | arccot |
+//	arccoth | arccsc | arccsch | arcsec | arcsech | arcsinh | arctanh | determinant | transpose | card |
+//	quotient | divide | power | rem | implies | vectorproduct | scalarproduct | outerproduct | setdiff
+//	| fn | compose | plus | times | max | min | gcd | lcm | and | or | xor | union | intersect |
+//	cartesianproduct | mean | sdev | variance | median | mode | selector | root | minus | log | int |
+//	diff | partialdiff | divergence | grad | curl | laplacian | sum | product | limit | moment | exists
+//	| forall | neq | factorof | in | notin | notsubset | notprsubset | tendsto | eq | leq | lt | geq |
+//	gt | equivalent | approx | subset | prsubset | mi | mn | mo | mtext | ms | mspace | mrow | mfrac |
+//	msqrt | mroot | menclose | mstyle | merror | mpadded | mphantom | mfenced | msub | msup | msubsup |
+//	munder | mover | munderover | mmultiscripts | mtable | mtr | mlabeledtr | mtd | maligngroup |
+//	malignmark | maction)*
+type Lambda struct {
+	XlinkHref           string                `xml:"href,attr"`
+	XlinkType           string                `xml:"type,attr"`
+	Class               string                `xml:"class,attr"`
+	Style               string                `xml:"style,attr"`
+	ID                  string                `xml:"id,attr"`
+	Xref                string                `xml:"xref,attr"`
+	Other               string                `xml:"other,attr"`
+	Csymbol             []Csymbol             `xml:"csymbol"`
+	Ci                  []Ci                  `xml:"ci"`
+	Cn                  []Cn                  `xml:"cn"`
+	Apply               []Apply               `xml:"apply"`
+	Reln                []Reln                `xml:"reln"`
+	Lambda              []Lambda              `xml:"lambda"`
+	Condition           []Condition           `xml:"condition"`
+	Declare             []Declare             `xml:"declare"`
+	Sep                 []Sep                 `xml:"sep"`
+	Semantics           []Semantics           `xml:"semantics"`
+	Annotation          []Annotation          `xml:"annotation"`
+	AnnotationXml       []AnnotationXml       `xml:"annotation-xml"`
+	Integers            []Integers            `xml:"integers"`
+	Reals               []Reals               `xml:"reals"`
+	Rationals           []Rationals           `xml:"rationals"`
+	Naturalnumbers      []Naturalnumbers      `xml:"naturalnumbers"`
+	Complexes           []Complexes           `xml:"complexes"`
+	Primes              []Primes              `xml:"primes"`
+	Exponentiale        []Exponentiale        `xml:"exponentiale"`
+	Imaginaryi          []Imaginaryi          `xml:"imaginaryi"`
+	Notanumber          []Notanumber          `xml:"notanumber"`
+	True                []True                `xml:"true"`
+	False               []False               `xml:"false"`
+	Emptyset            []Emptyset            `xml:"emptyset"`
+	Pi                  []Pi                  `xml:"pi"`
+	Eulergamma          []Eulergamma          `xml:"eulergamma"`
+	Infinity            []Infinity            `xml:"infinity"`
+	Interval            []Interval            `xml:"interval"`
+	List                []List                `xml:"list"`
+	Matrix              []Matrix              `xml:"matrix"`
+	Matrixrow           []Matrixrow           `xml:"matrixrow"`
+	Set                 []Set                 `xml:"set"`
+	Vector              []Vector              `xml:"vector"`
+	Piecewise           []Piecewise           `xml:"piecewise"`
+	Lowlimit            []Lowlimit            `xml:"lowlimit"`
+	Uplimit             []Uplimit             `xml:"uplimit"`
+	Bvar                []Bvar                `xml:"bvar"`
+	Degree              []Degree              `xml:"degree"`
+	Logbase             []Logbase             `xml:"logbase"`
+	Momentabout         []Momentabout         `xml:"momentabout"`
+	Domainofapplication []Domainofapplication `xml:"domainofapplication"`
+	Inverse             []Inverse             `xml:"inverse"`
+	Ident               []Ident               `xml:"ident"`
+	Domain              []Domain              `xml:"domain"`
+	Codomain            []Codomain            `xml:"codomain"`
+	Image               []Image               `xml:"image"`
+	Abs                 []Abs                 `xml:"abs"`
+	Conjugate           []Conjugate           `xml:"conjugate"`
+	Exp                 []Exp                 `xml:"exp"`
+	Factorial           []Factorial           `xml:"factorial"`
+	Arg                 []Arg                 `xml:"arg"`
+	Real                []Real                `xml:"real"`
+	Imaginary           []Imaginary           `xml:"imaginary"`
+	Floor               []Floor               `xml:"floor"`
+	Ceiling             []Ceiling             `xml:"ceiling"`
+	Not                 []Not                 `xml:"not"`
+	Ln                  []Ln                  `xml:"ln"`
+	Sin                 []Sin                 `xml:"sin"`
+	Cos                 []Cos                 `xml:"cos"`
+	Tan                 []Tan                 `xml:"tan"`
+	Sec                 []Sec                 `xml:"sec"`
+	Csc                 []Csc                 `xml:"csc"`
+	Cot                 []Cot                 `xml:"cot"`
+	Sinh                []Sinh                `xml:"sinh"`
+	Cosh                []Cosh                `xml:"cosh"`
+	Tanh                []Tanh                `xml:"tanh"`
+	Sech                []Sech                `xml:"sech"`
+	Csch                []Csch                `xml:"csch"`
+	Coth                []Coth                `xml:"coth"`
+	Arcsin              []Arcsin              `xml:"arcsin"`
+	Arccos              []Arccos              `xml:"arccos"`
+	Arctan              []Arctan              `xml:"arctan"`
+	Arccosh             []Arccosh             `xml:"arccosh"`
+	Arccot              []Arccot              `xml:"arccot"`
+	Arccoth             []Arccoth             `xml:"arccoth"`
+	Arccsc              []Arccsc              `xml:"arccsc"`
+	Arccsch             []Arccsch             `xml:"arccsch"`
+	Arcsec              []Arcsec              `xml:"arcsec"`
+	Arcsech             []Arcsech             `xml:"arcsech"`
+	Arcsinh             []Arcsinh             `xml:"arcsinh"`
+	Arctanh             []Arctanh             `xml:"arctanh"`
+	Determinant         []Determinant         `xml:"determinant"`
+	Transpose           []Transpose           `xml:"transpose"`
+	Card                []Card                `xml:"card"`
+	Quotient            []Quotient            `xml:"quotient"`
+	Divide              []Divide              `xml:"divide"`
+	Power               []Power               `xml:"power"`
+	Rem                 []Rem                 `xml:"rem"`
+	Implies             []Implies             `xml:"implies"`
+	Vectorproduct       []Vectorproduct       `xml:"vectorproduct"`
+	Scalarproduct       []Scalarproduct       `xml:"scalarproduct"`
+	Outerproduct        []Outerproduct        `xml:"outerproduct"`
+	Setdiff             []Setdiff             `xml:"setdiff"`
+	Fn                  []Fn                  `xml:"fn"`
+	Compose             []Compose             `xml:"compose"`
+	Plus                []Plus                `xml:"plus"`
+	Times               []Times               `xml:"times"`
+	Max                 []Max                 `xml:"max"`
+	Min                 []Min                 `xml:"min"`
+	Gcd                 []Gcd                 `xml:"gcd"`
+	Lcm                 []Lcm                 `xml:"lcm"`
+	And                 []And                 `xml:"and"`
+	Or                  []Or                  `xml:"or"`
+	Xor                 []Xor                 `xml:"xor"`
+	Union               []Union               `xml:"union"`
+	Intersect           []Intersect           `xml:"intersect"`
+	Cartesianproduct    []Cartesianproduct    `xml:"cartesianproduct"`
+	Mean                []Mean                `xml:"mean"`
+	Sdev                []Sdev                `xml:"sdev"`
+	Variance            []Variance            `xml:"variance"`
+	Median              []Median              `xml:"median"`
+	Mode                []Mode                `xml:"mode"`
+	Selector            []Selector            `xml:"selector"`
+	Root                []Root                `xml:"root"`
+	Minus               []Minus               `xml:"minus"`
+	Log                 []Log                 `xml:"log"`
+	Int                 []Int                 `xml:"int"`
+	Diff                []Diff                `xml:"diff"`
+	Partialdiff         []Partialdiff         `xml:"partialdiff"`
+	Divergence          []Divergence          `xml:"divergence"`
+	Grad                []Grad                `xml:"grad"`
+	Curl                []Curl                `xml:"curl"`
+	Laplacian           []Laplacian           `xml:"laplacian"`
+	Sum                 []Sum                 `xml:"sum"`
+	Product             []Product             `xml:"product"`
+	Limit               []Limit               `xml:"limit"`
+	Moment              []Moment              `xml:"moment"`
+	Exists              []Exists              `xml:"exists"`
+	Forall              []Forall              `xml:"forall"`
+	Neq                 []Neq                 `xml:"neq"`
+	Factorof            []Factorof            `xml:"factorof"`
+	In                  []In                  `xml:"in"`
+	Notin               []Notin               `xml:"notin"`
+	Notsubset           []Notsubset           `xml:"notsubset"`
+	Notprsubset         []Notprsubset         `xml:"notprsubset"`
+	Tendsto             []Tendsto             `xml:"tendsto"`
+	Eq                  []Eq                  `xml:"eq"`
+	Leq                 []Leq                 `xml:"leq"`
+	Lt                  []Lt                  `xml:"lt"`
+	Geq                 []Geq                 `xml:"geq"`
+	Gt                  []Gt                  `xml:"gt"`
+	Equivalent          []Equivalent          `xml:"equivalent"`
+	Approx              []Approx              `xml:"approx"`
+	Subset              []Subset              `xml:"subset"`
+	Prsubset            []Prsubset            `xml:"prsubset"`
+	Mi                  []Mi                  `xml:"mi"`
+	Mn                  []Mn                  `xml:"mn"`
+	Mo                  []Mo                  `xml:"mo"`
+	Mtext               []Mtext               `xml:"mtext"`
+	Ms                  []Ms                  `xml:"ms"`
+	Mspace              []Mspace              `xml:"mspace"`
+	Mrow                []Mrow                `xml:"mrow"`
+	Mfrac               []Mfrac               `xml:"mfrac"`
+	Msqrt               []Msqrt               `xml:"msqrt"`
+	Mroot               []Mroot               `xml:"mroot"`
+	Menclose            []Menclose            `xml:"menclose"`
+	Mstyle              []Mstyle              `xml:"mstyle"`
+	Merror              []Merror              `xml:"merror"`
+	Mpadded             []Mpadded             `xml:"mpadded"`
+	Mphantom            []Mphantom            `xml:"mphantom"`
+	Mfenced             []Mfenced             `xml:"mfenced"`
+	Msub                []Msub                `xml:"msub"`
+	Msup                []Msup                `xml:"msup"`
+	Msubsup             []Msubsup             `xml:"msubsup"`
+	Munder              []Munder              `xml:"munder"`
+	Mover               []Mover               `xml:"mover"`
+	Munderover          []Munderover          `xml:"munderover"`
+	Mmultiscripts       []Mmultiscripts       `xml:"mmultiscripts"`
+	Mtable              []Mtable              `xml:"mtable"`
+	Mtr                 []Mtr                 `xml:"mtr"`
+	Mlabeledtr          []Mlabeledtr          `xml:"mlabeledtr"`
+	Mtd                 []Mtd                 `xml:"mtd"`
+	Maligngroup         []Maligngroup         `xml:"maligngroup"`
+	Malignmark          []Malignmark          `xml:"malignmark"`
+	Maction             []Maction             `xml:"maction"`
+}
+
+// Condition is the element condition
+//
+//	(csymbol | ci | cn | apply | reln | lambda | condition | declare | sep | semantics | annotation |
+//	annotation-xml | integers | reals | rationals | naturalnumbers | complexes | primes | exponentiale |
+//	imaginaryi | notanumber | true | false | emptyset | pi | eulergamma | infinity | interval | list |
+//	matrix | matrixrow | set | vector | piecewise | lowlimit | uplimit | bvar | degree | logbase |
+//	momentabout | domainofapplication | inverse | ident | domain | codomain | image | abs | conjugate |
+//	exp | factorial | arg | real | imaginary | floor | ceiling | not | ln | sin | cos | tan | sec | csc
+//	| cot | sinh | cosh | tanh | sech | csch | coth | arcsin | arccos | arctan | arccosh | arccot |
+//	arccoth | arccsc | arccsch | arcsec | arcsech | arcsinh | arctanh | determinant | transpose | card |
+//	quotient | divide | power | rem | implies | vectorproduct | scalarproduct | outerproduct | setdiff
+//	| fn | compose | plus | times | max | min | gcd | lcm | and | or | xor | union | intersect |
+//	cartesianproduct | mean | sdev | variance | median | mode | selector | root | minus | log | int |
+//	diff | partialdiff | divergence | grad | curl | laplacian | sum | product | limit | moment | exists
+//	| forall | neq | factorof | in | notin | notsubset | notprsubset | tendsto | eq | leq | lt | geq |
+//	gt | equivalent | approx | subset | prsubset | mi | mn | mo | mtext | ms | mspace | mrow | mfrac |
+//	msqrt | mroot | menclose | mstyle | merror | mpadded | mphantom | mfenced | msub | msup | msubsup |
+//	munder | mover | munderover | mmultiscripts | mtable | mtr | mlabeledtr | mtd | maligngroup |
+//	malignmark | maction)*
+type Condition struct {
+	XlinkHref           string                `xml:"href,attr"`
+	XlinkType           string                `xml:"type,attr"`
+	Class               string                `xml:"class,attr"`
+	Style               string                `xml:"style,attr"`
+	ID                  string                `xml:"id,attr"`
+	Xref                string                `xml:"xref,attr"`
+	Other               string                `xml:"other,attr"`
+	Csymbol             []Csymbol             `xml:"csymbol"`
+	Ci                  []Ci                  `xml:"ci"`
+	Cn                  []Cn                  `xml:"cn"`
+	Apply               []Apply               `xml:"apply"`
+	Reln                []Reln                `xml:"reln"`
+	Lambda              []Lambda              `xml:"lambda"`
+	Condition           []Condition           `xml:"condition"`
+	Declare             []Declare             `xml:"declare"`
+	Sep                 []Sep                 `xml:"sep"`
+	Semantics           []Semantics           `xml:"semantics"`
+	Annotation          []Annotation          `xml:"annotation"`
+	AnnotationXml       []AnnotationXml       `xml:"annotation-xml"`
+	Integers            []Integers            `xml:"integers"`
+	Reals               []Reals               `xml:"reals"`
+	Rationals           []Rationals           `xml:"rationals"`
+	Naturalnumbers      []Naturalnumbers      `xml:"naturalnumbers"`
+	Complexes           []Complexes           `xml:"complexes"`
+	Primes              []Primes              `xml:"primes"`
+	Exponentiale        []Exponentiale        `xml:"exponentiale"`
+	Imaginaryi          []Imaginaryi          `xml:"imaginaryi"`
+	Notanumber          []Notanumber          `xml:"notanumber"`
+	True                []True                `xml:"true"`
+	False               []False               `xml:"false"`
+	Emptyset            []Emptyset            `xml:"emptyset"`
+	Pi                  []Pi                  `xml:"pi"`
+	Eulergamma          []Eulergamma          `xml:"eulergamma"`
+	Infinity            []Infinity            `xml:"infinity"`
+	Interval            []Interval            `xml:"interval"`
+	List                []List                `xml:"list"`
+	Matrix              []Matrix              `xml:"matrix"`
+	Matrixrow           []Matrixrow           `xml:"matrixrow"`
+	Set                 []Set                 `xml:"set"`
+	Vector              []Vector              `xml:"vector"`
+	Piecewise           []Piecewise           `xml:"piecewise"`
+	Lowlimit            []Lowlimit            `xml:"lowlimit"`
+	Uplimit             []Uplimit             `xml:"uplimit"`
+	Bvar                []Bvar                `xml:"bvar"`
+	Degree              []Degree              `xml:"degree"`
+	Logbase             []Logbase             `xml:"logbase"`
+	Momentabout         []Momentabout         `xml:"momentabout"`
+	Domainofapplication []Domainofapplication `xml:"domainofapplication"`
+	Inverse             []Inverse             `xml:"inverse"`
+	Ident               []Ident               `xml:"ident"`
+	Domain              []Domain              `xml:"domain"`
+	Codomain            []Codomain            `xml:"codomain"`
+	Image               []Image               `xml:"image"`
+	Abs                 []Abs                 `xml:"abs"`
+	Conjugate           []Conjugate           `xml:"conjugate"`
+	Exp                 []Exp                 `xml:"exp"`
+	Factorial           []Factorial           `xml:"factorial"`
+	Arg                 []Arg                 `xml:"arg"`
+	Real                []Real                `xml:"real"`
+	Imaginary           []Imaginary           `xml:"imaginary"`
+	Floor               []Floor               `xml:"floor"`
+	Ceiling             []Ceiling             `xml:"ceiling"`
+	Not                 []Not                 `xml:"not"`
+	Ln                  []Ln                  `xml:"ln"`
+	Sin                 []Sin                 `xml:"sin"`
+	Cos                 []Cos                 `xml:"cos"`
+	Tan                 []Tan                 `xml:"tan"`
+	Sec                 []Sec                 `xml:"sec"`
+	Csc                 []Csc                 `xml:"csc"`
+	Cot                 []Cot                 `xml:"cot"`
+	Sinh                []Sinh                `xml:"sinh"`
+	Cosh                []Cosh                `xml:"cosh"`
+	Tanh                []Tanh                `xml:"tanh"`
+	Sech                []Sech                `xml:"sech"`
+	Csch                []Csch                `xml:"csch"`
+	Coth                []Coth                `xml:"coth"`
+	Arcsin              []Arcsin              `xml:"arcsin"`
+	Arccos              []Arccos              `xml:"arccos"`
+	Arctan              []Arctan              `xml:"arctan"`
+	Arccosh             []Arccosh             `xml:"arccosh"`
+	Arccot              []Arccot              `xml:"arccot"`
+	Arccoth             []Arccoth             `xml:"arccoth"`
+	Arccsc              []Arccsc              `xml:"arccsc"`
+	Arccsch             []Arccsch             `xml:"arccsch"`
+	Arcsec              []Arcsec              `xml:"arcsec"`
+	Arcsech             []Arcsech             `xml:"arcsech"`
+	Arcsinh             []Arcsinh             `xml:"arcsinh"`
+	Arctanh             []Arctanh             `xml:"arctanh"`
+	Determinant         []Determinant         `xml:"determinant"`
+	Transpose           []Transpose           `xml:"transpose"`
+	Card                []Card                `xml:"card"`
+	Quotient            []Quotient            `xml:"quotient"`
+	Divide              []Divide              `xml:"divide"`
+	Power               []Power               `xml:"power"`
+	Rem                 []Rem                 `xml:"rem"`
+	Implies             []Implies             `xml:"implies"`
+	Vectorproduct       []Vectorproduct       `xml:"vectorproduct"`
+	Scalarproduct       []Scalarproduct       `xml:"scalarproduct"`
+	Outerproduct        []Outerproduct        `xml:"outerproduct"`
+	Setdiff             []Setdiff             `xml:"setdiff"`
+	Fn                  []Fn                  `xml:"fn"`
+	Compose             []Compose             `xml:"compose"`
+	Plus                []Plus                `xml:"plus"`
+	Times               []Times               `xml:"times"`
+	Max                 []Max                 `xml:"max"`
+	Min                 []Min                 `xml:"min"`
+	Gcd                 []Gcd                 `xml:"gcd"`
+	Lcm                 []Lcm                 `xml:"lcm"`
+	And                 []And                 `xml:"and"`
+	Or                  []Or                  `xml:"or"`
+	Xor                 []Xor                 `xml:"xor"`
+	Union               []Union               `xml:"union"`
+	Intersect           []Intersect           `xml:"intersect"`
+	Cartesianproduct    []Cartesianproduct    `xml:"cartesianproduct"`
+	Mean                []Mean                `xml:"mean"`
+	Sdev                []Sdev                `xml:"sdev"`
+	Variance            []Variance            `xml:"variance"`
+	Median              []Median              `xml:"median"`
+	Mode                []Mode                `xml:"mode"`
+	Selector            []Selector            `xml:"selector"`
+	Root                []Root                `xml:"root"`
+	Minus               []Minus               `xml:"minus"`
+	Log                 []Log                 `xml:"log"`
+	Int                 []Int                 `xml:"int"`
+	Diff                []Diff                `xml:"diff"`
+	Partialdiff         []Partialdiff         `xml:"partialdiff"`
+	Divergence          []Divergence          `xml:"divergence"`
+	Grad                []Grad                `xml:"grad"`
+	Curl                []Curl                `xml:"curl"`
+	Laplacian           []Laplacian           `xml:"laplacian"`
+	Sum                 []Sum                 `xml:"sum"`
+	Product             []Product             `xml:"product"`
+	Limit               []Limit               `xml:"limit"`
+	Moment              []Moment              `xml:"moment"`
+	Exists              []Exists              `xml:"exists"`
+	Forall              []Forall              `xml:"forall"`
+	Neq                 []Neq                 `xml:"neq"`
+	Factorof            []Factorof            `xml:"factorof"`
+	In                  []In                  `xml:"in"`
+	Notin               []Notin               `xml:"notin"`
+	Notsubset           []Notsubset           `xml:"notsubset"`
+	Notprsubset         []Notprsubset         `xml:"notprsubset"`
+	Tendsto             []Tendsto             `xml:"tendsto"`
+	Eq                  []Eq                  `xml:"eq"`
+	Leq                 []Leq                 `xml:"leq"`
+	Lt                  []Lt                  `xml:"lt"`
+	Geq                 []Geq                 `xml:"geq"`
+	Gt                  []Gt                  `xml:"gt"`
+	Equivalent          []Equivalent          `xml:"equivalent"`
+	Approx              []Approx              `xml:"approx"`
+	Subset              []Subset              `xml:"subset"`
+	Prsubset            []Prsubset            `xml:"prsubset"`
+	Mi                  []Mi                  `xml:"mi"`
+	Mn                  []Mn                  `xml:"mn"`
+	Mo                  []Mo                  `xml:"mo"`
+	Mtext               []Mtext               `xml:"mtext"`
+	Ms                  []Ms                  `xml:"ms"`
+	Mspace              []Mspace              `xml:"mspace"`
+	Mrow                []Mrow                `xml:"mrow"`
+	Mfrac               []Mfrac               `xml:"mfrac"`
+	Msqrt               []Msqrt               `xml:"msqrt"`
+	Mroot               []Mroot               `xml:"mroot"`
+	Menclose            []Menclose            `xml:"menclose"`
+	Mstyle              []Mstyle              `xml:"mstyle"`
+	Merror              []Merror              `xml:"merror"`
+	Mpadded             []Mpadded             `xml:"mpadded"`
+	Mphantom            []Mphantom            `xml:"mphantom"`
+	Mfenced             []Mfenced             `xml:"mfenced"`
+	Msub                []Msub                `xml:"msub"`
+	Msup                []Msup                `xml:"msup"`
+	Msubsup             []Msubsup             `xml:"msubsup"`
+	Munder              []Munder              `xml:"munder"`
+	Mover               []Mover               `xml:"mover"`
+	Munderover          []Munderover          `xml:"munderover"`
+	Mmultiscripts       []Mmultiscripts       `xml:"mmultiscripts"`
+	Mtable              []Mtable              `xml:"mtable"`
+	Mtr                 []Mtr                 `xml:"mtr"`
+	Mlabeledtr          []Mlabeledtr          `xml:"mlabeledtr"`
+	Mtd                 []Mtd                 `xml:"mtd"`
+	Maligngroup         []Maligngroup         `xml:"maligngroup"`
+	Malignmark          []Malignmark          `xml:"malignmark"`
+	Maction             []Maction             `xml:"maction"`
+}
+
+// Declare is the element declare
+//
+//	(csymbol | ci | cn | apply | reln | lambda | condition | declare | sep | semantics | annotation |
+//	annotation-xml | integers | reals | rationals | naturalnumbers | complexes | primes | exponentiale |
+//	imaginaryi | notanumber | true | false | emptyset | pi | eulergamma | infinity | interval | list |
+//	matrix | matrixrow | set | vector | piecewise | lowlimit | uplimit | bvar | degree | logbase |
+//	momentabout | domainofapplication | inverse | ident | domain | codomain | image | abs | conjugate |
+//	exp | factorial | arg | real | imaginary | floor | ceiling | not | ln | sin | cos | tan | sec | csc
+//	| cot | sinh | cosh | tanh | sech | csch | coth | arcsin | arccos | arctan | arccosh | arccot |
+//	arccoth | arccsc | arccsch | arcsec | arcsech | arcsinh | arctanh | determinant | transpose | card |
+//	quotient | divide | power | rem | implies | vectorproduct | scalarproduct | outerproduct | setdiff
+//	| fn | compose | plus | times | max | min | gcd | lcm | and | or | xor | union | intersect |
+//	cartesianproduct | mean | sdev | variance | median | mode | selector | root | minus | log | int |
+//	diff | partialdiff | divergence | grad | curl | laplacian | sum | product | limit | moment | exists
+//	| forall | neq | factorof | in | notin | notsubset | notprsubset | tendsto | eq | leq | lt | geq |
+//	gt | equivalent | approx | subset | prsubset | mi | mn | mo | mtext | ms | mspace | mrow | mfrac |
+//	msqrt | mroot | menclose | mstyle | merror | mpadded | mphantom | mfenced | msub | msup | msubsup |
+//	munder | mover | munderover | mmultiscripts | mtable | mtr | mlabeledtr | mtd | maligngroup |
+//	malignmark | maction)*
+type Declare struct {
+	XlinkHref           string                `xml:"href,attr"`
+	XlinkType           string                `xml:"xlink type,attr"`
+	Class               string                `xml:"class,attr"`
+	Style               string                `xml:"style,attr"`
+	ID                  string                `xml:"id,attr"`
+	Xref                string                `xml:"xref,attr"`
+	Other               string                `xml:"other,attr"`
+	Type                string                `xml:"type,attr"`
+	Scope               string                `xml:"scope,attr"`
+	Nargs               string                `xml:"nargs,attr"`
+	Occurrence          string                `xml:"occurrence,attr"`
+	DefinitionURL       string                `xml:"definitionURL,attr"`
+	Encoding            string                `xml:"encoding,attr"`
+	Csymbol             []Csymbol             `xml:"csymbol"`
+	Ci                  []Ci                  `xml:"ci"`
+	Cn                  []Cn                  `xml:"cn"`
+	Apply               []Apply               `xml:"apply"`
+	Reln                []Reln                `xml:"reln"`
+	Lambda              []Lambda              `xml:"lambda"`
+	Condition           []Condition           `xml:"condition"`
+	Declare             []Declare             `xml:"declare"`
+	Sep                 []Sep                 `xml:"sep"`
+	Semantics           []Semantics           `xml:"semantics"`
+	Annotation          []Annotation          `xml:"annotation"`
+	AnnotationXml       []AnnotationXml       `xml:"annotation-xml"`
+	Integers            []Integers            `xml:"integers"`
+	Reals               []Reals               `xml:"reals"`
+	Rationals           []Rationals           `xml:"rationals"`
+	Naturalnumbers      []Naturalnumbers      `xml:"naturalnumbers"`
+	Complexes           []Complexes           `xml:"complexes"`
+	Primes              []Primes              `xml:"primes"`
+	Exponentiale        []Exponentiale        `xml:"exponentiale"`
+	Imaginaryi          []Imaginaryi          `xml:"imaginaryi"`
+	Notanumber          []Notanumber          `xml:"notanumber"`
+	True                []True                `xml:"true"`
+	False               []False               `xml:"false"`
+	Emptyset            []Emptyset            `xml:"emptyset"`
+	Pi                  []Pi                  `xml:"pi"`
+	Eulergamma          []Eulergamma          `xml:"eulergamma"`
+	Infinity            []Infinity            `xml:"infinity"`
+	Interval            []Interval            `xml:"interval"`
+	List                []List                `xml:"list"`
+	Matrix              []Matrix              `xml:"matrix"`
+	Matrixrow           []Matrixrow           `xml:"matrixrow"`
+	Set                 []Set                 `xml:"set"`
+	Vector              []Vector              `xml:"vector"`
+	Piecewise           []Piecewise           `xml:"piecewise"`
+	Lowlimit            []Lowlimit            `xml:"lowlimit"`
+	Uplimit             []Uplimit             `xml:"uplimit"`
+	Bvar                []Bvar                `xml:"bvar"`
+	Degree              []Degree              `xml:"degree"`
+	Logbase             []Logbase             `xml:"logbase"`
+	Momentabout         []Momentabout         `xml:"momentabout"`
+	Domainofapplication []Domainofapplication `xml:"domainofapplication"`
+	Inverse             []Inverse             `xml:"inverse"`
+	Ident               []Ident               `xml:"ident"`
+	Domain              []Domain              `xml:"domain"`
+	Codomain            []Codomain            `xml:"codomain"`
+	Image               []Image               `xml:"image"`
+	Abs                 []Abs                 `xml:"abs"`
+	Conjugate           []Conjugate           `xml:"conjugate"`
+	Exp                 []Exp                 `xml:"exp"`
+	Factorial           []Factorial           `xml:"factorial"`
+	Arg                 []Arg                 `xml:"arg"`
+	Real                []Real                `xml:"real"`
+	Imaginary           []Imaginary           `xml:"imaginary"`
+	Floor               []Floor               `xml:"floor"`
+	Ceiling             []Ceiling             `xml:"ceiling"`
+	Not                 []Not                 `xml:"not"`
+	Ln                  []Ln                  `xml:"ln"`
+	Sin                 []Sin                 `xml:"sin"`
+	Cos                 []Cos                 `xml:"cos"`
+	Tan                 []Tan                 `xml:"tan"`
+	Sec                 []Sec                 `xml:"sec"`
+	Csc                 []Csc                 `xml:"csc"`
+	Cot                 []Cot                 `xml:"cot"`
+	Sinh                []Sinh                `xml:"sinh"`
+	Cosh                []Cosh                `xml:"cosh"`
+	Tanh                []Tanh                `xml:"tanh"`
+	Sech                []Sech                `xml:"sech"`
+	Csch                []Csch                `xml:"csch"`
+	Coth                []Coth                `xml:"coth"`
+	Arcsin              []Arcsin              `xml:"arcsin"`
+	Arccos              []Arccos              `xml:"arccos"`
+	Arctan              []Arctan              `xml:"arctan"`
+	Arccosh             []Arccosh             `xml:"arccosh"`
+	Arccot              []Arccot              `xml:"arccot"`
+	Arccoth             []Arccoth             `xml:"arccoth"`
+	Arccsc              []Arccsc              `xml:"arccsc"`
+	Arccsch             []Arccsch             `xml:"arccsch"`
+	Arcsec              []Arcsec              `xml:"arcsec"`
+	Arcsech             []Arcsech             `xml:"arcsech"`
+	Arcsinh             []Arcsinh             `xml:"arcsinh"`
+	Arctanh             []Arctanh             `xml:"arctanh"`
+	Determinant         []Determinant         `xml:"determinant"`
+	Transpose           []Transpose           `xml:"transpose"`
+	Card                []Card                `xml:"card"`
+	Quotient            []Quotient            `xml:"quotient"`
+	Divide              []Divide              `xml:"divide"`
+	Power               []Power               `xml:"power"`
+	Rem                 []Rem                 `xml:"rem"`
+	Implies             []Implies             `xml:"implies"`
+	Vectorproduct       []Vectorproduct       `xml:"vectorproduct"`
+	Scalarproduct       []Scalarproduct       `xml:"scalarproduct"`
+	Outerproduct        []Outerproduct        `xml:"outerproduct"`
+	Setdiff             []Setdiff             `xml:"setdiff"`
+	Fn                  []Fn                  `xml:"fn"`
+	Compose             []Compose             `xml:"compose"`
+	Plus                []Plus                `xml:"plus"`
+	Times               []Times               `xml:"times"`
+	Max                 []Max                 `xml:"max"`
+	Min                 []Min                 `xml:"min"`
+	Gcd                 []Gcd                 `xml:"gcd"`
+	Lcm                 []Lcm                 `xml:"lcm"`
+	And                 []And                 `xml:"and"`
+	Or                  []Or                  `xml:"or"`
+	Xor                 []Xor                 `xml:"xor"`
+	Union               []Union               `xml:"union"`
+	Intersect           []Intersect           `xml:"intersect"`
+	Cartesianproduct    []Cartesianproduct    `xml:"cartesianproduct"`
+	Mean                []Mean                `xml:"mean"`
+	Sdev                []Sdev                `xml:"sdev"`
+	Variance            []Variance            `xml:"variance"`
+	Median              []Median              `xml:"median"`
+	Mode                []Mode                `xml:"mode"`
+	Selector            []Selector            `xml:"selector"`
+	Root                []Root                `xml:"root"`
+	Minus               []Minus               `xml:"minus"`
+	Log                 []Log                 `xml:"log"`
+	Int                 []Int                 `xml:"int"`
+	Diff                []Diff                `xml:"diff"`
+	Partialdiff         []Partialdiff         `xml:"partialdiff"`
+	Divergence          []Divergence          `xml:"divergence"`
+	Grad                []Grad                `xml:"grad"`
+	Curl                []Curl                `xml:"curl"`
+	Laplacian           []Laplacian           `xml:"laplacian"`
+	Sum                 []Sum                 `xml:"sum"`
+	Product             []Product             `xml:"product"`
+	Limit               []Limit               `xml:"limit"`
+	Moment              []Moment              `xml:"moment"`
+	Exists              []Exists              `xml:"exists"`
+	Forall              []Forall              `xml:"forall"`
+	Neq                 []Neq                 `xml:"neq"`
+	Factorof            []Factorof            `xml:"factorof"`
+	In                  []In                  `xml:"in"`
+	Notin               []Notin               `xml:"notin"`
+	Notsubset           []Notsubset           `xml:"notsubset"`
+	Notprsubset         []Notprsubset         `xml:"notprsubset"`
+	Tendsto             []Tendsto             `xml:"tendsto"`
+	Eq                  []Eq                  `xml:"eq"`
+	Leq                 []Leq                 `xml:"leq"`
+	Lt                  []Lt                  `xml:"lt"`
+	Geq                 []Geq                 `xml:"geq"`
+	Gt                  []Gt                  `xml:"gt"`
+	Equivalent          []Equivalent          `xml:"equivalent"`
+	Approx              []Approx              `xml:"approx"`
+	Subset              []Subset              `xml:"subset"`
+	Prsubset            []Prsubset            `xml:"prsubset"`
+	Mi                  []Mi                  `xml:"mi"`
+	Mn                  []Mn                  `xml:"mn"`
+	Mo                  []Mo                  `xml:"mo"`
+	Mtext               []Mtext               `xml:"mtext"`
+	Ms                  []Ms                  `xml:"ms"`
+	Mspace              []Mspace              `xml:"mspace"`
+	Mrow                []Mrow                `xml:"mrow"`
+	Mfrac               []Mfrac               `xml:"mfrac"`
+	Msqrt               []Msqrt               `xml:"msqrt"`
+	Mroot               []Mroot               `xml:"mroot"`
+	Menclose            []Menclose            `xml:"menclose"`
+	Mstyle              []Mstyle              `xml:"mstyle"`
+	Merror              []Merror              `xml:"merror"`
+	Mpadded             []Mpadded             `xml:"mpadded"`
+	Mphantom            []Mphantom            `xml:"mphantom"`
+	Mfenced             []Mfenced             `xml:"mfenced"`
+	Msub                []Msub                `xml:"msub"`
+	Msup                []Msup                `xml:"msup"`
+	Msubsup             []Msubsup             `xml:"msubsup"`
+	Munder              []Munder              `xml:"munder"`
+	Mover               []Mover               `xml:"mover"`
+	Munderover          []Munderover          `xml:"munderover"`
+	Mmultiscripts       []Mmultiscripts       `xml:"mmultiscripts"`
+	Mtable              []Mtable              `xml:"mtable"`
+	Mtr                 []Mtr                 `xml:"mtr"`
+	Mlabeledtr          []Mlabeledtr          `xml:"mlabeledtr"`
+	Mtd                 []Mtd                 `xml:"mtd"`
+	Maligngroup         []Maligngroup         `xml:"maligngroup"`
+	Malignmark          []Malignmark          `xml:"malignmark"`
+	Maction             []Maction             `xml:"maction"`
+}
+
+// Semantics is the element semantics
+//
+//	(csymbol | ci | cn | apply | reln | lambda | condition | declare | sep | semantics | annotation |
+//	annotation-xml | integers | reals | rationals | naturalnumbers | complexes | primes | exponentiale |
+//	imaginaryi | notanumber | true | false | emptyset | pi | eulergamma | infinity | interval | list |
+//	matrix | matrixrow | set | vector | piecewise | lowlimit | uplimit | bvar | degree | logbase |
+//	momentabout | domainofapplication | inverse | ident | domain | codomain | image | abs | conjugate |
+//	exp | factorial | arg | real | imaginary | floor | ceiling | not | ln | sin | cos | tan | sec | csc
+//	| cot | sinh | cosh | tanh | sech | csch | coth | arcsin | arccos | arctan | arccosh | arccot |
+//	arccoth | arccsc | arccsch | arcsec | arcsech | arcsinh | arctanh | determinant | transpose | card |
+//	quotient | divide | power | rem | implies | vectorproduct | scalarproduct | outerproduct | setdiff
+//	| fn | compose | plus | times | max | min | gcd | lcm | and | or | xor | union | intersect |
+//	cartesianproduct | mean | sdev | variance | median | mode | selector | root | minus | log | int |
+//	diff | partialdiff | divergence | grad | curl | laplacian | sum | product | limit | moment | exists
+//	| forall | neq | factorof | in | notin | notsubset | notprsubset | tendsto | eq | leq | lt | geq |
+//	gt | equivalent | approx | subset | prsubset | mi | mn | mo | mtext | ms | mspace | mrow | mfrac |
+//	msqrt | mroot | menclose | mstyle | merror | mpadded | mphantom | mfenced | msub | msup | msubsup |
+//	munder | mover | munderover | mmultiscripts | mtable | mtr | mlabeledtr | mtd | maligngroup |
+//	malignmark | maction)*
+type Semantics struct {
+	XlinkHref           string                `xml:"href,attr"`
+	XlinkType           string                `xml:"type,attr"`
+	Class               string                `xml:"class,attr"`
+	Style               string                `xml:"style,attr"`
+	ID                  string                `xml:"id,attr"`
+	Xref                string                `xml:"xref,attr"`
+	Other               string                `xml:"other,attr"`
+	DefinitionURL       string                `xml:"definitionURL,attr"`
+	Encoding            string                `xml:"encoding,attr"`
+	Csymbol             []Csymbol             `xml:"csymbol"`
+	Ci                  []Ci                  `xml:"ci"`
+	Cn                  []Cn                  `xml:"cn"`
+	Apply               []Apply               `xml:"apply"`
+	Reln                []Reln                `xml:"reln"`
+	Lambda              []Lambda              `xml:"lambda"`
+	Condition           []Condition           `xml:"condition"`
+	Declare             []Declare             `xml:"declare"`
+	Sep                 []Sep                 `xml:"sep"`
+	Semantics           []Semantics           `xml:"semantics"`
+	Annotation          []Annotation          `xml:"annotation"`
+	AnnotationXml       []AnnotationXml       `xml:"annotation-xml"`
+	Integers            []Integers            `xml:"integers"`
+	Reals               []Reals               `xml:"reals"`
+	Rationals           []Rationals           `xml:"rationals"`
+	Naturalnumbers      []Naturalnumbers      `xml:"naturalnumbers"`
+	Complexes           []Complexes           `xml:"complexes"`
+	Primes              []Primes              `xml:"primes"`
+	Exponentiale        []Exponentiale        `xml:"exponentiale"`
+	Imaginaryi          []Imaginaryi          `xml:"imaginaryi"`
+	Notanumber          []Notanumber          `xml:"notanumber"`
+	True                []True                `xml:"true"`
+	False               []False               `xml:"false"`
+	Emptyset            []Emptyset            `xml:"emptyset"`
+	Pi                  []Pi                  `xml:"pi"`
+	Eulergamma          []Eulergamma          `xml:"eulergamma"`
+	Infinity            []Infinity            `xml:"infinity"`
+	Interval            []Interval            `xml:"interval"`
+	List                []List                `xml:"list"`
+	Matrix              []Matrix              `xml:"matrix"`
+	Matrixrow           []Matrixrow           `xml:"matrixrow"`
+	Set                 []Set                 `xml:"set"`
+	Vector              []Vector              `xml:"vector"`
+	Piecewise           []Piecewise           `xml:"piecewise"`
+	Lowlimit            []Lowlimit            `xml:"lowlimit"`
+	Uplimit             []Uplimit             `xml:"uplimit"`
+	Bvar                []Bvar                `xml:"bvar"`
+	Degree              []Degree              `xml:"degree"`
+	Logbase             []Logbase             `xml:"logbase"`
+	Momentabout         []Momentabout         `xml:"momentabout"`
+	Domainofapplication []Domainofapplication `xml:"domainofapplication"`
+	Inverse             []Inverse             `xml:"inverse"`
+	Ident               []Ident               `xml:"ident"`
+	Domain              []Domain              `xml:"domain"`
+	Codomain            []Codomain            `xml:"codomain"`
+	Image               []Image               `xml:"image"`
+	Abs                 []Abs                 `xml:"abs"`
+	Conjugate           []Conjugate           `xml:"conjugate"`
+	Exp                 []Exp                 `xml:"exp"`
+	Factorial           []Factorial           `xml:"factorial"`
+	Arg                 []Arg                 `xml:"arg"`
+	Real                []Real                `xml:"real"`
+	Imaginary           []Imaginary           `xml:"imaginary"`
+	Floor               []Floor               `xml:"floor"`
+	Ceiling             []Ceiling             `xml:"ceiling"`
+	Not                 []Not                 `xml:"not"`
+	Ln                  []Ln                  `xml:"ln"`
+	Sin                 []Sin                 `xml:"sin"`
+	Cos                 []Cos                 `xml:"cos"`
+	Tan                 []Tan                 `xml:"tan"`
+	Sec                 []Sec                 `xml:"sec"`
+	Csc                 []Csc                 `xml:"csc"`
+	Cot                 []Cot                 `xml:"cot"`
+	Sinh                []Sinh                `xml:"sinh"`
+	Cosh                []Cosh                `xml:"cosh"`
+	Tanh                []Tanh                `xml:"tanh"`
+	Sech                []Sech                `xml:"sech"`
+	Csch                []Csch                `xml:"csch"`
+	Coth                []Coth                `xml:"coth"`
+	Arcsin              []Arcsin              `xml:"arcsin"`
+	Arccos              []Arccos              `xml:"arccos"`
+	Arctan              []Arctan              `xml:"arctan"`
+	Arccosh             []Arccosh             `xml:"arccosh"`
+	Arccot              []Arccot              `xml:"arccot"`
+	Arccoth             []Arccoth             `xml:"arccoth"`
+	Arccsc              []Arccsc              `xml:"arccsc"`
+	Arccsch             []Arccsch             `xml:"arccsch"`
+	Arcsec              []Arcsec              `xml:"arcsec"`
+	Arcsech             []Arcsech             `xml:"arcsech"`
+	Arcsinh             []Arcsinh             `xml:"arcsinh"`
+	Arctanh             []Arctanh             `xml:"arctanh"`
+	Determinant         []Determinant         `xml:"determinant"`
+	Transpose           []Transpose           `xml:"transpose"`
+	Card                []Card                `xml:"card"`
+	Quotient            []Quotient            `xml:"quotient"`
+	Divide              []Divide              `xml:"divide"`
+	Power               []Power               `xml:"power"`
+	Rem                 []Rem                 `xml:"rem"`
+	Implies             []Implies             `xml:"implies"`
+	Vectorproduct       []Vectorproduct       `xml:"vectorproduct"`
+	Scalarproduct       []Scalarproduct       `xml:"scalarproduct"`
+	Outerproduct        []Outerproduct        `xml:"outerproduct"`
+	Setdiff             []Setdiff             `xml:"setdiff"`
+	Fn                  []Fn                  `xml:"fn"`
+	Compose             []Compose             `xml:"compose"`
+	Plus                []Plus                `xml:"plus"`
+	Times               []Times               `xml:"times"`
+	Max                 []Max                 `xml:"max"`
+	Min                 []Min                 `xml:"min"`
+	Gcd                 []Gcd                 `xml:"gcd"`
+	Lcm                 []Lcm                 `xml:"lcm"`
+	And                 []And                 `xml:"and"`
+	Or                  []Or                  `xml:"or"`
+	Xor                 []Xor                 `xml:"xor"`
+	Union               []Union               `xml:"union"`
+	Intersect           []Intersect           `xml:"intersect"`
+	Cartesianproduct    []Cartesianproduct    `xml:"cartesianproduct"`
+	Mean                []Mean                `xml:"mean"`
+	Sdev                []Sdev                `xml:"sdev"`
+	Variance            []Variance            `xml:"variance"`
+	Median              []Median              `xml:"median"`
+	Mode                []Mode                `xml:"mode"`
+	Selector            []Selector            `xml:"selector"`
+	Root                []Root                `xml:"root"`
+	Minus               []Minus               `xml:"minus"`
+	Log                 []Log                 `xml:"log"`
+	Int                 []Int                 `xml:"int"`
+	Diff                []Diff                `xml:"diff"`
+	Partialdiff         []Partialdiff         `xml:"partialdiff"`
+	Divergence          []Divergence          `xml:"divergence"`
+	Grad                []Grad                `xml:"grad"`
+	Curl                []Curl                `xml:"curl"`
+	Laplacian           []Laplacian           `xml:"laplacian"`
+	Sum                 []Sum                 `xml:"sum"`
+	Product             []Product             `xml:"product"`
+	Limit               []Limit               `xml:"limit"`
+	Moment              []Moment              `xml:"moment"`
+	Exists              []Exists              `xml:"exists"`
+	Forall              []Forall              `xml:"forall"`
+	Neq                 []Neq                 `xml:"neq"`
+	Factorof            []Factorof            `xml:"factorof"`
+	In                  []In                  `xml:"in"`
+	Notin               []Notin               `xml:"notin"`
+	Notsubset           []Notsubset           `xml:"notsubset"`
+	Notprsubset         []Notprsubset         `xml:"notprsubset"`
+	Tendsto             []Tendsto             `xml:"tendsto"`
+	Eq                  []Eq                  `xml:"eq"`
+	Leq                 []Leq                 `xml:"leq"`
+	Lt                  []Lt                  `xml:"lt"`
+	Geq                 []Geq                 `xml:"geq"`
+	Gt                  []Gt                  `xml:"gt"`
+	Equivalent          []Equivalent          `xml:"equivalent"`
+	Approx              []Approx              `xml:"approx"`
+	Subset              []Subset              `xml:"subset"`
+	Prsubset            []Prsubset            `xml:"prsubset"`
+	Mi                  []Mi                  `xml:"mi"`
+	Mn                  []Mn                  `xml:"mn"`
+	Mo                  []Mo                  `xml:"mo"`
+	Mtext               []Mtext               `xml:"mtext"`
+	Ms                  []Ms                  `xml:"ms"`
+	Mspace              []Mspace              `xml:"mspace"`
+	Mrow                []Mrow                `xml:"mrow"`
+	Mfrac               []Mfrac               `xml:"mfrac"`
+	Msqrt               []Msqrt               `xml:"msqrt"`
+	Mroot               []Mroot               `xml:"mroot"`
+	Menclose            []Menclose            `xml:"menclose"`
+	Mstyle              []Mstyle              `xml:"mstyle"`
+	Merror              []Merror              `xml:"merror"`
+	Mpadded             []Mpadded             `xml:"mpadded"`
+	Mphantom            []Mphantom            `xml:"mphantom"`
+	Mfenced             []Mfenced             `xml:"mfenced"`
+	Msub                []Msub                `xml:"msub"`
+	Msup                []Msup                `xml:"msup"`
+	Msubsup             []Msubsup             `xml:"msubsup"`
+	Munder              []Munder              `xml:"munder"`
+	Mover               []Mover               `xml:"mover"`
+	Munderover          []Munderover          `xml:"munderover"`
+	Mmultiscripts       []Mmultiscripts       `xml:"mmultiscripts"`
+	Mtable              []Mtable              `xml:"mtable"`
+	Mtr                 []Mtr                 `xml:"mtr"`
+	Mlabeledtr          []Mlabeledtr          `xml:"mlabeledtr"`
+	Mtd                 []Mtd                 `xml:"mtd"`
+	Maligngroup         []Maligngroup         `xml:"maligngroup"`
+	Malignmark          []Malignmark          `xml:"malignmark"`
+	Maction             []Maction             `xml:"maction"`
+}
+
+// Annotation is the element annotation
+//
+//	(#PCDATA)
+type Annotation struct {
+	Text      string `xml:",chardata"`
+	XlinkHref string `xml:"href,attr"`
+	XlinkType string `xml:"type,attr"`
+	Class     string `xml:"class,attr"`
+	Style     string `xml:"style,attr"`
+	ID        string `xml:"id,attr"`
+	Xref      string `xml:"xref,attr"`
+	Other     string `xml:"other,attr"`
+	Encoding  string `xml:"encoding,attr"`
+}
+
+// AnnotationXml is the element annotation-xml
+//
+//	ANY
+type AnnotationXml struct {
+	InnerXML  string `xml:",innerxml"`
+	XlinkHref string `xml:"href,attr"`
+	XlinkType string `xml:"type,attr"`
+	Class     string `xml:"class,attr"`
+	Style     string `xml:"style,attr"`
+	ID        string `xml:"id,attr"`
+	Xref      string `xml:"xref,attr"`
+	Other     string `xml:"other,attr"`
+	Encoding  string `xml:"encoding,attr"`
+}
+
+// Interval is the element interval
+//
+//	(csymbol | ci | cn | apply | reln | lambda | condition | declare | sep | semantics | annotation |
+//	annotation-xml | integers | reals | rationals | naturalnumbers | complexes | primes | exponentiale |
+//	imaginaryi | notanumber | true | false | emptyset | pi | eulergamma | infinity | interval | list |
+//	matrix | matrixrow | set | vector | piecewise | lowlimit | uplimit | bvar | degree | logbase |
+//	momentabout | domainofapplication | inverse | ident | domain | codomain | image | abs | conjugate |
+//	exp | factorial | arg | real | imaginary | floor | ceiling | not | ln | sin | cos | tan | sec | csc
+//	| cot | sinh | cosh | tanh | sech | csch | coth | arcsin | arccos | arctan | arccosh | arccot |
+//	arccoth | arccsc | arccsch | arcsec | arcsech | arcsinh | arctanh | determinant | transpose | card |
+//	quotient | divide | power | rem | implies | vectorproduct | scalarproduct | outerproduct | setdiff
+//	| fn | compose | plus | times | max | min | gcd | lcm | and | or | xor | union | intersect |
+//	cartesianproduct | mean | sdev | variance | median | mode | selector | root | minus | log | int |
+//	diff | partialdiff | divergence | grad | curl | laplacian | sum | product | limit | moment | exists
+//	| forall | neq | factorof | in | notin | notsubset | notprsubset | tendsto | eq | leq | lt | geq |
+//	gt | equivalent | approx | subset | prsubset | mi | mn | mo | mtext | ms | mspace | mrow | mfrac |
+//	msqrt | mroot | menclose | mstyle | merror | mpadded | mphantom | mfenced | msub | msup | msubsup |
+//	munder | mover | munderover | mmultiscripts | mtable | mtr | mlabeledtr | mtd | maligngroup |
+//	malignmark | maction)*
+type Interval struct {
+	XlinkHref           string                `xml:"href,attr"`
+	XlinkType           string                `xml:"type,attr"`
+	Class               string                `xml:"class,attr"`
+	Style               string                `xml:"style,attr"`
+	ID                  string                `xml:"id,attr"`
+	Xref                string                `xml:"xref,attr"`
+	Other               string                `xml:"other,attr"`
+	Closure             string                `xml:"closure,attr"`
+	Csymbol             []Csymbol             `xml:"csymbol"`
+	Ci                  []Ci                  `xml:"ci"`
+	Cn                  []Cn                  `xml:"cn"`
+	Apply               []Apply               `xml:"apply"`
+	Reln                []Reln                `xml:"reln"`
+	Lambda              []Lambda              `xml:"lambda"`
+	Condition           []Condition           `xml:"condition"`
+	Declare             []Declare             `xml:"declare"`
+	Sep                 []Sep                 `xml:"sep"`
+	Semantics           []Semantics           `xml:"semantics"`
+	Annotation          []Annotation          `xml:"annotation"`
+	AnnotationXml       []AnnotationXml       `xml:"annotation-xml"`
+	Integers            []Integers            `xml:"integers"`
+	Reals               []Reals               `xml:"reals"`
+	Rationals           []Rationals           `xml:"rationals"`
+	Naturalnumbers      []Naturalnumbers      `xml:"naturalnumbers"`
+	Complexes           []Complexes           `xml:"complexes"`
+	Primes              []Primes              `xml:"primes"`
+	Exponentiale        []Exponentiale        `xml:"exponentiale"`
+	Imaginaryi          []Imaginaryi          `xml:"imaginaryi"`
+	Notanumber          []Notanumber          `xml:"notanumber"`
+	True                []True                `xml:"true"`
+	False               []False               `xml:"false"`
+	Emptyset            []Emptyset            `xml:"emptyset"`
+	Pi                  []Pi                  `xml:"pi"`
+	Eulergamma          []Eulergamma          `xml:"eulergamma"`
+	Infinity            []Infinity            `xml:"infinity"`
+	Interval            []Interval            `xml:"interval"`
+	List                []List                `xml:"list"`
+	Matrix              []Matrix              `xml:"matrix"`
+	Matrixrow           []Matrixrow           `xml:"matrixrow"`
+	Set                 []Set                 `xml:"set"`
+	Vector              []Vector              `xml:"vector"`
+	Piecewise           []Piecewise           `xml:"piecewise"`
+	Lowlimit            []Lowlimit            `xml:"lowlimit"`
+	Uplimit             []Uplimit             `xml:"uplimit"`
+	Bvar                []Bvar                `xml:"bvar"`
+	Degree              []Degree              `xml:"degree"`
+	Logbase             []Logbase             `xml:"logbase"`
+	Momentabout         []Momentabout         `xml:"momentabout"`
+	Domainofapplication []Domainofapplication `xml:"domainofapplication"`
+	Inverse             []Inverse             `xml:"inverse"`
+	Ident               []Ident               `xml:"ident"`
+	Domain              []Domain              `xml:"domain"`
+	Codomain            []Codomain            `xml:"codomain"`
+	Image               []Image               `xml:"image"`
+	Abs                 []Abs                 `xml:"abs"`
+	Conjugate           []Conjugate           `xml:"conjugate"`
+	Exp                 []Exp                 `xml:"exp"`
+	Factorial           []Factorial           `xml:"factorial"`
+	Arg                 []Arg                 `xml:"arg"`
+	Real                []Real                `xml:"real"`
+	Imaginary           []Imaginary           `xml:"imaginary"`
+	Floor               []Floor               `xml:"floor"`
+	Ceiling             []Ceiling             `xml:"ceiling"`
+	Not                 []Not                 `xml:"not"`
+	Ln                  []Ln                  `xml:"ln"`
+	Sin                 []Sin                 `xml:"sin"`
+	Cos                 []Cos                 `xml:"cos"`
+	Tan                 []Tan                 `xml:"tan"`
+	Sec                 []Sec                 `xml:"sec"`
+	Csc                 []Csc                 `xml:"csc"`
+	Cot                 []Cot                 `xml:"cot"`
+	Sinh                []Sinh                `xml:"sinh"`
+	Cosh                []Cosh                `xml:"cosh"`
+	Tanh                []Tanh                `xml:"tanh"`
+	Sech                []Sech                `xml:"sech"`
+	Csch                []Csch                `xml:"csch"`
+	Coth                []Coth                `xml:"coth"`
+	Arcsin              []Arcsin              `xml:"arcsin"`
+	Arccos              []Arccos              `xml:"arccos"`
+	Arctan              []Arctan              `xml:"arctan"`
+	Arccosh             []Arccosh             `xml:"arccosh"`
+	Arccot              []Arccot              `xml:"arccot"`
+	Arccoth             []Arccoth             `xml:"arccoth"`
+	Arccsc              []Arccsc              `xml:"arccsc"`
+	Arccsch             []Arccsch             `xml:"arccsch"`
+	Arcsec              []Arcsec              `xml:"arcsec"`
+	Arcsech             []Arcsech             `xml:"arcsech"`
+	Arcsinh             []Arcsinh             `xml:"arcsinh"`
+	Arctanh             []Arctanh             `xml:"arctanh"`
+	Determinant         []Determinant         `xml:"determinant"`
+	Transpose           []Transpose           `xml:"transpose"`
+	Card                []Card                `xml:"card"`
+	Quotient            []Quotient            `xml:"quotient"`
+	Divide              []Divide              `xml:"divide"`
+	Power               []Power               `xml:"power"`
+	Rem                 []Rem                 `xml:"rem"`
+	Implies             []Implies             `xml:"implies"`
+	Vectorproduct       []Vectorproduct       `xml:"vectorproduct"`
+	Scalarproduct       []Scalarproduct       `xml:"scalarproduct"`
+	Outerproduct        []Outerproduct        `xml:"outerproduct"`
+	Setdiff             []Setdiff             `xml:"setdiff"`
+	Fn                  []Fn                  `xml:"fn"`
+	Compose             []Compose             `xml:"compose"`
+	Plus                []Plus                `xml:"plus"`
+	Times               []Times               `xml:"times"`
+	Max                 []Max                 `xml:"max"`
+	Min                 []Min                 `xml:"min"`
+	Gcd                 []Gcd                 `xml:"gcd"`
+	Lcm                 []Lcm                 `xml:"lcm"`
+	And                 []And                 `xml:"and"`
+	Or                  []Or                  `xml:"or"`
+	Xor                 []Xor                 `xml:"xor"`
+	Union               []Union               `xml:"union"`
+	Intersect           []Intersect           `xml:"intersect"`
+	Cartesianproduct    []Cartesianproduct    `xml:"cartesianproduct"`
+	Mean                []Mean                `xml:"mean"`
+	Sdev                []Sdev                `xml:"sdev"`
+	Variance            []Variance            `xml:"variance"`
+	Median              []Median              `xml:"median"`
+	Mode                []Mode                `xml:"mode"`
+	Selector            []Selector            `xml:"selector"`
+	Root                []Root                `xml:"root"`
+	Minus               []Minus               `xml:"minus"`
+	Log                 []Log                 `xml:"log"`
+	Int                 []Int                 `xml:"int"`
+	Diff                []Diff                `xml:"diff"`
+	Partialdiff         []Partialdiff         `xml:"partialdiff"`
+	Divergence          []Divergence          `xml:"divergence"`
+	Grad                []Grad                `xml:"grad"`
+	Curl                []Curl                `xml:"curl"`
+	Laplacian           []Laplacian           `xml:"laplacian"`
+	Sum                 []Sum                 `xml:"sum"`
+	Product             []Product             `xml:"product"`
+	Limit               []Limit               `xml:"limit"`
+	Moment              []Moment              `xml:"moment"`
+	Exists              []Exists              `xml:"exists"`
+	Forall              []Forall              `xml:"forall"`
+	Neq                 []Neq                 `xml:"neq"`
+	Factorof            []Factorof            `xml:"factorof"`
+	In                  []In                  `xml:"in"`
+	Notin               []Notin               `xml:"notin"`
+	Notsubset           []Notsubset           `xml:"notsubset"`
+	Notprsubset         []Notprsubset         `xml:"notprsubset"`
+	Tendsto             []Tendsto             `xml:"tendsto"`
+	Eq                  []Eq                  `xml:"eq"`
+	Leq                 []Leq                 `xml:"leq"`
+	Lt                  []Lt                  `xml:"lt"`
+	Geq                 []Geq                 `xml:"geq"`
+	Gt                  []Gt                  `xml:"gt"`
+	Equivalent          []Equivalent          `xml:"equivalent"`
+	Approx              []Approx              `xml:"approx"`
+	Subset              []Subset              `xml:"subset"`
+	Prsubset            []Prsubset            `xml:"prsubset"`
+	Mi                  []Mi                  `xml:"mi"`
+	Mn                  []Mn                  `xml:"mn"`
+	Mo                  []Mo                  `xml:"mo"`
+	Mtext               []Mtext               `xml:"mtext"`
+	Ms                  []Ms                  `xml:"ms"`
+	Mspace              []Mspace              `xml:"mspace"`
+	Mrow                []Mrow                `xml:"mrow"`
+	Mfrac               []Mfrac               `xml:"mfrac"`
+	Msqrt               []Msqrt               `xml:"msqrt"`
+	Mroot               []Mroot               `xml:"mroot"`
+	Menclose            []Menclose            `xml:"menclose"`
+	Mstyle              []Mstyle              `xml:"mstyle"`
+	Merror              []Merror              `xml:"merror"`
+	Mpadded             []Mpadded             `xml:"mpadded"`
+	Mphantom            []Mphantom            `xml:"mphantom"`
+	Mfenced             []Mfenced             `xml:"mfenced"`
+	Msub                []Msub                `xml:"msub"`
+	Msup                []Msup                `xml:"msup"`
+	Msubsup             []Msubsup             `xml:"msubsup"`
+	Munder              []Munder              `xml:"munder"`
+	Mover               []Mover               `xml:"mover"`
+	Munderover          []Munderover          `xml:"munderover"`
+	Mmultiscripts       []Mmultiscripts       `xml:"mmultiscripts"`
+	Mtable              []Mtable              `xml:"mtable"`
+	Mtr                 []Mtr                 `xml:"mtr"`
+	Mlabeledtr          []Mlabeledtr          `xml:"mlabeledtr"`
+	Mtd                 []Mtd                 `xml:"mtd"`
+	Maligngroup         []Maligngroup         `xml:"maligngroup"`
+	Malignmark          []Malignmark          `xml:"malignmark"`
+	Maction             []Maction             `xml:"maction"`
+}
+
+// List is the element list
+//
+//	(csymbol | ci | cn | apply | reln | lambda | condition | declare | sep | semantics | annotation |
+//	annotation-xml | integers | reals | rationals | naturalnumbers | complexes | primes | exponentiale |
+//	imaginaryi | notanumber | true | false | emptyset | pi | eulergamma | infinity | interval | list |
+//	matrix | matrixrow | set | vector | piecewise | lowlimit | uplimit | bvar | degree | logbase |
+//	momentabout | domainofapplication | inverse | ident | domain | codomain | image | abs | conjugate |
+//	exp | factorial | arg | real | imaginary | floor | ceiling | not | ln | sin | cos | tan | sec | csc
+//	| cot | sinh | cosh | tanh | sech | csch | coth | arcsin | arccos | arctan | arccosh | arccot |
+//	arccoth | arccsc | arccsch | arcsec | arcsech | arcsinh | arctanh | determinant | transpose | card |
+//	quotient | divide | power | rem | implies | vectorproduct | scalarproduct | outerproduct | setdiff
+//	| fn | compose | plus | times | max | min | gcd | lcm | and | or | xor | union | intersect |
+//	cartesianproduct | mean | sdev | variance | median | mode | selector | root | minus | log | int |
+//	diff | partialdiff | divergence | grad | curl | laplacian | sum | product | limit | moment | exists
+//	| forall | neq | factorof | in | notin | notsubset | notprsubset | tendsto | eq | leq | lt | geq |
+//	gt | equivalent | approx | subset | prsubset | mi | mn | mo | mtext | ms | mspace | mrow | mfrac |
+//	msqrt | mroot | menclose | mstyle | merror | mpadded | mphantom | mfenced | msub | msup | msubsup |
+//	munder | mover | munderover | mmultiscripts | mtable | mtr | mlabeledtr | mtd | maligngroup |
+//	malignmark | maction)*
+type List struct {
+	XlinkHref           string                `xml:"href,attr"`
+	XlinkType           string                `xml:"type,attr"`
+	Class               string                `xml:"class,attr"`
+	Style               string                `xml:"style,attr"`
+	ID                  string                `xml:"id,attr"`
+	Xref                string                `xml:"xref,attr"`
+	Other               string                `xml:"other,attr"`
+	Order               string                `xml:"order,attr"`
+	Csymbol             []Csymbol             `xml:"csymbol"`
+	Ci                  []Ci                  `xml:"ci"`
+	Cn                  []Cn                  `xml:"cn"`
+	Apply               []Apply               `xml:"apply"`
+	Reln                []Reln                `xml:"reln"`
+	Lambda              []Lambda              `xml:"lambda"`
+	Condition           []Condition           `xml:"condition"`
+	Declare             []Declare             `xml:"declare"`
+	Sep                 []Sep                 `xml:"sep"`
+	Semantics           []Semantics           `xml:"semantics"`
+	Annotation          []Annotation          `xml:"annotation"`
+	AnnotationXml       []AnnotationXml       `xml:"annotation-xml"`
+	Integers            []Integers            `xml:"integers"`
+	Reals               []Reals               `xml:"reals"`
+	Rationals           []Rationals           `xml:"rationals"`
+	Naturalnumbers      []Naturalnumbers      `xml:"naturalnumbers"`
+	Complexes           []Complexes           `xml:"complexes"`
+	Primes              []Primes              `xml:"primes"`
+	Exponentiale        []Exponentiale        `xml:"exponentiale"`
+	Imaginaryi          []Imaginaryi          `xml:"imaginaryi"`
+	Notanumber          []Notanumber          `xml:"notanumber"`
+	True                []True                `xml:"true"`
+	False               []False               `xml:"false"`
+	Emptyset            []Emptyset            `xml:"emptyset"`
+	Pi                  []Pi                  `xml:"pi"`
+	Eulergamma          []Eulergamma          `xml:"eulergamma"`
+	Infinity            []Infinity            `xml:"infinity"`
+	Interval            []Interval            `xml:"interval"`
+	List                []List                `xml:"list"`
+	Matrix              []Matrix              `xml:"matrix"`
+	Matrixrow           []Matrixrow           `xml:"matrixrow"`
+	Set                 []Set                 `xml:"set"`
+	Vector              []Vector              `xml:"vector"`
+	Piecewise           []Piecewise           `xml:"piecewise"`
+	Lowlimit            []Lowlimit            `xml:"lowlimit"`
+	Uplimit             []Uplimit             `xml:"uplimit"`
+	Bvar                []Bvar                `xml:"bvar"`
+	Degree              []Degree              `xml:"degree"`
+	Logbase             []Logbase             `xml:"logbase"`
+	Momentabout         []Momentabout         `xml:"momentabout"`
+	Domainofapplication []Domainofapplication `xml:"domainofapplication"`
+	Inverse             []Inverse             `xml:"inverse"`
+	Ident               []Ident               `xml:"ident"`
+	Domain              []Domain              `xml:"domain"`
+	Codomain            []Codomain            `xml:"codomain"`
+	Image               []Image               `xml:"image"`
+	Abs                 []Abs                 `xml:"abs"`
+	Conjugate           []Conjugate           `xml:"conjugate"`
+	Exp                 []Exp                 `xml:"exp"`
+	Factorial           []Factorial           `xml:"factorial"`
+	Arg                 []Arg                 `xml:"arg"`
+	Real                []Real                `xml:"real"`
+	Imaginary           []Imaginary           `xml:"imaginary"`
+	Floor               []Floor               `xml:"floor"`
+	Ceiling             []Ceiling             `xml:"ceiling"`
+	Not                 []Not                 `xml:"not"`
+	Ln                  []Ln                  `xml:"ln"`
+	Sin                 []Sin                 `xml:"sin"`
+	Cos                 []Cos                 `xml:"cos"`
+	Tan                 []Tan                 `xml:"tan"`
+	Sec                 []Sec                 `xml:"sec"`
+	Csc                 []Csc                 `xml:"csc"`
+	Cot                 []Cot                 `xml:"cot"`
+	Sinh                []Sinh                `xml:"sinh"`
+	Cosh                []Cosh                `xml:"cosh"`
+	Tanh                []Tanh                `xml:"tanh"`
+	Sech                []Sech                `xml:"sech"`
+	Csch                []Csch                `xml:"csch"`
+	Coth                []Coth                `xml:"coth"`
+	Arcsin              []Arcsin              `xml:"arcsin"`
+	Arccos              []Arccos              `xml:"arccos"`
+	Arctan              []Arctan              `xml:"arctan"`
+	Arccosh             []Arccosh             `xml:"arccosh"`
+	Arccot              []Arccot              `xml:"arccot"`
+	Arccoth             []Arccoth             `xml:"arccoth"`
+	Arccsc              []Arccsc              `xml:"arccsc"`
+	Arccsch             []Arccsch             `xml:"arccsch"`
+	Arcsec              []Arcsec              `xml:"arcsec"`
+	Arcsech             []Arcsech             `xml:"arcsech"`
+	Arcsinh             []Arcsinh             `xml:"arcsinh"`
+	Arctanh             []Arctanh             `xml:"arctanh"`
+	Determinant         []Determinant         `xml:"determinant"`
+	Transpose           []Transpose           `xml:"transpose"`
+	Card                []Card                `xml:"card"`
+	Quotient            []Quotient            `xml:"quotient"`
+	Divide              []Divide              `xml:"divide"`
+	Power               []Power               `xml:"power"`
+	Rem                 []Rem                 `xml:"rem"`
+	Implies             []Implies             `xml:"implies"`
+	Vectorproduct       []Vectorproduct       `xml:"vectorproduct"`
+	Scalarproduct       []Scalarproduct       `xml:"scalarproduct"`
+	Outerproduct        []Outerproduct        `xml:"outerproduct"`
+	Setdiff             []Setdiff             `xml:"setdiff"`
+	Fn                  []Fn                  `xml:"fn"`
+	Compose             []Compose             `xml:"compose"`
+	Plus                []Plus                `xml:"plus"`
+	Times               []Times               `xml:"times"`
+	Max                 []Max                 `xml:"max"`
+	Min                 []Min                 `xml:"min"`
+	Gcd                 []Gcd                 `xml:"gcd"`
+	Lcm                 []Lcm                 `xml:"lcm"`
+	And                 []And                 `xml:"and"`
+	Or                  []Or                  `xml:"or"`
+	Xor                 []Xor                 `xml:"xor"`
+	Union               []Union               `xml:"union"`
+	Intersect           []Intersect           `xml:"intersect"`
+	Cartesianproduct    []Cartesianproduct    `xml:"cartesianproduct"`
+	Mean                []Mean                `xml:"mean"`
+	Sdev                []Sdev                `xml:"sdev"`
+	Variance            []Variance            `xml:"variance"`
+	Median              []Median              `xml:"median"`
+	Mode                []Mode                `xml:"mode"`
+	Selector            []Selector            `xml:"selector"`
+	Root                []Root                `xml:"root"`
+	Minus               []Minus               `xml:"minus"`
+	Log                 []Log                 `xml:"log"`
+	Int                 []Int                 `xml:"int"`
+	Diff                []Diff                `xml:"diff"`
+	Partialdiff         []Partialdiff         `xml:"partialdiff"`
+	Divergence          []Divergence          `xml:"divergence"`
+	Grad                []Grad                `xml:"grad"`
+	Curl                []Curl                `xml:"curl"`
+	Laplacian           []Laplacian           `xml:"laplacian"`
+	Sum                 []Sum                 `xml:"sum"`
+	Product             []Product             `xml:"product"`
+	Limit               []Limit               `xml:"limit"`
+	Moment              []Moment              `xml:"moment"`
+	Exists              []Exists              `xml:"exists"`
+	Forall              []Forall              `xml:"forall"`
+	Neq                 []Neq                 `xml:"neq"`
+	Factorof            []Factorof            `xml:"factorof"`
+	In                  []In                  `xml:"in"`
+	Notin               []Notin               `xml:"notin"`
+	Notsubset           []Notsubset           `xml:"notsubset"`
+	Notprsubset         []Notprsubset         `xml:"notprsubset"`
+	Tendsto             []Tendsto             `xml:"tendsto"`
+	Eq                  []Eq                  `xml:"eq"`
+	Leq                 []Leq                 `xml:"leq"`
+	Lt                  []Lt                  `xml:"lt"`
+	Geq                 []Geq                 `xml:"geq"`
+	Gt                  []Gt                  `xml:"gt"`
+	Equivalent          []Equivalent          `xml:"equivalent"`
+	Approx              []Approx              `xml:"approx"`
+	Subset              []Subset              `xml:"subset"`
+	Prsubset            []Prsubset            `xml:"prsubset"`
+	Mi                  []Mi                  `xml:"mi"`
+	Mn                  []Mn                  `xml:"mn"`
+	Mo                  []Mo                  `xml:"mo"`
+	Mtext               []Mtext               `xml:"mtext"`
+	Ms                  []Ms                  `xml:"ms"`
+	Mspace              []Mspace              `xml:"mspace"`
+	Mrow                []Mrow                `xml:"mrow"`
+	Mfrac               []Mfrac               `xml:"mfrac"`
+	Msqrt               []Msqrt               `xml:"msqrt"`
+	Mroot               []Mroot               `xml:"mroot"`
+	Menclose            []Menclose            `xml:"menclose"`
+	Mstyle              []Mstyle              `xml:"mstyle"`
+	Merror              []Merror              `xml:"merror"`
+	Mpadded             []Mpadded             `xml:"mpadded"`
+	Mphantom            []Mphantom            `xml:"mphantom"`
+	Mfenced             []Mfenced             `xml:"mfenced"`
+	Msub                []Msub                `xml:"msub"`
+	Msup                []Msup                `xml:"msup"`
+	Msubsup             []Msubsup             `xml:"msubsup"`
+	Munder              []Munder              `xml:"munder"`
+	Mover               []Mover               `xml:"mover"`
+	Munderover          []Munderover          `xml:"munderover"`
+	Mmultiscripts       []Mmultiscripts       `xml:"mmultiscripts"`
+	Mtable              []Mtable              `xml:"mtable"`
+	Mtr                 []Mtr                 `xml:"mtr"`
+	Mlabeledtr          []Mlabeledtr          `xml:"mlabeledtr"`
+	Mtd                 []Mtd                 `xml:"mtd"`
+	Maligngroup         []Maligngroup         `xml:"maligngroup"`
+	Malignmark          []Malignmark          `xml:"malignmark"`
+	Maction             []Maction             `xml:"maction"`
+}
+
+// Matrix is the element matrix
+//
+//	(csymbol | ci | cn | apply | reln | lambda | condition | declare | sep | semantics | annotation |
+//	annotation-xml | integers | reals | rationals | naturalnumbers | complexes | primes | exponentiale |
+//	imaginaryi | notanumber | true | false | emptyset | pi | eulergamma | infinity | interval | list |
+//	matrix | matrixrow | set | vector | piecewise | lowlimit | uplimit | bvar | degree | logbase |
+//	momentabout | domainofapplication | inverse | ident | domain | codomain | image | abs | conjugate |
+//	exp | factorial | arg | real | imaginary | floor | ceiling | not | ln | sin | cos | tan | sec | csc
+//	| cot | sinh | cosh | tanh | sech | csch | coth | arcsin | arccos | arctan | arccosh | arccot |
+//	arccoth | arccsc | arccsch | arcsec | arcsech | arcsinh | arctanh | determinant | transpose | card |
+//	quotient | divide | power | rem | implies | vectorproduct | scalarproduct | outerproduct | setdiff
+//	| fn | compose | plus | times | max | min | gcd | lcm | and | or | xor | union | intersect |
+//	cartesianproduct | mean | sdev | variance | median | mode | selector | root | minus | log | int |
+//	diff | partialdiff | divergence | grad | curl | laplacian | sum | product | limit | moment | exists
+//	| forall | neq | factorof | in | notin | notsubset | notprsubset | tendsto | eq | leq | lt | geq |
+//	gt | equivalent | approx | subset | prsubset | mi | mn | mo | mtext | ms | mspace | mrow | mfrac |
+//	msqrt | mroot | menclose | mstyle | merror | mpadded | mphantom | mfenced | msub | msup | msubsup |
+//	munder | mover | munderover | mmultiscripts | mtable | mtr | mlabeledtr | mtd | maligngroup |
+//	malignmark | maction)*
+type Matrix struct {
+	XlinkHref           string                `xml:"href,attr"`
+	XlinkType           string                `xml:"type,attr"`
+	Class               string                `xml:"class,attr"`
+	Style               string                `xml:"style,attr"`
+	ID                  string                `xml:"id,attr"`
+	Xref                string                `xml:"xref,attr"`
+	Other               string                `xml:"other,attr"`
+	Csymbol             []Csymbol             `xml:"csymbol"`
+	Ci                  []Ci                  `xml:"ci"`
+	Cn                  []Cn                  `xml:"cn"`
+	Apply               []Apply               `xml:"apply"`
+	Reln                []Reln                `xml:"reln"`
+	Lambda              []Lambda              `xml:"lambda"`
+	Condition           []Condition           `xml:"condition"`
+	Declare             []Declare             `xml:"declare"`
+	Sep                 []Sep                 `xml:"sep"`
+	Semantics           []Semantics           `xml:"semantics"`
+	Annotation          []Annotation          `xml:"annotation"`
+	AnnotationXml       []AnnotationXml       `xml:"annotation-xml"`
+	Integers            []Integers            `xml:"integers"`
+	Reals               []Reals               `xml:"reals"`
+	Rationals           []Rationals           `xml:"rationals"`
+	Naturalnumbers      []Naturalnumbers      `xml:"naturalnumbers"`
+	Complexes           []Complexes           `xml:"complexes"`
+	Primes              []Primes              `xml:"primes"`
+	Exponentiale        []Exponentiale        `xml:"exponentiale"`
+	Imaginaryi          []Imaginaryi          `xml:"imaginaryi"`
+	Notanumber          []Notanumber          `xml:"notanumber"`
+	True                []True                `xml:"true"`
+	False               []False               `xml:"false"`
+	Emptyset            []Emptyset            `xml:"emptyset"`
+	Pi                  []Pi                  `xml:"pi"`
+	Eulergamma          []Eulergamma          `xml:"eulergamma"`
+	Infinity            []Infinity            `xml:"infinity"`
+	Interval            []Interval            `xml:"interval"`
+	List                []List                `xml:"list"`
+	Matrix              []Matrix              `xml:"matrix"`
+	Matrixrow           []Matrixrow           `xml:"matrixrow"`
+	Set                 []Set                 `xml:"set"`
+	Vector              []Vector              `xml:"vector"`
+	Piecewise           []Piecewise           `xml:"piecewise"`
+	Lowlimit            []Lowlimit            `xml:"lowlimit"`
+	Uplimit             []Uplimit             `xml:"uplimit"`
+	Bvar                []Bvar                `xml:"bvar"`
+	Degree              []Degree              `xml:"degree"`
+	Logbase             []Logbase             `xml:"logbase"`
+	Momentabout         []Momentabout         `xml:"momentabout"`
+	Domainofapplication []Domainofapplication `xml:"domainofapplication"`
+	Inverse             []Inverse             `xml:"inverse"`
+	Ident               []Ident               `xml:"ident"`
+	Domain              []Domain              `xml:"domain"`
+	Codomain            []Codomain            `xml:"codomain"`
+	Image               []Image               `xml:"image"`
+	Abs                 []Abs                 `xml:"abs"`
+	Conjugate           []Conjugate           `xml:"conjugate"`
+	Exp                 []Exp                 `xml:"exp"`
+	Factorial           []Factorial           `xml:"factorial"`
+	Arg                 []Arg                 `xml:"arg"`
+	Real                []Real                `xml:"real"`
+	Imaginary           []Imaginary           `xml:"imaginary"`
+	Floor               []Floor               `xml:"floor"`
+	Ceiling             []Ceiling             `xml:"ceiling"`
+	Not                 []Not                 `xml:"not"`
+	Ln                  []Ln                  `xml:"ln"`
+	Sin                 []Sin                 `xml:"sin"`
+	Cos                 []Cos                 `xml:"cos"`
+	Tan                 []Tan                 `xml:"tan"`
+	Sec                 []Sec                 `xml:"sec"`
+	Csc                 []Csc                 `xml:"csc"`
+	Cot                 []Cot                 `xml:"cot"`
+	Sinh                []Sinh                `xml:"sinh"`
+	Cosh                []Cosh                `xml:"cosh"`
+	Tanh                []Tanh                `xml:"tanh"`
+	Sech                []Sech                `xml:"sech"`
+	Csch                []Csch                `xml:"csch"`
+	Coth                []Coth                `xml:"coth"`
+	Arcsin              []Arcsin              `xml:"arcsin"`
+	Arccos              []Arccos              `xml:"arccos"`
+	Arctan              []Arctan              `xml:"arctan"`
+	Arccosh             []Arccosh             `xml:"arccosh"`
+	Arccot              []Arccot              `xml:"arccot"`
+	Arccoth             []Arccoth             `xml:"arccoth"`
+	Arccsc              []Arccsc              `xml:"arccsc"`
+	Arccsch             []Arccsch             `xml:"arccsch"`
+	Arcsec              []Arcsec              `xml:"arcsec"`
+	Arcsech             []Arcsech             `xml:"arcsech"`
+	Arcsinh             []Arcsinh             `xml:"arcsinh"`
+	Arctanh             []Arctanh             `xml:"arctanh"`
+	Determinant         []Determinant         `xml:"determinant"`
+	Transpose           []Transpose           `xml:"transpose"`
+	Card                []Card                `xml:"card"`
+	Quotient            []Quotient            `xml:"quotient"`
+	Divide              []Divide              `xml:"divide"`
+	Power               []Power               `xml:"power"`
+	Rem                 []Rem                 `xml:"rem"`
+	Implies             []Implies             `xml:"implies"`
+	Vectorproduct       []Vectorproduct       `xml:"vectorproduct"`
+	Scalarproduct       []Scalarproduct       `xml:"scalarproduct"`
+	Outerproduct        []Outerproduct        `xml:"outerproduct"`
+	Setdiff             []Setdiff             `xml:"setdiff"`
+	Fn                  []Fn                  `xml:"fn"`
+	Compose             []Compose             `xml:"compose"`
+	Plus                []Plus                `xml:"plus"`
+	Times               []Times               `xml:"times"`
+	Max                 []Max                 `xml:"max"`
+	Min                 []Min                 `xml:"min"`
+	Gcd                 []Gcd                 `xml:"gcd"`
+	Lcm                 []Lcm                 `xml:"lcm"`
+	And                 []And                 `xml:"and"`
+	Or                  []Or                  `xml:"or"`
+	Xor                 []Xor                 `xml:"xor"`
+	Union               []Union               `xml:"union"`
+	Intersect           []Intersect           `xml:"intersect"`
+	Cartesianproduct    []Cartesianproduct    `xml:"cartesianproduct"`
+	Mean                []Mean                `xml:"mean"`
+	Sdev                []Sdev                `xml:"sdev"`
+	Variance            []Variance            `xml:"variance"`
+	Median              []Median              `xml:"median"`
+	Mode                []Mode                `xml:"mode"`
+	Selector            []Selector            `xml:"selector"`
+	Root                []Root                `xml:"root"`
+	Minus               []Minus               `xml:"minus"`
+	Log                 []Log                 `xml:"log"`
+	Int                 []Int                 `xml:"int"`
+	Diff                []Diff                `xml:"diff"`
+	Partialdiff         []Partialdiff         `xml:"partialdiff"`
+	Divergence          []Divergence          `xml:"divergence"`
+	Grad                []Grad                `xml:"grad"`
+	Curl                []Curl                `xml:"curl"`
+	Laplacian           []Laplacian           `xml:"laplacian"`
+	Sum                 []Sum                 `xml:"sum"`
+	Product             []Product             `xml:"product"`
+	Limit               []Limit               `xml:"limit"`
+	Moment              []Moment              `xml:"moment"`
+	Exists              []Exists              `xml:"exists"`
+	Forall              []Forall              `xml:"forall"`
+	Neq                 []Neq                 `xml:"neq"`
+	Factorof            []Factorof            `xml:"factorof"`
+	In                  []In                  `xml:"in"`
+	Notin               []Notin               `xml:"notin"`
+	Notsubset           []Notsubset           `xml:"notsubset"`
+	Notprsubset         []Notprsubset         `xml:"notprsubset"`
+	Tendsto             []Tendsto             `xml:"tendsto"`
+	Eq                  []Eq                  `xml:"eq"`
+	Leq                 []Leq                 `xml:"leq"`
+	Lt                  []Lt                  `xml:"lt"`
+	Geq                 []Geq                 `xml:"geq"`
+	Gt                  []Gt                  `xml:"gt"`
+	Equivalent          []Equivalent          `xml:"equivalent"`
+	Approx              []Approx              `xml:"approx"`
+	Subset              []Subset              `xml:"subset"`
+	Prsubset            []Prsubset            `xml:"prsubset"`
+	Mi                  []Mi                  `xml:"mi"`
+	Mn                  []Mn                  `xml:"mn"`
+	Mo                  []Mo                  `xml:"mo"`
+	Mtext               []Mtext               `xml:"mtext"`
+	Ms                  []Ms                  `xml:"ms"`
+	Mspace              []Mspace              `xml:"mspace"`
+	Mrow                []Mrow                `xml:"mrow"`
+	Mfrac               []Mfrac               `xml:"mfrac"`
+	Msqrt               []Msqrt               `xml:"msqrt"`
+	Mroot               []Mroot               `xml:"mroot"`
+	Menclose            []Menclose            `xml:"menclose"`
+	Mstyle              []Mstyle              `xml:"mstyle"`
+	Merror              []Merror              `xml:"merror"`
+	Mpadded             []Mpadded             `xml:"mpadded"`
+	Mphantom            []Mphantom            `xml:"mphantom"`
+	Mfenced             []Mfenced             `xml:"mfenced"`
+	Msub                []Msub                `xml:"msub"`
+	Msup                []Msup                `xml:"msup"`
+	Msubsup             []Msubsup             `xml:"msubsup"`
+	Munder              []Munder              `xml:"munder"`
+	Mover               []Mover               `xml:"mover"`
+	Munderover          []Munderover          `xml:"munderover"`
+	Mmultiscripts       []Mmultiscripts       `xml:"mmultiscripts"`
+	Mtable              []Mtable              `xml:"mtable"`
+	Mtr                 []Mtr                 `xml:"mtr"`
+	Mlabeledtr          []Mlabeledtr          `xml:"mlabeledtr"`
+	Mtd                 []Mtd                 `xml:"mtd"`
+	Maligngroup         []Maligngroup         `xml:"maligngroup"`
+	Malignmark          []Malignmark          `xml:"malignmark"`
+	Maction             []Maction             `xml:"maction"`
+}
+
+// Matrixrow is the element matrixrow
+//
+//	(csymbol | ci | cn | apply | reln | lambda | condition | declare | sep | semantics | annotation |
+//	annotation-xml | integers | reals | rationals | naturalnumbers | complexes | primes | exponentiale |
+//	imaginaryi | notanumber | true | false | emptyset | pi | eulergamma | infinity | interval | list |
+//	matrix | matrixrow | set | vector | piecewise | lowlimit | uplimit | bvar | degree | logbase |
+//	momentabout | domainofapplication | inverse | ident | domain | codomain | image | abs | conjugate |
+//	exp | factorial | arg | real | imaginary | floor | ceiling | not | ln | sin | cos | tan | sec | csc
+//	| cot | sinh | cosh | tanh | sech | csch | coth | arcsin | arccos | arctan | arccosh | arccot |
+//	arccoth | arccsc | arccsch | arcsec | arcsech | arcsinh | arctanh | determinant | transpose | card |
+//	quotient | divide | power | rem | implies | vectorproduct | scalarproduct | outerproduct | setdiff
+//	| fn | compose | plus | times | max | min | gcd | lcm | and | or | xor | union | intersect |
+//	cartesianproduct | mean | sdev | variance | median | mode | selector | root | minus | log | int |
+//	diff | partialdiff | divergence | grad | curl | laplacian | sum | product | limit | moment | exists
+//	| forall | neq | factorof | in | notin | notsubset | notprsubset | tendsto | eq | leq | lt | geq |
+//	gt | equivalent | approx | subset | prsubset | mi | mn | mo | mtext | ms | mspace | mrow | mfrac |
+//	msqrt | mroot | menclose | mstyle | merror | mpadded | mphantom | mfenced | msub | msup | msubsup |
+//	munder | mover | munderover | mmultiscripts | mtable | mtr | mlabeledtr | mtd | maligngroup |
+//	malignmark | maction)*
+type Matrixrow struct {
+	XlinkHref           string                `xml:"href,attr"`
+	XlinkType           string                `xml:"type,attr"`
+	Class               string                `xml:"class,attr"`
+	Style               string                `xml:"style,attr"`
+	ID                  string                `xml:"id,attr"`
+	Xref                string                `xml:"xref,attr"`
+	Other               string                `xml:"other,attr"`
+	Csymbol             []Csymbol             `xml:"csymbol"`
+	Ci                  []Ci                  `xml:"ci"`
+	Cn                  []Cn                  `xml:"cn"`
+	Apply               []Apply               `xml:"apply"`
+	Reln                []Reln                `xml:"reln"`
+	Lambda              []Lambda              `xml:"lambda"`
+	Condition           []Condition           `xml:"condition"`
+	Declare             []Declare             `xml:"declare"`
+	Sep                 []Sep                 `xml:"sep"`
+	Semantics           []Semantics           `xml:"semantics"`
+	Annotation          []Annotation          `xml:"annotation"`
+	AnnotationXml       []AnnotationXml       `xml:"annotation-xml"`
+	Integers            []Integers            `xml:"integers"`
+	Reals               []Reals               `xml:"reals"`
+	Rationals           []Rationals           `xml:"rationals"`
+	Naturalnumbers      []Naturalnumbers      `xml:"naturalnumbers"`
+	Complexes           []Complexes           `xml:"complexes"`
+	Primes              []Primes              `xml:"primes"`
+	Exponentiale        []Exponentiale        `xml:"exponentiale"`
+	Imaginaryi          []Imaginaryi          `xml:"imaginaryi"`
+	Notanumber          []Notanumber          `xml:"notanumber"`
+	True                []True                `xml:"true"`
+	False               []False               `xml:"false"`
+	Emptyset            []Emptyset            `xml:"emptyset"`
+	Pi                  []Pi                  `xml:"pi"`
+	Eulergamma          []Eulergamma          `xml:"eulergamma"`
+	Infinity            []Infinity            `xml:"infinity"`
+	Interval            []Interval            `xml:"interval"`
+	List                []List                `xml:"list"`
+	Matrix              []Matrix              `xml:"matrix"`
+	Matrixrow           []Matrixrow           `xml:"matrixrow"`
+	Set                 []Set                 `xml:"set"`
+	Vector              []Vector              `xml:"vector"`
+	Piecewise           []Piecewise           `xml:"piecewise"`
+	Lowlimit            []Lowlimit            `xml:"lowlimit"`
+	Uplimit             []Uplimit             `xml:"uplimit"`
+	Bvar                []Bvar                `xml:"bvar"`
+	Degree              []Degree              `xml:"degree"`
+	Logbase             []Logbase             `xml:"logbase"`
+	Momentabout         []Momentabout         `xml:"momentabout"`
+	Domainofapplication []Domainofapplication `xml:"domainofapplication"`
+	Inverse             []Inverse             `xml:"inverse"`
+	Ident               []Ident               `xml:"ident"`
+	Domain              []Domain              `xml:"domain"`
+	Codomain            []Codomain            `xml:"codomain"`
+	Image               []Image               `xml:"image"`
+	Abs                 []Abs                 `xml:"abs"`
+	Conjugate           []Conjugate           `xml:"conjugate"`
+	Exp                 []Exp                 `xml:"exp"`
+	Factorial           []Factorial           `xml:"factorial"`
+	Arg                 []Arg                 `xml:"arg"`
+	Real                []Real                `xml:"real"`
+	Imaginary           []Imaginary           `xml:"imaginary"`
+	Floor               []Floor               `xml:"floor"`
+	Ceiling             []Ceiling             `xml:"ceiling"`
+	Not                 []Not                 `xml:"not"`
+	Ln                  []Ln                  `xml:"ln"`
+	Sin                 []Sin                 `xml:"sin"`
+	Cos                 []Cos                 `xml:"cos"`
+	Tan                 []Tan                 `xml:"tan"`
+	Sec                 []Sec                 `xml:"sec"`
+	Csc                 []Csc                 `xml:"csc"`
+	Cot                 []Cot                 `xml:"cot"`
+	Sinh                []Sinh                `xml:"sinh"`
+	Cosh                []Cosh                `xml:"cosh"`
+	Tanh                []Tanh                `xml:"tanh"`
+	Sech                []Sech                `xml:"sech"`
+	Csch                []Csch                `xml:"csch"`
+	Coth                []Coth                `xml:"coth"`
+	Arcsin              []Arcsin              `xml:"arcsin"`
+	Arccos              []Arccos              `xml:"arccos"`
+	Arctan              []Arctan              `xml:"arctan"`
+	Arccosh             []Arccosh             `xml:"arccosh"`
+	Arccot              []Arccot              `xml:"arccot"`
+	Arccoth             []Arccoth             `xml:"arccoth"`
+	Arccsc              []Arccsc              `xml:"arccsc"`
+	Arccsch             []Arccsch             `xml:"arccsch"`
+	Arcsec              []Arcsec              `xml:"arcsec"`
+	Arcsech             []Arcsech             `xml:"arcsech"`
+	Arcsinh             []Arcsinh             `xml:"arcsinh"`
+	Arctanh             []Arctanh             `xml:"arctanh"`
+	Determinant         []Determinant         `xml:"determinant"`
+	Transpose           []Transpose           `xml:"transpose"`
+	Card                []Card                `xml:"card"`
+	Quotient            []Quotient            `xml:"quotient"`
+	Divide              []Divide              `xml:"divide"`
+	Power               []Power               `xml:"power"`
+	Rem                 []Rem                 `xml:"rem"`
+	Implies             []Implies             `xml:"implies"`
+	Vectorproduct       []Vectorproduct       `xml:"vectorproduct"`
+	Scalarproduct       []Scalarproduct       `xml:"scalarproduct"`
+	Outerproduct        []Outerproduct        `xml:"outerproduct"`
+	Setdiff             []Setdiff             `xml:"setdiff"`
+	Fn                  []Fn                  `xml:"fn"`
+	Compose             []Compose             `xml:"compose"`
+	Plus                []Plus                `xml:"plus"`
+	Times               []Times               `xml:"times"`
+	Max                 []Max                 `xml:"max"`
+	Min                 []Min                 `xml:"min"`
+	Gcd                 []Gcd                 `xml:"gcd"`
+	Lcm                 []Lcm                 `xml:"lcm"`
+	And                 []And                 `xml:"and"`
+	Or                  []Or                  `xml:"or"`
+	Xor                 []Xor                 `xml:"xor"`
+	Union               []Union               `xml:"union"`
+	Intersect           []Intersect           `xml:"intersect"`
+	Cartesianproduct    []Cartesianproduct    `xml:"cartesianproduct"`
+	Mean                []Mean                `xml:"mean"`
+	Sdev                []Sdev                `xml:"sdev"`
+	Variance            []Variance            `xml:"variance"`
+	Median              []Median              `xml:"median"`
+	Mode                []Mode                `xml:"mode"`
+	Selector            []Selector            `xml:"selector"`
+	Root                []Root                `xml:"root"`
+	Minus               []Minus               `xml:"minus"`
+	Log                 []Log                 `xml:"log"`
+	Int                 []Int                 `xml:"int"`
+	Diff                []Diff                `xml:"diff"`
+	Partialdiff         []Partialdiff         `xml:"partialdiff"`
+	Divergence          []Divergence          `xml:"divergence"`
+	Grad                []Grad                `xml:"grad"`
+	Curl                []Curl                `xml:"curl"`
+	Laplacian           []Laplacian           `xml:"laplacian"`
+	Sum                 []Sum                 `xml:"sum"`
+	Product             []Product             `xml:"product"`
+	Limit               []Limit               `xml:"limit"`
+	Moment              []Moment              `xml:"moment"`
+	Exists              []Exists              `xml:"exists"`
+	Forall              []Forall              `xml:"forall"`
+	Neq                 []Neq                 `xml:"neq"`
+	Factorof            []Factorof            `xml:"factorof"`
+	In                  []In                  `xml:"in"`
+	Notin               []Notin               `xml:"notin"`
+	Notsubset           []Notsubset           `xml:"notsubset"`
+	Notprsubset         []Notprsubset         `xml:"notprsubset"`
+	Tendsto             []Tendsto             `xml:"tendsto"`
+	Eq                  []Eq                  `xml:"eq"`
+	Leq                 []Leq                 `xml:"leq"`
+	Lt                  []Lt                  `xml:"lt"`
+	Geq                 []Geq                 `xml:"geq"`
+	Gt                  []Gt                  `xml:"gt"`
+	Equivalent          []Equivalent          `xml:"equivalent"`
+	Approx              []Approx              `xml:"approx"`
+	Subset              []Subset              `xml:"subset"`
+	Prsubset            []Prsubset            `xml:"prsubset"`
+	Mi                  []Mi                  `xml:"mi"`
+	Mn                  []Mn                  `xml:"mn"`
+	Mo                  []Mo                  `xml:"mo"`
+	Mtext               []Mtext               `xml:"mtext"`
+	Ms                  []Ms                  `xml:"ms"`
+	Mspace              []Mspace              `xml:"mspace"`
+	Mrow                []Mrow                `xml:"mrow"`
+	Mfrac               []Mfrac               `xml:"mfrac"`
+	Msqrt               []Msqrt               `xml:"msqrt"`
+	Mroot               []Mroot               `xml:"mroot"`
+	Menclose            []Menclose            `xml:"menclose"`
+	Mstyle              []Mstyle              `xml:"mstyle"`
+	Merror              []Merror              `xml:"merror"`
+	Mpadded             []Mpadded             `xml:"mpadded"`
+	Mphantom            []Mphantom            `xml:"mphantom"`
+	Mfenced             []Mfenced             `xml:"mfenced"`
+	Msub                []Msub                `xml:"msub"`
+	Msup                []Msup                `xml:"msup"`
+	Msubsup             []Msubsup             `xml:"msubsup"`
+	Munder              []Munder              `xml:"munder"`
+	Mover               []Mover               `xml:"mover"`
+	Munderover          []Munderover          `xml:"munderover"`
+	Mmultiscripts       []Mmultiscripts       `xml:"mmultiscripts"`
+	Mtable              []Mtable              `xml:"mtable"`
+	Mtr                 []Mtr                 `xml:"mtr"`
+	Mlabeledtr          []Mlabeledtr          `xml:"mlabeledtr"`
+	Mtd                 []Mtd                 `xml:"mtd"`
+	Maligngroup         []Maligngroup         `xml:"maligngroup"`
+	Malignmark          []Malignmark          `xml:"malignmark"`
+	Maction             []Maction             `xml:"maction"`
+}
+
+// Set is the element set
+//
+//	(csymbol | ci | cn | apply | reln | lambda | condition | declare | sep | semantics | annotation |
+//	annotation-xml | integers | reals | rationals | naturalnumbers | complexes | primes | exponentiale |
+//	imaginaryi | notanumber | true | false | emptyset | pi | eulergamma | infinity | interval | list |
+//	matrix | matrixrow | set | vector | piecewise | lowlimit | uplimit | bvar | degree | logbase |
+//	momentabout | domainofapplication | inverse | ident | domain | codomain | image | abs | conjugate |
+//	exp | factorial | arg | real | imaginary | floor | ceiling | not | ln | sin | cos | tan | sec | csc
+//	| cot | sinh | cosh | tanh | sech | csch | coth | arcsin | arccos | arctan | arccosh | arccot |
+//	arccoth | arccsc | arccsch | arcsec | arcsech | arcsinh | arctanh | determinant | transpose | card |
+//	quotient | divide | power | rem | implies | vectorproduct | scalarproduct | outerproduct | setdiff
+//	| fn | compose | plus | times | max | min | gcd | lcm | and | or | xor | union | intersect |
+//	cartesianproduct | mean | sdev | variance | median | mode | selector | root | minus | log | int |
+//	diff | partialdiff | divergence | grad | curl | laplacian | sum | product | limit | moment | exists
+//	| forall | neq | factorof | in | notin | notsubset | notprsubset | tendsto | eq | leq | lt | geq |
+//	gt | equivalent | approx | subset | prsubset | mi | mn | mo | mtext | ms | mspace | mrow | mfrac |
+//	msqrt | mroot | menclose | mstyle | merror | mpadded | mphantom | mfenced | msub | msup | msubsup |
+//	munder | mover | munderover | mmultiscripts | mtable | mtr | mlabeledtr | mtd | maligngroup |
+//	malignmark | maction)*
+type Set struct {
+	XlinkHref           string                `xml:"href,attr"`
+	XlinkType           string                `xml:"xlink type,attr"`
+	Class               string                `xml:"class,attr"`
+	Style               string                `xml:"style,attr"`
+	ID                  string                `xml:"id,attr"`
+	Xref                string                `xml:"xref,attr"`
+	Other               string                `xml:"other,attr"`
+	Type                string                `xml:"type,attr"`
+	Csymbol             []Csymbol             `xml:"csymbol"`
+	Ci                  []Ci                  `xml:"ci"`
+	Cn                  []Cn                  `xml:"cn"`
+	Apply               []Apply               `xml:"apply"`
+	Reln                []Reln                `xml:"reln"`
+	Lambda              []Lambda              `xml:"lambda"`
+	Condition           []Condition           `xml:"condition"`
+	Declare             []Declare             `xml:"declare"`
+	Sep                 []Sep                 `xml:"sep"`
+	Semantics           []Semantics           `xml:"semantics"`
+	Annotation          []Annotation          `xml:"annotation"`
+	AnnotationXml       []AnnotationXml       `xml:"annotation-xml"`
+	Integers            []Integers            `xml:"integers"`
+	Reals               []Reals               `xml:"reals"`
+	Rationals           []Rationals           `xml:"rationals"`
+	Naturalnumbers      []Naturalnumbers      `xml:"naturalnumbers"`
+	Complexes           []Complexes           `xml:"complexes"`
+	Primes              []Primes              `xml:"primes"`
+	Exponentiale        []Exponentiale        `xml:"exponentiale"`
+	Imaginaryi          []Imaginaryi          `xml:"imaginaryi"`
+	Notanumber          []Notanumber          `xml:"notanumber"`
+	True                []True                `xml:"true"`
+	False               []False               `xml:"false"`
+	Emptyset            []Emptyset            `xml:"emptyset"`
+	Pi                  []Pi                  `xml:"pi"`
+	Eulergamma          []Eulergamma          `xml:"eulergamma"`
+	Infinity            []Infinity            `xml:"infinity"`
+	Interval            []Interval            `xml:"interval"`
+	List                []List                `xml:"list"`
+	Matrix              []Matrix              `xml:"matrix"`
+	Matrixrow           []Matrixrow           `xml:"matrixrow"`
+	Set                 []Set                 `xml:"set"`
+	Vector              []Vector              `xml:"vector"`
+	Piecewise           []Piecewise           `xml:"piecewise"`
+	Lowlimit            []Lowlimit            `xml:"lowlimit"`
+	Uplimit             []Uplimit             `xml:"uplimit"`
+	Bvar                []Bvar                `xml:"bvar"`
+	Degree              []Degree              `xml:"degree"`
+	Logbase             []Logbase             `xml:"logbase"`
+	Momentabout         []Momentabout         `xml:"momentabout"`
+	Domainofapplication []Domainofapplication `xml:"domainofapplication"`
+	Inverse             []Inverse             `xml:"inverse"`
+	Ident               []Ident               `xml:"ident"`
+	Domain              []Domain              `xml:"domain"`
+	Codomain            []Codomain            `xml:"codomain"`
+	Image               []Image               `xml:"image"`
+	Abs                 []Abs                 `xml:"abs"`
+	Conjugate           []Conjugate           `xml:"conjugate"`
+	Exp                 []Exp                 `xml:"exp"`
+	Factorial           []Factorial           `xml:"factorial"`
+	Arg                 []Arg                 `xml:"arg"`
+	Real                []Real                `xml:"real"`
+	Imaginary           []Imaginary           `xml:"imaginary"`
+	Floor               []Floor               `xml:"floor"`
+	Ceiling             []Ceiling             `xml:"ceiling"`
+	Not                 []Not                 `xml:"not"`
+	Ln                  []Ln                  `xml:"ln"`
+	Sin                 []Sin                 `xml:"sin"`
+	Cos                 []Cos                 `xml:"cos"`
+	Tan                 []Tan                 `xml:"tan"`
+	Sec                 []Sec                 `xml:"sec"`
+	Csc                 []Csc                 `xml:"csc"`
+	Cot                 []Cot                 `xml:"cot"`
+	Sinh                []Sinh                `xml:"sinh"`
+	Cosh                []Cosh                `xml:"cosh"`
+	Tanh                []Tanh                `xml:"tanh"`
+	Sech                []Sech                `xml:"sech"`
+	Csch                []Csch                `xml:"csch"`
+	Coth                []Coth                `xml:"coth"`
+	Arcsin              []Arcsin              `xml:"arcsin"`
+	Arccos              []Arccos              `xml:"arccos"`
+	Arctan              []Arctan              `xml:"arctan"`
+	Arccosh             []Arccosh             `xml:"arccosh"`
+	Arccot              []Arccot              `xml:"arccot"`
+	Arccoth             []Arccoth             `xml:"arccoth"`
+	Arccsc              []Arccsc              `xml:"arccsc"`
+	Arccsch             []Arccsch             `xml:"arccsch"`
+	Arcsec              []Arcsec              `xml:"arcsec"`
+	Arcsech             []Arcsech             `xml:"arcsech"`
+	Arcsinh             []Arcsinh             `xml:"arcsinh"`
+	Arctanh             []Arctanh             `xml:"arctanh"`
+	Determinant         []Determinant         `xml:"determinant"`
+	Transpose           []Transpose           `xml:"transpose"`
+	Card                []Card                `xml:"card"`
+	Quotient            []Quotient            `xml:"quotient"`
+	Divide              []Divide              `xml:"divide"`
+	Power               []Power               `xml:"power"`
+	Rem                 []Rem                 `xml:"rem"`
+	Implies             []Implies             `xml:"implies"`
+	Vectorproduct       []Vectorproduct       `xml:"vectorproduct"`
+	Scalarproduct       []Scalarproduct       `xml:"scalarproduct"`
+	Outerproduct        []Outerproduct        `xml:"outerproduct"`
+	Setdiff             []Setdiff             `xml:"setdiff"`
+	Fn                  []Fn                  `xml:"fn"`
+	Compose             []Compose             `xml:"compose"`
+	Plus                []Plus                `xml:"plus"`
+	Times               []Times               `xml:"times"`
+	Max                 []Max                 `xml:"max"`
+	Min                 []Min                 `xml:"min"`
+	Gcd                 []Gcd                 `xml:"gcd"`
+	Lcm                 []Lcm                 `xml:"lcm"`
+	And                 []And                 `xml:"and"`
+	Or                  []Or                  `xml:"or"`
+	Xor                 []Xor                 `xml:"xor"`
+	Union               []Union               `xml:"union"`
+	Intersect           []Intersect           `xml:"intersect"`
+	Cartesianproduct    []Cartesianproduct    `xml:"cartesianproduct"`
+	Mean                []Mean                `xml:"mean"`
+	Sdev                []Sdev                `xml:"sdev"`
+	Variance            []Variance            `xml:"variance"`
+	Median              []Median              `xml:"median"`
+	Mode                []Mode                `xml:"mode"`
+	Selector            []Selector            `xml:"selector"`
+	Root                []Root                `xml:"root"`
+	Minus               []Minus               `xml:"minus"`
+	Log                 []Log                 `xml:"log"`
+	Int                 []Int                 `xml:"int"`
+	Diff                []Diff                `xml:"diff"`
+	Partialdiff         []Partialdiff         `xml:"partialdiff"`
+	Divergence          []Divergence          `xml:"divergence"`
+	Grad                []Grad                `xml:"grad"`
+	Curl                []Curl                `xml:"curl"`
+	Laplacian           []Laplacian           `xml:"laplacian"`
+	Sum                 []Sum                 `xml:"sum"`
+	Product             []Product             `xml:"product"`
+	Limit               []Limit               `xml:"limit"`
+	Moment              []Moment              `xml:"moment"`
+	Exists              []Exists              `xml:"exists"`
+	Forall              []Forall              `xml:"forall"`
+	Neq                 []Neq                 `xml:"neq"`
+	Factorof            []Factorof            `xml:"factorof"`
+	In                  []In                  `xml:"in"`
+	Notin               []Notin               `xml:"notin"`
+	Notsubset           []Notsubset           `xml:"notsubset"`
+	Notprsubset         []Notprsubset         `xml:"notprsubset"`
+	Tendsto             []Tendsto             `xml:"tendsto"`
+	Eq                  []Eq                  `xml:"eq"`
+	Leq                 []Leq                 `xml:"leq"`
+	Lt                  []Lt                  `xml:"lt"`
+	Geq                 []Geq                 `xml:"geq"`
+	Gt                  []Gt                  `xml:"gt"`
+	Equivalent          []Equivalent          `xml:"equivalent"`
+	Approx              []Approx              `xml:"approx"`
+	Subset              []Subset              `xml:"subset"`
+	Prsubset            []Prsubset            `xml:"prsubset"`
+	Mi                  []Mi                  `xml:"mi"`
+	Mn                  []Mn                  `xml:"mn"`
+	Mo                  []Mo                  `xml:"mo"`
+	Mtext               []Mtext               `xml:"mtext"`
+	Ms                  []Ms                  `xml:"ms"`
+	Mspace              []Mspace              `xml:"mspace"`
+	Mrow                []Mrow                `xml:"mrow"`
+	Mfrac               []Mfrac               `xml:"mfrac"`
+	Msqrt               []Msqrt               `xml:"msqrt"`
+	Mroot               []Mroot               `xml:"mroot"`
+	Menclose            []Menclose            `xml:"menclose"`
+	Mstyle              []Mstyle              `xml:"mstyle"`
+	Merror              []Merror              `xml:"merror"`
+	Mpadded             []Mpadded             `xml:"mpadded"`
+	Mphantom            []Mphantom            `xml:"mphantom"`
+	Mfenced             []Mfenced             `xml:"mfenced"`
+	Msub                []Msub                `xml:"msub"`
+	Msup                []Msup                `xml:"msup"`
+	Msubsup             []Msubsup             `xml:"msubsup"`
+	Munder              []Munder              `xml:"munder"`
+	Mover               []Mover               `xml:"mover"`
+	Munderover          []Munderover          `xml:"munderover"`
+	Mmultiscripts       []Mmultiscripts       `xml:"mmultiscripts"`
+	Mtable              []Mtable              `xml:"mtable"`
+	Mtr                 []Mtr                 `xml:"mtr"`
+	Mlabeledtr          []Mlabeledtr          `xml:"mlabeledtr"`
+	Mtd                 []Mtd                 `xml:"mtd"`
+	Maligngroup         []Maligngroup         `xml:"maligngroup"`
+	Malignmark          []Malignmark          `xml:"malignmark"`
+	Maction             []Maction             `xml:"maction"`
+}
+
+// Vector is the element vector
+//
+//	(csymbol | ci | cn | apply | reln | lambda | condition | declare | sep | semantics | annotation |
+//	annotation-xml | integers | reals | rationals | naturalnumbers | complexes | primes | exponentiale |
+//	imaginaryi | notanumber | true | false | emptyset | pi | eulergamma | infinity | interval | list |
+//	matrix | matrixrow | set | vector | piecewise | lowlimit | uplimit | bvar | degree | logbase |
+//	momentabout | domainofapplication | inverse | ident | domain | codomain | image | abs | conjugate |
+//	exp | factorial | arg | real | imaginary | floor | ceiling | not | ln | sin | cos | tan | sec | csc
+//	| cot | sinh | cosh | tanh | sech | csch | coth | arcsin | arccos | arctan | arccosh | arccot |
+//	arccoth | arccsc | arccsch | arcsec | arcsech | arcsinh | arctanh | determinant | transpose | card |
+//	quotient | divide | power | rem | implies | vectorproduct | scalarproduct | outerproduct | setdiff
+//	| fn | compose | plus | times | max | min | gcd | lcm | and | or | xor | union | intersect |
+//	cartesianproduct | mean | sdev | variance | median | mode | selector | root | minus | log | int |
+//	diff | partialdiff | divergence | grad | curl | laplacian | sum | product | limit | moment | exists
+//	| forall | neq | factorof | in | notin | notsubset | notprsubset | tendsto | eq | leq | lt | geq |
+//	gt | equivalent | approx | subset | prsubset | mi | mn | mo | mtext | ms | mspace | mrow | mfrac |
+//	msqrt | mroot | menclose | mstyle | merror | mpadded | mphantom | mfenced | msub | msup | msubsup |
+//	munder | mover | munderover | mmultiscripts | mtable | mtr | mlabeledtr | mtd | maligngroup |
+//	malignmark | maction)*
+type Vector struct {
+	XlinkHref           string                `xml:"href,attr"`
+	XlinkType           string                `xml:"type,attr"`
+	Class               string                `xml:"class,attr"`
+	Style               string                `xml:"style,attr"`
+	ID                  string                `xml:"id,attr"`
+	Xref                string                `xml:"xref,attr"`
+	Other               string                `xml:"other,attr"`
+	Csymbol             []Csymbol             `xml:"csymbol"`
+	Ci                  []Ci                  `xml:"ci"`
+	Cn                  []Cn                  `xml:"cn"`
+	Apply               []Apply               `xml:"apply"`
+	Reln                []Reln                `xml:"reln"`
+	Lambda              []Lambda              `xml:"lambda"`
+	Condition           []Condition           `xml:"condition"`
+	Declare             []Declare             `xml:"declare"`
+	Sep                 []Sep                 `xml:"sep"`
+	Semantics           []Semantics           `xml:"semantics"`
+	Annotation          []Annotation          `xml:"annotation"`
+	AnnotationXml       []AnnotationXml       `xml:"annotation-xml"`
+	Integers            []Integers            `xml:"integers"`
+	Reals               []Reals               `xml:"reals"`
+	Rationals           []Rationals           `xml:"rationals"`
+	Naturalnumbers      []Naturalnumbers      `xml:"naturalnumbers"`
+	Complexes           []Complexes           `xml:"complexes"`
+	Primes              []Primes              `xml:"primes"`
+	Exponentiale        []Exponentiale        `xml:"exponentiale"`
+	Imaginaryi          []Imaginaryi          `xml:"imaginaryi"`
+	Notanumber          []Notanumber          `xml:"notanumber"`
+	True                []True                `xml:"true"`
+	False               []False               `xml:"false"`
+	Emptyset            []Emptyset            `xml:"emptyset"`
+	Pi                  []Pi                  `xml:"pi"`
+	Eulergamma          []Eulergamma          `xml:"eulergamma"`
+	Infinity            []Infinity            `xml:"infinity"`
+	Interval            []Interval            `xml:"interval"`
+	List                []List                `xml:"list"`
+	Matrix              []Matrix              `xml:"matrix"`
+	Matrixrow           []Matrixrow           `xml:"matrixrow"`
+	Set                 []Set                 `xml:"set"`
+	Vector              []Vector              `xml:"vector"`
+	Piecewise           []Piecewise           `xml:"piecewise"`
+	Lowlimit            []Lowlimit            `xml:"lowlimit"`
+	Uplimit             []Uplimit             `xml:"uplimit"`
+	Bvar                []Bvar                `xml:"bvar"`
+	Degree              []Degree              `xml:"degree"`
+	Logbase             []Logbase             `xml:"logbase"`
+	Momentabout         []Momentabout         `xml:"momentabout"`
+	Domainofapplication []Domainofapplication `xml:"domainofapplication"`
+	Inverse             []Inverse             `xml:"inverse"`
+	Ident               []Ident               `xml:"ident"`
+	Domain              []Domain              `xml:"domain"`
+	Codomain            []Codomain            `xml:"codomain"`
+	Image               []Image               `xml:"image"`
+	Abs                 []Abs                 `xml:"abs"`
+	Conjugate           []Conjugate           `xml:"conjugate"`
+	Exp                 []Exp                 `xml:"exp"`
+	Factorial           []Factorial           `xml:"factorial"`
+	Arg                 []Arg                 `xml:"arg"`
+	Real                []Real                `xml:"real"`
+	Imaginary           []Imaginary           `xml:"imaginary"`
+	Floor               []Floor               `xml:"floor"`
+	Ceiling             []Ceiling             `xml:"ceiling"`
+	Not                 []Not                 `xml:"not"`
+	Ln                  []Ln                  `xml:"ln"`
+	Sin                 []Sin                 `xml:"sin"`
+	Cos                 []Cos                 `xml:"cos"`
+	Tan                 []Tan                 `xml:"tan"`
+	Sec                 []Sec                 `xml:"sec"`
+	Csc                 []Csc                 `xml:"csc"`
+	Cot                 []Cot                 `xml:"cot"`
+	Sinh                []Sinh                `xml:"sinh"`
+	Cosh                []Cosh                `xml:"cosh"`
+	Tanh                []Tanh                `xml:"tanh"`
+	Sech                []Sech                `xml:"sech"`
+	Csch                []Csch                `xml:"csch"`
+	Coth                []Coth                `xml:"coth"`
+	Arcsin              []Arcsin              `xml:"arcsin"`
+	Arccos              []Arccos              `xml:"arccos"`
+	Arctan              []Arctan              `xml:"arctan"`
+	Arccosh             []Arccosh             `xml:"arccosh"`
+	Arccot              []Arccot              `xml:"arccot"`
+	Arccoth             []Arccoth             `xml:"arccoth"`
+	Arccsc              []Arccsc              `xml:"arccsc"`
+	Arccsch             []Arccsch             `xml:"arccsch"`
+	Arcsec              []Arcsec              `xml:"arcsec"`
+	Arcsech             []Arcsech             `xml:"arcsech"`
+	Arcsinh             []Arcsinh             `xml:"arcsinh"`
+	Arctanh             []Arctanh             `xml:"arctanh"`
+	Determinant         []Determinant         `xml:"determinant"`
+	Transpose           []Transpose           `xml:"transpose"`
+	Card                []Card                `xml:"card"`
+	Quotient            []Quotient            `xml:"quotient"`
+	Divide              []Divide              `xml:"divide"`
+	Power               []Power               `xml:"power"`
+	Rem                 []Rem                 `xml:"rem"`
+	Implies             []Implies             `xml:"implies"`
+	Vectorproduct       []Vectorproduct       `xml:"vectorproduct"`
+	Scalarproduct       []Scalarproduct       `xml:"scalarproduct"`
+	Outerproduct        []Outerproduct        `xml:"outerproduct"`
+	Setdiff             []Setdiff             `xml:"setdiff"`
+	Fn                  []Fn                  `xml:"fn"`
+	Compose             []Compose             `xml:"compose"`
+	Plus                []Plus                `xml:"plus"`
+	Times               []Times               `xml:"times"`
+	Max                 []Max                 `xml:"max"`
+	Min                 []Min                 `xml:"min"`
+	Gcd                 []Gcd                 `xml:"gcd"`
+	Lcm                 []Lcm                 `xml:"lcm"`
+	And                 []And                 `xml:"and"`
+	Or                  []Or                  `xml:"or"`
+	Xor                 []Xor                 `xml:"xor"`
+	Union               []Union               `xml:"union"`
+	Intersect           []Intersect           `xml:"intersect"`
+	Cartesianproduct    []Cartesianproduct    `xml:"cartesianproduct"`
+	Mean                []Mean                `xml:"mean"`
+	Sdev                []Sdev                `xml:"sdev"`
+	Variance            []Variance            `xml:"variance"`
+	Median              []Median              `xml:"median"`
+	Mode                []Mode                `xml:"mode"`
+	Selector            []Selector            `xml:"selector"`
+	Root                []Root                `xml:"root"`
+	Minus               []Minus               `xml:"minus"`
+	Log                 []Log                 `xml:"log"`
+	Int                 []Int                 `xml:"int"`
+	Diff                []Diff                `xml:"diff"`
+	Partialdiff         []Partialdiff         `xml:"partialdiff"`
+	Divergence          []Divergence          `xml:"divergence"`
+	Grad                []Grad                `xml:"grad"`
+	Curl                []Curl                `xml:"curl"`
+	Laplacian           []Laplacian           `xml:"laplacian"`
+	Sum                 []Sum                 `xml:"sum"`
+	Product             []Product             `xml:"product"`
+	Limit               []Limit               `xml:"limit"`
+	Moment              []Moment              `xml:"moment"`
+	Exists              []Exists              `xml:"exists"`
+	Forall              []Forall              `xml:"forall"`
+	Neq                 []Neq                 `xml:"neq"`
+	Factorof            []Factorof            `xml:"factorof"`
+	In                  []In                  `xml:"in"`
+	Notin               []Notin               `xml:"notin"`
+	Notsubset           []Notsubset           `xml:"notsubset"`
+	Notprsubset         []Notprsubset         `xml:"notprsubset"`
+	Tendsto             []Tendsto             `xml:"tendsto"`
+	Eq                  []Eq                  `xml:"eq"`
+	Leq                 []Leq                 `xml:"leq"`
+	Lt                  []Lt                  `xml:"lt"`
+	Geq                 []Geq                 `xml:"geq"`
+	Gt                  []Gt                  `xml:"gt"`
+	Equivalent          []Equivalent          `xml:"equivalent"`
+	Approx              []Approx              `xml:"approx"`
+	Subset              []Subset              `xml:"subset"`
+	Prsubset            []Prsubset            `xml:"prsubset"`
+	Mi                  []Mi                  `xml:"mi"`
+	Mn                  []Mn                  `xml:"mn"`
+	Mo                  []Mo                  `xml:"mo"`
+	Mtext               []Mtext               `xml:"mtext"`
+	Ms                  []Ms                  `xml:"ms"`
+	Mspace              []Mspace              `xml:"mspace"`
+	Mrow                []Mrow                `xml:"mrow"`
+	Mfrac               []Mfrac               `xml:"mfrac"`
+	Msqrt               []Msqrt               `xml:"msqrt"`
+	Mroot               []Mroot               `xml:"mroot"`
+	Menclose            []Menclose            `xml:"menclose"`
+	Mstyle              []Mstyle              `xml:"mstyle"`
+	Merror              []Merror              `xml:"merror"`
+	Mpadded             []Mpadded             `xml:"mpadded"`
+	Mphantom            []Mphantom            `xml:"mphantom"`
+	Mfenced             []Mfenced             `xml:"mfenced"`
+	Msub                []Msub                `xml:"msub"`
+	Msup                []Msup                `xml:"msup"`
+	Msubsup             []Msubsup             `xml:"msubsup"`
+	Munder              []Munder              `xml:"munder"`
+	Mover               []Mover               `xml:"mover"`
+	Munderover          []Munderover          `xml:"munderover"`
+	Mmultiscripts       []Mmultiscripts       `xml:"mmultiscripts"`
+	Mtable              []Mtable              `xml:"mtable"`
+	Mtr                 []Mtr                 `xml:"mtr"`
+	Mlabeledtr          []Mlabeledtr          `xml:"mlabeledtr"`
+	Mtd                 []Mtd                 `xml:"mtd"`
+	Maligngroup         []Maligngroup         `xml:"maligngroup"`
+	Malignmark          []Malignmark          `xml:"malignmark"`
+	Maction             []Maction             `xml:"maction"`
+}
+
+// Piecewise is the element piecewise
+//
+//	(piece*,otherwise?)
+type Piecewise struct {
+	XlinkHref string     `xml:"href,attr"`
+	XlinkType string     `xml:"type,attr"`
+	Class     string     `xml:"class,attr"`
+	Style     string     `xml:"style,attr"`
+	ID        string     `xml:"id,attr"`
+	Xref      string     `xml:"xref,attr"`
+	Other     string     `xml:"other,attr"`
+	Piece     []Piece    `xml:"piece"`
+	Otherwise *Otherwise `xml:"otherwise"`
+}
+
+// Piece is the element piece
+//
+//	(csymbol | ci | cn | apply | reln | lambda | condition | declare | sep | semantics | annotation |
+//	annotation-xml | integers | reals | rationals | naturalnumbers | complexes | primes | exponentiale |
+//	imaginaryi | notanumber | true | false | emptyset | pi | eulergamma | infinity | interval | list |
+//	matrix | matrixrow | set | vector | piecewise | lowlimit | uplimit | bvar | degree | logbase |
+//	momentabout | domainofapplication | inverse | ident | domain | codomain | image | abs | conjugate |
+//	exp | factorial | arg | real | imaginary | floor | ceiling | not | ln | sin | cos | tan | sec | csc
+//	| cot | sinh | cosh | tanh | sech | csch | coth | arcsin | arccos | arctan | arccosh | arccot |
+//	arccoth | arccsc | arccsch | arcsec | arcsech | arcsinh | arctanh | determinant | transpose | card |
+//	quotient | divide | power | rem | implies | vectorproduct | scalarproduct | outerproduct | setdiff
+//	| fn | compose | plus | times | max | min | gcd | lcm | and | or | xor | union | intersect |
+//	cartesianproduct | mean | sdev | variance | median | mode | selector | root | minus | log | int |
+//	diff | partialdiff | divergence | grad | curl | laplacian | sum | product | limit | moment | exists
+//	| forall | neq | factorof | in | notin | notsubset | notprsubset | tendsto | eq | leq | lt | geq |
+//	gt | equivalent | approx | subset | prsubset | mi | mn | mo | mtext | ms | mspace | mrow | mfrac |
+//	msqrt | mroot | menclose | mstyle | merror | mpadded | mphantom | mfenced | msub | msup | msubsup |
+//	munder | mover | munderover | mmultiscripts | mtable | mtr | mlabeledtr | mtd | maligngroup |
+//	malignmark | maction)*
+type Piece struct {
+	XlinkHref           string                `xml:"href,attr"`
+	XlinkType           string                `xml:"type,attr"`
+	Class               string                `xml:"class,attr"`
+	Style               string                `xml:"style,attr"`
+	ID                  string                `xml:"id,attr"`
+	Xref                string                `xml:"xref,attr"`
+	Other               string                `xml:"other,attr"`
+	Csymbol             []Csymbol             `xml:"csymbol"`
+	Ci                  []Ci                  `xml:"ci"`
+	Cn                  []Cn                  `xml:"cn"`
+	Apply               []Apply               `xml:"apply"`
+	Reln                []Reln                `xml:"reln"`
+	Lambda              []Lambda              `xml:"lambda"`
+	Condition           []Condition           `xml:"condition"`
+	Declare             []Declare             `xml:"declare"`
+	Sep                 []Sep                 `xml:"sep"`
+	Semantics           []Semantics           `xml:"semantics"`
+	Annotation          []Annotation          `xml:"annotation"`
+	AnnotationXml       []AnnotationXml       `xml:"annotation-xml"`
+	Integers            []Integers            `xml:"integers"`
+	Reals               []Reals               `xml:"reals"`
+	Rationals           []Rationals           `xml:"rationals"`
+	Naturalnumbers      []Naturalnumbers      `xml:"naturalnumbers"`
+	Complexes           []Complexes           `xml:"complexes"`
+	Primes              []Primes              `xml:"primes"`
+	Exponentiale        []Exponentiale        `xml:"exponentiale"`
+	Imaginaryi          []Imaginaryi          `xml:"imaginaryi"`
+	Notanumber          []Notanumber          `xml:"notanumber"`
+	True                []True                `xml:"true"`
+	False               []False               `xml:"false"`
+	Emptyset            []Emptyset            `xml:"emptyset"`
+	Pi                  []Pi                  `xml:"pi"`
+	Eulergamma          []Eulergamma          `xml:"eulergamma"`
+	Infinity            []Infinity            `xml:"infinity"`
+	Interval            []Interval            `xml:"interval"`
+	List                []List                `xml:"list"`
+	Matrix              []Matrix              `xml:"matrix"`
+	Matrixrow           []Matrixrow           `xml:"matrixrow"`
+	Set                 []Set                 `xml:"set"`
+	Vector              []Vector              `xml:"vector"`
+	Piecewise           []Piecewise           `xml:"piecewise"`
+	Lowlimit            []Lowlimit            `xml:"lowlimit"`
+	Uplimit             []Uplimit             `xml:"uplimit"`
+	Bvar                []Bvar                `xml:"bvar"`
+	Degree              []Degree              `xml:"degree"`
+	Logbase             []Logbase             `xml:"logbase"`
+	Momentabout         []Momentabout         `xml:"momentabout"`
+	Domainofapplication []Domainofapplication `xml:"domainofapplication"`
+	Inverse             []Inverse             `xml:"inverse"`
+	Ident               []Ident               `xml:"ident"`
+	Domain              []Domain              `xml:"domain"`
+	Codomain            []Codomain            `xml:"codomain"`
+	Image               []Image               `xml:"image"`
+	Abs                 []Abs                 `xml:"abs"`
+	Conjugate           []Conjugate           `xml:"conjugate"`
+	Exp                 []Exp                 `xml:"exp"`
+	Factorial           []Factorial           `xml:"factorial"`
+	Arg                 []Arg                 `xml:"arg"`
+	Real                []Real                `xml:"real"`
+	Imaginary           []Imaginary           `xml:"imaginary"`
+	Floor               []Floor               `xml:"floor"`
+	Ceiling             []Ceiling             `xml:"ceiling"`
+	Not                 []Not                 `xml:"not"`
+	Ln                  []Ln                  `xml:"ln"`
+	Sin                 []Sin                 `xml:"sin"`
+	Cos                 []Cos                 `xml:"cos"`
+	Tan                 []Tan                 `xml:"tan"`
+	Sec                 []Sec                 `xml:"sec"`
+	Csc                 []Csc                 `xml:"csc"`
+	Cot                 []Cot                 `xml:"cot"`
+	Sinh                []Sinh                `xml:"sinh"`
+	Cosh                []Cosh                `xml:"cosh"`
+	Tanh                []Tanh                `xml:"tanh"`
+	Sech                []Sech                `xml:"sech"`
+	Csch                []Csch                `xml:"csch"`
+	Coth                []Coth                `xml:"coth"`
+	Arcsin              []Arcsin              `xml:"arcsin"`
+	Arccos              []Arccos              `xml:"arccos"`
+	Arctan              []Arctan              `xml:"arctan"`
+	Arccosh             []Arccosh             `xml:"arccosh"`
+	Arccot              []Arccot              `xml:"arccot"`
+	Arccoth             []Arccoth             `xml:"arccoth"`
+	Arccsc              []Arccsc              `xml:"arccsc"`
+	Arccsch             []Arccsch             `xml:"arccsch"`
+	Arcsec              []Arcsec              `xml:"arcsec"`
+	Arcsech             []Arcsech             `xml:"arcsech"`
+	Arcsinh             []Arcsinh             `xml:"arcsinh"`
+	Arctanh             []Arctanh             `xml:"arctanh"`
+	Determinant         []Determinant         `xml:"determinant"`
+	Transpose           []Transpose           `xml:"transpose"`
+	Card                []Card                `xml:"card"`
+	Quotient            []Quotient            `xml:"quotient"`
+	Divide              []Divide              `xml:"divide"`
+	Power               []Power               `xml:"power"`
+	Rem                 []Rem                 `xml:"rem"`
+	Implies             []Implies             `xml:"implies"`
+	Vectorproduct       []Vectorproduct       `xml:"vectorproduct"`
+	Scalarproduct       []Scalarproduct       `xml:"scalarproduct"`
+	Outerproduct        []Outerproduct        `xml:"outerproduct"`
+	Setdiff             []Setdiff             `xml:"setdiff"`
+	Fn                  []Fn                  `xml:"fn"`
+	Compose             []Compose             `xml:"compose"`
+	Plus                []Plus                `xml:"plus"`
+	Times               []Times               `xml:"times"`
+	Max                 []Max                 `xml:"max"`
+	Min                 []Min                 `xml:"min"`
+	Gcd                 []Gcd                 `xml:"gcd"`
+	Lcm                 []Lcm                 `xml:"lcm"`
+	And                 []And                 `xml:"and"`
+	Or                  []Or                  `xml:"or"`
+	Xor                 []Xor                 `xml:"xor"`
+	Union               []Union               `xml:"union"`
+	Intersect           []Intersect           `xml:"intersect"`
+	Cartesianproduct    []Cartesianproduct    `xml:"cartesianproduct"`
+	Mean                []Mean                `xml:"mean"`
+	Sdev                []Sdev                `xml:"sdev"`
+	Variance            []Variance            `xml:"variance"`
+	Median              []Median              `xml:"median"`
+	Mode                []Mode                `xml:"mode"`
+	Selector            []Selector            `xml:"selector"`
+	Root                []Root                `xml:"root"`
+	Minus               []Minus               `xml:"minus"`
+	Log                 []Log                 `xml:"log"`
+	Int                 []Int                 `xml:"int"`
+	Diff                []Diff                `xml:"diff"`
+	Partialdiff         []Partialdiff         `xml:"partialdiff"`
+	Divergence          []Divergence          `xml:"divergence"`
+	Grad                []Grad                `xml:"grad"`
+	Curl                []Curl                `xml:"curl"`
+	Laplacian           []Laplacian           `xml:"laplacian"`
+	Sum                 []Sum                 `xml:"sum"`
+	Product             []Product             `xml:"product"`
+	Limit               []Limit               `xml:"limit"`
+	Moment              []Moment              `xml:"moment"`
+	Exists              []Exists              `xml:"exists"`
+	Forall              []Forall              `xml:"forall"`
+	Neq                 []Neq                 `xml:"neq"`
+	Factorof            []Factorof            `xml:"factorof"`
+	In                  []In                  `xml:"in"`
+	Notin               []Notin               `xml:"notin"`
+	Notsubset           []Notsubset           `xml:"notsubset"`
+	Notprsubset         []Notprsubset         `xml:"notprsubset"`
+	Tendsto             []Tendsto             `xml:"tendsto"`
+	Eq                  []Eq                  `xml:"eq"`
+	Leq                 []Leq                 `xml:"leq"`
+	Lt                  []Lt                  `xml:"lt"`
+	Geq                 []Geq                 `xml:"geq"`
+	Gt                  []Gt                  `xml:"gt"`
+	Equivalent          []Equivalent          `xml:"equivalent"`
+	Approx              []Approx              `xml:"approx"`
+	Subset              []Subset              `xml:"subset"`
+	Prsubset            []Prsubset            `xml:"prsubset"`
+	Mi                  []Mi                  `xml:"mi"`
+	Mn                  []Mn                  `xml:"mn"`
+	Mo                  []Mo                  `xml:"mo"`
+	Mtext               []Mtext               `xml:"mtext"`
+	Ms                  []Ms                  `xml:"ms"`
+	Mspace              []Mspace              `xml:"mspace"`
+	Mrow                []Mrow                `xml:"mrow"`
+	Mfrac               []Mfrac               `xml:"mfrac"`
+	Msqrt               []Msqrt               `xml:"msqrt"`
+	Mroot               []Mroot               `xml:"mroot"`
+	Menclose            []Menclose            `xml:"menclose"`
+	Mstyle              []Mstyle              `xml:"mstyle"`
+	Merror              []Merror              `xml:"merror"`
+	Mpadded             []Mpadded             `xml:"mpadded"`
+	Mphantom            []Mphantom            `xml:"mphantom"`
+	Mfenced             []Mfenced             `xml:"mfenced"`
+	Msub                []Msub                `xml:"msub"`
+	Msup                []Msup                `xml:"msup"`
+	Msubsup             []Msubsup             `xml:"msubsup"`
+	Munder              []Munder              `xml:"munder"`
+	Mover               []Mover               `xml:"mover"`
+	Munderover          []Munderover          `xml:"munderover"`
+	Mmultiscripts       []Mmultiscripts       `xml:"mmultiscripts"`
+	Mtable              []Mtable              `xml:"mtable"`
+	Mtr                 []Mtr                 `xml:"mtr"`
+	Mlabeledtr          []Mlabeledtr          `xml:"mlabeledtr"`
+	Mtd                 []Mtd                 `xml:"mtd"`
+	Maligngroup         []Maligngroup         `xml:"maligngroup"`
+	Malignmark          []Malignmark          `xml:"malignmark"`
+	Maction             []Maction             `xml:"maction"`
+}
+
+// Otherwise is the element otherwise
+//
+//	(csymbol | ci | cn | apply | reln | lambda | condition | declare | sep | semantics | annotation |
+//	annotation-xml | integers | reals | rationals | naturalnumbers | complexes | primes | exponentiale |
+//	imaginaryi | notanumber | true | false | emptyset | pi | eulergamma | infinity | interval | list |
+//	matrix | matrixrow | set | vector | piecewise | lowlimit | uplimit | bvar | degree | logbase |
+//	momentabout | domainofapplication | inverse | ident | domain | codomain | image | abs | conjugate |
+//	exp | factorial | arg | real | imaginary | floor | ceiling | not | ln | sin | cos | tan | sec | csc
+//	| cot | sinh | cosh | tanh | sech | csch | coth | arcsin | arccos | arctan | arccosh | arccot |
+//	arccoth | arccsc | arccsch | arcsec | arcsech | arcsinh | arctanh | determinant | transpose | card |
+//	quotient | divide | power | rem | implies | vectorproduct | scalarproduct | outerproduct | setdiff
+//	| fn | compose | plus | times | max | min | gcd | lcm | and | or | xor | union | intersect |
+//	cartesianproduct | mean | sdev | variance | median | mode | selector | root | minus | log | int |
+//	diff | partialdiff | divergence | grad | curl | laplacian | sum | product | limit | moment | exists
+//	| forall | neq | factorof | in | notin | notsubset | notprsubset | tendsto | eq | leq | lt | geq |
+//	gt | equivalent | approx | subset | prsubset | mi | mn | mo | mtext | ms | mspace | mrow | mfrac |
+//	msqrt | mroot | menclose | mstyle | merror | mpadded | mphantom | mfenced | msub | msup | msubsup |
+//	munder | mover | munderover | mmultiscripts | mtable | mtr | mlabeledtr | mtd | maligngroup |
+//	malignmark | maction)*
+type Otherwise struct {
+	XlinkHref           string                `xml:"href,attr"`
+	XlinkType           string                `xml:"type,attr"`
+	Class               string                `xml:"class,attr"`
+	Style               string                `xml:"style,attr"`
+	ID                  string                `xml:"id,attr"`
+	Xref                string                `xml:"xref,attr"`
+	Other               string                `xml:"other,attr"`
+	Csymbol             []Csymbol             `xml:"csymbol"`
+	Ci                  []Ci                  `xml:"ci"`
+	Cn                  []Cn                  `xml:"cn"`
+	Apply               []Apply               `xml:"apply"`
+	Reln                []Reln                `xml:"reln"`
+	Lambda              []Lambda              `xml:"lambda"`
+	Condition           []Condition           `xml:"condition"`
+	Declare             []Declare             `xml:"declare"`
+	Sep                 []Sep                 `xml:"sep"`
+	Semantics           []Semantics           `xml:"semantics"`
+	Annotation          []Annotation          `xml:"annotation"`
+	AnnotationXml       []AnnotationXml       `xml:"annotation-xml"`
+	Integers            []Integers            `xml:"integers"`
+	Reals               []Reals               `xml:"reals"`
+	Rationals           []Rationals           `xml:"rationals"`
+	Naturalnumbers      []Naturalnumbers      `xml:"naturalnumbers"`
+	Complexes           []Complexes           `xml:"complexes"`
+	Primes              []Primes              `xml:"primes"`
+	Exponentiale        []Exponentiale        `xml:"exponentiale"`
+	Imaginaryi          []Imaginaryi          `xml:"imaginaryi"`
+	Notanumber          []Notanumber          `xml:"notanumber"`
+	True                []True                `xml:"true"`
+	False               []False               `xml:"false"`
+	Emptyset            []Emptyset            `xml:"emptyset"`
+	Pi                  []Pi                  `xml:"pi"`
+	Eulergamma          []Eulergamma          `xml:"eulergamma"`
+	Infinity            []Infinity            `xml:"infinity"`
+	Interval            []Interval            `xml:"interval"`
+	List                []List                `xml:"list"`
+	Matrix              []Matrix              `xml:"matrix"`
+	Matrixrow           []Matrixrow           `xml:"matrixrow"`
+	Set                 []Set                 `xml:"set"`
+	Vector              []Vector              `xml:"vector"`
+	Piecewise           []Piecewise           `xml:"piecewise"`
+	Lowlimit            []Lowlimit            `xml:"lowlimit"`
+	Uplimit             []Uplimit             `xml:"uplimit"`
+	Bvar                []Bvar                `xml:"bvar"`
+	Degree              []Degree              `xml:"degree"`
+	Logbase             []Logbase             `xml:"logbase"`
+	Momentabout         []Momentabout         `xml:"momentabout"`
+	Domainofapplication []Domainofapplication `xml:"domainofapplication"`
+	Inverse             []Inverse             `xml:"inverse"`
+	Ident               []Ident               `xml:"ident"`
+	Domain              []Domain              `xml:"domain"`
+	Codomain            []Codomain            `xml:"codomain"`
+	Image               []Image               `xml:"image"`
+	Abs                 []Abs                 `xml:"abs"`
+	Conjugate           []Conjugate           `xml:"conjugate"`
+	Exp                 []Exp                 `xml:"exp"`
+	Factorial           []Factorial           `xml:"factorial"`
+	Arg                 []Arg                 `xml:"arg"`
+	Real                []Real                `xml:"real"`
+	Imaginary           []Imaginary           `xml:"imaginary"`
+	Floor               []Floor               `xml:"floor"`
+	Ceiling             []Ceiling             `xml:"ceiling"`
+	Not                 []Not                 `xml:"not"`
+	Ln                  []Ln                  `xml:"ln"`
+	Sin                 []Sin                 `xml:"sin"`
+	Cos                 []Cos                 `xml:"cos"`
+	Tan                 []Tan                 `xml:"tan"`
+	Sec                 []Sec                 `xml:"sec"`
+	Csc                 []Csc                 `xml:"csc"`
+	Cot                 []Cot                 `xml:"cot"`
+	Sinh                []Sinh                `xml:"sinh"`
+	Cosh                []Cosh                `xml:"cosh"`
+	Tanh                []Tanh                `xml:"tanh"`
+	Sech                []Sech                `xml:"sech"`
+	Csch                []Csch                `xml:"csch"`
+	Coth                []Coth                `xml:"coth"`
+	Arcsin              []Arcsin              `xml:"arcsin"`
+	Arccos              []Arccos              `xml:"arccos"`
+	Arctan              []Arctan              `xml:"arctan"`
+	Arccosh             []Arccosh             `xml:"arccosh"`
+	Arccot              []Arccot              `xml:"arccot"`
+	Arccoth             []Arccoth             `xml:"arccoth"`
+	Arccsc              []Arccsc              `xml:"arccsc"`
+	Arccsch             []Arccsch             `xml:"arccsch"`
+	Arcsec              []Arcsec              `xml:"arcsec"`
+	Arcsech             []Arcsech             `xml:"arcsech"`
+	Arcsinh             []Arcsinh             `xml:"arcsinh"`
+	Arctanh             []Arctanh             `xml:"arctanh"`
+	Determinant         []Determinant         `xml:"determinant"`
+	Transpose           []Transpose           `xml:"transpose"`
+	Card                []Card                `xml:"card"`
+	Quotient            []Quotient            `xml:"quotient"`
+	Divide              []Divide              `xml:"divide"`
+	Power               []Power               `xml:"power"`
+	Rem                 []Rem                 `xml:"rem"`
+	Implies             []Implies             `xml:"implies"`
+	Vectorproduct       []Vectorproduct       `xml:"vectorproduct"`
+	Scalarproduct       []Scalarproduct       `xml:"scalarproduct"`
+	Outerproduct        []Outerproduct        `xml:"outerproduct"`
+	Setdiff             []Setdiff             `xml:"setdiff"`
+	Fn                  []Fn                  `xml:"fn"`
+	Compose             []Compose             `xml:"compose"`
+	Plus                []Plus                `xml:"plus"`
+	Times               []Times               `xml:"times"`
+	Max                 []Max                 `xml:"max"`
+	Min                 []Min                 `xml:"min"`
+	Gcd                 []Gcd                 `xml:"gcd"`
+	Lcm                 []Lcm                 `xml:"lcm"`
+	And                 []And                 `xml:"and"`
+	Or                  []Or                  `xml:"or"`
+	Xor                 []Xor                 `xml:"xor"`
+	Union               []Union               `xml:"union"`
+	Intersect           []Intersect           `xml:"intersect"`
+	Cartesianproduct    []Cartesianproduct    `xml:"cartesianproduct"`
+	Mean                []Mean                `xml:"mean"`
+	Sdev                []Sdev                `xml:"sdev"`
+	Variance            []Variance            `xml:"variance"`
+	Median              []Median              `xml:"median"`
+	Mode                []Mode                `xml:"mode"`
+	Selector            []Selector            `xml:"selector"`
+	Root                []Root                `xml:"root"`
+	Minus               []Minus               `xml:"minus"`
+	Log                 []Log                 `xml:"log"`
+	Int                 []Int                 `xml:"int"`
+	Diff                []Diff                `xml:"diff"`
+	Partialdiff         []Partialdiff         `xml:"partialdiff"`
+	Divergence          []Divergence          `xml:"divergence"`
+	Grad                []Grad                `xml:"grad"`
+	Curl                []Curl                `xml:"curl"`
+	Laplacian           []Laplacian           `xml:"laplacian"`
+	Sum                 []Sum                 `xml:"sum"`
+	Product             []Product             `xml:"product"`
+	Limit               []Limit               `xml:"limit"`
+	Moment              []Moment              `xml:"moment"`
+	Exists              []Exists              `xml:"exists"`
+	Forall              []Forall              `xml:"forall"`
+	Neq                 []Neq                 `xml:"neq"`
+	Factorof            []Factorof            `xml:"factorof"`
+	In                  []In                  `xml:"in"`
+	Notin               []Notin               `xml:"notin"`
+	Notsubset           []Notsubset           `xml:"notsubset"`
+	Notprsubset         []Notprsubset         `xml:"notprsubset"`
+	Tendsto             []Tendsto             `xml:"tendsto"`
+	Eq                  []Eq                  `xml:"eq"`
+	Leq                 []Leq                 `xml:"leq"`
+	Lt                  []Lt                  `xml:"lt"`
+	Geq                 []Geq                 `xml:"geq"`
+	Gt                  []Gt                  `xml:"gt"`
+	Equivalent          []Equivalent          `xml:"equivalent"`
+	Approx              []Approx              `xml:"approx"`
+	Subset              []Subset              `xml:"subset"`
+	Prsubset            []Prsubset            `xml:"prsubset"`
+	Mi                  []Mi                  `xml:"mi"`
+	Mn                  []Mn                  `xml:"mn"`
+	Mo                  []Mo                  `xml:"mo"`
+	Mtext               []Mtext               `xml:"mtext"`
+	Ms                  []Ms                  `xml:"ms"`
+	Mspace              []Mspace              `xml:"mspace"`
+	Mrow                []Mrow                `xml:"mrow"`
+	Mfrac               []Mfrac               `xml:"mfrac"`
+	Msqrt               []Msqrt               `xml:"msqrt"`
+	Mroot               []Mroot               `xml:"mroot"`
+	Menclose            []Menclose            `xml:"menclose"`
+	Mstyle              []Mstyle              `xml:"mstyle"`
+	Merror              []Merror              `xml:"merror"`
+	Mpadded             []Mpadded             `xml:"mpadded"`
+	Mphantom            []Mphantom            `xml:"mphantom"`
+	Mfenced             []Mfenced             `xml:"mfenced"`
+	Msub                []Msub                `xml:"msub"`
+	Msup                []Msup                `xml:"msup"`
+	Msubsup             []Msubsup             `xml:"msubsup"`
+	Munder              []Munder              `xml:"munder"`
+	Mover               []Mover               `xml:"mover"`
+	Munderover          []Munderover          `xml:"munderover"`
+	Mmultiscripts       []Mmultiscripts       `xml:"mmultiscripts"`
+	Mtable              []Mtable              `xml:"mtable"`
+	Mtr                 []Mtr                 `xml:"mtr"`
+	Mlabeledtr          []Mlabeledtr          `xml:"mlabeledtr"`
+	Mtd                 []Mtd                 `xml:"mtd"`
+	Maligngroup         []Maligngroup         `xml:"maligngroup"`
+	Malignmark          []Malignmark          `xml:"malignmark"`
+	Maction             []Maction             `xml:"maction"`
+}
+
+// Lowlimit is the element lowlimit
+//
+//	(csymbol | ci | cn | apply | reln | lambda | condition | declare | sep | semantics | annotation |
+//	annotation-xml | integers | reals | rationals | naturalnumbers | complexes | primes | exponentiale |
+//	imaginaryi | notanumber | true | false | emptyset | pi | eulergamma | infinity | interval | list |
+//	matrix | matrixrow | set | vector | piecewise | lowlimit | uplimit | bvar | degree | logbase |
+//	momentabout | domainofapplication | inverse | ident | domain | codomain | image | abs | conjugate |
+//	exp | factorial | arg | real | imaginary | floor | ceiling | not | ln | sin | cos | tan | sec | csc
+//	| cot | sinh | cosh | tanh | sech | csch | coth | arcsin | arccos | arctan | arccosh | arccot |
+//	arccoth | arccsc | arccsch | arcsec | arcsech | arcsinh | arctanh | determinant | transpose | card |
+//	quotient | divide | power | rem | implies | vectorproduct | scalarproduct | outerproduct | setdiff
+//	| fn | compose | plus | times | max | min | gcd | lcm | and | or | xor | union | intersect |
+//	cartesianproduct | mean | sdev | variance | median | mode | selector | root | minus | log | int |
+//	diff | partialdiff | divergence | grad | curl | laplacian | sum | product | limit | moment | exists
+//	| forall | neq | factorof | in | notin | notsubset | notprsubset | tendsto | eq | leq | lt | geq |
+//	gt | equivalent | approx | subset | prsubset | mi | mn | mo | mtext | ms | mspace | mrow | mfrac |
+//	msqrt | mroot | menclose | mstyle | merror | mpadded | mphantom | mfenced | msub | msup | msubsup |
+//	munder | mover | munderover | mmultiscripts | mtable | mtr | mlabeledtr | mtd | maligngroup |
+//	malignmark | maction)*
+type Lowlimit struct {
+	XlinkHref           string                `xml:"href,attr"`
+	XlinkType           string                `xml:"type,attr"`
+	Class               string                `xml:"class,attr"`
+	Style               string                `xml:"style,attr"`
+	ID                  string                `xml:"id,attr"`
+	Xref                string                `xml:"xref,attr"`
+	Other               string                `xml:"other,attr"`
+	Csymbol             []Csymbol             `xml:"csymbol"`
+	Ci                  []Ci                  `xml:"ci"`
+	Cn                  []Cn                  `xml:"cn"`
+	Apply               []Apply               `xml:"apply"`
+	Reln                []Reln                `xml:"reln"`
+	Lambda              []Lambda              `xml:"lambda"`
+	Condition           []Condition           `xml:"condition"`
+	Declare             []Declare             `xml:"declare"`
+	Sep                 []Sep                 `xml:"sep"`
+	Semantics           []Semantics           `xml:"semantics"`
+	Annotation          []Annotation          `xml:"annotation"`
+	AnnotationXml       []AnnotationXml       `xml:"annotation-xml"`
+	Integers            []Integers            `xml:"integers"`
+	Reals               []Reals               `xml:"reals"`
+	Rationals           []Rationals           `xml:"rationals"`
+	Naturalnumbers      []Naturalnumbers      `xml:"naturalnumbers"`
+	Complexes           []Complexes           `xml:"complexes"`
+	Primes              []Primes              `xml:"primes"`
+	Exponentiale        []Exponentiale        `xml:"exponentiale"`
+	Imaginaryi          []Imaginaryi          `xml:"imaginaryi"`
+	Notanumber          []Notanumber          `xml:"notanumber"`
+	True                []True                `xml:"true"`
+	False               []False               `xml:"false"`
+	Emptyset            []Emptyset            `xml:"emptyset"`
+	Pi                  []Pi                  `xml:"pi"`
+	Eulergamma          []Eulergamma          `xml:"eulergamma"`
+	Infinity            []Infinity            `xml:"infinity"`
+	Interval            []Interval            `xml:"interval"`
+	List                []List                `xml:"list"`
+	Matrix              []Matrix              `xml:"matrix"`
+	Matrixrow           []Matrixrow           `xml:"matrixrow"`
+	Set                 []Set                 `xml:"set"`
+	Vector              []Vector              `xml:"vector"`
+	Piecewise           []Piecewise           `xml:"piecewise"`
+	Lowlimit            []Lowlimit            `xml:"lowlimit"`
+	Uplimit             []Uplimit             `xml:"uplimit"`
+	Bvar                []Bvar                `xml:"bvar"`
+	Degree              []Degree              `xml:"degree"`
+	Logbase             []Logbase             `xml:"logbase"`
+	Momentabout         []Momentabout         `xml:"momentabout"`
+	Domainofapplication []Domainofapplication `xml:"domainofapplication"`
+	Inverse             []Inverse             `xml:"inverse"`
+	Ident               []Ident               `xml:"ident"`
+	Domain              []Domain              `xml:"domain"`
+	Codomain            []Codomain            `xml:"codomain"`
+	Image               []Image               `xml:"image"`
+	Abs                 []Abs                 `xml:"abs"`
+	Conjugate           []Conjugate           `xml:"conjugate"`
+	Exp                 []Exp                 `xml:"exp"`
+	Factorial           []Factorial           `xml:"factorial"`
+	Arg                 []Arg                 `xml:"arg"`
+	Real                []Real                `xml:"real"`
+	Imaginary           []Imaginary           `xml:"imaginary"`
+	Floor               []Floor               `xml:"floor"`
+	Ceiling             []Ceiling             `xml:"ceiling"`
+	Not                 []Not                 `xml:"not"`
+	Ln                  []Ln                  `xml:"ln"`
+	Sin                 []Sin                 `xml:"sin"`
+	Cos                 []Cos                 `xml:"cos"`
+	Tan                 []Tan                 `xml:"tan"`
+	Sec                 []Sec                 `xml:"sec"`
+	Csc                 []Csc                 `xml:"csc"`
+	Cot                 []Cot                 `xml:"cot"`
+	Sinh                []Sinh                `xml:"sinh"`
+	Cosh                []Cosh                `xml:"cosh"`
+	Tanh                []Tanh                `xml:"tanh"`
+	Sech                []Sech                `xml:"sech"`
+	Csch                []Csch                `xml:"csch"`
+	Coth                []Coth                `xml:"coth"`
+	Arcsin              []Arcsin              `xml:"arcsin"`
+	Arccos              []Arccos              `xml:"arccos"`
+	Arctan              []Arctan              `xml:"arctan"`
+	Arccosh             []Arccosh             `xml:"arccosh"`
+	Arccot              []Arccot              `xml:"arccot"`
+	Arccoth             []Arccoth             `xml:"arccoth"`
+	Arccsc              []Arccsc              `xml:"arccsc"`
+	Arccsch             []Arccsch             `xml:"arccsch"`
+	Arcsec              []Arcsec              `xml:"arcsec"`
+	Arcsech             []Arcsech             `xml:"arcsech"`
+	Arcsinh             []Arcsinh             `xml:"arcsinh"`
+	Arctanh             []Arctanh             `xml:"arctanh"`
+	Determinant         []Determinant         `xml:"determinant"`
+	Transpose           []Transpose           `xml:"transpose"`
+	Card                []Card                `xml:"card"`
+	Quotient            []Quotient            `xml:"quotient"`
+	Divide              []Divide              `xml:"divide"`
+	Power               []Power               `xml:"power"`
+	Rem                 []Rem                 `xml:"rem"`
+	Implies             []Implies             `xml:"implies"`
+	Vectorproduct       []Vectorproduct       `xml:"vectorproduct"`
+	Scalarproduct       []Scalarproduct       `xml:"scalarproduct"`
+	Outerproduct        []Outerproduct        `xml:"outerproduct"`
+	Setdiff             []Setdiff             `xml:"setdiff"`
+	Fn                  []Fn                  `xml:"fn"`
+	Compose             []Compose             `xml:"compose"`
+	Plus                []Plus                `xml:"plus"`
+	Times               []Times               `xml:"times"`
+	Max                 []Max                 `xml:"max"`
+	Min                 []Min                 `xml:"min"`
+	Gcd                 []Gcd                 `xml:"gcd"`
+	Lcm                 []Lcm                 `xml:"lcm"`
+	And                 []And                 `xml:"and"`
+	Or                  []Or                  `xml:"or"`
+	Xor                 []Xor                 `xml:"xor"`
+	Union               []Union               `xml:"union"`
+	Intersect           []Intersect           `xml:"intersect"`
+	Cartesianproduct    []Cartesianproduct    `xml:"cartesianproduct"`
+	Mean                []Mean                `xml:"mean"`
+	Sdev                []Sdev                `xml:"sdev"`
+	Variance            []Variance            `xml:"variance"`
+	Median              []Median              `xml:"median"`
+	Mode                []Mode                `xml:"mode"`
+	Selector            []Selector            `xml:"selector"`
+	Root                []Root                `xml:"root"`
+	Minus               []Minus               `xml:"minus"`
+	Log                 []Log                 `xml:"log"`
+	Int                 []Int                 `xml:"int"`
+	Diff                []Diff                `xml:"diff"`
+	Partialdiff         []Partialdiff         `xml:"partialdiff"`
+	Divergence          []Divergence          `xml:"divergence"`
+	Grad                []Grad                `xml:"grad"`
+	Curl                []Curl                `xml:"curl"`
+	Laplacian           []Laplacian           `xml:"laplacian"`
+	Sum                 []Sum                 `xml:"sum"`
+	Product             []Product             `xml:"product"`
+	Limit               []Limit               `xml:"limit"`
+	Moment              []Moment              `xml:"moment"`
+	Exists              []Exists              `xml:"exists"`
+	Forall              []Forall              `xml:"forall"`
+	Neq                 []Neq                 `xml:"neq"`
+	Factorof            []Factorof            `xml:"factorof"`
+	In                  []In                  `xml:"in"`
+	Notin               []Notin               `xml:"notin"`
+	Notsubset           []Notsubset           `xml:"notsubset"`
+	Notprsubset         []Notprsubset         `xml:"notprsubset"`
+	Tendsto             []Tendsto             `xml:"tendsto"`
+	Eq                  []Eq                  `xml:"eq"`
+	Leq                 []Leq                 `xml:"leq"`
+	Lt                  []Lt                  `xml:"lt"`
+	Geq                 []Geq                 `xml:"geq"`
+	Gt                  []Gt                  `xml:"gt"`
+	Equivalent          []Equivalent          `xml:"equivalent"`
+	Approx              []Approx              `xml:"approx"`
+	Subset              []Subset              `xml:"subset"`
+	Prsubset            []Prsubset            `xml:"prsubset"`
+	Mi                  []Mi                  `xml:"mi"`
+	Mn                  []Mn                  `xml:"mn"`
+	Mo                  []Mo                  `xml:"mo"`
+	Mtext               []Mtext               `xml:"mtext"`
+	Ms                  []Ms                  `xml:"ms"`
+	Mspace              []Mspace              `xml:"mspace"`
+	Mrow                []Mrow                `xml:"mrow"`
+	Mfrac               []Mfrac               `xml:"mfrac"`
+	Msqrt               []Msqrt               `xml:"msqrt"`
+	Mroot               []Mroot               `xml:"mroot"`
+	Menclose            []Menclose            `xml:"menclose"`
+	Mstyle              []Mstyle              `xml:"mstyle"`
+	Merror              []Merror              `xml:"merror"`
+	Mpadded             []Mpadded             `xml:"mpadded"`
+	Mphantom            []Mphantom            `xml:"mphantom"`
+	Mfenced             []Mfenced             `xml:"mfenced"`
+	Msub                []Msub                `xml:"msub"`
+	Msup                []Msup                `xml:"msup"`
+	Msubsup             []Msubsup             `xml:"msubsup"`
+	Munder              []Munder              `xml:"munder"`
+	Mover               []Mover               `xml:"mover"`
+	Munderover          []Munderover          `xml:"munderover"`
+	Mmultiscripts       []Mmultiscripts       `xml:"mmultiscripts"`
+	Mtable              []Mtable              `xml:"mtable"`
+	Mtr                 []Mtr                 `xml:"mtr"`
+	Mlabeledtr          []Mlabeledtr          `xml:"mlabeledtr"`
+	Mtd                 []Mtd                 `xml:"mtd"`
+	Maligngroup         []Maligngroup         `xml:"maligngroup"`
+	Malignmark          []Malignmark          `xml:"malignmark"`
+	Maction             []Maction             `xml:"maction"`
+}
+
+// Uplimit is the element uplimit
+//
+//	(csymbol | ci | cn | apply | reln | lambda | condition | declare | sep | semantics | annotation |
+//	annotation-xml | integers | reals | rationals | naturalnumbers | complexes | primes | exponentiale |
+//	imaginaryi | notanumber | true | false | emptyset | pi | eulergamma | infinity | interval | list |
+//	matrix | matrixrow | set | vector | piecewise | lowlimit | uplimit | bvar | degree | logbase |
+//	momentabout | domainofapplication | inverse | ident | domain | codomain | image | abs | conjugate |
+//	exp | factorial | arg | real | imaginary | floor | ceiling | not | ln | sin | cos | tan | sec | csc
+//	| cot | sinh | cosh | tanh | sech | csch | coth | arcsin | arccos | arctan | arccosh | arccot |
+//	arccoth | arccsc | arccsch | arcsec | arcsech | arcsinh | arctanh | determinant | transpose | card |
+//	quotient | divide | power | rem | implies | vectorproduct | scalarproduct | outerproduct | setdiff
+//	| fn | compose | plus | times | max | min | gcd | lcm | and | or | xor | union | intersect |
+//	cartesianproduct | mean | sdev | variance | median | mode | selector | root | minus | log | int |
+//	diff | partialdiff | divergence | grad | curl | laplacian | sum | product | limit | moment | exists
+//	| forall | neq | factorof | in | notin | notsubset | notprsubset | tendsto | eq | leq | lt | geq |
+//	gt | equivalent | approx | subset | prsubset | mi | mn | mo | mtext | ms | mspace | mrow | mfrac |
+//	msqrt | mroot | menclose | mstyle | merror | mpadded | mphantom | mfenced | msub | msup | msubsup |
+//	munder | mover | munderover | mmultiscripts | mtable | mtr | mlabeledtr | mtd | maligngroup |
+//	malignmark | maction)*
+type Uplimit struct {
+	XlinkHref           string                `xml:"href,attr"`
+	XlinkType           string                `xml:"type,attr"`
+	Class               string                `xml:"class,attr"`
+	Style               string                `xml:"style,attr"`
+	ID                  string                `xml:"id,attr"`
+	Xref                string                `xml:"xref,attr"`
+	Other               string                `xml:"other,attr"`
+	Csymbol             []Csymbol             `xml:"csymbol"`
+	Ci                  []Ci                  `xml:"ci"`
+	Cn                  []Cn                  `xml:"cn"`
+	Apply               []Apply               `xml:"apply"`
+	Reln                []Reln                `xml:"reln"`
+	Lambda              []Lambda              `xml:"lambda"`
+	Condition           []Condition           `xml:"condition"`
+	Declare             []Declare             `xml:"declare"`
+	Sep                 []Sep                 `xml:"sep"`
+	Semantics           []Semantics           `xml:"semantics"`
+	Annotation          []Annotation          `xml:"annotation"`
+	AnnotationXml       []AnnotationXml       `xml:"annotation-xml"`
+	Integers            []Integers            `xml:"integers"`
+	Reals               []Reals               `xml:"reals"`
+	Rationals           []Rationals           `xml:"rationals"`
+	Naturalnumbers      []Naturalnumbers      `xml:"naturalnumbers"`
+	Complexes           []Complexes           `xml:"complexes"`
+	Primes              []Primes              `xml:"primes"`
+	Exponentiale        []Exponentiale        `xml:"exponentiale"`
+	Imaginaryi          []Imaginaryi          `xml:"imaginaryi"`
+	Notanumber          []Notanumber          `xml:"notanumber"`
+	True                []True                `xml:"true"`
+	False               []False               `xml:"false"`
+	Emptyset            []Emptyset            `xml:"emptyset"`
+	Pi                  []Pi                  `xml:"pi"`
+	Eulergamma          []Eulergamma          `xml:"eulergamma"`
+	Infinity            []Infinity            `xml:"infinity"`
+	Interval            []Interval            `xml:"interval"`
+	List                []List                `xml:"list"`
+	Matrix              []Matrix              `xml:"matrix"`
+	Matrixrow           []Matrixrow           `xml:"matrixrow"`
+	Set                 []Set                 `xml:"set"`
+	Vector              []Vector              `xml:"vector"`
+	Piecewise           []Piecewise           `xml:"piecewise"`
+	Lowlimit            []Lowlimit            `xml:"lowlimit"`
+	Uplimit             []Uplimit             `xml:"uplimit"`
+	Bvar                []Bvar                `xml:"bvar"`
+	Degree              []Degree              `xml:"degree"`
+	Logbase             []Logbase             `xml:"logbase"`
+	Momentabout         []Momentabout         `xml:"momentabout"`
+	Domainofapplication []Domainofapplication `xml:"domainofapplication"`
+	Inverse             []Inverse             `xml:"inverse"`
+	Ident               []Ident               `xml:"ident"`
+	Domain              []Domain              `xml:"domain"`
+	Codomain            []Codomain            `xml:"codomain"`
+	Image               []Image               `xml:"image"`
+	Abs                 []Abs                 `xml:"abs"`
+	Conjugate           []Conjugate           `xml:"conjugate"`
+	Exp                 []Exp                 `xml:"exp"`
+	Factorial           []Factorial           `xml:"factorial"`
+	Arg                 []Arg                 `xml:"arg"`
+	Real                []Real                `xml:"real"`
+	Imaginary           []Imaginary           `xml:"imaginary"`
+	Floor               []Floor               `xml:"floor"`
+	Ceiling             []Ceiling             `xml:"ceiling"`
+	Not                 []Not                 `xml:"not"`
+	Ln                  []Ln                  `xml:"ln"`
+	Sin                 []Sin                 `xml:"sin"`
+	Cos                 []Cos                 `xml:"cos"`
+	Tan                 []Tan                 `xml:"tan"`
+	Sec                 []Sec                 `xml:"sec"`
+	Csc                 []Csc                 `xml:"csc"`
+	Cot                 []Cot                 `xml:"cot"`
+	Sinh                []Sinh                `xml:"sinh"`
+	Cosh                []Cosh                `xml:"cosh"`
+	Tanh                []Tanh                `xml:"tanh"`
+	Sech                []Sech                `xml:"sech"`
+	Csch                []Csch                `xml:"csch"`
+	Coth                []Coth                `xml:"coth"`
+	Arcsin              []Arcsin              `xml:"arcsin"`
+	Arccos              []Arccos              `xml:"arccos"`
+	Arctan              []Arctan              `xml:"arctan"`
+	Arccosh             []Arccosh             `xml:"arccosh"`
+	Arccot              []Arccot              `xml:"arccot"`
+	Arccoth             []Arccoth             `xml:"arccoth"`
+	Arccsc              []Arccsc              `xml:"arccsc"`
+	Arccsch             []Arccsch             `xml:"arccsch"`
+	Arcsec              []Arcsec              `xml:"arcsec"`
+	Arcsech             []Arcsech             `xml:"arcsech"`
+	Arcsinh             []Arcsinh             `xml:"arcsinh"`
+	Arctanh             []Arctanh             `xml:"arctanh"`
+	Determinant         []Determinant         `xml:"determinant"`
+	Transpose           []Transpose           `xml:"transpose"`
+	Card                []Card                `xml:"card"`
+	Quotient            []Quotient            `xml:"quotient"`
+	Divide              []Divide              `xml:"divide"`
+	Power               []Power               `xml:"power"`
+	Rem                 []Rem                 `xml:"rem"`
+	Implies             []Implies             `xml:"implies"`
+	Vectorproduct       []Vectorproduct       `xml:"vectorproduct"`
+	Scalarproduct       []Scalarproduct       `xml:"scalarproduct"`
+	Outerproduct        []Outerproduct        `xml:"outerproduct"`
+	Setdiff             []Setdiff             `xml:"setdiff"`
+	Fn                  []Fn                  `xml:"fn"`
+	Compose             []Compose             `xml:"compose"`
+	Plus                []Plus                `xml:"plus"`
+	Times               []Times               `xml:"times"`
+	Max                 []Max                 `xml:"max"`
+	Min                 []Min                 `xml:"min"`
+	Gcd                 []Gcd                 `xml:"gcd"`
+	Lcm                 []Lcm                 `xml:"lcm"`
+	And                 []And                 `xml:"and"`
+	Or                  []Or                  `xml:"or"`
+	Xor                 []Xor                 `xml:"xor"`
+	Union               []Union               `xml:"union"`
+	Intersect           []Intersect           `xml:"intersect"`
+	Cartesianproduct    []Cartesianproduct    `xml:"cartesianproduct"`
+	Mean                []Mean                `xml:"mean"`
+	Sdev                []Sdev                `xml:"sdev"`
+	Variance            []Variance            `xml:"variance"`
+	Median              []Median              `xml:"median"`
+	Mode                []Mode                `xml:"mode"`
+	Selector            []Selector            `xml:"selector"`
+	Root                []Root                `xml:"root"`
+	Minus               []Minus               `xml:"minus"`
+	Log                 []Log                 `xml:"log"`
+	Int                 []Int                 `xml:"int"`
+	Diff                []Diff                `xml:"diff"`
+	Partialdiff         []Partialdiff         `xml:"partialdiff"`
+	Divergence          []Divergence          `xml:"divergence"`
+	Grad                []Grad                `xml:"grad"`
+	Curl                []Curl                `xml:"curl"`
+	Laplacian           []Laplacian           `xml:"laplacian"`
+	Sum                 []Sum                 `xml:"sum"`
+	Product             []Product             `xml:"product"`
+	Limit               []Limit               `xml:"limit"`
+	Moment              []Moment              `xml:"moment"`
+	Exists              []Exists              `xml:"exists"`
+	Forall              []Forall              `xml:"forall"`
+	Neq                 []Neq                 `xml:"neq"`
+	Factorof            []Factorof            `xml:"factorof"`
+	In                  []In                  `xml:"in"`
+	Notin               []Notin               `xml:"notin"`
+	Notsubset           []Notsubset           `xml:"notsubset"`
+	Notprsubset         []Notprsubset         `xml:"notprsubset"`
+	Tendsto             []Tendsto             `xml:"tendsto"`
+	Eq                  []Eq                  `xml:"eq"`
+	Leq                 []Leq                 `xml:"leq"`
+	Lt                  []Lt                  `xml:"lt"`
+	Geq                 []Geq                 `xml:"geq"`
+	Gt                  []Gt                  `xml:"gt"`
+	Equivalent          []Equivalent          `xml:"equivalent"`
+	Approx              []Approx              `xml:"approx"`
+	Subset              []Subset              `xml:"subset"`
+	Prsubset            []Prsubset            `xml:"prsubset"`
+	Mi                  []Mi                  `xml:"mi"`
+	Mn                  []Mn                  `xml:"mn"`
+	Mo                  []Mo                  `xml:"mo"`
+	Mtext               []Mtext               `xml:"mtext"`
+	Ms                  []Ms                  `xml:"ms"`
+	Mspace              []Mspace              `xml:"mspace"`
+	Mrow                []Mrow                `xml:"mrow"`
+	Mfrac               []Mfrac               `xml:"mfrac"`
+	Msqrt               []Msqrt               `xml:"msqrt"`
+	Mroot               []Mroot               `xml:"mroot"`
+	Menclose            []Menclose            `xml:"menclose"`
+	Mstyle              []Mstyle              `xml:"mstyle"`
+	Merror              []Merror              `xml:"merror"`
+	Mpadded             []Mpadded             `xml:"mpadded"`
+	Mphantom            []Mphantom            `xml:"mphantom"`
+	Mfenced             []Mfenced             `xml:"mfenced"`
+	Msub                []Msub                `xml:"msub"`
+	Msup                []Msup                `xml:"msup"`
+	Msubsup             []Msubsup             `xml:"msubsup"`
+	Munder              []Munder              `xml:"munder"`
+	Mover               []Mover               `xml:"mover"`
+	Munderover          []Munderover          `xml:"munderover"`
+	Mmultiscripts       []Mmultiscripts       `xml:"mmultiscripts"`
+	Mtable              []Mtable              `xml:"mtable"`
+	Mtr                 []Mtr                 `xml:"mtr"`
+	Mlabeledtr          []Mlabeledtr          `xml:"mlabeledtr"`
+	Mtd                 []Mtd                 `xml:"mtd"`
+	Maligngroup         []Maligngroup         `xml:"maligngroup"`
+	Malignmark          []Malignmark          `xml:"malignmark"`
+	Maction             []Maction             `xml:"maction"`
+}
+
+// Bvar is the element bvar
+//
+//	(csymbol | ci | cn | apply | reln | lambda | condition | declare | sep | semantics | annotation |
+//	annotation-xml | integers | reals | rationals | naturalnumbers | complexes | primes | exponentiale |
+//	imaginaryi | notanumber | true | false | emptyset | pi | eulergamma | infinity | interval | list |
+//	matrix | matrixrow | set | vector | piecewise | lowlimit | uplimit | bvar | degree | logbase |
+//	momentabout | domainofapplication | inverse | ident | domain | codomain | image | abs | conjugate |
+//	exp | factorial | arg | real | imaginary | floor | ceiling | not | ln | sin | cos | tan | sec | csc
+//	| cot | sinh | cosh | tanh | sech | csch | coth | arcsin | arccos | arctan | arccosh | arccot |
+//	arccoth | arccsc | arccsch | arcsec | arcsech | arcsinh | arctanh | determinant | transpose | card |
+//	quotient | divide | power | rem | implies | vectorproduct | scalarproduct | outerproduct | setdiff
+//	| fn | compose | plus | times | max | min | gcd | lcm | and | or | xor | union | intersect |
+//	cartesianproduct | mean | sdev | variance | median | mode | selector | root | minus | log | int |
+//	diff | partialdiff | divergence | grad | curl | laplacian | sum | product | limit | moment | exists
+//	| forall | neq | factorof | in | notin | notsubset | notprsubset | tendsto | eq | leq | lt | geq |
+//	gt | equivalent | approx | subset | prsubset | mi | mn | mo | mtext | ms | mspace | mrow | mfrac |
+//	msqrt | mroot | menclose | mstyle | merror | mpadded | mphantom | mfenced | msub | msup | msubsup |
+//	munder | mover | munderover | mmultiscripts | mtable | mtr | mlabeledtr | mtd | maligngroup |
+//	malignmark | maction)*
+type Bvar struct {
+	XlinkHref           string                `xml:"href,attr"`
+	XlinkType           string                `xml:"type,attr"`
+	Class               string                `xml:"class,attr"`
+	Style               string                `xml:"style,attr"`
+	ID                  string                `xml:"id,attr"`
+	Xref                string                `xml:"xref,attr"`
+	Other               string                `xml:"other,attr"`
+	Csymbol             []Csymbol             `xml:"csymbol"`
+	Ci                  []Ci                  `xml:"ci"`
+	Cn                  []Cn                  `xml:"cn"`
+	Apply               []Apply               `xml:"apply"`
+	Reln                []Reln                `xml:"reln"`
+	Lambda              []Lambda              `xml:"lambda"`
+	Condition           []Condition           `xml:"condition"`
+	Declare             []Declare             `xml:"declare"`
+	Sep                 []Sep                 `xml:"sep"`
+	Semantics           []Semantics           `xml:"semantics"`
+	Annotation          []Annotation          `xml:"annotation"`
+	AnnotationXml       []AnnotationXml       `xml:"annotation-xml"`
+	Integers            []Integers            `xml:"integers"`
+	Reals               []Reals               `xml:"reals"`
+	Rationals           []Rationals           `xml:"rationals"`
+	Naturalnumbers      []Naturalnumbers      `xml:"naturalnumbers"`
+	Complexes           []Complexes           `xml:"complexes"`
+	Primes              []Primes              `xml:"primes"`
+	Exponentiale        []Exponentiale        `xml:"exponentiale"`
+	Imaginaryi          []Imaginaryi          `xml:"imaginaryi"`
+	Notanumber          []Notanumber          `xml:"notanumber"`
+	True                []True                `xml:"true"`
+	False               []False               `xml:"false"`
+	Emptyset            []Emptyset            `xml:"emptyset"`
+	Pi                  []Pi                  `xml:"pi"`
+	Eulergamma          []Eulergamma          `xml:"eulergamma"`
+	Infinity            []Infinity            `xml:"infinity"`
+	Interval            []Interval            `xml:"interval"`
+	List                []List                `xml:"list"`
+	Matrix              []Matrix              `xml:"matrix"`
+	Matrixrow           []Matrixrow           `xml:"matrixrow"`
+	Set                 []Set                 `xml:"set"`
+	Vector              []Vector              `xml:"vector"`
+	Piecewise           []Piecewise           `xml:"piecewise"`
+	Lowlimit            []Lowlimit            `xml:"lowlimit"`
+	Uplimit             []Uplimit             `xml:"uplimit"`
+	Bvar                []Bvar                `xml:"bvar"`
+	Degree              []Degree              `xml:"degree"`
+	Logbase             []Logbase             `xml:"logbase"`
+	Momentabout         []Momentabout         `xml:"momentabout"`
+	Domainofapplication []Domainofapplication `xml:"domainofapplication"`
+	Inverse             []Inverse             `xml:"inverse"`
+	Ident               []Ident               `xml:"ident"`
+	Domain              []Domain              `xml:"domain"`
+	Codomain            []Codomain            `xml:"codomain"`
+	Image               []Image               `xml:"image"`
+	Abs                 []Abs                 `xml:"abs"`
+	Conjugate           []Conjugate           `xml:"conjugate"`
+	Exp                 []Exp                 `xml:"exp"`
+	Factorial           []Factorial           `xml:"factorial"`
+	Arg                 []Arg                 `xml:"arg"`
+	Real                []Real                `xml:"real"`
+	Imaginary           []Imaginary           `xml:"imaginary"`
+	Floor               []Floor               `xml:"floor"`
+	Ceiling             []Ceiling             `xml:"ceiling"`
+	Not                 []Not                 `xml:"not"`
+	Ln                  []Ln                  `xml:"ln"`
+	Sin                 []Sin                 `xml:"sin"`
+	Cos                 []Cos                 `xml:"cos"`
+	Tan                 []Tan                 `xml:"tan"`
+	Sec                 []Sec                 `xml:"sec"`
+	Csc                 []Csc                 `xml:"csc"`
+	Cot                 []Cot                 `xml:"cot"`
+	Sinh                []Sinh                `xml:"sinh"`
+	Cosh                []Cosh                `xml:"cosh"`
+	Tanh                []Tanh                `xml:"tanh"`
+	Sech                []Sech                `xml:"sech"`
+	Csch                []Csch                `xml:"csch"`
+	Coth                []Coth                `xml:"coth"`
+	Arcsin              []Arcsin              `xml:"arcsin"`
+	Arccos              []Arccos              `xml:"arccos"`
+	Arctan              []Arctan              `xml:"arctan"`
+	Arccosh             []Arccosh             `xml:"arccosh"`
+	Arccot              []Arccot              `xml:"arccot"`
+	Arccoth             []Arccoth             `xml:"arccoth"`
+	Arccsc              []Arccsc              `xml:"arccsc"`
+	Arccsch             []Arccsch             `xml:"arccsch"`
+	Arcsec              []Arcsec              `xml:"arcsec"`
+	Arcsech             []Arcsech             `xml:"arcsech"`
+	Arcsinh             []Arcsinh             `xml:"arcsinh"`
+	Arctanh             []Arctanh             `xml:"arctanh"`
+	Determinant         []Determinant         `xml:"determinant"`
+	Transpose           []Transpose           `xml:"transpose"`
+	Card                []Card                `xml:"card"`
+	Quotient            []Quotient            `xml:"quotient"`
+	Divide              []Divide              `xml:"divide"`
+	Power               []Power               `xml:"power"`
+	Rem                 []Rem                 `xml:"rem"`
+	Implies             []Implies             `xml:"implies"`
+	Vectorproduct       []Vectorproduct       `xml:"vectorproduct"`
+	Scalarproduct       []Scalarproduct       `xml:"scalarproduct"`
+	Outerproduct        []Outerproduct        `xml:"outerproduct"`
+	Setdiff             []Setdiff             `xml:"setdiff"`
+	Fn                  []Fn                  `xml:"fn"`
+	Compose             []Compose             `xml:"compose"`
+	Plus                []Plus                `xml:"plus"`
+	Times               []Times               `xml:"times"`
+	Max                 []Max                 `xml:"max"`
+	Min                 []Min                 `xml:"min"`
+	Gcd                 []Gcd                 `xml:"gcd"`
+	Lcm                 []Lcm                 `xml:"lcm"`
+	And                 []And                 `xml:"and"`
+	Or                  []Or                  `xml:"or"`
+	Xor                 []Xor                 `xml:"xor"`
+	Union               []Union               `xml:"union"`
+	Intersect           []Intersect           `xml:"intersect"`
+	Cartesianproduct    []Cartesianproduct    `xml:"cartesianproduct"`
+	Mean                []Mean                `xml:"mean"`
+	Sdev                []Sdev                `xml:"sdev"`
+	Variance            []Variance            `xml:"variance"`
+	Median              []Median              `xml:"median"`
+	Mode                []Mode                `xml:"mode"`
+	Selector            []Selector            `xml:"selector"`
+	Root                []Root                `xml:"root"`
+	Minus               []Minus               `xml:"minus"`
+	Log                 []Log                 `xml:"log"`
+	Int                 []Int                 `xml:"int"`
+	Diff                []Diff                `xml:"diff"`
+	Partialdiff         []Partialdiff         `xml:"partialdiff"`
+	Divergence          []Divergence          `xml:"divergence"`
+	Grad                []Grad                `xml:"grad"`
+	Curl                []Curl                `xml:"curl"`
+	Laplacian           []Laplacian           `xml:"laplacian"`
+	Sum                 []Sum                 `xml:"sum"`
+	Product             []Product             `xml:"product"`
+	Limit               []Limit               `xml:"limit"`
+	Moment              []Moment              `xml:"moment"`
+	Exists              []Exists              `xml:"exists"`
+	Forall              []Forall              `xml:"forall"`
+	Neq                 []Neq                 `xml:"neq"`
+	Factorof            []Factorof            `xml:"factorof"`
+	In                  []In                  `xml:"in"`
+	Notin               []Notin               `xml:"notin"`
+	Notsubset           []Notsubset           `xml:"notsubset"`
+	Notprsubset         []Notprsubset         `xml:"notprsubset"`
+	Tendsto             []Tendsto             `xml:"tendsto"`
+	Eq                  []Eq                  `xml:"eq"`
+	Leq                 []Leq                 `xml:"leq"`
+	Lt                  []Lt                  `xml:"lt"`
+	Geq                 []Geq                 `xml:"geq"`
+	Gt                  []Gt                  `xml:"gt"`
+	Equivalent          []Equivalent          `xml:"equivalent"`
+	Approx              []Approx              `xml:"approx"`
+	Subset              []Subset              `xml:"subset"`
+	Prsubset            []Prsubset            `xml:"prsubset"`
+	Mi                  []Mi                  `xml:"mi"`
+	Mn                  []Mn                  `xml:"mn"`
+	Mo                  []Mo                  `xml:"mo"`
+	Mtext               []Mtext               `xml:"mtext"`
+	Ms                  []Ms                  `xml:"ms"`
+	Mspace              []Mspace              `xml:"mspace"`
+	Mrow                []Mrow                `xml:"mrow"`
+	Mfrac               []Mfrac               `xml:"mfrac"`
+	Msqrt               []Msqrt               `xml:"msqrt"`
+	Mroot               []Mroot               `xml:"mroot"`
+	Menclose            []Menclose            `xml:"menclose"`
+	Mstyle              []Mstyle              `xml:"mstyle"`
+	Merror              []Merror              `xml:"merror"`
+	Mpadded             []Mpadded             `xml:"mpadded"`
+	Mphantom            []Mphantom            `xml:"mphantom"`
+	Mfenced             []Mfenced             `xml:"mfenced"`
+	Msub                []Msub                `xml:"msub"`
+	Msup                []Msup                `xml:"msup"`
+	Msubsup             []Msubsup             `xml:"msubsup"`
+	Munder              []Munder              `xml:"munder"`
+	Mover               []Mover               `xml:"mover"`
+	Munderover          []Munderover          `xml:"munderover"`
+	Mmultiscripts       []Mmultiscripts       `xml:"mmultiscripts"`
+	Mtable              []Mtable              `xml:"mtable"`
+	Mtr                 []Mtr                 `xml:"mtr"`
+	Mlabeledtr          []Mlabeledtr          `xml:"mlabeledtr"`
+	Mtd                 []Mtd                 `xml:"mtd"`
+	Maligngroup         []Maligngroup         `xml:"maligngroup"`
+	Malignmark          []Malignmark          `xml:"malignmark"`
+	Maction             []Maction             `xml:"maction"`
+}
+
+// Degree is the element degree
+//
+//	(csymbol | ci | cn | apply | reln | lambda | condition | declare | sep | semantics | annotation |
+//	annotation-xml | integers | reals | rationals | naturalnumbers | complexes | primes | exponentiale |
+//	imaginaryi | notanumber | true | false | emptyset | pi | eulergamma | infinity | interval | list |
+//	matrix | matrixrow | set | vector | piecewise | lowlimit | uplimit | bvar | degree | logbase |
+//	momentabout | domainofapplication | inverse | ident | domain | codomain | image | abs | conjugate |
+//	exp | factorial | arg | real | imaginary | floor | ceiling | not | ln | sin | cos | tan | sec | csc
+//	| cot | sinh | cosh | tanh | sech | csch | coth | arcsin | arccos | arctan | arccosh | arccot |
+//	arccoth | arccsc | arccsch | arcsec | arcsech | arcsinh | arctanh | determinant | transpose | card |
+//	quotient | divide | power | rem | implies | vectorproduct | scalarproduct | outerproduct | setdiff
+//	| fn | compose | plus | times | max | min | gcd | lcm | and | or | xor | union | intersect |
+//	cartesianproduct | mean | sdev | variance | median | mode | selector | root | minus | log | int |
+//	diff | partialdiff | divergence | grad | curl | laplacian | sum | product | limit | moment | exists
+//	| forall | neq | factorof | in | notin | notsubset | notprsubset | tendsto | eq | leq | lt | geq |
+//	gt | equivalent | approx | subset | prsubset | mi | mn | mo | mtext | ms | mspace | mrow | mfrac |
+//	msqrt | mroot | menclose | mstyle | merror | mpadded | mphantom | mfenced | msub | msup | msubsup |
+//	munder | mover | munderover | mmultiscripts | mtable | mtr | mlabeledtr | mtd | maligngroup |
+//	malignmark | maction)*
+type Degree struct {
+	XlinkHref           string                `xml:"href,attr"`
+	XlinkType           string                `xml:"type,attr"`
+	Class               string                `xml:"class,attr"`
+	Style               string                `xml:"style,attr"`
+	ID                  string                `xml:"id,attr"`
+	Xref                string                `xml:"xref,attr"`
+	Other               string                `xml:"other,attr"`
+	Csymbol             []Csymbol             `xml:"csymbol"`
+	Ci                  []Ci                  `xml:"ci"`
+	Cn                  []Cn                  `xml:"cn"`
+	Apply               []Apply               `xml:"apply"`
+	Reln                []Reln                `xml:"reln"`
+	Lambda              []Lambda              `xml:"lambda"`
+	Condition           []Condition           `xml:"condition"`
+	Declare             []Declare             `xml:"declare"`
+	Sep                 []Sep                 `xml:"sep"`
+	Semantics           []Semantics           `xml:"semantics"`
+	Annotation          []Annotation          `xml:"annotation"`
+	AnnotationXml       []AnnotationXml       `xml:"annotation-xml"`
+	Integers            []Integers            `xml:"integers"`
+	Reals               []Reals               `xml:"reals"`
+	Rationals           []Rationals           `xml:"rationals"`
+	Naturalnumbers      []Naturalnumbers      `xml:"naturalnumbers"`
+	Complexes           []Complexes           `xml:"complexes"`
+	Primes              []Primes              `xml:"primes"`
+	Exponentiale        []Exponentiale        `xml:"exponentiale"`
+	Imaginaryi          []Imaginaryi          `xml:"imaginaryi"`
+	Notanumber          []Notanumber          `xml:"notanumber"`
+	True                []True                `xml:"true"`
+	False               []False               `xml:"false"`
+	Emptyset            []Emptyset            `xml:"emptyset"`
+	Pi                  []Pi                  `xml:"pi"`
+	Eulergamma          []Eulergamma          `xml:"eulergamma"`
+	Infinity            []Infinity            `xml:"infinity"`
+	Interval            []Interval            `xml:"interval"`
+	List                []List                `xml:"list"`
+	Matrix              []Matrix              `xml:"matrix"`
+	Matrixrow           []Matrixrow           `xml:"matrixrow"`
+	Set                 []Set                 `xml:"set"`
+	Vector              []Vector              `xml:"vector"`
+	Piecewise           []Piecewise           `xml:"piecewise"`
+	Lowlimit            []Lowlimit            `xml:"lowlimit"`
+	Uplimit             []Uplimit             `xml:"uplimit"`
+	Bvar                []Bvar                `xml:"bvar"`
+	Degree              []Degree              `xml:"degree"`
+	Logbase             []Logbase             `xml:"logbase"`
+	Momentabout         []Momentabout         `xml:"momentabout"`
+	Domainofapplication []Domainofapplication `xml:"domainofapplication"`
+	Inverse             []Inverse             `xml:"inverse"`
+	Ident               []Ident               `xml:"ident"`
+	Domain              []Domain              `xml:"domain"`
+	Codomain            []Codomain            `xml:"codomain"`
+	Image               []Image               `xml:"image"`
+	Abs                 []Abs                 `xml:"abs"`
+	Conjugate           []Conjugate           `xml:"conjugate"`
+	Exp                 []Exp                 `xml:"exp"`
+	Factorial           []Factorial           `xml:"factorial"`
+	Arg                 []Arg                 `xml:"arg"`
+	Real                []Real                `xml:"real"`
+	Imaginary           []Imaginary           `xml:"imaginary"`
+	Floor               []Floor               `xml:"floor"`
+	Ceiling             []Ceiling             `xml:"ceiling"`
+	Not                 []Not                 `xml:"not"`
+	Ln                  []Ln                  `xml:"ln"`
+	Sin                 []Sin                 `xml:"sin"`
+	Cos                 []Cos                 `xml:"cos"`
+	Tan                 []Tan                 `xml:"tan"`
+	Sec                 []Sec                 `xml:"sec"`
+	Csc                 []Csc                 `xml:"csc"`
+	Cot                 []Cot                 `xml:"cot"`
+	Sinh                []Sinh                `xml:"sinh"`
+	Cosh                []Cosh                `xml:"cosh"`
+	Tanh                []Tanh                `xml:"tanh"`
+	Sech                []Sech                `xml:"sech"`
+	Csch                []Csch                `xml:"csch"`
+	Coth                []Coth                `xml:"coth"`
+	Arcsin              []Arcsin              `xml:"arcsin"`
+	Arccos              []Arccos              `xml:"arccos"`
+	Arctan              []Arctan              `xml:"arctan"`
+	Arccosh             []Arccosh             `xml:"arccosh"`
+	Arccot              []Arccot              `xml:"arccot"`
+	Arccoth             []Arccoth             `xml:"arccoth"`
+	Arccsc              []Arccsc              `xml:"arccsc"`
+	Arccsch             []Arccsch             `xml:"arccsch"`
+	Arcsec              []Arcsec              `xml:"arcsec"`
+	Arcsech             []Arcsech             `xml:"arcsech"`
+	Arcsinh             []Arcsinh             `xml:"arcsinh"`
+	Arctanh             []Arctanh             `xml:"arctanh"`
+	Determinant         []Determinant         `xml:"determinant"`
+	Transpose           []Transpose           `xml:"transpose"`
+	Card                []Card                `xml:"card"`
+	Quotient            []Quotient            `xml:"quotient"`
+	Divide              []Divide              `xml:"divide"`
+	Power               []Power               `xml:"power"`
+	Rem                 []Rem                 `xml:"rem"`
+	Implies             []Implies             `xml:"implies"`
+	Vectorproduct       []Vectorproduct       `xml:"vectorproduct"`
+	Scalarproduct       []Scalarproduct       `xml:"scalarproduct"`
+	Outerproduct        []Outerproduct        `xml:"outerproduct"`
+	Setdiff             []Setdiff             `xml:"setdiff"`
+	Fn                  []Fn                  `xml:"fn"`
+	Compose             []Compose             `xml:"compose"`
+	Plus                []Plus                `xml:"plus"`
+	Times               []Times               `xml:"times"`
+	Max                 []Max                 `xml:"max"`
+	Min                 []Min                 `xml:"min"`
+	Gcd                 []Gcd                 `xml:"gcd"`
+	Lcm                 []Lcm                 `xml:"lcm"`
+	And                 []And                 `xml:"and"`
+	Or                  []Or                  `xml:"or"`
+	Xor                 []Xor                 `xml:"xor"`
+	Union               []Union               `xml:"union"`
+	Intersect           []Intersect           `xml:"intersect"`
+	Cartesianproduct    []Cartesianproduct    `xml:"cartesianproduct"`
+	Mean                []Mean                `xml:"mean"`
+	Sdev                []Sdev                `xml:"sdev"`
+	Variance            []Variance            `xml:"variance"`
+	Median              []Median              `xml:"median"`
+	Mode                []Mode                `xml:"mode"`
+	Selector            []Selector            `xml:"selector"`
+	Root                []Root                `xml:"root"`
+	Minus               []Minus               `xml:"minus"`
+	Log                 []Log                 `xml:"log"`
+	Int                 []Int                 `xml:"int"`
+	Diff                []Diff                `xml:"diff"`
+	Partialdiff         []Partialdiff         `xml:"partialdiff"`
+	Divergence          []Divergence          `xml:"divergence"`
+	Grad                []Grad                `xml:"grad"`
+	Curl                []Curl                `xml:"curl"`
+	Laplacian           []Laplacian           `xml:"laplacian"`
+	Sum                 []Sum                 `xml:"sum"`
+	Product             []Product             `xml:"product"`
+	Limit               []Limit               `xml:"limit"`
+	Moment              []Moment              `xml:"moment"`
+	Exists              []Exists              `xml:"exists"`
+	Forall              []Forall              `xml:"forall"`
+	Neq                 []Neq                 `xml:"neq"`
+	Factorof            []Factorof            `xml:"factorof"`
+	In                  []In                  `xml:"in"`
+	Notin               []Notin               `xml:"notin"`
+	Notsubset           []Notsubset           `xml:"notsubset"`
+	Notprsubset         []Notprsubset         `xml:"notprsubset"`
+	Tendsto             []Tendsto             `xml:"tendsto"`
+	Eq                  []Eq                  `xml:"eq"`
+	Leq                 []Leq                 `xml:"leq"`
+	Lt                  []Lt                  `xml:"lt"`
+	Geq                 []Geq                 `xml:"geq"`
+	Gt                  []Gt                  `xml:"gt"`
+	Equivalent          []Equivalent          `xml:"equivalent"`
+	Approx              []Approx              `xml:"approx"`
+	Subset              []Subset              `xml:"subset"`
+	Prsubset            []Prsubset            `xml:"prsubset"`
+	Mi                  []Mi                  `xml:"mi"`
+	Mn                  []Mn                  `xml:"mn"`
+	Mo                  []Mo                  `xml:"mo"`
+	Mtext               []Mtext               `xml:"mtext"`
+	Ms                  []Ms                  `xml:"ms"`
+	Mspace              []Mspace              `xml:"mspace"`
+	Mrow                []Mrow                `xml:"mrow"`
+	Mfrac               []Mfrac               `xml:"mfrac"`
+	Msqrt               []Msqrt               `xml:"msqrt"`
+	Mroot               []Mroot               `xml:"mroot"`
+	Menclose            []Menclose            `xml:"menclose"`
+	Mstyle              []Mstyle              `xml:"mstyle"`
+	Merror              []Merror              `xml:"merror"`
+	Mpadded             []Mpadded             `xml:"mpadded"`
+	Mphantom            []Mphantom            `xml:"mphantom"`
+	Mfenced             []Mfenced             `xml:"mfenced"`
+	Msub                []Msub                `xml:"msub"`
+	Msup                []Msup                `xml:"msup"`
+	Msubsup             []Msubsup             `xml:"msubsup"`
+	Munder              []Munder              `xml:"munder"`
+	Mover               []Mover               `xml:"mover"`
+	Munderover          []Munderover          `xml:"munderover"`
+	Mmultiscripts       []Mmultiscripts       `xml:"mmultiscripts"`
+	Mtable              []Mtable              `xml:"mtable"`
+	Mtr                 []Mtr                 `xml:"mtr"`
+	Mlabeledtr          []Mlabeledtr          `xml:"mlabeledtr"`
+	Mtd                 []Mtd                 `xml:"mtd"`
+	Maligngroup         []Maligngroup         `xml:"maligngroup"`
+	Malignmark          []Malignmark          `xml:"malignmark"`
+	Maction             []Maction             `xml:"maction"`
+}
+
+// Logbase is the element logbase
+//
+//	(csymbol | ci | cn | apply | reln | lambda | condition | declare | sep | semantics | annotation |
+//	annotation-xml | integers | reals | rationals | naturalnumbers | complexes | primes | exponentiale |
+//	imaginaryi | notanumber | true | false | emptyset | pi | eulergamma | infinity | interval | list |
+//	matrix | matrixrow | set | vector | piecewise | lowlimit | uplimit | bvar | degree | logbase |
+//	momentabout | domainofapplication | inverse | ident | domain | codomain | image | abs | conjugate |
+//	exp | factorial | arg | real | imaginary | floor | ceiling | not | ln | sin | cos | tan | sec | csc
+//	| cot | sinh | cosh | tanh | sech | csch | coth | arcsin | arccos | arctan | arccosh | arccot |
+//	arccoth | arccsc | arccsch | arcsec | arcsech | arcsinh | arctanh | determinant | transpose | card |
+//	quotient | divide | power | rem | implies | vectorproduct | scalarproduct | outerproduct | setdiff
+//	| fn | compose | plus | times | max | min | gcd | lcm | and | or | xor | union | intersect |
+//	cartesianproduct | mean | sdev | variance | median | mode | selector | root | minus | log | int |
+//	diff | partialdiff | divergence | grad | curl | laplacian | sum | product | limit | moment | exists
+//	| forall | neq | factorof | in | notin | notsubset | notprsubset | tendsto | eq | leq | lt | geq |
+//	gt | equivalent | approx | subset | prsubset | mi | mn | mo | mtext | ms | mspace | mrow | mfrac |
+//	msqrt | mroot | menclose | mstyle | merror | mpadded | mphantom | mfenced | msub | msup | msubsup |
+//	munder | mover | munderover | mmultiscripts | mtable | mtr | mlabeledtr | mtd | maligngroup |
+//	malignmark | maction)*
+type Logbase struct {
+	XlinkHref           string                `xml:"href,attr"`
+	XlinkType           string                `xml:"type,attr"`
+	Class               string                `xml:"class,attr"`
+	Style               string                `xml:"style,attr"`
+	ID                  string                `xml:"id,attr"`
+	Xref                string                `xml:"xref,attr"`
+	Other               string                `xml:"other,attr"`
+	Csymbol             []Csymbol             `xml:"csymbol"`
+	Ci                  []Ci                  `xml:"ci"`
+	Cn                  []Cn                  `xml:"cn"`
+	Apply               []Apply               `xml:"apply"`
+	Reln                []Reln                `xml:"reln"`
+	Lambda              []Lambda              `xml:"lambda"`
+	Condition           []Condition           `xml:"condition"`
+	Declare             []Declare             `xml:"declare"`
+	Sep                 []Sep                 `xml:"sep"`
+	Semantics           []Semantics           `xml:"semantics"`
+	Annotation          []Annotation          `xml:"annotation"`
+	AnnotationXml       []AnnotationXml       `xml:"annotation-xml"`
+	Integers            []Integers            `xml:"integers"`
+	Reals               []Reals               `xml:"reals"`
+	Rationals           []Rationals           `xml:"rationals"`
+	Naturalnumbers      []Naturalnumbers      `xml:"naturalnumbers"`
+	Complexes           []Complexes           `xml:"complexes"`
+	Primes              []Primes              `xml:"primes"`
+	Exponentiale        []Exponentiale        `xml:"exponentiale"`
+	Imaginaryi          []Imaginaryi          `xml:"imaginaryi"`
+	Notanumber          []Notanumber          `xml:"notanumber"`
+	True                []True                `xml:"true"`
+	False               []False               `xml:"false"`
+	Emptyset            []Emptyset            `xml:"emptyset"`
+	Pi                  []Pi                  `xml:"pi"`
+	Eulergamma          []Eulergamma          `xml:"eulergamma"`
+	Infinity            []Infinity            `xml:"infinity"`
+	Interval            []Interval            `xml:"interval"`
+	List                []List                `xml:"list"`
+	Matrix              []Matrix              `xml:"matrix"`
+	Matrixrow           []Matrixrow           `xml:"matrixrow"`
+	Set                 []Set                 `xml:"set"`
+	Vector              []Vector              `xml:"vector"`
+	Piecewise           []Piecewise           `xml:"piecewise"`
+	Lowlimit            []Lowlimit            `xml:"lowlimit"`
+	Uplimit             []Uplimit             `xml:"uplimit"`
+	Bvar                []Bvar                `xml:"bvar"`
+	Degree              []Degree              `xml:"degree"`
+	Logbase             []Logbase             `xml:"logbase"`
+	Momentabout         []Momentabout         `xml:"momentabout"`
+	Domainofapplication []Domainofapplication `xml:"domainofapplication"`
+	Inverse             []Inverse             `xml:"inverse"`
+	Ident               []Ident               `xml:"ident"`
+	Domain              []Domain              `xml:"domain"`
+	Codomain            []Codomain            `xml:"codomain"`
+	Image               []Image               `xml:"image"`
+	Abs                 []Abs                 `xml:"abs"`
+	Conjugate           []Conjugate           `xml:"conjugate"`
+	Exp                 []Exp                 `xml:"exp"`
+	Factorial           []Factorial           `xml:"factorial"`
+	Arg                 []Arg                 `xml:"arg"`
+	Real                []Real                `xml:"real"`
+	Imaginary           []Imaginary           `xml:"imaginary"`
+	Floor               []Floor               `xml:"floor"`
+	Ceiling             []Ceiling             `xml:"ceiling"`
+	Not                 []Not                 `xml:"not"`
+	Ln                  []Ln                  `xml:"ln"`
+	Sin                 []Sin                 `xml:"sin"`
+	Cos                 []Cos                 `xml:"cos"`
+	Tan                 []Tan                 `xml:"tan"`
+	Sec                 []Sec                 `xml:"sec"`
+	Csc                 []Csc                 `xml:"csc"`
+	Cot                 []Cot                 `xml:"cot"`
+	Sinh                []Sinh                `xml:"sinh"`
+	Cosh                []Cosh                `xml:"cosh"`
+	Tanh                []Tanh                `xml:"tanh"`
+	Sech                []Sech                `xml:"sech"`
+	Csch                []Csch                `xml:"csch"`
+	Coth                []Coth                `xml:"coth"`
+	Arcsin              []Arcsin              `xml:"arcsin"`
+	Arccos              []Arccos              `xml:"arccos"`
+	Arctan              []Arctan              `xml:"arctan"`
+	Arccosh             []Arccosh             `xml:"arccosh"`
+	Arccot              []Arccot              `xml:"arccot"`
+	Arccoth             []Arccoth             `xml:"arccoth"`
+	Arccsc              []Arccsc              `xml:"arccsc"`
+	Arccsch             []Arccsch             `xml:"arccsch"`
+	Arcsec              []Arcsec              `xml:"arcsec"`
+	Arcsech             []Arcsech             `xml:"arcsech"`
+	Arcsinh             []Arcsinh             `xml:"arcsinh"`
+	Arctanh             []Arctanh             `xml:"arctanh"`
+	Determinant         []Determinant         `xml:"determinant"`
+	Transpose           []Transpose           `xml:"transpose"`
+	Card                []Card                `xml:"card"`
+	Quotient            []Quotient            `xml:"quotient"`
+	Divide              []Divide              `xml:"divide"`
+	Power               []Power               `xml:"power"`
+	Rem                 []Rem                 `xml:"rem"`
+	Implies             []Implies             `xml:"implies"`
+	Vectorproduct       []Vectorproduct       `xml:"vectorproduct"`
+	Scalarproduct       []Scalarproduct       `xml:"scalarproduct"`
+	Outerproduct        []Outerproduct        `xml:"outerproduct"`
+	Setdiff             []Setdiff             `xml:"setdiff"`
+	Fn                  []Fn                  `xml:"fn"`
+	Compose             []Compose             `xml:"compose"`
+	Plus                []Plus                `xml:"plus"`
+	Times               []Times               `xml:"times"`
+	Max                 []Max                 `xml:"max"`
+	Min                 []Min                 `xml:"min"`
+	Gcd                 []Gcd                 `xml:"gcd"`
+	Lcm                 []Lcm                 `xml:"lcm"`
+	And                 []And                 `xml:"and"`
+	Or                  []Or                  `xml:"or"`
+	Xor                 []Xor                 `xml:"xor"`
+	Union               []Union               `xml:"union"`
+	Intersect           []Intersect           `xml:"intersect"`
+	Cartesianproduct    []Cartesianproduct    `xml:"cartesianproduct"`
+	Mean                []Mean                `xml:"mean"`
+	Sdev                []Sdev                `xml:"sdev"`
+	Variance            []Variance            `xml:"variance"`
+	Median              []Median              `xml:"median"`
+	Mode                []Mode                `xml:"mode"`
+	Selector            []Selector            `xml:"selector"`
+	Root                []Root                `xml:"root"`
+	Minus               []Minus               `xml:"minus"`
+	Log                 []Log                 `xml:"log"`
+	Int                 []Int                 `xml:"int"`
+	Diff                []Diff                `xml:"diff"`
+	Partialdiff         []Partialdiff         `xml:"partialdiff"`
+	Divergence          []Divergence          `xml:"divergence"`
+	Grad                []Grad                `xml:"grad"`
+	Curl                []Curl                `xml:"curl"`
+	Laplacian           []Laplacian           `xml:"laplacian"`
+	Sum                 []Sum                 `xml:"sum"`
+	Product             []Product             `xml:"product"`
+	Limit               []Limit               `xml:"limit"`
+	Moment              []Moment              `xml:"moment"`
+	Exists              []Exists              `xml:"exists"`
+	Forall              []Forall              `xml:"forall"`
+	Neq                 []Neq                 `xml:"neq"`
+	Factorof            []Factorof            `xml:"factorof"`
+	In                  []In                  `xml:"in"`
+	Notin               []Notin               `xml:"notin"`
+	Notsubset           []Notsubset           `xml:"notsubset"`
+	Notprsubset         []Notprsubset         `xml:"notprsubset"`
+	Tendsto             []Tendsto             `xml:"tendsto"`
+	Eq                  []Eq                  `xml:"eq"`
+	Leq                 []Leq                 `xml:"leq"`
+	Lt                  []Lt                  `xml:"lt"`
+	Geq                 []Geq                 `xml:"geq"`
+	Gt                  []Gt                  `xml:"gt"`
+	Equivalent          []Equivalent          `xml:"equivalent"`
+	Approx              []Approx              `xml:"approx"`
+	Subset              []Subset              `xml:"subset"`
+	Prsubset            []Prsubset            `xml:"prsubset"`
+	Mi                  []Mi                  `xml:"mi"`
+	Mn                  []Mn                  `xml:"mn"`
+	Mo                  []Mo                  `xml:"mo"`
+	Mtext               []Mtext               `xml:"mtext"`
+	Ms                  []Ms                  `xml:"ms"`
+	Mspace              []Mspace              `xml:"mspace"`
+	Mrow                []Mrow                `xml:"mrow"`
+	Mfrac               []Mfrac               `xml:"mfrac"`
+	Msqrt               []Msqrt               `xml:"msqrt"`
+	Mroot               []Mroot               `xml:"mroot"`
+	Menclose            []Menclose            `xml:"menclose"`
+	Mstyle              []Mstyle              `xml:"mstyle"`
+	Merror              []Merror              `xml:"merror"`
+	Mpadded             []Mpadded             `xml:"mpadded"`
+	Mphantom            []Mphantom            `xml:"mphantom"`
+	Mfenced             []Mfenced             `xml:"mfenced"`
+	Msub                []Msub                `xml:"msub"`
+	Msup                []Msup                `xml:"msup"`
+	Msubsup             []Msubsup             `xml:"msubsup"`
+	Munder              []Munder              `xml:"munder"`
+	Mover               []Mover               `xml:"mover"`
+	Munderover          []Munderover          `xml:"munderover"`
+	Mmultiscripts       []Mmultiscripts       `xml:"mmultiscripts"`
+	Mtable              []Mtable              `xml:"mtable"`
+	Mtr                 []Mtr                 `xml:"mtr"`
+	Mlabeledtr          []Mlabeledtr          `xml:"mlabeledtr"`
+	Mtd                 []Mtd                 `xml:"mtd"`
+	Maligngroup         []Maligngroup         `xml:"maligngroup"`
+	Malignmark          []Malignmark          `xml:"malignmark"`
+	Maction             []Maction             `xml:"maction"`
+}
+
+// Momentabout is the element momentabout
+//
+//	(csymbol | ci | cn | apply | reln | lambda | condition | declare | sep | semantics | annotation |
+//	annotation-xml | integers | reals | rationals | naturalnumbers | complexes | primes | exponentiale |
+//	imaginaryi | notanumber | true | false | emptyset | pi | eulergamma | infinity | interval | list |
+//	matrix | matrixrow | set | vector | piecewise | lowlimit | uplimit | bvar | degree | logbase |
+//	momentabout | domainofapplication | inverse | ident | domain | codomain | image | abs | conjugate |
+//	exp | factorial | arg | real | imaginary | floor | ceiling | not | ln | sin | cos | tan | sec | csc
+//	| cot | sinh | cosh | tanh | sech | csch | coth | arcsin | arccos | arctan | arccosh | arccot |
+//	arccoth | arccsc | arccsch | arcsec | arcsech | arcsinh | arctanh | determinant | transpose | card |
+//	quotient | divide | power | rem | implies | vectorproduct | scalarproduct | outerproduct | setdiff
+//	| fn | compose | plus | times | max | min | gcd | lcm | and | or | xor | union | intersect |
+//	cartesianproduct | mean | sdev | variance | median | mode | selector | root | minus | log | int |
+//	diff | partialdiff | divergence | grad | curl | laplacian | sum | product | limit | moment | exists
+//	| forall | neq | factorof | in | notin | notsubset | notprsubset | tendsto | eq | leq | lt | geq |
+//	gt | equivalent | approx | subset | prsubset | mi | mn | mo | mtext | ms | mspace | mrow | mfrac |
+//	msqrt | mroot | menclose | mstyle | merror | mpadded | mphantom | mfenced | msub | msup | msubsup |
+//	munder | mover | munderover | mmultiscripts | mtable | mtr | mlabeledtr | mtd | maligngroup |
+//	malignmark | maction)*
+type Momentabout struct {
+	XlinkHref           string                `xml:"href,attr"`
+	XlinkType           string                `xml:"type,attr"`
+	Class               string                `xml:"class,attr"`
+	Style               string                `xml:"style,attr"`
+	ID                  string                `xml:"id,attr"`
+	Xref                string                `xml:"xref,attr"`
+	Other               string                `xml:"other,attr"`
+	Csymbol             []Csymbol             `xml:"csymbol"`
+	Ci                  []Ci                  `xml:"ci"`
+	Cn                  []Cn                  `xml:"cn"`
+	Apply               []Apply               `xml:"apply"`
+	Reln                []Reln                `xml:"reln"`
+	Lambda              []Lambda              `xml:"lambda"`
+	Condition           []Condition           `xml:"condition"`
+	Declare             []Declare             `xml:"declare"`
+	Sep                 []Sep                 `xml:"sep"`
+	Semantics           []Semantics           `xml:"semantics"`
+	Annotation          []Annotation          `xml:"annotation"`
+	AnnotationXml       []AnnotationXml       `xml:"annotation-xml"`
+	Integers            []Integers            `xml:"integers"`
+	Reals               []Reals               `xml:"reals"`
+	Rationals           []Rationals           `xml:"rationals"`
+	Naturalnumbers      []Naturalnumbers      `xml:"naturalnumbers"`
+	Complexes           []Complexes           `xml:"complexes"`
+	Primes              []Primes              `xml:"primes"`
+	Exponentiale        []Exponentiale        `xml:"exponentiale"`
+	Imaginaryi          []Imaginaryi          `xml:"imaginaryi"`
+	Notanumber          []Notanumber          `xml:"notanumber"`
+	True                []True                `xml:"true"`
+	False               []False               `xml:"false"`
+	Emptyset            []Emptyset            `xml:"emptyset"`
+	Pi                  []Pi                  `xml:"pi"`
+	Eulergamma          []Eulergamma          `xml:"eulergamma"`
+	Infinity            []Infinity            `xml:"infinity"`
+	Interval            []Interval            `xml:"interval"`
+	List                []List                `xml:"list"`
+	Matrix              []Matrix              `xml:"matrix"`
+	Matrixrow           []Matrixrow           `xml:"matrixrow"`
+	Set                 []Set                 `xml:"set"`
+	Vector              []Vector              `xml:"vector"`
+	Piecewise           []Piecewise           `xml:"piecewise"`
+	Lowlimit            []Lowlimit            `xml:"lowlimit"`
+	Uplimit             []Uplimit             `xml:"uplimit"`
+	Bvar                []Bvar                `xml:"bvar"`
+	Degree              []Degree              `xml:"degree"`
+	Logbase             []Logbase             `xml:"logbase"`
+	Momentabout         []Momentabout         `xml:"momentabout"`
+	Domainofapplication []Domainofapplication `xml:"domainofapplication"`
+	Inverse             []Inverse             `xml:"inverse"`
+	Ident               []Ident               `xml:"ident"`
+	Domain              []Domain              `xml:"domain"`
+	Codomain            []Codomain            `xml:"codomain"`
+	Image               []Image               `xml:"image"`
+	Abs                 []Abs                 `xml:"abs"`
+	Conjugate           []Conjugate           `xml:"conjugate"`
+	Exp                 []Exp                 `xml:"exp"`
+	Factorial           []Factorial           `xml:"factorial"`
+	Arg                 []Arg                 `xml:"arg"`
+	Real                []Real                `xml:"real"`
+	Imaginary           []Imaginary           `xml:"imaginary"`
+	Floor               []Floor               `xml:"floor"`
+	Ceiling             []Ceiling             `xml:"ceiling"`
+	Not                 []Not                 `xml:"not"`
+	Ln                  []Ln                  `xml:"ln"`
+	Sin                 []Sin                 `xml:"sin"`
+	Cos                 []Cos                 `xml:"cos"`
+	Tan                 []Tan                 `xml:"tan"`
+	Sec                 []Sec                 `xml:"sec"`
+	Csc                 []Csc                 `xml:"csc"`
+	Cot                 []Cot                 `xml:"cot"`
+	Sinh                []Sinh                `xml:"sinh"`
+	Cosh                []Cosh                `xml:"cosh"`
+	Tanh                []Tanh                `xml:"tanh"`
+	Sech                []Sech                `xml:"sech"`
+	Csch                []Csch                `xml:"csch"`
+	Coth                []Coth                `xml:"coth"`
+	Arcsin              []Arcsin              `xml:"arcsin"`
+	Arccos              []Arccos              `xml:"arccos"`
+	Arctan              []Arctan              `xml:"arctan"`
+	Arccosh             []Arccosh             `xml:"arccosh"`
+	Arccot              []Arccot              `xml:"arccot"`
+	Arccoth             []Arccoth             `xml:"arccoth"`
+	Arccsc              []Arccsc              `xml:"arccsc"`
+	Arccsch             []Arccsch             `xml:"arccsch"`
+	Arcsec              []Arcsec              `xml:"arcsec"`
+	Arcsech             []Arcsech             `xml:"arcsech"`
+	Arcsinh             []Arcsinh             `xml:"arcsinh"`
+	Arctanh             []Arctanh             `xml:"arctanh"`
+	Determinant         []Determinant         `xml:"determinant"`
+	Transpose           []Transpose           `xml:"transpose"`
+	Card                []Card                `xml:"card"`
+	Quotient            []Quotient            `xml:"quotient"`
+	Divide              []Divide              `xml:"divide"`
+	Power               []Power               `xml:"power"`
+	Rem                 []Rem                 `xml:"rem"`
+	Implies             []Implies             `xml:"implies"`
+	Vectorproduct       []Vectorproduct       `xml:"vectorproduct"`
+	Scalarproduct       []Scalarproduct       `xml:"scalarproduct"`
+	Outerproduct        []Outerproduct        `xml:"outerproduct"`
+	Setdiff             []Setdiff             `xml:"setdiff"`
+	Fn                  []Fn                  `xml:"fn"`
+	Compose             []Compose             `xml:"compose"`
+	Plus                []Plus                `xml:"plus"`
+	Times               []Times               `xml:"times"`
+	Max                 []Max                 `xml:"max"`
+	Min                 []Min                 `xml:"min"`
+	Gcd                 []Gcd                 `xml:"gcd"`
+	Lcm                 []Lcm                 `xml:"lcm"`
+	And                 []And                 `xml:"and"`
+	Or                  []Or                  `xml:"or"`
+	Xor                 []Xor                 `xml:"xor"`
+	Union               []Union               `xml:"union"`
+	Intersect           []Intersect           `xml:"intersect"`
+	Cartesianproduct    []Cartesianproduct    `xml:"cartesianproduct"`
+	Mean                []Mean                `xml:"mean"`
+	Sdev                []Sdev                `xml:"sdev"`
+	Variance            []Variance            `xml:"variance"`
+	Median              []Median              `xml:"median"`
+	Mode                []Mode                `xml:"mode"`
+	Selector            []Selector            `xml:"selector"`
+	Root                []Root                `xml:"root"`
+	Minus               []Minus               `xml:"minus"`
+	Log                 []Log                 `xml:"log"`
+	Int                 []Int                 `xml:"int"`
+	Diff                []Diff                `xml:"diff"`
+	Partialdiff         []Partialdiff         `xml:"partialdiff"`
+	Divergence          []Divergence          `xml:"divergence"`
+	Grad                []Grad                `xml:"grad"`
+	Curl                []Curl                `xml:"curl"`
+	Laplacian           []Laplacian           `xml:"laplacian"`
+	Sum                 []Sum                 `xml:"sum"`
+	Product             []Product             `xml:"product"`
+	Limit               []Limit               `xml:"limit"`
+	Moment              []Moment              `xml:"moment"`
+	Exists              []Exists              `xml:"exists"`
+	Forall              []Forall              `xml:"forall"`
+	Neq                 []Neq                 `xml:"neq"`
+	Factorof            []Factorof            `xml:"factorof"`
+	In                  []In                  `xml:"in"`
+	Notin               []Notin               `xml:"notin"`
+	Notsubset           []Notsubset           `xml:"notsubset"`
+	Notprsubset         []Notprsubset         `xml:"notprsubset"`
+	Tendsto             []Tendsto             `xml:"tendsto"`
+	Eq                  []Eq                  `xml:"eq"`
+	Leq                 []Leq                 `xml:"leq"`
+	Lt                  []Lt                  `xml:"lt"`
+	Geq                 []Geq                 `xml:"geq"`
+	Gt                  []Gt                  `xml:"gt"`
+	Equivalent          []Equivalent          `xml:"equivalent"`
+	Approx              []Approx              `xml:"approx"`
+	Subset              []Subset              `xml:"subset"`
+	Prsubset            []Prsubset            `xml:"prsubset"`
+	Mi                  []Mi                  `xml:"mi"`
+	Mn                  []Mn                  `xml:"mn"`
+	Mo                  []Mo                  `xml:"mo"`
+	Mtext               []Mtext               `xml:"mtext"`
+	Ms                  []Ms                  `xml:"ms"`
+	Mspace              []Mspace              `xml:"mspace"`
+	Mrow                []Mrow                `xml:"mrow"`
+	Mfrac               []Mfrac               `xml:"mfrac"`
+	Msqrt               []Msqrt               `xml:"msqrt"`
+	Mroot               []Mroot               `xml:"mroot"`
+	Menclose            []Menclose            `xml:"menclose"`
+	Mstyle              []Mstyle              `xml:"mstyle"`
+	Merror              []Merror              `xml:"merror"`
+	Mpadded             []Mpadded             `xml:"mpadded"`
+	Mphantom            []Mphantom            `xml:"mphantom"`
+	Mfenced             []Mfenced             `xml:"mfenced"`
+	Msub                []Msub                `xml:"msub"`
+	Msup                []Msup                `xml:"msup"`
+	Msubsup             []Msubsup             `xml:"msubsup"`
+	Munder              []Munder              `xml:"munder"`
+	Mover               []Mover               `xml:"mover"`
+	Munderover          []Munderover          `xml:"munderover"`
+	Mmultiscripts       []Mmultiscripts       `xml:"mmultiscripts"`
+	Mtable              []Mtable              `xml:"mtable"`
+	Mtr                 []Mtr                 `xml:"mtr"`
+	Mlabeledtr          []Mlabeledtr          `xml:"mlabeledtr"`
+	Mtd                 []Mtd                 `xml:"mtd"`
+	Maligngroup         []Maligngroup         `xml:"maligngroup"`
+	Malignmark          []Malignmark          `xml:"malignmark"`
+	Maction             []Maction             `xml:"maction"`
+}
+
+// Domainofapplication is the element domainofapplication
+//
+//	(csymbol | ci | cn | apply | reln | lambda | condition | declare | sep | semantics | annotation |
+//	annotation-xml | integers | reals | rationals | naturalnumbers | complexes | primes | exponentiale |
+//	imaginaryi | notanumber | true | false | emptyset | pi | eulergamma | infinity | interval | list |
+//	matrix | matrixrow | set | vector | piecewise | lowlimit | uplimit | bvar | degree | logbase |
+//	momentabout | domainofapplication | inverse | ident | domain | codomain | image | abs | conjugate |
+//	exp | factorial | arg | real | imaginary | floor | ceiling | not | ln | sin | cos | tan | sec | csc
+//	| cot | sinh | cosh | tanh | sech | csch | coth | arcsin | arccos | arctan | arccosh | arccot |
+//	arccoth | arccsc | arccsch | arcsec | arcsech | arcsinh | arctanh | determinant | transpose | card |
+//	quotient | divide | power | rem | implies | vectorproduct | scalarproduct | outerproduct | setdiff
+//	| fn | compose | plus | times | max | min | gcd | lcm | and | or | xor | union | intersect |
+//	cartesianproduct | mean | sdev | variance | median | mode | selector | root | minus | log | int |
+//	diff | partialdiff | divergence | grad | curl | laplacian | sum | product | limit | moment | exists
+//	| forall | neq | factorof | in | notin | notsubset | notprsubset | tendsto | eq | leq | lt | geq |
+//	gt | equivalent | approx | subset | prsubset | mi | mn | mo | mtext | ms | mspace | mrow | mfrac |
+//	msqrt | mroot | menclose | mstyle | merror | mpadded | mphantom | mfenced | msub | msup | msubsup |
+//	munder | mover | munderover | mmultiscripts | mtable | mtr | mlabeledtr | mtd | maligngroup |
+//	malignmark | maction)*
+type Domainofapplication struct {
+	XlinkHref           string                `xml:"href,attr"`
+	XlinkType           string                `xml:"type,attr"`
+	Class               string                `xml:"class,attr"`
+	Style               string                `xml:"style,attr"`
+	ID                  string                `xml:"id,attr"`
+	Xref                string                `xml:"xref,attr"`
+	Other               string                `xml:"other,attr"`
+	Csymbol             []Csymbol             `xml:"csymbol"`
+	Ci                  []Ci                  `xml:"ci"`
+	Cn                  []Cn                  `xml:"cn"`
+	Apply               []Apply               `xml:"apply"`
+	Reln                []Reln                `xml:"reln"`
+	Lambda              []Lambda              `xml:"lambda"`
+	Condition           []Condition           `xml:"condition"`
+	Declare             []Declare             `xml:"declare"`
+	Sep                 []Sep                 `xml:"sep"`
+	Semantics           []Semantics           `xml:"semantics"`
+	Annotation          []Annotation          `xml:"annotation"`
+	AnnotationXml       []AnnotationXml       `xml:"annotation-xml"`
+	Integers            []Integers            `xml:"integers"`
+	Reals               []Reals               `xml:"reals"`
+	Rationals           []Rationals           `xml:"rationals"`
+	Naturalnumbers      []Naturalnumbers      `xml:"naturalnumbers"`
+	Complexes           []Complexes           `xml:"complexes"`
+	Primes              []Primes              `xml:"primes"`
+	Exponentiale        []Exponentiale        `xml:"exponentiale"`
+	Imaginaryi          []Imaginaryi          `xml:"imaginaryi"`
+	Notanumber          []Notanumber          `xml:"notanumber"`
+	True                []True                `xml:"true"`
+	False               []False               `xml:"false"`
+	Emptyset            []Emptyset            `xml:"emptyset"`
+	Pi                  []Pi                  `xml:"pi"`
+	Eulergamma          []Eulergamma          `xml:"eulergamma"`
+	Infinity            []Infinity            `xml:"infinity"`
+	Interval            []Interval            `xml:"interval"`
+	List                []List                `xml:"list"`
+	Matrix              []Matrix              `xml:"matrix"`
+	Matrixrow           []Matrixrow           `xml:"matrixrow"`
+	Set                 []Set                 `xml:"set"`
+	Vector              []Vector              `xml:"vector"`
+	Piecewise           []Piecewise           `xml:"piecewise"`
+	Lowlimit            []Lowlimit            `xml:"lowlimit"`
+	Uplimit             []Uplimit             `xml:"uplimit"`
+	Bvar                []Bvar                `xml:"bvar"`
+	Degree              []Degree              `xml:"degree"`
+	Logbase             []Logbase             `xml:"logbase"`
+	Momentabout         []Momentabout         `xml:"momentabout"`
+	Domainofapplication []Domainofapplication `xml:"domainofapplication"`
+	Inverse             []Inverse             `xml:"inverse"`
+	Ident               []Ident               `xml:"ident"`
+	Domain              []Domain              `xml:"domain"`
+	Codomain            []Codomain            `xml:"codomain"`
+	Image               []Image               `xml:"image"`
+	Abs                 []Abs                 `xml:"abs"`
+	Conjugate           []Conjugate           `xml:"conjugate"`
+	Exp                 []Exp                 `xml:"exp"`
+	Factorial           []Factorial           `xml:"factorial"`
+	Arg                 []Arg                 `xml:"arg"`
+	Real                []Real                `xml:"real"`
+	Imaginary           []Imaginary           `xml:"imaginary"`
+	Floor               []Floor               `xml:"floor"`
+	Ceiling             []Ceiling             `xml:"ceiling"`
+	Not                 []Not                 `xml:"not"`
+	Ln                  []Ln                  `xml:"ln"`
+	Sin                 []Sin                 `xml:"sin"`
+	Cos                 []Cos                 `xml:"cos"`
+	Tan                 []Tan                 `xml:"tan"`
+	Sec                 []Sec                 `xml:"sec"`
+	Csc                 []Csc                 `xml:"csc"`
+	Cot                 []Cot                 `xml:"cot"`
+	Sinh                []Sinh                `xml:"sinh"`
+	Cosh                []Cosh                `xml:"cosh"`
+	Tanh                []Tanh                `xml:"tanh"`
+	Sech                []Sech                `xml:"sech"`
+	Csch                []Csch                `xml:"csch"`
+	Coth                []Coth                `xml:"coth"`
+	Arcsin              []Arcsin              `xml:"arcsin"`
+	Arccos              []Arccos              `xml:"arccos"`
+	Arctan              []Arctan              `xml:"arctan"`
+	Arccosh             []Arccosh             `xml:"arccosh"`
+	Arccot              []Arccot              `xml:"arccot"`
+	Arccoth             []Arccoth             `xml:"arccoth"`
+	Arccsc              []Arccsc              `xml:"arccsc"`
+	Arccsch             []Arccsch             `xml:"arccsch"`
+	Arcsec              []Arcsec              `xml:"arcsec"`
+	Arcsech             []Arcsech             `xml:"arcsech"`
+	Arcsinh             []Arcsinh             `xml:"arcsinh"`
+	Arctanh             []Arctanh             `xml:"arctanh"`
+	Determinant         []Determinant         `xml:"determinant"`
+	Transpose           []Transpose           `xml:"transpose"`
+	Card                []Card                `xml:"card"`
+	Quotient            []Quotient            `xml:"quotient"`
+	Divide              []Divide              `xml:"divide"`
+	Power               []Power               `xml:"power"`
+	Rem                 []Rem                 `xml:"rem"`
+	Implies             []Implies             `xml:"implies"`
+	Vectorproduct       []Vectorproduct       `xml:"vectorproduct"`
+	Scalarproduct       []Scalarproduct       `xml:"scalarproduct"`
+	Outerproduct        []Outerproduct        `xml:"outerproduct"`
+	Setdiff             []Setdiff             `xml:"setdiff"`
+	Fn                  []Fn                  `xml:"fn"`
+	Compose             []Compose             `xml:"compose"`
+	Plus                []Plus                `xml:"plus"`
+	Times               []Times               `xml:"times"`
+	Max                 []Max                 `xml:"max"`
+	Min                 []Min                 `xml:"min"`
+	Gcd                 []Gcd                 `xml:"gcd"`
+	Lcm                 []Lcm                 `xml:"lcm"`
+	And                 []And                 `xml:"and"`
+	Or                  []Or                  `xml:"or"`
+	Xor                 []Xor                 `xml:"xor"`
+	Union               []Union               `xml:"union"`
+	Intersect           []Intersect           `xml:"intersect"`
+	Cartesianproduct    []Cartesianproduct    `xml:"cartesianproduct"`
+	Mean                []Mean                `xml:"mean"`
+	Sdev                []Sdev                `xml:"sdev"`
+	Variance            []Variance            `xml:"variance"`
+	Median              []Median              `xml:"median"`
+	Mode                []Mode                `xml:"mode"`
+	Selector            []Selector            `xml:"selector"`
+	Root                []Root                `xml:"root"`
+	Minus               []Minus               `xml:"minus"`
+	Log                 []Log                 `xml:"log"`
+	Int                 []Int                 `xml:"int"`
+	Diff                []Diff                `xml:"diff"`
+	Partialdiff         []Partialdiff         `xml:"partialdiff"`
+	Divergence          []Divergence          `xml:"divergence"`
+	Grad                []Grad                `xml:"grad"`
+	Curl                []Curl                `xml:"curl"`
+	Laplacian           []Laplacian           `xml:"laplacian"`
+	Sum                 []Sum                 `xml:"sum"`
+	Product             []Product             `xml:"product"`
+	Limit               []Limit               `xml:"limit"`
+	Moment              []Moment              `xml:"moment"`
+	Exists              []Exists              `xml:"exists"`
+	Forall              []Forall              `xml:"forall"`
+	Neq                 []Neq                 `xml:"neq"`
+	Factorof            []Factorof            `xml:"factorof"`
+	In                  []In                  `xml:"in"`
+	Notin               []Notin               `xml:"notin"`
+	Notsubset           []Notsubset           `xml:"notsubset"`
+	Notprsubset         []Notprsubset         `xml:"notprsubset"`
+	Tendsto             []Tendsto             `xml:"tendsto"`
+	Eq                  []Eq                  `xml:"eq"`
+	Leq                 []Leq                 `xml:"leq"`
+	Lt                  []Lt                  `xml:"lt"`
+	Geq                 []Geq                 `xml:"geq"`
+	Gt                  []Gt                  `xml:"gt"`
+	Equivalent          []Equivalent          `xml:"equivalent"`
+	Approx              []Approx              `xml:"approx"`
+	Subset              []Subset              `xml:"subset"`
+	Prsubset            []Prsubset            `xml:"prsubset"`
+	Mi                  []Mi                  `xml:"mi"`
+	Mn                  []Mn                  `xml:"mn"`
+	Mo                  []Mo                  `xml:"mo"`
+	Mtext               []Mtext               `xml:"mtext"`
+	Ms                  []Ms                  `xml:"ms"`
+	Mspace              []Mspace              `xml:"mspace"`
+	Mrow                []Mrow                `xml:"mrow"`
+	Mfrac               []Mfrac               `xml:"mfrac"`
+	Msqrt               []Msqrt               `xml:"msqrt"`
+	Mroot               []Mroot               `xml:"mroot"`
+	Menclose            []Menclose            `xml:"menclose"`
+	Mstyle              []Mstyle              `xml:"mstyle"`
+	Merror              []Merror              `xml:"merror"`
+	Mpadded             []Mpadded             `xml:"mpadded"`
+	Mphantom            []Mphantom            `xml:"mphantom"`
+	Mfenced             []Mfenced             `xml:"mfenced"`
+	Msub                []Msub                `xml:"msub"`
+	Msup                []Msup                `xml:"msup"`
+	Msubsup             []Msubsup             `xml:"msubsup"`
+	Munder              []Munder              `xml:"munder"`
+	Mover               []Mover               `xml:"mover"`
+	Munderover          []Munderover          `xml:"munderover"`
+	Mmultiscripts       []Mmultiscripts       `xml:"mmultiscripts"`
+	Mtable              []Mtable              `xml:"mtable"`
+	Mtr                 []Mtr                 `xml:"mtr"`
+	Mlabeledtr          []Mlabeledtr          `xml:"mlabeledtr"`
+	Mtd                 []Mtd                 `xml:"mtd"`
+	Maligngroup         []Maligngroup         `xml:"maligngroup"`
+	Malignmark          []Malignmark          `xml:"malignmark"`
+	Maction             []Maction             `xml:"maction"`
+}
+
+// Inverse is the element inverse
+//
+//	EMPTY
+type Inverse struct {
+	XlinkHref     string `xml:"href,attr"`
+	XlinkType     string `xml:"type,attr"`
+	Class         string `xml:"class,attr"`
+	Style         string `xml:"style,attr"`
+	ID            string `xml:"id,attr"`
+	Xref          string `xml:"xref,attr"`
+	Other         string `xml:"other,attr"`
+	DefinitionURL string `xml:"definitionURL,attr"`
+	Encoding      string `xml:"encoding,attr"`
+}
+
+// Ident is the element ident
+//
+//	EMPTY
+type Ident struct {
+	XlinkHref     string `xml:"href,attr"`
+	XlinkType     string `xml:"type,attr"`
+	Class         string `xml:"class,attr"`
+	Style         string `xml:"style,attr"`
+	ID            string `xml:"id,attr"`
+	Xref          string `xml:"xref,attr"`
+	Other         string `xml:"other,attr"`
+	DefinitionURL string `xml:"definitionURL,attr"`
+	Encoding      string `xml:"encoding,attr"`
+}
+
+// Domain is the element domain
+//
+//	EMPTY
+type Domain struct {
+	XlinkHref     string `xml:"href,attr"`
+	XlinkType     string `xml:"type,attr"`
+	Class         string `xml:"class,attr"`
+	Style         string `xml:"style,attr"`
+	ID            string `xml:"id,attr"`
+	Xref          string `xml:"xref,attr"`
+	Other         string `xml:"other,attr"`
+	DefinitionURL string `xml:"definitionURL,attr"`
+	Encoding      string `xml:"encoding,attr"`
+}
+
+// Codomain is the element codomain
+//
+//	EMPTY
+type Codomain struct {
+	XlinkHref     string `xml:"href,attr"`
+	XlinkType     string `xml:"type,attr"`
+	Class         string `xml:"class,attr"`
+	Style         string `xml:"style,attr"`
+	ID            string `xml:"id,attr"`
+	Xref          string `xml:"xref,attr"`
+	Other         string `xml:"other,attr"`
+	DefinitionURL string `xml:"definitionURL,attr"`
+	Encoding      string `xml:"encoding,attr"`
+}
+
+// Image is the element image
+//
+//	EMPTY
+type Image struct {
+	XlinkHref     string `xml:"href,attr"`
+	XlinkType     string `xml:"type,attr"`
+	Class         string `xml:"class,attr"`
+	Style         string `xml:"style,attr"`
+	ID            string `xml:"id,attr"`
+	Xref          string `xml:"xref,attr"`
+	Other         string `xml:"other,attr"`
+	DefinitionURL string `xml:"definitionURL,attr"`
+	Encoding      string `xml:"encoding,attr"`
+}
+
+// Abs is the element abs
+//
+//	EMPTY
+type Abs struct {
+	XlinkHref     string `xml:"href,attr"`
+	XlinkType     string `xml:"type,attr"`
+	Class         string `xml:"class,attr"`
+	Style         string `xml:"style,attr"`
+	ID            string `xml:"id,attr"`
+	Xref          string `xml:"xref,attr"`
+	Other         string `xml:"other,attr"`
+	DefinitionURL string `xml:"definitionURL,attr"`
+	Encoding      string `xml:"encoding,attr"`
+}
+
+// Conjugate is the element conjugate
+//
+//	EMPTY
+type Conjugate struct {
+	XlinkHref     string `xml:"href,attr"`
+	XlinkType     string `xml:"type,attr"`
+	Class         string `xml:"class,attr"`
+	Style         string `xml:"style,attr"`
+	ID            string `xml:"id,attr"`
+	Xref          string `xml:"xref,attr"`
+	Other         string `xml:"other,attr"`
+	DefinitionURL string `xml:"definitionURL,attr"`
+	Encoding      string `xml:"encoding,attr"`
+}
+
+// Exp is the element exp
+//
+//	EMPTY
+type Exp struct {
+	XlinkHref     string `xml:"href,attr"`
+	XlinkType     string `xml:"type,attr"`
+	Class         string `xml:"class,attr"`
+	Style         string `xml:"style,attr"`
+	ID            string `xml:"id,attr"`
+	Xref          string `xml:"xref,attr"`
+	Other         string `xml:"other,attr"`
+	DefinitionURL string `xml:"definitionURL,attr"`
+	Encoding      string `xml:"encoding,attr"`
+}
+
+// Factorial is the element factorial
+//
+//	EMPTY
+type Factorial struct {
+	XlinkHref     string `xml:"href,attr"`
+	XlinkType     string `xml:"type,attr"`
+	Class         string `xml:"class,attr"`
+	Style         string `xml:"style,attr"`
+	ID            string `xml:"id,attr"`
+	Xref          string `xml:"xref,attr"`
+	Other         string `xml:"other,attr"`
+	DefinitionURL string `xml:"definitionURL,attr"`
+	Encoding      string `xml:"encoding,attr"`
+}
+
+// Arg is the element arg
+//
+//	EMPTY
+type Arg struct {
+	XlinkHref     string `xml:"href,attr"`
+	XlinkType     string `xml:"type,attr"`
+	Class         string `xml:"class,attr"`
+	Style         string `xml:"style,attr"`
+	ID            string `xml:"id,attr"`
+	Xref          string `xml:"xref,attr"`
+	Other         string `xml:"other,attr"`
+	DefinitionURL string `xml:"definitionURL,attr"`
+	Encoding      string `xml:"encoding,attr"`
+}
+
+// Real is the element real
+//
+//	EMPTY
+type Real struct {
+	XlinkHref     string `xml:"href,attr"`
+	XlinkType     string `xml:"type,attr"`
+	Class         string `xml:"class,attr"`
+	Style         string `xml:"style,attr"`
+	ID            string `xml:"id,attr"`
+	Xref          string `xml:"xref,attr"`
+	Other         string `xml:"other,attr"`
+	DefinitionURL string `xml:"definitionURL,attr"`
+	Encoding      string `xml:"encoding,attr"`
+}
+
+// Imaginary is the element imaginary
+//
+//	EMPTY
+type Imaginary struct {
+	XlinkHref     string `xml:"href,attr"`
+	XlinkType     string `xml:"type,attr"`
+	Class         string `xml:"class,attr"`
+	Style         string `xml:"style,attr"`
+	ID            string `xml:"id,attr"`
+	Xref          string `xml:"xref,attr"`
+	Other         string `xml:"other,attr"`
+	DefinitionURL string `xml:"definitionURL,attr"`
+	Encoding      string `xml:"encoding,attr"`
+}
+
+// Floor is the element floor
+//
+//	EMPTY
+type Floor struct {
+	XlinkHref     string `xml:"href,attr"`
+	XlinkType     string `xml:"type,attr"`
+	Class         string `xml:"class,attr"`
+	Style         string `xml:"style,attr"`
+	ID            string `xml:"id,attr"`
+	Xref          string `xml:"xref,attr"`
+	Other         string `xml:"other,attr"`
+	DefinitionURL string `xml:"definitionURL,attr"`
+	Encoding      string `xml:"encoding,attr"`
+}
+
+// Ceiling is the element ceiling
+//
+//	EMPTY
+type Ceiling struct {
+	XlinkHref     string `xml:"href,attr"`
+	XlinkType     string `xml:"type,attr"`
+	Class         string `xml:"class,attr"`
+	Style         string `xml:"style,attr"`
+	ID            string `xml:"id,attr"`
+	Xref          string `xml:"xref,attr"`
+	Other         string `xml:"other,attr"`
+	DefinitionURL string `xml:"definitionURL,attr"`
+	Encoding      string `xml:"encoding,attr"`
+}
+
+// Not is the element not
+//
+//	EMPTY
+type Not struct {
+	XlinkHref     string `xml:"href,attr"`
+	XlinkType     string `xml:"type,attr"`
+	Class         string `xml:"class,attr"`
+	Style         string `xml:"style,attr"`
+	ID            string `xml:"id,attr"`
+	Xref          string `xml:"xref,attr"`
+	Other         string `xml:"other,attr"`
+	DefinitionURL string `xml:"definitionURL,attr"`
+	Encoding      string `xml:"encoding,attr"`
+}
+
+// Ln is the element ln
+//
+//	EMPTY
+type Ln struct {
+	XlinkHref     string `xml:"href,attr"`
+	XlinkType     string `xml:"type,attr"`
+	Class         string `xml:"class,attr"`
+	Style         string `xml:"style,attr"`
+	ID            string `xml:"id,attr"`
+	Xref          string `xml:"xref,attr"`
+	Other         string `xml:"other,attr"`
+	DefinitionURL string `xml:"definitionURL,attr"`
+	Encoding      string `xml:"encoding,attr"`
+}
+
+// Sin is the element sin
+//
+//	EMPTY
+type Sin struct {
+	XlinkHref     string `xml:"href,attr"`
+	XlinkType     string `xml:"type,attr"`
+	Class         string `xml:"class,attr"`
+	Style         string `xml:"style,attr"`
+	ID            string `xml:"id,attr"`
+	Xref          string `xml:"xref,attr"`
+	Other         string `xml:"other,attr"`
+	DefinitionURL string `xml:"definitionURL,attr"`
+	Encoding      string `xml:"encoding,attr"`
+}
+
+// Cos is the element cos
+//
+//	EMPTY
+type Cos struct {
+	XlinkHref     string `xml:"href,attr"`
+	XlinkType     string `xml:"type,attr"`
+	Class         string `xml:"class,attr"`
+	Style         string `xml:"style,attr"`
+	ID            string `xml:"id,attr"`
+	Xref          string `xml:"xref,attr"`
+	Other         string `xml:"other,attr"`
+	DefinitionURL string `xml:"definitionURL,attr"`
+	Encoding      string `xml:"encoding,attr"`
+}
+
+// Tan is the element tan
+//
+//	EMPTY
+type Tan struct {
+	XlinkHref     string `xml:"href,attr"`
+	XlinkType     string `xml:"type,attr"`
+	Class         string `xml:"class,attr"`
+	Style         string `xml:"style,attr"`
+	ID            string `xml:"id,attr"`
+	Xref          string `xml:"xref,attr"`
+	Other         string `xml:"other,attr"`
+	DefinitionURL string `xml:"definitionURL,attr"`
+	Encoding      string `xml:"encoding,attr"`
+}
+
+// Sec is the element sec
+//
+//	EMPTY
+type Sec struct {
+	XlinkHref     string `xml:"href,attr"`
+	XlinkType     string `xml:"type,attr"`
+	Class         string `xml:"class,attr"`
+	Style         string `xml:"style,attr"`
+	ID            string `xml:"id,attr"`
+	Xref          string `xml:"xref,attr"`
+	Other         string `xml:"other,attr"`
+	DefinitionURL string `xml:"definitionURL,attr"`
+	Encoding      string `xml:"encoding,attr"`
+}
+
+// Csc is the element csc
+//
+//	EMPTY
+type Csc struct {
+	XlinkHref     string `xml:"href,attr"`
+	XlinkType     string `xml:"type,attr"`
+	Class         string `xml:"class,attr"`
+	Style         string `xml:"style,attr"`
+	ID            string `xml:"id,attr"`
+	Xref          string `xml:"xref,attr"`
+	Other         string `xml:"other,attr"`
+	DefinitionURL string `xml:"definitionURL,attr"`
+	Encoding      string `xml:"encoding,attr"`
+}
+
+// Cot is the element cot
+//
+//	EMPTY
+type Cot struct {
+	XlinkHref     string `xml:"href,attr"`
+	XlinkType     string `xml:"type,attr"`
+	Class         string `xml:"class,attr"`
+	Style         string `xml:"style,attr"`
+	ID            string `xml:"id,attr"`
+	Xref          string `xml:"xref,attr"`
+	Other         string `xml:"other,attr"`
+	DefinitionURL string `xml:"definitionURL,attr"`
+	Encoding      string `xml:"encoding,attr"`
+}
+
+// Sinh is the element sinh
+//
+//	EMPTY
+type Sinh struct {
+	XlinkHref     string `xml:"href,attr"`
+	XlinkType     string `xml:"type,attr"`
+	Class         string `xml:"class,attr"`
+	Style         string `xml:"style,attr"`
+	ID            string `xml:"id,attr"`
+	Xref          string `xml:"xref,attr"`
+	Other         string `xml:"other,attr"`
+	DefinitionURL string `xml:"definitionURL,attr"`
+	Encoding      string `xml:"encoding,attr"`
+}
+
+// Cosh is the element cosh
+//
+//	EMPTY
+type Cosh struct {
+	XlinkHref     string `xml:"href,attr"`
+	XlinkType     string `xml:"type,attr"`
+	Class         string `xml:"class,attr"`
+	Style         string `xml:"style,attr"`
+	ID            string `xml:"id,attr"`
+	Xref          string `xml:"xref,attr"`
+	Other         string `xml:"other,attr"`
+	DefinitionURL string `xml:"definitionURL,attr"`
+	Encoding      string `xml:"encoding,attr"`
+}
+
+// Tanh is the element tanh
+//
+//	EMPTY
+type Tanh struct {
+	XlinkHref     string `xml:"href,attr"`
+	XlinkType     string `xml:"type,attr"`
+	Class         string `xml:"class,attr"`
+	Style         string `xml:"style,attr"`
+	ID            string `xml:"id,attr"`
+	Xref          string `xml:"xref,attr"`
+	Other         string `xml:"other,attr"`
+	DefinitionURL string `xml:"definitionURL,attr"`
+	Encoding      string `xml:"encoding,attr"`
+}
+
+// Sech is the element sech
+//
+//	EMPTY
+type Sech struct {
+	XlinkHref     string `xml:"href,attr"`
+	XlinkType     string `xml:"type,attr"`
+	Class         string `xml:"class,attr"`
+	Style         string `xml:"style,attr"`
+	ID            string `xml:"id,attr"`
+	Xref          string `xml:"xref,attr"`
+	Other         string `xml:"other,attr"`
+	DefinitionURL string `xml:"definitionURL,attr"`
+	Encoding      string `xml:"encoding,attr"`
+}
+
+// Csch is the element csch
+//
+//	EMPTY
+type Csch struct {
+	XlinkHref     string `xml:"href,attr"`
+	XlinkType     string `xml:"type,attr"`
+	Class         string `xml:"class,attr"`
+	Style         string `xml:"style,attr"`
+	ID            string `xml:"id,attr"`
+	Xref          string `xml:"xref,attr"`
+	Other         string `xml:"other,attr"`
+	DefinitionURL string `xml:"definitionURL,attr"`
+	Encoding      string `xml:"encoding,attr"`
+}
+
+// Coth is the element coth
+//
+//	EMPTY
+type Coth struct {
+	XlinkHref     string `xml:"href,attr"`
+	XlinkType     string `xml:"type,attr"`
+	Class         string `xml:"class,attr"`
+	Style         string `xml:"style,attr"`
+	ID            string `xml:"id,attr"`
+	Xref          string `xml:"xref,attr"`
+	Other         string `xml:"other,attr"`
+	DefinitionURL string `xml:"definitionURL,attr"`
+	Encoding      string `xml:"encoding,attr"`
+}
+
+// Arcsin is the element arcsin
+//
+//	EMPTY
+type Arcsin struct {
+	XlinkHref     string `xml:"href,attr"`
+	XlinkType     string `xml:"type,attr"`
+	Class         string `xml:"class,attr"`
+	Style         string `xml:"style,attr"`
+	ID            string `xml:"id,attr"`
+	Xref          string `xml:"xref,attr"`
+	Other         string `xml:"other,attr"`
+	DefinitionURL string `xml:"definitionURL,attr"`
+	Encoding      string `xml:"encoding,attr"`
+}
+
+// Arccos is the element arccos
+//
+//	EMPTY
+type Arccos struct {
+	XlinkHref     string `xml:"href,attr"`
+	XlinkType     string `xml:"type,attr"`
+	Class         string `xml:"class,attr"`
+	Style         string `xml:"style,attr"`
+	ID            string `xml:"id,attr"`
+	Xref          string `xml:"xref,attr"`
+	Other         string `xml:"other,attr"`
+	DefinitionURL string `xml:"definitionURL,attr"`
+	Encoding      string `xml:"encoding,attr"`
+}
+
+// Arctan is the element arctan
+//
+//	EMPTY
+type Arctan struct {
+	XlinkHref     string `xml:"href,attr"`
+	XlinkType     string `xml:"type,attr"`
+	Class         string `xml:"class,attr"`
+	Style         string `xml:"style,attr"`
+	ID            string `xml:"id,attr"`
+	Xref          string `xml:"xref,attr"`
+	Other         string `xml:"other,attr"`
+	DefinitionURL string `xml:"definitionURL,attr"`
+	Encoding      string `xml:"encoding,attr"`
+}
+
+// Arccosh is the element arccosh
+//
+//	EMPTY
+type Arccosh struct {
+	XlinkHref     string `xml:"href,attr"`
+	XlinkType     string `xml:"type,attr"`
+	Class         string `xml:"class,attr"`
+	Style         string `xml:"style,attr"`
+	ID            string `xml:"id,attr"`
+	Xref          string `xml:"xref,attr"`
+	Other         string `xml:"other,attr"`
+	DefinitionURL string `xml:"definitionURL,attr"`
+	Encoding      string `xml:"encoding,attr"`
+}
+
+// Arccot is the element arccot
+//
+//	EMPTY
+type Arccot struct {
+	XlinkHref     string `xml:"href,attr"`
+	XlinkType     string `xml:"type,attr"`
+	Class         string `xml:"class,attr"`
+	Style         string `xml:"style,attr"`
+	ID            string `xml:"id,attr"`
+	Xref          string `xml:"xref,attr"`
+	Other         string `xml:"other,attr"`
+	DefinitionURL string `xml:"definitionURL,attr"`
+	Encoding      string `xml:"encoding,attr"`
+}
+
+// Arccoth is the element arccoth
+//
+//	EMPTY
+type Arccoth struct {
+	XlinkHref     string `xml:"href,attr"`
+	XlinkType     string `xml:"type,attr"`
+	Class         string `xml:"class,attr"`
+	Style         string `xml:"style,attr"`
+	ID            string `xml:"id,attr"`
+	Xref          string `xml:"xref,attr"`
+	Other         string `xml:"other,attr"`
+	DefinitionURL string `xml:"definitionURL,attr"`
+	Encoding      string `xml:"encoding,attr"`
+}
+
+// Arccsc is the element arccsc
+//
+//	EMPTY
+type Arccsc struct {
+	XlinkHref     string `xml:"href,attr"`
+	XlinkType     string `xml:"type,attr"`
+	Class         string `xml:"class,attr"`
+	Style         string `xml:"style,attr"`
+	ID            string `xml:"id,attr"`
+	Xref          string `xml:"xref,attr"`
+	Other         string `xml:"other,attr"`
+	DefinitionURL string `xml:"definitionURL,attr"`
+	Encoding      string `xml:"encoding,attr"`
+}
+
+// Arccsch is the element arccsch
+//
+//	EMPTY
+type Arccsch struct {
+	XlinkHref     string `xml:"href,attr"`
+	XlinkType     string `xml:"type,attr"`
+	Class         string `xml:"class,attr"`
+	Style         string `xml:"style,attr"`
+	ID            string `xml:"id,attr"`
+	Xref          string `xml:"xref,attr"`
+	Other         string `xml:"other,attr"`
+	DefinitionURL string `xml:"definitionURL,attr"`
+	Encoding      string `xml:"encoding,attr"`
+}
+
+// Arcsec is the element arcsec
+//
+//	EMPTY
+type Arcsec struct {
+	XlinkHref     string `xml:"href,attr"`
+	XlinkType     string `xml:"type,attr"`
+	Class         string `xml:"class,attr"`
+	Style         string `xml:"style,attr"`
+	ID            string `xml:"id,attr"`
+	Xref          string `xml:"xref,attr"`
+	Other         string `xml:"other,attr"`
+	DefinitionURL string `xml:"definitionURL,attr"`
+	Encoding      string `xml:"encoding,attr"`
+}
+
+// Arcsech is the element arcsech
+//
+//	EMPTY
+type Arcsech struct {
+	XlinkHref     string `xml:"href,attr"`
+	XlinkType     string `xml:"type,attr"`
+	Class         string `xml:"class,attr"`
+	Style         string `xml:"style,attr"`
+	ID            string `xml:"id,attr"`
+	Xref          string `xml:"xref,attr"`
+	Other         string `xml:"other,attr"`
+	DefinitionURL string `xml:"definitionURL,attr"`
+	Encoding      string `xml:"encoding,attr"`
+}
+
+// Arcsinh is the element arcsinh
+//
+//	EMPTY
+type Arcsinh struct {
+	XlinkHref     string `xml:"href,attr"`
+	XlinkType     string `xml:"type,attr"`
+	Class         string `xml:"class,attr"`
+	Style         string `xml:"style,attr"`
+	ID            string `xml:"id,attr"`
+	Xref          string `xml:"xref,attr"`
+	Other         string `xml:"other,attr"`
+	DefinitionURL string `xml:"definitionURL,attr"`
+	Encoding      string `xml:"encoding,attr"`
+}
+
+// Arctanh is the element arctanh
+//
+//	EMPTY
+type Arctanh struct {
+	XlinkHref     string `xml:"href,attr"`
+	XlinkType     string `xml:"type,attr"`
+	Class         string `xml:"class,attr"`
+	Style         string `xml:"style,attr"`
+	ID            string `xml:"id,attr"`
+	Xref          string `xml:"xref,attr"`
+	Other         string `xml:"other,attr"`
+	DefinitionURL string `xml:"definitionURL,attr"`
+	Encoding      string `xml:"encoding,attr"`
+}
+
+// Determinant is the element determinant
+//
+//	EMPTY
+type Determinant struct {
+	XlinkHref     string `xml:"href,attr"`
+	XlinkType     string `xml:"type,attr"`
+	Class         string `xml:"class,attr"`
+	Style         string `xml:"style,attr"`
+	ID            string `xml:"id,attr"`
+	Xref          string `xml:"xref,attr"`
+	Other         string `xml:"other,attr"`
+	DefinitionURL string `xml:"definitionURL,attr"`
+	Encoding      string `xml:"encoding,attr"`
+}
+
+// Transpose is the element transpose
+//
+//	EMPTY
+type Transpose struct {
+	XlinkHref     string `xml:"href,attr"`
+	XlinkType     string `xml:"type,attr"`
+	Class         string `xml:"class,attr"`
+	Style         string `xml:"style,attr"`
+	ID            string `xml:"id,attr"`
+	Xref          string `xml:"xref,attr"`
+	Other         string `xml:"other,attr"`
+	DefinitionURL string `xml:"definitionURL,attr"`
+	Encoding      string `xml:"encoding,attr"`
+}
+
+// Card is the element card
+//
+//	EMPTY
+type Card struct {
+	XlinkHref     string `xml:"href,attr"`
+	XlinkType     string `xml:"type,attr"`
+	Class         string `xml:"class,attr"`
+	Style         string `xml:"style,attr"`
+	ID            string `xml:"id,attr"`
+	Xref          string `xml:"xref,attr"`
+	Other         string `xml:"other,attr"`
+	DefinitionURL string `xml:"definitionURL,attr"`
+	Encoding      string `xml:"encoding,attr"`
+}
+
+// Quotient is the element quotient
+//
+//	EMPTY
+type Quotient struct {
+	XlinkHref     string `xml:"href,attr"`
+	XlinkType     string `xml:"type,attr"`
+	Class         string `xml:"class,attr"`
+	Style         string `xml:"style,attr"`
+	ID            string `xml:"id,attr"`
+	Xref          string `xml:"xref,attr"`
+	Other         string `xml:"other,attr"`
+	DefinitionURL string `xml:"definitionURL,attr"`
+	Encoding      string `xml:"encoding,attr"`
+}
+
+// Divide is the element divide
+//
+//	EMPTY
+type Divide struct {
+	XlinkHref     string `xml:"href,attr"`
+	XlinkType     string `xml:"type,attr"`
+	Class         string `xml:"class,attr"`
+	Style         string `xml:"style,attr"`
+	ID            string `xml:"id,attr"`
+	Xref          string `xml:"xref,attr"`
+	Other         string `xml:"other,attr"`
+	DefinitionURL string `xml:"definitionURL,attr"`
+	Encoding      string `xml:"encoding,attr"`
+}
+
+// Power is the element power
+//
+//	EMPTY
+type Power struct {
+	XlinkHref     string `xml:"href,attr"`
+	XlinkType     string `xml:"type,attr"`
+	Class         string `xml:"class,attr"`
+	Style         string `xml:"style,attr"`
+	ID            string `xml:"id,attr"`
+	Xref          string `xml:"xref,attr"`
+	Other         string `xml:"other,attr"`
+	DefinitionURL string `xml:"definitionURL,attr"`
+	Encoding      string `xml:"encoding,attr"`
+}
+
+// Rem is the element rem
+//
+//	EMPTY
+type Rem struct {
+	XlinkHref     string `xml:"href,attr"`
+	XlinkType     string `xml:"type,attr"`
+	Class         string `xml:"class,attr"`
+	Style         string `xml:"style,attr"`
+	ID            string `xml:"id,attr"`
+	Xref          string `xml:"xref,attr"`
+	Other         string `xml:"other,attr"`
+	DefinitionURL string `xml:"definitionURL,attr"`
+	Encoding      string `xml:"encoding,attr"`
+}
+
+// Implies is the element implies
+//
+//	EMPTY
+type Implies struct {
+	XlinkHref     string `xml:"href,attr"`
+	XlinkType     string `xml:"type,attr"`
+	Class         string `xml:"class,attr"`
+	Style         string `xml:"style,attr"`
+	ID            string `xml:"id,attr"`
+	Xref          string `xml:"xref,attr"`
+	Other         string `xml:"other,attr"`
+	DefinitionURL string `xml:"definitionURL,attr"`
+	Encoding      string `xml:"encoding,attr"`
+}
+
+// Vectorproduct is the element vectorproduct
+//
+//	EMPTY
+type Vectorproduct struct {
+	XlinkHref     string `xml:"href,attr"`
+	XlinkType     string `xml:"type,attr"`
+	Class         string `xml:"class,attr"`
+	Style         string `xml:"style,attr"`
+	ID            string `xml:"id,attr"`
+	Xref          string `xml:"xref,attr"`
+	Other         string `xml:"other,attr"`
+	DefinitionURL string `xml:"definitionURL,attr"`
+	Encoding      string `xml:"encoding,attr"`
+}
+
+// Scalarproduct is the element scalarproduct
+//
+//	EMPTY
+type Scalarproduct struct {
+	XlinkHref     string `xml:"href,attr"`
+	XlinkType     string `xml:"type,attr"`
+	Class         string `xml:"class,attr"`
+	Style         string `xml:"style,attr"`
+	ID            string `xml:"id,attr"`
+	Xref          string `xml:"xref,attr"`
+	Other         string `xml:"other,attr"`
+	DefinitionURL string `xml:"definitionURL,attr"`
+	Encoding      string `xml:"encoding,attr"`
+}
+
+// Outerproduct is the element outerproduct
+//
+//	EMPTY
+type Outerproduct struct {
+	XlinkHref     string `xml:"href,attr"`
+	XlinkType     string `xml:"type,attr"`
+	Class         string `xml:"class,attr"`
+	Style         string `xml:"style,attr"`
+	ID            string `xml:"id,attr"`
+	Xref          string `xml:"xref,attr"`
+	Other         string `xml:"other,attr"`
+	DefinitionURL string `xml:"definitionURL,attr"`
+	Encoding      string `xml:"encoding,attr"`
+}
+
+// Setdiff is the element setdiff
+//
+//	EMPTY
+type Setdiff struct {
+	XlinkHref     string `xml:"href,attr"`
+	XlinkType     string `xml:"type,attr"`
+	Class         string `xml:"class,attr"`
+	Style         string `xml:"style,attr"`
+	ID            string `xml:"id,attr"`
+	Xref          string `xml:"xref,attr"`
+	Other         string `xml:"other,attr"`
+	DefinitionURL string `xml:"definitionURL,attr"`
+	Encoding      string `xml:"encoding,attr"`
+}
+
+// Fn is the element fn
+//
+//	(csymbol | ci | cn | apply | reln | lambda | condition | declare | sep | semantics | annotation |
+//	annotation-xml | integers | reals | rationals | naturalnumbers | complexes | primes | exponentiale |
+//	imaginaryi | notanumber | true | false | emptyset | pi | eulergamma | infinity | interval | list |
+//	matrix | matrixrow | set | vector | piecewise | lowlimit | uplimit | bvar | degree | logbase |
+//	momentabout | domainofapplication | inverse | ident | domain | codomain | image | abs | conjugate |
+//	exp | factorial | arg | real | imaginary | floor | ceiling | not | ln | sin | cos | tan | sec | csc
+//	| cot | sinh | cosh | tanh | sech | csch | coth | arcsin | arccos | arctan | arccosh | arccot |
+//	arccoth | arccsc | arccsch | arcsec | arcsech | arcsinh | arctanh | determinant | transpose | card |
+//	quotient | divide | power | rem | implies | vectorproduct | scalarproduct | outerproduct | setdiff
+//	| fn | compose | plus | times | max | min | gcd | lcm | and | or | xor | union | intersect |
+//	cartesianproduct | mean | sdev | variance | median | mode | selector | root | minus | log | int |
+//	diff | partialdiff | divergence | grad | curl | laplacian | sum | product | limit | moment | exists
+//	| forall | neq | factorof | in | notin | notsubset | notprsubset | tendsto | eq | leq | lt | geq |
+//	gt | equivalent | approx | subset | prsubset | mi | mn | mo | mtext | ms | mspace | mrow | mfrac |
+//	msqrt | mroot | menclose | mstyle | merror | mpadded | mphantom | mfenced | msub | msup | msubsup |
+//	munder | mover | munderover | mmultiscripts | mtable | mtr | mlabeledtr | mtd | maligngroup |
+//	malignmark | maction)*
+type Fn struct {
+	XlinkHref           string                `xml:"href,attr"`
+	XlinkType           string                `xml:"type,attr"`
+	Class               string                `xml:"class,attr"`
+	Style               string                `xml:"style,attr"`
+	ID                  string                `xml:"id,attr"`
+	Xref                string                `xml:"xref,attr"`
+	Other               string                `xml:"other,attr"`
+	DefinitionURL       string                `xml:"definitionURL,attr"`
+	Encoding            string                `xml:"encoding,attr"`
+	Csymbol             []Csymbol             `xml:"csymbol"`
+	Ci                  []Ci                  `xml:"ci"`
+	Cn                  []Cn                  `xml:"cn"`
+	Apply               []Apply               `xml:"apply"`
+	Reln                []Reln                `xml:"reln"`
+	Lambda              []Lambda              `xml:"lambda"`
+	Condition           []Condition           `xml:"condition"`
+	Declare             []Declare             `xml:"declare"`
+	Sep                 []Sep                 `xml:"sep"`
+	Semantics           []Semantics           `xml:"semantics"`
+	Annotation          []Annotation          `xml:"annotation"`
+	AnnotationXml       []AnnotationXml       `xml:"annotation-xml"`
+	Integers            []Integers            `xml:"integers"`
+	Reals               []Reals               `xml:"reals"`
+	Rationals           []Rationals           `xml:"rationals"`
+	Naturalnumbers      []Naturalnumbers      `xml:"naturalnumbers"`
+	Complexes           []Complexes           `xml:"complexes"`
+	Primes              []Primes              `xml:"primes"`
+	Exponentiale        []Exponentiale        `xml:"exponentiale"`
+	Imaginaryi          []Imaginaryi          `xml:"imaginaryi"`
+	Notanumber          []Notanumber          `xml:"notanumber"`
+	True                []True                `xml:"true"`
+	False               []False               `xml:"false"`
+	Emptyset            []Emptyset            `xml:"emptyset"`
+	Pi                  []Pi                  `xml:"pi"`
+	Eulergamma          []Eulergamma          `xml:"eulergamma"`
+	Infinity            []Infinity            `xml:"infinity"`
+	Interval            []Interval            `xml:"interval"`
+	List                []List                `xml:"list"`
+	Matrix              []Matrix              `xml:"matrix"`
+	Matrixrow           []Matrixrow           `xml:"matrixrow"`
+	Set                 []Set                 `xml:"set"`
+	Vector              []Vector              `xml:"vector"`
+	Piecewise           []Piecewise           `xml:"piecewise"`
+	Lowlimit            []Lowlimit            `xml:"lowlimit"`
+	Uplimit             []Uplimit             `xml:"uplimit"`
+	Bvar                []Bvar                `xml:"bvar"`
+	Degree              []Degree              `xml:"degree"`
+	Logbase             []Logbase             `xml:"logbase"`
+	Momentabout         []Momentabout         `xml:"momentabout"`
+	Domainofapplication []Domainofapplication `xml:"domainofapplication"`
+	Inverse             []Inverse             `xml:"inverse"`
+	Ident               []Ident               `xml:"ident"`
+	Domain              []Domain              `xml:"domain"`
+	Codomain            []Codomain            `xml:"codomain"`
+	Image               []Image               `xml:"image"`
+	Abs                 []Abs                 `xml:"abs"`
+	Conjugate           []Conjugate           `xml:"conjugate"`
+	Exp                 []Exp                 `xml:"exp"`
+	Factorial           []Factorial           `xml:"factorial"`
+	Arg                 []Arg                 `xml:"arg"`
+	Real                []Real                `xml:"real"`
+	Imaginary           []Imaginary           `xml:"imaginary"`
+	Floor               []Floor               `xml:"floor"`
+	Ceiling             []Ceiling             `xml:"ceiling"`
+	Not                 []Not                 `xml:"not"`
+	Ln                  []Ln                  `xml:"ln"`
+	Sin                 []Sin                 `xml:"sin"`
+	Cos                 []Cos                 `xml:"cos"`
+	Tan                 []Tan                 `xml:"tan"`
+	Sec                 []Sec                 `xml:"sec"`
+	Csc                 []Csc                 `xml:"csc"`
+	Cot                 []Cot                 `xml:"cot"`
+	Sinh                []Sinh                `xml:"sinh"`
+	Cosh                []Cosh                `xml:"cosh"`
+	Tanh                []Tanh                `xml:"tanh"`
+	Sech                []Sech                `xml:"sech"`
+	Csch                []Csch                `xml:"csch"`
+	Coth                []Coth                `xml:"coth"`
+	Arcsin              []Arcsin              `xml:"arcsin"`
+	Arccos              []Arccos              `xml:"arccos"`
+	Arctan              []Arctan              `xml:"arctan"`
+	Arccosh             []Arccosh             `xml:"arccosh"`
+	Arccot              []Arccot              `xml:"arccot"`
+	Arccoth             []Arccoth             `xml:"arccoth"`
+	Arccsc              []Arccsc              `xml:"arccsc"`
+	Arccsch             []Arccsch             `xml:"arccsch"`
+	Arcsec              []Arcsec              `xml:"arcsec"`
+	Arcsech             []Arcsech             `xml:"arcsech"`
+	Arcsinh             []Arcsinh             `xml:"arcsinh"`
+	Arctanh             []Arctanh             `xml:"arctanh"`
+	Determinant         []Determinant         `xml:"determinant"`
+	Transpose           []Transpose           `xml:"transpose"`
+	Card                []Card                `xml:"card"`
+	Quotient            []Quotient            `xml:"quotient"`
+	Divide              []Divide              `xml:"divide"`
+	Power               []Power               `xml:"power"`
+	Rem                 []Rem                 `xml:"rem"`
+	Implies             []Implies             `xml:"implies"`
+	Vectorproduct       []Vectorproduct       `xml:"vectorproduct"`
+	Scalarproduct       []Scalarproduct       `xml:"scalarproduct"`
+	Outerproduct        []Outerproduct        `xml:"outerproduct"`
+	Setdiff             []Setdiff             `xml:"setdiff"`
+	Fn                  []Fn                  `xml:"fn"`
+	Compose             []Compose             `xml:"compose"`
+	Plus                []Plus                `xml:"plus"`
+	Times               []Times               `xml:"times"`
+	Max                 []Max                 `xml:"max"`
+	Min                 []Min                 `xml:"min"`
+	Gcd                 []Gcd                 `xml:"gcd"`
+	Lcm                 []Lcm                 `xml:"lcm"`
+	And                 []And                 `xml:"and"`
+	Or                  []Or                  `xml:"or"`
+	Xor                 []Xor                 `xml:"xor"`
+	Union               []Union               `xml:"union"`
+	Intersect           []Intersect           `xml:"intersect"`
+	Cartesianproduct    []Cartesianproduct    `xml:"cartesianproduct"`
+	Mean                []Mean                `xml:"mean"`
+	Sdev                []Sdev                `xml:"sdev"`
+	Variance            []Variance            `xml:"variance"`
+	Median              []Median              `xml:"median"`
+	Mode                []Mode                `xml:"mode"`
+	Selector            []Selector            `xml:"selector"`
+	Root                []Root                `xml:"root"`
+	Minus               []Minus               `xml:"minus"`
+	Log                 []Log                 `xml:"log"`
+	Int                 []Int                 `xml:"int"`
+	Diff                []Diff                `xml:"diff"`
+	Partialdiff         []Partialdiff         `xml:"partialdiff"`
+	Divergence          []Divergence          `xml:"divergence"`
+	Grad                []Grad                `xml:"grad"`
+	Curl                []Curl                `xml:"curl"`
+	Laplacian           []Laplacian           `xml:"laplacian"`
+	Sum                 []Sum                 `xml:"sum"`
+	Product             []Product             `xml:"product"`
+	Limit               []Limit               `xml:"limit"`
+	Moment              []Moment              `xml:"moment"`
+	Exists              []Exists              `xml:"exists"`
+	Forall              []Forall              `xml:"forall"`
+	Neq                 []Neq                 `xml:"neq"`
+	Factorof            []Factorof            `xml:"factorof"`
+	In                  []In                  `xml:"in"`
+	Notin               []Notin               `xml:"notin"`
+	Notsubset           []Notsubset           `xml:"notsubset"`
+	Notprsubset         []Notprsubset         `xml:"notprsubset"`
+	Tendsto             []Tendsto             `xml:"tendsto"`
+	Eq                  []Eq                  `xml:"eq"`
+	Leq                 []Leq                 `xml:"leq"`
+	Lt                  []Lt                  `xml:"lt"`
+	Geq                 []Geq                 `xml:"geq"`
+	Gt                  []Gt                  `xml:"gt"`
+	Equivalent          []Equivalent          `xml:"equivalent"`
+	Approx              []Approx              `xml:"approx"`
+	Subset              []Subset              `xml:"subset"`
+	Prsubset            []Prsubset            `xml:"prsubset"`
+	Mi                  []Mi                  `xml:"mi"`
+	Mn                  []Mn                  `xml:"mn"`
+	Mo                  []Mo                  `xml:"mo"`
+	Mtext               []Mtext               `xml:"mtext"`
+	Ms                  []Ms                  `xml:"ms"`
+	Mspace              []Mspace              `xml:"mspace"`
+	Mrow                []Mrow                `xml:"mrow"`
+	Mfrac               []Mfrac               `xml:"mfrac"`
+	Msqrt               []Msqrt               `xml:"msqrt"`
+	Mroot               []Mroot               `xml:"mroot"`
+	Menclose            []Menclose            `xml:"menclose"`
+	Mstyle              []Mstyle              `xml:"mstyle"`
+	Merror              []Merror              `xml:"merror"`
+	Mpadded             []Mpadded             `xml:"mpadded"`
+	Mphantom            []Mphantom            `xml:"mphantom"`
+	Mfenced             []Mfenced             `xml:"mfenced"`
+	Msub                []Msub                `xml:"msub"`
+	Msup                []Msup                `xml:"msup"`
+	Msubsup             []Msubsup             `xml:"msubsup"`
+	Munder              []Munder              `xml:"munder"`
+	Mover               []Mover               `xml:"mover"`
+	Munderover          []Munderover          `xml:"munderover"`
+	Mmultiscripts       []Mmultiscripts       `xml:"mmultiscripts"`
+	Mtable              []Mtable              `xml:"mtable"`
+	Mtr                 []Mtr                 `xml:"mtr"`
+	Mlabeledtr          []Mlabeledtr          `xml:"mlabeledtr"`
+	Mtd                 []Mtd                 `xml:"mtd"`
+	Maligngroup         []Maligngroup         `xml:"maligngroup"`
+	Malignmark          []Malignmark          `xml:"malignmark"`
+	Maction             []Maction             `xml:"maction"`
+}
+
+// Compose is the element compose
+//
+//	EMPTY
+type Compose struct {
+	XlinkHref     string `xml:"href,attr"`
+	XlinkType     string `xml:"type,attr"`
+	Class         string `xml:"class,attr"`
+	Style         string `xml:"style,attr"`
+	ID            string `xml:"id,attr"`
+	Xref          string `xml:"xref,attr"`
+	Other         string `xml:"other,attr"`
+	DefinitionURL string `xml:"definitionURL,attr"`
+	Encoding      string `xml:"encoding,attr"`
+}
+
+// Plus is the element plus
+//
+//	EMPTY
+type Plus struct {
+	XlinkHref     string `xml:"href,attr"`
+	XlinkType     string `xml:"type,attr"`
+	Class         string `xml:"class,attr"`
+	Style         string `xml:"style,attr"`
+	ID            string `xml:"id,attr"`
+	Xref          string `xml:"xref,attr"`
+	Other         string `xml:"other,attr"`
+	DefinitionURL string `xml:"definitionURL,attr"`
+	Encoding      string `xml:"encoding,attr"`
+}
+
+// Times is the element times
+//
+//	EMPTY
+type Times struct {
+	XlinkHref     string `xml:"href,attr"`
+	XlinkType     string `xml:"type,attr"`
+	Class         string `xml:"class,attr"`
+	Style         string `xml:"style,attr"`
+	ID            string `xml:"id,attr"`
+	Xref          string `xml:"xref,attr"`
+	Other         string `xml:"other,attr"`
+	DefinitionURL string `xml:"definitionURL,attr"`
+	Encoding      string `xml:"encoding,attr"`
+}
+
+// Max is the element max
+//
+//	EMPTY
+type Max struct {
+	XlinkHref     string `xml:"href,attr"`
+	XlinkType     string `xml:"type,attr"`
+	Class         string `xml:"class,attr"`
+	Style         string `xml:"style,attr"`
+	ID            string `xml:"id,attr"`
+	Xref          string `xml:"xref,attr"`
+	Other         string `xml:"other,attr"`
+	DefinitionURL string `xml:"definitionURL,attr"`
+	Encoding      string `xml:"encoding,attr"`
+}
+
+// Min is the element min
+//
+//	EMPTY
+type Min struct {
+	XlinkHref     string `xml:"href,attr"`
+	XlinkType     string `xml:"type,attr"`
+	Class         string `xml:"class,attr"`
+	Style         string `xml:"style,attr"`
+	ID            string `xml:"id,attr"`
+	Xref          string `xml:"xref,attr"`
+	Other         string `xml:"other,attr"`
+	DefinitionURL string `xml:"definitionURL,attr"`
+	Encoding      string `xml:"encoding,attr"`
+}
+
+// Gcd is the element gcd
+//
+//	EMPTY
+type Gcd struct {
+	XlinkHref     string `xml:"href,attr"`
+	XlinkType     string `xml:"type,attr"`
+	Class         string `xml:"class,attr"`
+	Style         string `xml:"style,attr"`
+	ID            string `xml:"id,attr"`
+	Xref          string `xml:"xref,attr"`
+	Other         string `xml:"other,attr"`
+	DefinitionURL string `xml:"definitionURL,attr"`
+	Encoding      string `xml:"encoding,attr"`
+}
+
+// Lcm is the element lcm
+//
+//	EMPTY
+type Lcm struct {
+	XlinkHref     string `xml:"href,attr"`
+	XlinkType     string `xml:"type,attr"`
+	Class         string `xml:"class,attr"`
+	Style         string `xml:"style,attr"`
+	ID            string `xml:"id,attr"`
+	Xref          string `xml:"xref,attr"`
+	Other         string `xml:"other,attr"`
+	DefinitionURL string `xml:"definitionURL,attr"`
+	Encoding      string `xml:"encoding,attr"`
+}
+
+// And is the element and
+//
+//	EMPTY
+type And struct {
+	XlinkHref     string `xml:"href,attr"`
+	XlinkType     string `xml:"type,attr"`
+	Class         string `xml:"class,attr"`
+	Style         string `xml:"style,attr"`
+	ID            string `xml:"id,attr"`
+	Xref          string `xml:"xref,attr"`
+	Other         string `xml:"other,attr"`
+	DefinitionURL string `xml:"definitionURL,attr"`
+	Encoding      string `xml:"encoding,attr"`
+}
+
+// Or is the element or
+//
+//	EMPTY
+type Or struct {
+	XlinkHref     string `xml:"href,attr"`
+	XlinkType     string `xml:"type,attr"`
+	Class         string `xml:"class,attr"`
+	Style         string `xml:"style,attr"`
+	ID            string `xml:"id,attr"`
+	Xref          string `xml:"xref,attr"`
+	Other         string `xml:"other,attr"`
+	DefinitionURL string `xml:"definitionURL,attr"`
+	Encoding      string `xml:"encoding,attr"`
+}
+
+// Xor is the element xor
+//
+//	EMPTY
+type Xor struct {
+	XlinkHref     string `xml:"href,attr"`
+	XlinkType     string `xml:"type,attr"`
+	Class         string `xml:"class,attr"`
+	Style         string `xml:"style,attr"`
+	ID            string `xml:"id,attr"`
+	Xref          string `xml:"xref,attr"`
+	Other         string `xml:"other,attr"`
+	DefinitionURL string `xml:"definitionURL,attr"`
+	Encoding      string `xml:"encoding,attr"`
+}
+
+// Union is the element union
+//
+//	EMPTY
+type Union struct {
+	XlinkHref     string `xml:"href,attr"`
+	XlinkType     string `xml:"type,attr"`
+	Class         string `xml:"class,attr"`
+	Style         string `xml:"style,attr"`
+	ID            string `xml:"id,attr"`
+	Xref          string `xml:"xref,attr"`
+	Other         string `xml:"other,attr"`
+	DefinitionURL string `xml:"definitionURL,attr"`
+	Encoding      string `xml:"encoding,attr"`
+}
+
+// Intersect is the element intersect
+//
+//	EMPTY
+type Intersect struct {
+	XlinkHref     string `xml:"href,attr"`
+	XlinkType     string `xml:"type,attr"`
+	Class         string `xml:"class,attr"`
+	Style         string `xml:"style,attr"`
+	ID            string `xml:"id,attr"`
+	Xref          string `xml:"xref,attr"`
+	Other         string `xml:"other,attr"`
+	DefinitionURL string `xml:"definitionURL,attr"`
+	Encoding      string `xml:"encoding,attr"`
+}
+
+// Cartesianproduct is the element cartesianproduct
+//
+//	EMPTY
+type Cartesianproduct struct {
+	XlinkHref     string `xml:"href,attr"`
+	XlinkType     string `xml:"type,attr"`
+	Class         string `xml:"class,attr"`
+	Style         string `xml:"style,attr"`
+	ID            string `xml:"id,attr"`
+	Xref          string `xml:"xref,attr"`
+	Other         string `xml:"other,attr"`
+	DefinitionURL string `xml:"definitionURL,attr"`
+	Encoding      string `xml:"encoding,attr"`
+}
+
+// Mean is the element mean
+//
+//	EMPTY
+type Mean struct {
+	XlinkHref     string `xml:"href,attr"`
+	XlinkType     string `xml:"type,attr"`
+	Class         string `xml:"class,attr"`
+	Style         string `xml:"style,attr"`
+	ID            string `xml:"id,attr"`
+	Xref          string `xml:"xref,attr"`
+	Other         string `xml:"other,attr"`
+	DefinitionURL string `xml:"definitionURL,attr"`
+	Encoding      string `xml:"encoding,attr"`
+}
+
+// Sdev is the element sdev
+//
+//	EMPTY
+type Sdev struct {
+	XlinkHref     string `xml:"href,attr"`
+	XlinkType     string `xml:"type,attr"`
+	Class         string `xml:"class,attr"`
+	Style         string `xml:"style,attr"`
+	ID            string `xml:"id,attr"`
+	Xref          string `xml:"xref,attr"`
+	Other         string `xml:"other,attr"`
+	DefinitionURL string `xml:"definitionURL,attr"`
+	Encoding      string `xml:"encoding,attr"`
+}
+
+// Variance is the element variance
+//
+//	EMPTY
+type Variance struct {
+	XlinkHref     string `xml:"href,attr"`
+	XlinkType     string `xml:"type,attr"`
+	Class         string `xml:"class,attr"`
+	Style         string `xml:"style,attr"`
+	ID            string `xml:"id,attr"`
+	Xref          string `xml:"xref,attr"`
+	Other         string `xml:"other,attr"`
+	DefinitionURL string `xml:"definitionURL,attr"`
+	Encoding      string `xml:"encoding,attr"`
+}
+
+// Median is the element median
+//
+//	EMPTY
+type Median struct {
+	XlinkHref     string `xml:"href,attr"`
+	XlinkType     string `xml:"type,attr"`
+	Class         string `xml:"class,attr"`
+	Style         string `xml:"style,attr"`
+	ID            string `xml:"id,attr"`
+	Xref          string `xml:"xref,attr"`
+	Other         string `xml:"other,attr"`
+	DefinitionURL string `xml:"definitionURL,attr"`
+	Encoding      string `xml:"encoding,attr"`
+}
+
+// Mode is the element mode
+//
+//	EMPTY
+type Mode struct {
+	XlinkHref     string `xml:"href,attr"`
+	XlinkType     string `xml:"type,attr"`
+	Class         string `xml:"class,attr"`
+	Style         string `xml:"style,attr"`
+	ID            string `xml:"id,attr"`
+	Xref          string `xml:"xref,attr"`
+	Other         string `xml:"other,attr"`
+	DefinitionURL string `xml:"definitionURL,attr"`
+	Encoding      string `xml:"encoding,attr"`
+}
+
+// Selector is the element selector
+//
+//	EMPTY
+type Selector struct {
+	XlinkHref     string `xml:"href,attr"`
+	XlinkType     string `xml:"type,attr"`
+	Class         string `xml:"class,attr"`
+	Style         string `xml:"style,attr"`
+	ID            string `xml:"id,attr"`
+	Xref          string `xml:"xref,attr"`
+	Other         string `xml:"other,attr"`
+	DefinitionURL string `xml:"definitionURL,attr"`
+	Encoding      string `xml:"encoding,attr"`
+}
+
+// Root is the element root
+//
+//	EMPTY
+type Root struct {
+	XlinkHref     string `xml:"href,attr"`
+	XlinkType     string `xml:"type,attr"`
+	Class         string `xml:"class,attr"`
+	Style         string `xml:"style,attr"`
+	ID            string `xml:"id,attr"`
+	Xref          string `xml:"xref,attr"`
+	Other         string `xml:"other,attr"`
+	DefinitionURL string `xml:"definitionURL,attr"`
+	Encoding      string `xml:"encoding,attr"`
+}
+
+// Minus is the element minus
+//
+//	EMPTY
+type Minus struct {
+	XlinkHref     string `xml:"href,attr"`
+	XlinkType     string `xml:"type,attr"`
+	Class         string `xml:"class,attr"`
+	Style         string `xml:"style,attr"`
+	ID            string `xml:"id,attr"`
+	Xref          string `xml:"xref,attr"`
+	Other         string `xml:"other,attr"`
+	DefinitionURL string `xml:"definitionURL,attr"`
+	Encoding      string `xml:"encoding,attr"`
+}
+
+// Log is the element log
+//
+//	EMPTY
+type Log struct {
+	XlinkHref     string `xml:"href,attr"`
+	XlinkType     string `xml:"type,attr"`
+	Class         string `xml:"class,attr"`
+	Style         string `xml:"style,attr"`
+	ID            string `xml:"id,attr"`
+	Xref          string `xml:"xref,attr"`
+	Other         string `xml:"other,attr"`
+	DefinitionURL string `xml:"definitionURL,attr"`
+	Encoding      string `xml:"encoding,attr"`
+}
+
+// Int is the element int
+//
+//	EMPTY
+type Int struct {
+	XlinkHref     string `xml:"href,attr"`
+	XlinkType     string `xml:"type,attr"`
+	Class         string `xml:"class,attr"`
+	Style         string `xml:"style,attr"`
+	ID            string `xml:"id,attr"`
+	Xref          string `xml:"xref,attr"`
+	Other         string `xml:"other,attr"`
+	DefinitionURL string `xml:"definitionURL,attr"`
+	Encoding      string `xml:"encoding,attr"`
+}
+
+// Diff is the element diff
+//
+//	EMPTY
+type Diff struct {
+	XlinkHref     string `xml:"href,attr"`
+	XlinkType     string `xml:"type,attr"`
+	Class         string `xml:"class,attr"`
+	Style         string `xml:"style,attr"`
+	ID            string `xml:"id,attr"`
+	Xref          string `xml:"xref,attr"`
+	Other         string `xml:"other,attr"`
+	DefinitionURL string `xml:"definitionURL,attr"`
+	Encoding      string `xml:"encoding,attr"`
+}
+
+// Partialdiff is the element partialdiff
+//
+//	EMPTY
+type Partialdiff struct {
+	XlinkHref     string `xml:"href,attr"`
+	XlinkType     string `xml:"type,attr"`
+	Class         string `xml:"class,attr"`
+	Style         string `xml:"style,attr"`
+	ID            string `xml:"id,attr"`
+	Xref          string `xml:"xref,attr"`
+	Other         string `xml:"other,attr"`
+	DefinitionURL string `xml:"definitionURL,attr"`
+	Encoding      string `xml:"encoding,attr"`
+}
+
+// Divergence is the element divergence
+//
+//	EMPTY
+type Divergence struct {
+	XlinkHref     string `xml:"href,attr"`
+	XlinkType     string `xml:"type,attr"`
+	Class         string `xml:"class,attr"`
+	Style         string `xml:"style,attr"`
+	ID            string `xml:"id,attr"`
+	Xref          string `xml:"xref,attr"`
+	Other         string `xml:"other,attr"`
+	DefinitionURL string `xml:"definitionURL,attr"`
+	Encoding      string `xml:"encoding,attr"`
+}
+
+// Grad is the element grad
+//
+//	EMPTY
+type Grad struct {
+	XlinkHref     string `xml:"href,attr"`
+	XlinkType     string `xml:"type,attr"`
+	Class         string `xml:"class,attr"`
+	Style         string `xml:"style,attr"`
+	ID            string `xml:"id,attr"`
+	Xref          string `xml:"xref,attr"`
+	Other         string `xml:"other,attr"`
+	DefinitionURL string `xml:"definitionURL,attr"`
+	Encoding      string `xml:"encoding,attr"`
+}
+
+// Curl is the element curl
+//
+//	EMPTY
+type Curl struct {
+	XlinkHref     string `xml:"href,attr"`
+	XlinkType     string `xml:"type,attr"`
+	Class         string `xml:"class,attr"`
+	Style         string `xml:"style,attr"`
+	ID            string `xml:"id,attr"`
+	Xref          string `xml:"xref,attr"`
+	Other         string `xml:"other,attr"`
+	DefinitionURL string `xml:"definitionURL,attr"`
+	Encoding      string `xml:"encoding,attr"`
+}
+
+// Laplacian is the element laplacian
+//
+//	EMPTY
+type Laplacian struct {
+	XlinkHref     string `xml:"href,attr"`
+	XlinkType     string `xml:"type,attr"`
+	Class         string `xml:"class,attr"`
+	Style         string `xml:"style,attr"`
+	ID            string `xml:"id,attr"`
+	Xref          string `xml:"xref,attr"`
+	Other         string `xml:"other,attr"`
+	DefinitionURL string `xml:"definitionURL,attr"`
+	Encoding      string `xml:"encoding,attr"`
+}
+
+// Sum is the element sum
+//
+//	EMPTY
+type Sum struct {
+	XlinkHref     string `xml:"href,attr"`
+	XlinkType     string `xml:"type,attr"`
+	Class         string `xml:"class,attr"`
+	Style         string `xml:"style,attr"`
+	ID            string `xml:"id,attr"`
+	Xref          string `xml:"xref,attr"`
+	Other         string `xml:"other,attr"`
+	DefinitionURL string `xml:"definitionURL,attr"`
+	Encoding      string `xml:"encoding,attr"`
+}
+
+// Product is the element product
+//
+//	EMPTY
+type Product struct {
+	XlinkHref     string `xml:"href,attr"`
+	XlinkType     string `xml:"type,attr"`
+	Class         string `xml:"class,attr"`
+	Style         string `xml:"style,attr"`
+	ID            string `xml:"id,attr"`
+	Xref          string `xml:"xref,attr"`
+	Other         string `xml:"other,attr"`
+	DefinitionURL string `xml:"definitionURL,attr"`
+	Encoding      string `xml:"encoding,attr"`
+}
+
+// Limit is the element limit
+//
+//	EMPTY
+type Limit struct {
+	XlinkHref     string `xml:"href,attr"`
+	XlinkType     string `xml:"type,attr"`
+	Class         string `xml:"class,attr"`
+	Style         string `xml:"style,attr"`
+	ID            string `xml:"id,attr"`
+	Xref          string `xml:"xref,attr"`
+	Other         string `xml:"other,attr"`
+	DefinitionURL string `xml:"definitionURL,attr"`
+	Encoding      string `xml:"encoding,attr"`
+}
+
+// Moment is the element moment
+//
+//	EMPTY
+type Moment struct {
+	XlinkHref     string `xml:"href,attr"`
+	XlinkType     string `xml:"type,attr"`
+	Class         string `xml:"class,attr"`
+	Style         string `xml:"style,attr"`
+	ID            string `xml:"id,attr"`
+	Xref          string `xml:"xref,attr"`
+	Other         string `xml:"other,attr"`
+	DefinitionURL string `xml:"definitionURL,attr"`
+	Encoding      string `xml:"encoding,attr"`
+}
+
+// Exists is the element exists
+//
+//	EMPTY
+type Exists struct {
+	XlinkHref     string `xml:"href,attr"`
+	XlinkType     string `xml:"type,attr"`
+	Class         string `xml:"class,attr"`
+	Style         string `xml:"style,attr"`
+	ID            string `xml:"id,attr"`
+	Xref          string `xml:"xref,attr"`
+	Other         string `xml:"other,attr"`
+	DefinitionURL string `xml:"definitionURL,attr"`
+	Encoding      string `xml:"encoding,attr"`
+}
+
+// Forall is the element forall
+//
+//	EMPTY
+type Forall struct {
+	XlinkHref     string `xml:"href,attr"`
+	XlinkType     string `xml:"type,attr"`
+	Class         string `xml:"class,attr"`
+	Style         string `xml:"style,attr"`
+	ID            string `xml:"id,attr"`
+	Xref          string `xml:"xref,attr"`
+	Other         string `xml:"other,attr"`
+	DefinitionURL string `xml:"definitionURL,attr"`
+	Encoding      string `xml:"encoding,attr"`
+}
+
+// Neq is the element neq
+//
+//	EMPTY
+type Neq struct {
+	XlinkHref     string `xml:"href,attr"`
+	XlinkType     string `xml:"type,attr"`
+	Class         string `xml:"class,attr"`
+	Style         string `xml:"style,attr"`
+	ID            string `xml:"id,attr"`
+	Xref          string `xml:"xref,attr"`
+	Other         string `xml:"other,attr"`
+	DefinitionURL string `xml:"definitionURL,attr"`
+	Encoding      string `xml:"encoding,attr"`
+}
+
+// Factorof is the element factorof
+//
+//	EMPTY
+type Factorof struct {
+	XlinkHref     string `xml:"href,attr"`
+	XlinkType     string `xml:"type,attr"`
+	Class         string `xml:"class,attr"`
+	Style         string `xml:"style,attr"`
+	ID            string `xml:"id,attr"`
+	Xref          string `xml:"xref,attr"`
+	Other         string `xml:"other,attr"`
+	DefinitionURL string `xml:"definitionURL,attr"`
+	Encoding      string `xml:"encoding,attr"`
+}
+
+// In is the element in
+//
+//	EMPTY
+type In struct {
+	XlinkHref     string `xml:"href,attr"`
+	XlinkType     string `xml:"type,attr"`
+	Class         string `xml:"class,attr"`
+	Style         string `xml:"style,attr"`
+	ID            string `xml:"id,attr"`
+	Xref          string `xml:"xref,attr"`
+	Other         string `xml:"other,attr"`
+	DefinitionURL string `xml:"definitionURL,attr"`
+	Encoding      string `xml:"encoding,attr"`
+}
+
+// Notin is the element notin
+//
+//	EMPTY
+type Notin struct {
+	XlinkHref     string `xml:"href,attr"`
+	XlinkType     string `xml:"type,attr"`
+	Class         string `xml:"class,attr"`
+	Style         string `xml:"style,attr"`
+	ID            string `xml:"id,attr"`
+	Xref          string `xml:"xref,attr"`
+	Other         string `xml:"other,attr"`
+	DefinitionURL string `xml:"definitionURL,attr"`
+	Encoding      string `xml:"encoding,attr"`
+}
+
+// Notsubset is the element notsubset
+//
+//	EMPTY
+type Notsubset struct {
+	XlinkHref     string `xml:"href,attr"`
+	XlinkType     string `xml:"type,attr"`
+	Class         string `xml:"class,attr"`
+	Style         string `xml:"style,attr"`
+	ID            string `xml:"id,attr"`
+	Xref          string `xml:"xref,attr"`
+	Other         string `xml:"other,attr"`
+	DefinitionURL string `xml:"definitionURL,attr"`
+	Encoding      string `xml:"encoding,attr"`
+}
+
+// Notprsubset is the element notprsubset
+//
+//	EMPTY
+type Notprsubset struct {
+	XlinkHref     string `xml:"href,attr"`
+	XlinkType     string `xml:"type,attr"`
+	Class         string `xml:"class,attr"`
+	Style         string `xml:"style,attr"`
+	ID            string `xml:"id,attr"`
+	Xref          string `xml:"xref,attr"`
+	Other         string `xml:"other,attr"`
+	DefinitionURL string `xml:"definitionURL,attr"`
+	Encoding      string `xml:"encoding,attr"`
+}
+
+// Tendsto is the element tendsto
+//
+//	EMPTY
+type Tendsto struct {
+	XlinkHref     string `xml:"href,attr"`
+	XlinkType     string `xml:"xlink type,attr"`
+	Class         string `xml:"class,attr"`
+	Style         string `xml:"style,attr"`
+	ID            string `xml:"id,attr"`
+	Xref          string `xml:"xref,attr"`
+	Other         string `xml:"other,attr"`
+	DefinitionURL string `xml:"definitionURL,attr"`
+	Encoding      string `xml:"encoding,attr"`
+	Type          string `xml:"type,attr"`
+}
+
+// Eq is the element eq
+//
+//	EMPTY
+type Eq struct {
+	XlinkHref     string `xml:"href,attr"`
+	XlinkType     string `xml:"type,attr"`
+	Class         string `xml:"class,attr"`
+	Style         string `xml:"style,attr"`
+	ID            string `xml:"id,attr"`
+	Xref          string `xml:"xref,attr"`
+	Other         string `xml:"other,attr"`
+	DefinitionURL string `xml:"definitionURL,attr"`
+	Encoding      string `xml:"encoding,attr"`
+}
+
+// Leq is the element leq
+//
+//	EMPTY
+type Leq struct {
+	XlinkHref     string `xml:"href,attr"`
+	XlinkType     string `xml:"type,attr"`
+	Class         string `xml:"class,attr"`
+	Style         string `xml:"style,attr"`
+	ID            string `xml:"id,attr"`
+	Xref          string `xml:"xref,attr"`
+	Other         string `xml:"other,attr"`
+	DefinitionURL string `xml:"definitionURL,attr"`
+	Encoding      string `xml:"encoding,attr"`
+}
+
+// Lt is the element lt
+//
+//	EMPTY
+type Lt struct {
+	XlinkHref     string `xml:"href,attr"`
+	XlinkType     string `xml:"type,attr"`
+	Class         string `xml:"class,attr"`
+	Style         string `xml:"style,attr"`
+	ID            string `xml:"id,attr"`
+	Xref          string `xml:"xref,attr"`
+	Other         string `xml:"other,attr"`
+	DefinitionURL string `xml:"definitionURL,attr"`
+	Encoding      string `xml:"encoding,attr"`
+}
+
+// Geq is the element geq
+//
+//	EMPTY
+type Geq struct {
+	XlinkHref     string `xml:"href,attr"`
+	XlinkType     string `xml:"type,attr"`
+	Class         string `xml:"class,attr"`
+	Style         string `xml:"style,attr"`
+	ID            string `xml:"id,attr"`
+	Xref          string `xml:"xref,attr"`
+	Other         string `xml:"other,attr"`
+	DefinitionURL string `xml:"definitionURL,attr"`
+	Encoding      string `xml:"encoding,attr"`
+}
+
+// Gt is the element gt
+//
+//	EMPTY
+type Gt struct {
+	XlinkHref     string `xml:"href,attr"`
+	XlinkType     string `xml:"type,attr"`
+	Class         string `xml:"class,attr"`
+	Style         string `xml:"style,attr"`
+	ID            string `xml:"id,attr"`
+	Xref          string `xml:"xref,attr"`
+	Other         string `xml:"other,attr"`
+	DefinitionURL string `xml:"definitionURL,attr"`
+	Encoding      string `xml:"encoding,attr"`
+}
+
+// Equivalent is the element equivalent
+//
+//	EMPTY
+type Equivalent struct {
+	XlinkHref     string `xml:"href,attr"`
+	XlinkType     string `xml:"type,attr"`
+	Class         string `xml:"class,attr"`
+	Style         string `xml:"style,attr"`
+	ID            string `xml:"id,attr"`
+	Xref          string `xml:"xref,attr"`
+	Other         string `xml:"other,attr"`
+	DefinitionURL string `xml:"definitionURL,attr"`
+	Encoding      string `xml:"encoding,attr"`
+}
+
+// Approx is the element approx
+//
+//	EMPTY
+type Approx struct {
+	XlinkHref     string `xml:"href,attr"`
+	XlinkType     string `xml:"type,attr"`
+	Class         string `xml:"class,attr"`
+	Style         string `xml:"style,attr"`
+	ID            string `xml:"id,attr"`
+	Xref          string `xml:"xref,attr"`
+	Other         string `xml:"other,attr"`
+	DefinitionURL string `xml:"definitionURL,attr"`
+	Encoding      string `xml:"encoding,attr"`
+}
+
+// Subset is the element subset
+//
+//	EMPTY
+type Subset struct {
+	XlinkHref     string `xml:"href,attr"`
+	XlinkType     string `xml:"type,attr"`
+	Class         string `xml:"class,attr"`
+	Style         string `xml:"style,attr"`
+	ID            string `xml:"id,attr"`
+	Xref          string `xml:"xref,attr"`
+	Other         string `xml:"other,attr"`
+	DefinitionURL string `xml:"definitionURL,attr"`
+	Encoding      string `xml:"encoding,attr"`
+}
+
+// Prsubset is the element prsubset
+//
+//	EMPTY
+type Prsubset struct {
+	XlinkHref     string `xml:"href,attr"`
+	XlinkType     string `xml:"type,attr"`
+	Class         string `xml:"class,attr"`
+	Style         string `xml:"style,attr"`
+	ID            string `xml:"id,attr"`
+	Xref          string `xml:"xref,attr"`
+	Other         string `xml:"other,attr"`
+	DefinitionURL string `xml:"definitionURL,attr"`
+	Encoding      string `xml:"encoding,attr"`
+}
+
+// TechnicalField is the element technical-field
+//
+//	(heading*,p+)+
+type TechnicalField struct {
+	ID      string    `xml:"id,attr"`
+	Heading []Heading `xml:"heading"`
+	P       []P       `xml:"p"`
+}
+
+// BackgroundArt is the element background-art
+//
+//	(heading*,p+)+
+type BackgroundArt struct {
+	ID      string    `xml:"id,attr"`
+	Heading []Heading `xml:"heading"`
+	P       []P       `xml:"p"`
+}
+
+// Disclosure is the element disclosure
+//
+//	((tech-problem | tech-solution | advantageous-effects)* | (heading*,p+)+)
+type Disclosure struct {
+	ID                  string                `xml:"id,attr"`
+	TechProblem         []TechProblem         `xml:"tech-problem"`
+	TechSolution        []TechSolution        `xml:"tech-solution"`
+	AdvantageousEffects []AdvantageousEffects `xml:"advantageous-effects"`
+	Heading             []Heading             `xml:"heading"`
+	P                   []P                   `xml:"p"`
+}
+
+// TechProblem is the element tech-problem
+//
+//	(heading*,p+)+
+type TechProblem struct {
+	ID      string    `xml:"id,attr"`
+	Heading []Heading `xml:"heading"`
+	P       []P       `xml:"p"`
+}
+
+// TechSolution is the element tech-solution
+//
+//	(heading*,p+)+
+type TechSolution struct {
+	ID      string    `xml:"id,attr"`
+	Heading []Heading `xml:"heading"`
+	P       []P       `xml:"p"`
+}
+
+// AdvantageousEffects is the element advantageous-effects
+//
+//	(heading*,p+)+
+type AdvantageousEffects struct {
+	ID      string    `xml:"id,attr"`
+	Heading []Heading `xml:"heading"`
+	P       []P       `xml:"p"`
+}
+
+// DescriptionOfDrawings is the element description-of-drawings
+//
+//	(heading*,p+)+
+type DescriptionOfDrawings struct {
+	ID      string    `xml:"id,attr"`
+	Heading []Heading `xml:"heading"`
+	P       []P       `xml:"p"`
+}
+
+// BestMode is the element best-mode
+//
+//	(heading*,p+)+
+type BestMode struct {
+	ID      string    `xml:"id,attr"`
+	Heading []Heading `xml:"heading"`
+	P       []P       `xml:"p"`
+}
+
+// ModeForInvention is the element mode-for-invention
+//
+//	(heading*,p+)+
+type ModeForInvention struct {
+	ID      string    `xml:"id,attr"`
+	ModeNum string    `xml:"mode-num,attr"`
+	Heading []Heading `xml:"heading"`
+	P       []P       `xml:"p"`
+}
+
+// IndustrialApplicability is the element industrial-applicability
+//
+//	(heading*,p+)+
+type IndustrialApplicability struct {
+	ID      string    `xml:"id,attr"`
+	Heading []Heading `xml:"heading"`
+	P       []P       `xml:"p"`
+}
+
+// BibliographicData is the element bibliographic-data
+//
+//	(plain-language-designation?,publication-reference,classification-ipc?,classifications-ipcr?,
+//	classification-national?,classification-locarno?,classification-udc?,application-reference,
+//	language-of-filing?,language-of-publication?,priority-claims?,parties,designation-of-states?,
+//	invention-title+,assignees?,licensee-data?,date-of-earliest-priority?,
+//	date-complete-specification-filed?,dates-of-public-availability?,term-of-grant?,
+//	dates-rights-effective?,patent-family?,field-of-search?,figures?,references-cited?,examiners?,
+//	number-of-claims?,related-documents?,st50-republication?,date-exhibition-filed?,
+//	date-pct-article-22-39-fulfilled?,pct-application-no-longer-effective?,bio-deposit?,
+//	pct-or-regional-filing-data?,pct-or-regional-publishing-data?,defer-publication-of-search-report?,
+//	date-application-refused?,date-rights-reestablished?,date-application-withdrawn-by-applicant?,
+//	date-application-deemed-withdrawn?,date-of-revocation?,cmea-havana-agreement?,
+//	office-specific-bib-data*,opposition-data?,text*)
+type BibliographicData struct {
+	ID                                  string                               `xml:"id,attr"`
+	Lang                                string                               `xml:"lang,attr"`
+	Country                             string                               `xml:"country,attr"`
+	Status                              string                               `xml:"status,attr"`
+	PlainLanguageDesignation            *PlainLanguageDesignation            `xml:"plain-language-designation"`
+	PublicationReference                PublicationReference                 `xml:"publication-reference"`
+	ClassificationIpc                   *ClassificationIpc                   `xml:"classification-ipc"`
+	ClassificationsIpcr                 *ClassificationsIpcr                 `xml:"classifications-ipcr"`
+	ClassificationNational              *ClassificationNational              `xml:"classification-national"`
+	ClassificationLocarno               *ClassificationLocarno               `xml:"classification-locarno"`
+	ClassificationUdc                   *ClassificationUdc                   `xml:"classification-udc"`
+	ApplicationReference                ApplicationReference                 `xml:"application-reference"`
+	LanguageOfFiling                    string                               `xml:"language-of-filing"`
+	LanguageOfPublication               string                               `xml:"language-of-publication"`
+	PriorityClaims                      *PriorityClaims                      `xml:"priority-claims"`
+	Parties                             Parties                              `xml:"parties"`
+	DesignationOfStates                 *DesignationOfStates                 `xml:"designation-of-states"`
+	InventionTitle                      []InventionTitle                     `xml:"invention-title"`
+	Assignees                           *Assignees                           `xml:"assignees"`
+	LicenseeData                        *LicenseeData                        `xml:"licensee-data"`
+	DateOfEarliestPriority              *DateOfEarliestPriority              `xml:"date-of-earliest-priority"`
+	DateCompleteSpecificationFiled      *DateCompleteSpecificationFiled      `xml:"date-complete-specification-filed"`
+	DatesOfPublicAvailability           *DatesOfPublicAvailability           `xml:"dates-of-public-availability"`
+	TermOfGrant                         *TermOfGrant                         `xml:"term-of-grant"`
+	DatesRightsEffective                *DatesRightsEffective                `xml:"dates-rights-effective"`
+	PatentFamily                        *PatentFamily                        `xml:"patent-family"`
+	FieldOfSearch                       *FieldOfSearch                       `xml:"field-of-search"`
+	Figures                             *Figures                             `xml:"figures"`
+	ReferencesCited                     *ReferencesCited                     `xml:"references-cited"`
+	Examiners                           *Examiners                           `xml:"examiners"`
+	NumberOfClaims                      string                               `xml:"number-of-claims"`
+	RelatedDocuments                    *RelatedDocuments                    `xml:"related-documents"`
+	St50Republication                   *St50Republication                   `xml:"st50-republication"`
+	DateExhibitionFiled                 *DateExhibitionFiled                 `xml:"date-exhibition-filed"`
+	DatePctArticle2239Fulfilled         *DatePctArticle2239Fulfilled         `xml:"date-pct-article-22-39-fulfilled"`
+	PctApplicationNoLongerEffective     *PctApplicationNoLongerEffective     `xml:"pct-application-no-longer-effective"`
+	BioDeposit                          *BioDeposit                          `xml:"bio-deposit"`
+	PctOrRegionalFilingData             *PctOrRegionalFilingData             `xml:"pct-or-regional-filing-data"`
+	PctOrRegionalPublishingData         *PctOrRegionalPublishingData         `xml:"pct-or-regional-publishing-data"`
+	DeferPublicationOfSearchReport      *DeferPublicationOfSearchReport      `xml:"defer-publication-of-search-report"`
+	DateApplicationRefused              *DateApplicationRefused              `xml:"date-application-refused"`
+	DateRightsReestablished             *DateRightsReestablished             `xml:"date-rights-reestablished"`
+	DateApplicationWithdrawnByApplicant *DateApplicationWithdrawnByApplicant `xml:"date-application-withdrawn-by-applicant"`
+	DateApplicationDeemedWithdrawn      *DateApplicationDeemedWithdrawn      `xml:"date-application-deemed-withdrawn"`
+	DateOfRevocation                    *DateOfRevocation                    `xml:"date-of-revocation"`
+	CmeaHavanaAgreement                 *CmeaHavanaAgreement                 `xml:"cmea-havana-agreement"`
+	OfficeSpecificBibData               []OfficeSpecificBibData              `xml:"office-specific-bib-data"`
+	OppositionData                      *OppositionData                      `xml:"opposition-data"`
+	Text                                []string                             `xml:"text"`
+}
+
+// PlainLanguageDesignation is the element plain-language-designation
+//
+//	(#PCDATA)
+type PlainLanguageDesignation struct {
+	Text string `xml:",chardata"`
+	Lang string `xml:"lang,attr"`
+}
+
+// PublicationReference is the element publication-reference
+//
+//	(document-id)
+type PublicationReference struct {
+	ID         string     `xml:"id,attr"`
+	DocumentID DocumentID `xml:"document-id"`
+}
+
+// ClassificationIpc is the element classification-ipc
+//
+//	(edition,main-classification,further-classification*,(additional-info | linked-indexing-code-group |
+//	unlinked-indexing-code)*,text?)
+type ClassificationIpc struct {
+	ID                      string                    `xml:"id,attr"`
+	Edition                 string                    `xml:"edition"`
+	MainClassification      string                    `xml:"main-classification"`
+	FurtherClassification   []FurtherClassification   `xml:"further-classification"`
+	AdditionalInfo          []string                  `xml:"additional-info"`
+	LinkedIndexingCodeGroup []LinkedIndexingCodeGroup `xml:"linked-indexing-code-group"`
+	UnlinkedIndexingCode    []string                  `xml:"unlinked-indexing-code"`
+	Text                    string                    `xml:"text"`
+}
+
+// FurtherClassification is the element further-classification
+//
+//	(#PCDATA)
+type FurtherClassification struct {
+	Text     string `xml:",chardata"`
+	ID       string `xml:"id,attr"`
+	Sequence string `xml:"sequence,attr"`
+}
+
+// ClassificationUdc is the element classification-udc
+//
+//	(#PCDATA)
+type ClassificationUdc struct {
+	Text string `xml:",chardata"`
+	ID   string `xml:"id,attr"`
+}
+
+// ClassificationLocarno is the element classification-locarno
+//
+//	(edition,main-classification,further-classification*,text?)
+type ClassificationLocarno struct {
+	ID                    string                  `xml:"id,attr"`
+	Edition               string                  `xml:"edition"`
+	MainClassification    string                  `xml:"main-classification"`
+	FurtherClassification []FurtherClassification `xml:"further-classification"`
+	Text                  string                  `xml:"text"`
+}
+
+// ClassificationNational is the element classification-national
+//
+//	(country,edition?,main-classification,further-classification*,(additional-info |
+//	linked-indexing-code-group | unlinked-indexing-code)*,text?)
+type ClassificationNational struct {
+	ID                      string                    `xml:"id,attr"`
+	Country                 string                    `xml:"country"`
+	Edition                 string                    `xml:"edition"`
+	MainClassification      string                    `xml:"main-classification"`
+	FurtherClassification   []FurtherClassification   `xml:"further-classification"`
+	AdditionalInfo          []string                  `xml:"additional-info"`
+	LinkedIndexingCodeGroup []LinkedIndexingCodeGroup `xml:"linked-indexing-code-group"`
+	UnlinkedIndexingCode    []string                  `xml:"unlinked-indexing-code"`
+	Text                    string                    `xml:"text"`
+}
+
+// ClassificationsIpcr is the element classifications-ipcr
+//
+//	(classification-ipcr+)
+type ClassificationsIpcr struct {
+	ID                 string               `xml:"id,attr"`
+	ClassificationIpcr []ClassificationIpcr `xml:"classification-ipcr"`
+}
+
+// LinkedIndexingCodeGroup is the element linked-indexing-code-group
+//
+//	(main-linked-indexing-code,sub-linked-indexing-code+)
+type LinkedIndexingCodeGroup struct {
+	MainLinkedIndexingCode string   `xml:"main-linked-indexing-code"`
+	SubLinkedIndexingCode  []string `xml:"sub-linked-indexing-code"`
+}
+
+// ApplicationReference is the element application-reference
+//
+//	(document-id)
+type ApplicationReference struct {
+	ID         string     `xml:"id,attr"`
+	ApplType   string     `xml:"appl-type,attr"`
+	DocumentID DocumentID `xml:"document-id"`
+}
+
+// PriorityClaims is the element priority-claims
+//
+//	(priority-claim+)
+type PriorityClaims struct {
+	PriorityClaim []PriorityClaim `xml:"priority-claim"`
+}
+
+// PriorityClaim is the element priority-claim
+//
+//	(country,doc-number?,date,office-of-filing?,(priority-doc-requested | priority-doc-attached)?)
+type PriorityClaim struct {
+	ID                   string                `xml:"id,attr"`
+	Sequence             string                `xml:"sequence,attr"`
+	Kind                 string                `xml:"kind,attr"`
+	Country              string                `xml:"country"`
+	DocNumber            string                `xml:"doc-number"`
+	Date                 string                `xml:"date"`
+	OfficeOfFiling       *OfficeOfFiling       `xml:"office-of-filing"`
+	PriorityDocRequested *PriorityDocRequested `xml:"priority-doc-requested"`
+	PriorityDocAttached  *PriorityDocAttached  `xml:"priority-doc-attached"`
+}
+
+// OfficeOfFiling is the element office-of-filing
+//
+//	((region,country?) | country)
+type OfficeOfFiling struct {
+	Region  *Region `xml:"region"`
+	Country string  `xml:"country"`
+}
+
+// Region is the element region
+//
+//	(country)
+type Region struct {
+	Country string `xml:"country"`
+}
+
+// PriorityDocRequested is the element priority-doc-requested
+//
+//	EMPTY
+type PriorityDocRequested struct{}
+
+// PriorityDocAttached is the element priority-doc-attached
+//
+//	EMPTY
+type PriorityDocAttached struct{}
+
+// Parties is the element parties
+//
+//	(applicants,inventors?,correspondence-address?,agents?)
+type Parties struct {
+	Applicants            Applicants             `xml:"applicants"`
+	Inventors             *Inventors             `xml:"inventors"`
+	CorrespondenceAddress *CorrespondenceAddress `xml:"correspondence-address"`
+	Agents                *Agents                `xml:"agents"`
+}
+
+// Applicants is the element applicants
+//
+//	(applicant+)
+type Applicants struct {
+	Applicant []Applicant `xml:"applicant"`
+}
+
+// Applicant is the element applicant
+//
+//	(addressbook+,nationality,residence,us-rights*,designated-states?,designated-states-as-inventor?)
+type Applicant struct {
+	Sequence                   string                      `xml:"sequence,attr"`
+	AppType                    string                      `xml:"app-type,attr"`
+	Designation                string                      `xml:"designation,attr"`
+	Addressbook                []Addressbook               `xml:"addressbook"`
+	Nationality                Nationality                 `xml:"nationality"`
+	Residence                  Residence                   `xml:"residence"`
+	UsRights                   []UsRights                  `xml:"us-rights"`
+	DesignatedStates           *DesignatedStates           `xml:"designated-states"`
+	DesignatedStatesAsInventor *DesignatedStatesAsInventor `xml:"designated-states-as-inventor"`
+}
+
+// Nationality is the element nationality
+//
+//	(country)
+type Nationality struct {
+	Country string `xml:"country"`
+}
+
+// Residence is the element residence
+//
+//	(country)
+type Residence struct {
+	Country string `xml:"country"`
+}
+
+// UsRights is the element us-rights
+//
+//	(#PCDATA)
+type UsRights struct {
+	Text           string `xml:",chardata"`
+	ToDeadInventor string `xml:"to-dead-inventor,attr"`
+	Kind           string `xml:"kind,attr"`
+}
+
+// DesignatedStates is the element designated-states
+//
+//	(country*,(region,country+)*)
+type DesignatedStates struct {
+	Country []string `xml:"country"`
+	Region  []Region `xml:"region"`
+}
+
+// DesignatedStatesAsInventor is the element designated-states-as-inventor
+//
+//	(country*,(region,country+)*)
+type DesignatedStatesAsInventor struct {
+	Country []string `xml:"country"`
+	Region  []Region `xml:"region"`
+}
+
+// Inventors is the element inventors
+//
+//	(inventor | deceased-inventor)+
+type Inventors struct {
+	Inventor         []Inventor         `xml:"inventor"`
+	DeceasedInventor []DeceasedInventor `xml:"deceased-inventor"`
+}
+
+// Inventor is the element inventor
+//
+//	(addressbook+,designated-states?)
+type Inventor struct {
+	Sequence         string            `xml:"sequence,attr"`
+	Designation      string            `xml:"designation,attr"`
+	Addressbook      []Addressbook     `xml:"addressbook"`
+	DesignatedStates *DesignatedStates `xml:"designated-states"`
+}
+
+// DeceasedInventor is the element deceased-inventor
+//
+//	((name | (prefix?,(last-name | orgname),first-name?,middle-name?,suffix?,iid?,role?,orgname?,
+//	department?,synonym*)),registered-number?)
+type DeceasedInventor struct {
+	Sequence         string   `xml:"sequence,attr"`
+	Lang             string   `xml:"lang,attr"`
+	Name             *Name    `xml:"name"`
+	Prefix           string   `xml:"prefix"`
+	LastName         string   `xml:"last-name"`
+	Orgname          []string `xml:"orgname"`
+	FirstName        string   `xml:"first-name"`
+	MiddleName       string   `xml:"middle-name"`
+	Suffix           string   `xml:"suffix"`
+	Iid              string   `xml:"iid"`
+	Role             string   `xml:"role"`
+	Department       string   `xml:"department"`
+	Synonym          []string `xml:"synonym"`
+	RegisteredNumber string   `xml:"registered-number"`
+}
+
+// CorrespondenceAddress is the element correspondence-address
+//
+//	(customer-number | addressbook+)
+type CorrespondenceAddress struct {
+	CustomerNumber string        `xml:"customer-number"`
+	Addressbook    []Addressbook `xml:"addressbook"`
+}
+
+// Agents is the element agents
+//
+//	(customer-number | agent+)
+type Agents struct {
+	CustomerNumber string  `xml:"customer-number"`
+	Agent          []Agent `xml:"agent"`
+}
+
+// Agent is the element agent
+//
+//	(addressbook+)
+type Agent struct {
+	Sequence    string        `xml:"sequence,attr"`
+	RepType     string        `xml:"rep-type,attr"`
+	Addressbook []Addressbook `xml:"addressbook"`
+}
+
+// DesignationOfStates is the element designation-of-states
+//
+//	(designation-pct,(precautionary-designation-statement?,exclusion-from-designation?))
+type DesignationOfStates struct {
+	DesignationPct                    DesignationPct            `xml:"designation-pct"`
+	PrecautionaryDesignationStatement string                    `xml:"precautionary-designation-statement"`
+	ExclusionFromDesignation          *ExclusionFromDesignation `xml:"exclusion-from-designation"`
+}
+
+// DesignationPct is the element designation-pct
+//
+//	(regional*,national?,new-designation-country*)
+type DesignationPct struct {
+	Regional              []Regional `xml:"regional"`
+	National              *National  `xml:"national"`
+	NewDesignationCountry []string   `xml:"new-designation-country"`
+}
+
+// Regional is the element regional
+//
+//	(region,(country,protection-request*)+,any-other-state?)
+type Regional struct {
+	Region            Region              `xml:"region"`
+	Country           []string            `xml:"country"`
+	ProtectionRequest []ProtectionRequest `xml:"protection-request"`
+	AnyOtherState     *AnyOtherState      `xml:"any-other-state"`
+}
+
+// ProtectionRequest is the element protection-request
+//
+//	(kind-of-protection,document-id?)
+type ProtectionRequest struct {
+	KindOfProtection string      `xml:"kind-of-protection"`
+	DocumentID       *DocumentID `xml:"document-id"`
+}
+
+// AnyOtherState is the element any-other-state
+//
+//	EMPTY
+type AnyOtherState struct{}
+
+// National is the element national
+//
+//	(country,protection-request*)+
+type National struct {
+	Country           []string            `xml:"country"`
+	ProtectionRequest []ProtectionRequest `xml:"protection-request"`
+}
+
+// ExclusionFromDesignation is the element exclusion-from-designation
+//
+//	(regional*,national?)
+type ExclusionFromDesignation struct {
+	Regional []Regional `xml:"regional"`
+	National *National  `xml:"national"`
+}
+
+// InventionTitle is the element invention-title
+//
+//	(#PCDATA | b | i | u | sup | sub)*
+type InventionTitle struct {
+	Text     string `xml:",chardata"`
+	InnerXML string `xml:",innerxml"`
+	ID       string `xml:"id,attr"`
+	Lang     string `xml:"lang,attr"`
+	B        []B    `xml:"b"`
+	I        []I    `xml:"i"`
+	U        []U    `xml:"u"`
+	Sup      []Sup  `xml:"sup"`
+	Sub      []Sub  `xml:"sub"`
+}
+
+// Assignees is the element assignees
+//
+//	(assignee+)
+type Assignees struct {
+	Assignee []Assignee `xml:"assignee"`
+}
+
+// Assignee is the element assignee
+//
+//	(((name | (prefix?,(last-name | orgname),first-name?,middle-name?,suffix?,iid?,role?,orgname?,
+//	department?,synonym*)),registered-number?) | addressbook)
+type Assignee struct {
+	Name             *Name        `xml:"name"`
+	Prefix           string       `xml:"prefix"`
+	LastName         string       `xml:"last-name"`
+	Orgname          []string     `xml:"orgname"`
+	FirstName        string       `xml:"first-name"`
+	MiddleName       string       `xml:"middle-name"`
+	Suffix           string       `xml:"suffix"`
+	Iid              string       `xml:"iid"`
+	Role             string       `xml:"role"`
+	Department       string       `xml:"department"`
+	Synonym          []string     `xml:"synonym"`
+	RegisteredNumber string       `xml:"registered-number"`
+	Addressbook      *Addressbook `xml:"addressbook"`
+}
+
+// LicenseeData is the element licensee-data
+//
+//	(licensee,attorney-or-agent?,effective-in?)
+type LicenseeData struct {
+	Licensee        Licensee         `xml:"licensee"`
+	AttorneyOrAgent *AttorneyOrAgent `xml:"attorney-or-agent"`
+	EffectiveIn     *EffectiveIn     `xml:"effective-in"`
+}
+
+// Licensee is the element licensee
+//
+//	(addressbook)
+type Licensee struct {
+	Addressbook Addressbook `xml:"addressbook"`
+}
+
+// AttorneyOrAgent is the element attorney-or-agent
+//
+//	(addressbook,range-of-authority*)
+type AttorneyOrAgent struct {
+	RepType          string      `xml:"rep-type,attr"`
+	Addressbook      Addressbook `xml:"addressbook"`
+	RangeOfAuthority []string    `xml:"range-of-authority"`
+}
+
+// EffectiveIn is the element effective-in
+//
+//	(country+)
+type EffectiveIn struct {
+	Country []string `xml:"country"`
+}
+
+// DateOfEarliestPriority is the element date-of-earliest-priority
+//
+//	(date)
+type DateOfEarliestPriority struct {
+	Date string `xml:"date"`
+}
+
+// DateCompleteSpecificationFiled is the element date-complete-specification-filed
+//
+//	(date)
+type DateCompleteSpecificationFiled struct {
+	Date string `xml:"date"`
+}
+
+// DatesOfPublicAvailability is the element dates-of-public-availability
+//
+//	(gazette-reference?,unexamined-not-printed-without-grant?,examined-not-printed-without-grant?,
+//	unexamined-printed-without-grant?,examined-printed-without-grant?,claims-only-available?,
+//	not-printed-with-grant?,term-of-grant?,invalidation-of-patent?,printed-as-amended?)
+type DatesOfPublicAvailability struct {
+	GazetteReference                 *GazetteReference                 `xml:"gazette-reference"`
+	UnexaminedNotPrintedWithoutGrant *UnexaminedNotPrintedWithoutGrant `xml:"unexamined-not-printed-without-grant"`
+	ExaminedNotPrintedWithoutGrant   *ExaminedNotPrintedWithoutGrant   `xml:"examined-not-printed-without-grant"`
+	UnexaminedPrintedWithoutGrant    *UnexaminedPrintedWithoutGrant    `xml:"unexamined-printed-without-grant"`
+	ExaminedPrintedWithoutGrant      *ExaminedPrintedWithoutGrant      `xml:"examined-printed-without-grant"`
+	ClaimsOnlyAvailable              *ClaimsOnlyAvailable              `xml:"claims-only-available"`
+	NotPrintedWithGrant              *NotPrintedWithGrant              `xml:"not-printed-with-grant"`
+	TermOfGrant                      *TermOfGrant                      `xml:"term-of-grant"`
+	InvalidationOfPatent             *InvalidationOfPatent             `xml:"invalidation-of-patent"`
+	PrintedAsAmended                 *PrintedAsAmended                 `xml:"printed-as-amended"`
+}
+
+// GazetteReference is the element gazette-reference
+//
+//	(gazette-num,date,text?)
+type GazetteReference struct {
+	ID         string `xml:"id,attr"`
+	Country    string `xml:"country,attr"`
+	Lang       string `xml:"lang,attr"`
+	GazetteNum string `xml:"gazette-num"`
+	Date       string `xml:"date"`
+	Text       string `xml:"text"`
+}
+
+// UnexaminedNotPrintedWithoutGrant is the element unexamined-not-printed-without-grant
+//
+//	(document-id,text?)
+type UnexaminedNotPrintedWithoutGrant struct {
+	DocumentID DocumentID `xml:"document-id"`
+	Text       string     `xml:"text"`
+}
+
+// ExaminedNotPrintedWithoutGrant is the element examined-not-printed-without-grant
+//
+//	(document-id,text?)
+type ExaminedNotPrintedWithoutGrant struct {
+	DocumentID DocumentID `xml:"document-id"`
+	Text       string     `xml:"text"`
+}
+
+// UnexaminedPrintedWithoutGrant is the element unexamined-printed-without-grant
+//
+//	(document-id,text?)
+type UnexaminedPrintedWithoutGrant struct {
+	DocumentID DocumentID `xml:"document-id"`
+	Text       string     `xml:"text"`
+}
+
+// ExaminedPrintedWithoutGrant is the element examined-printed-without-grant
+//
+//	(document-id,text?)
+type ExaminedPrintedWithoutGrant struct {
+	DocumentID DocumentID `xml:"document-id"`
+	Text       string     `xml:"text"`
+}
+
+// ClaimsOnlyAvailable is the element claims-only-available
+//
+//	(document-id,text?)
+type ClaimsOnlyAvailable struct {
+	DocumentID DocumentID `xml:"document-id"`
+	Text       string     `xml:"text"`
+}
+
+// NotPrintedWithGrant is the element not-printed-with-grant
+//
+//	(document-id,text?)
+type NotPrintedWithGrant struct {
+	DocumentID DocumentID `xml:"document-id"`
+	Text       string     `xml:"text"`
+}
+
+// TermOfGrant is the element term-of-grant
+//
+//	(text | disclaimer | length-of-grant | lapse-of-patent)+
+type TermOfGrant struct {
+	Text          []string        `xml:"text"`
+	Disclaimer    []Disclaimer    `xml:"disclaimer"`
+	LengthOfGrant []string        `xml:"length-of-grant"`
+	LapseOfPatent []LapseOfPatent `xml:"lapse-of-patent"`
+}
+
+// Disclaimer is the element disclaimer
+//
+//	(date?,text?)
+type Disclaimer struct {
+	Date string `xml:"date"`
+	Text string `xml:"text"`
+}
+
+// LapseOfPatent is the element lapse-of-patent
+//
+//	(document-id,text?)
+type LapseOfPatent struct {
+	DocumentID DocumentID `xml:"document-id"`
+	Text       string     `xml:"text"`
+}
+
+// InvalidationOfPatent is the element invalidation-of-patent
+//
+//	(document-id,text?)
+type InvalidationOfPatent struct {
+	DocumentID DocumentID `xml:"document-id"`
+	Text       string     `xml:"text"`
+}
+
+// PrintedAsAmended is the element printed-as-amended
+//
+//	(document-id,text?)
+type PrintedAsAmended struct {
+	DocumentID DocumentID `xml:"document-id"`
+	Text       string     `xml:"text"`
+}
+
+// DatesRightsEffective is the element dates-rights-effective
+//
+//	(request-for-examination | first-examination-report-despatched | patent-maintained-as-amended |
+//	request-for-conversion-to-national-application | proceedings-suspended | proceedings-interrupted |
+//	proceedings-resumed | notification-of-rights-after-appeal | date-entering-into-force)+
+type DatesRightsEffective struct {
+	RequestForExamination                     []RequestForExamination                     `xml:"request-for-examination"`
+	FirstExaminationReportDespatched          []FirstExaminationReportDespatched          `xml:"first-examination-report-despatched"`
+	PatentMaintainedAsAmended                 []PatentMaintainedAsAmended                 `xml:"patent-maintained-as-amended"`
+	RequestForConversionToNationalApplication []RequestForConversionToNationalApplication `xml:"request-for-conversion-to-national-application"`
+	ProceedingsSuspended                      []ProceedingsSuspended                      `xml:"proceedings-suspended"`
+	ProceedingsInterrupted                    []ProceedingsInterrupted                    `xml:"proceedings-interrupted"`
+	ProceedingsResumed                        []ProceedingsResumed                        `xml:"proceedings-resumed"`
+	NotificationOfRightsAfterAppeal           []NotificationOfRightsAfterAppeal           `xml:"notification-of-rights-after-appeal"`
+	DateEnteringIntoForce                     []DateEnteringIntoForce                     `xml:"date-entering-into-force"`
+}
+
+// RequestForExamination is the element request-for-examination
+//
+//	(date)
+type RequestForExamination struct {
+	Date string `xml:"date"`
+}
+
+// FirstExaminationReportDespatched is the element first-examination-report-despatched
+//
+//	(date)
+type FirstExaminationReportDespatched struct {
+	Date string `xml:"date"`
+}
+
+// PatentMaintainedAsAmended is the element patent-maintained-as-amended
+//
+//	(date)
+type PatentMaintainedAsAmended struct {
+	Date string `xml:"date"`
+}
+
+// RequestForConversionToNationalApplication is the element request-for-conversion-to-national-application
+//
+//	(date,country)
+type RequestForConversionToNationalApplication struct {
+	Date    string `xml:"date"`
+	Country string `xml:"country"`
+}
+
+// ProceedingsSuspended is the element proceedings-suspended
+//
+//	(date)
+type ProceedingsSuspended struct {
+	Date string `xml:"date"`
+}
+
+// ProceedingsInterrupted is the element proceedings-interrupted
+//
+//	(date)
+type ProceedingsInterrupted struct {
+	Date string `xml:"date"`
+}
+
+// ProceedingsResumed is the element proceedings-resumed
+//
+//	(date)
+type ProceedingsResumed struct {
+	Date string `xml:"date"`
+}
+
+// NotificationOfRightsAfterAppeal is the element notification-of-rights-after-appeal
+//
+//	(date)
+type NotificationOfRightsAfterAppeal struct {
+	Date string `xml:"date"`
+}
+
+// DateEnteringIntoForce is the element date-entering-into-force
+//
+//	(date)
+type DateEnteringIntoForce struct {
+	Date string `xml:"date"`
+}
+
+// PatentFamily is the element patent-family
+//
+//	(priority-application,(family-member+ | text))
+type PatentFamily struct {
+	ID                  string              `xml:"id,attr"`
+	PriorityApplication PriorityApplication `xml:"priority-application"`
+	FamilyMember        []FamilyMember      `xml:"family-member"`
+	Text                string              `xml:"text"`
+}
+
+// PriorityApplication is the element priority-application
+//
+//	(document-id)
+type PriorityApplication struct {
+	DocumentID DocumentID `xml:"document-id"`
+}
+
+// FamilyMember is the element family-member
+//
+//	(document-id)
+type FamilyMember struct {
+	DocumentID DocumentID `xml:"document-id"`
+}
+
+// FieldOfSearch is the element field-of-search
+//
+//	(classification-ipc | classifications-ipcr | classification-national)+
+type FieldOfSearch struct {
+	ClassificationIpc      []ClassificationIpc      `xml:"classification-ipc"`
+	ClassificationsIpcr    []ClassificationsIpcr    `xml:"classifications-ipcr"`
+	ClassificationNational []ClassificationNational `xml:"classification-national"`
+}
+
+// Figures is the element figures
+//
+//	(number-of-drawing-sheets?,number-of-figures?,figure-to-publish?)
+type Figures struct {
+	NumberOfDrawingSheets string           `xml:"number-of-drawing-sheets"`
+	NumberOfFigures       string           `xml:"number-of-figures"`
+	FigureToPublish       *FigureToPublish `xml:"figure-to-publish"`
+}
+
+// ReferencesCited is the element references-cited
+//
+//	(text | (citation+,date-search-completed?,date-search-report-mailed?,place-of-search?,
+//	search-report-publication?,searcher?))
+type ReferencesCited struct {
+	Text                    string                   `xml:"text"`
+	Citation                []Citation               `xml:"citation"`
+	DateSearchCompleted     *DateSearchCompleted     `xml:"date-search-completed"`
+	DateSearchReportMailed  *DateSearchReportMailed  `xml:"date-search-report-mailed"`
+	PlaceOfSearch           *PlaceOfSearch           `xml:"place-of-search"`
+	SearchReportPublication *SearchReportPublication `xml:"search-report-publication"`
+	Searcher                *Searcher                `xml:"searcher"`
+}
+
+// PlaceOfSearch is the element place-of-search
+//
+//	(country)
+type PlaceOfSearch struct {
+	Country string `xml:"country"`
+}
+
+// ElectronicSignature is the element electronic-signature
+//
+//	(basic-signature | enhanced-signature)
+type ElectronicSignature struct {
+	Date              string             `xml:"date,attr"`
+	PlaceSigned       string             `xml:"place-signed,attr"`
+	BasicSignature    *BasicSignature    `xml:"basic-signature"`
+	EnhancedSignature *EnhancedSignature `xml:"enhanced-signature"`
+}
+
+// BasicSignature is the element basic-signature
+//
+//	(fax-image | text-string | click-wrap)
+type BasicSignature struct {
+	FaxImage   *FaxImage  `xml:"fax-image"`
+	TextString string     `xml:"text-string"`
+	ClickWrap  *ClickWrap `xml:"click-wrap"`
+}
+
+// FaxImage is the element fax-image
+//
+//	EMPTY
+type FaxImage struct {
+	File string `xml:"file,attr"`
+}
+
+// ClickWrap is the element click-wrap
+//
+//	EMPTY
+type ClickWrap struct{}
+
+// EnhancedSignature is the element enhanced-signature
+//
+//	(pkcs7)
+type EnhancedSignature struct {
+	Pkcs7 Pkcs7 `xml:"pkcs7"`
+}
+
+// Pkcs7 is the element pkcs7
+//
+//	EMPTY
+type Pkcs7 struct{}
+
+// AssistantExaminer is the element assistant-examiner
+//
+//	(((name | (prefix?,(last-name | orgname),first-name?,middle-name?,suffix?,iid?,role?,orgname?,
+//	department?,synonym*)),registered-number?),electronic-signature?)
+type AssistantExaminer struct {
+	Name                *Name                `xml:"name"`
+	Prefix              string               `xml:"prefix"`
+	LastName            string               `xml:"last-name"`
+	Orgname             []string             `xml:"orgname"`
+	FirstName           string               `xml:"first-name"`
+	MiddleName          string               `xml:"middle-name"`
+	Suffix              string               `xml:"suffix"`
+	Iid                 string               `xml:"iid"`
+	Role                string               `xml:"role"`
+	Department          string               `xml:"department"`
+	Synonym             []string             `xml:"synonym"`
+	RegisteredNumber    string               `xml:"registered-number"`
+	ElectronicSignature *ElectronicSignature `xml:"electronic-signature"`
+}
+
+// AuthorizedOfficer is the element authorized-officer
+//
+//	(((name | (prefix?,(last-name | orgname),first-name?,middle-name?,suffix?,iid?,role?,orgname?,
+//	department?,synonym*)),registered-number?),phone?,fax?,email?,electronic-signature?)
+type AuthorizedOfficer struct {
+	Name                *Name                `xml:"name"`
+	Prefix              string               `xml:"prefix"`
+	LastName            string               `xml:"last-name"`
+	Orgname             []string             `xml:"orgname"`
+	FirstName           string               `xml:"first-name"`
+	MiddleName          string               `xml:"middle-name"`
+	Suffix              string               `xml:"suffix"`
+	Iid                 string               `xml:"iid"`
+	Role                string               `xml:"role"`
+	Department          string               `xml:"department"`
+	Synonym             []string             `xml:"synonym"`
+	RegisteredNumber    string               `xml:"registered-number"`
+	Phone               string               `xml:"phone"`
+	Fax                 string               `xml:"fax"`
+	Email               string               `xml:"email"`
+	ElectronicSignature *ElectronicSignature `xml:"electronic-signature"`
+}
+
+// RelatedDocuments is the element related-documents
+//
+//	(addition | division | continuation | continuation-in-part | continuing-reissue | reissue |
+//	reexamination | substitution | utility-model-basis | correction | related-publication)+
+type RelatedDocuments struct {
+	Addition           []Addition           `xml:"addition"`
+	Division           []Division           `xml:"division"`
+	Continuation       []Continuation       `xml:"continuation"`
+	ContinuationInPart []ContinuationInPart `xml:"continuation-in-part"`
+	ContinuingReissue  []ContinuingReissue  `xml:"continuing-reissue"`
+	Reissue            []Reissue            `xml:"reissue"`
+	Reexamination      []Reexamination      `xml:"reexamination"`
+	Substitution       []Substitution       `xml:"substitution"`
+	UtilityModelBasis  []UtilityModelBasis  `xml:"utility-model-basis"`
+	Correction         []Correction         `xml:"correction"`
+	RelatedPublication []RelatedPublication `xml:"related-publication"`
+}
+
+// Addition is the element addition
+//
+//	(relation)
+type Addition struct {
+	Relation Relation `xml:"relation"`
+}
+
+// Relation is the element relation
+//
+//	(parent-doc,child-doc)
+type Relation struct {
+	ParentDoc ParentDoc `xml:"parent-doc"`
+	ChildDoc  ChildDoc  `xml:"child-doc"`
+}
+
+// ParentDoc is the element parent-doc
+//
+//	(document-id,parent-status?,parent-grant-document?,parent-pct-document?)
+type ParentDoc struct {
+	DocumentID          DocumentID           `xml:"document-id"`
+	ParentStatus        string               `xml:"parent-status"`
+	ParentGrantDocument *ParentGrantDocument `xml:"parent-grant-document"`
+	ParentPctDocument   *ParentPctDocument   `xml:"parent-pct-document"`
+}
+
+// ParentGrantDocument is the element parent-grant-document
+//
+//	(document-id)
+type ParentGrantDocument struct {
+	DocumentID DocumentID `xml:"document-id"`
+}
+
+// ParentPctDocument is the element parent-pct-document
+//
+//	(document-id)
+type ParentPctDocument struct {
+	DocumentID DocumentID `xml:"document-id"`
+}
+
+// ChildDoc is the element child-doc
+//
+//	(document-id)
+type ChildDoc struct {
+	DocumentID DocumentID `xml:"document-id"`
+}
+
+// Division is the element division
+//
+//	(relation)
+type Division struct {
+	Relation Relation `xml:"relation"`
+}
+
+// Continuation is the element continuation
+//
+//	(relation)
+type Continuation struct {
+	Relation Relation `xml:"relation"`
+}
+
+// ContinuationInPart is the element continuation-in-part
+//
+//	(relation)
+type ContinuationInPart struct {
+	Relation Relation `xml:"relation"`
+}
+
+// ContinuingReissue is the element continuing-reissue
+//
+//	(relation)
+type ContinuingReissue struct {
+	Relation Relation `xml:"relation"`
+}
+
+// Reissue is the element reissue
+//
+//	(relation)
+type Reissue struct {
+	Relation Relation `xml:"relation"`
+}
+
+// Reexamination is the element reexamination
+//
+//	(relation)
+type Reexamination struct {
+	Relation Relation `xml:"relation"`
+}
+
+// Substitution is the element substitution
+//
+//	(relation)
+type Substitution struct {
+	Relation Relation `xml:"relation"`
+}
+
+// UtilityModelBasis is the element utility-model-basis
+//
+//	(relation)
+type UtilityModelBasis struct {
+	Relation Relation `xml:"relation"`
+}
+
+// Correction is the element correction
+//
+//	(document-corrected,type-of-correction?,gazette-reference?,text?)
+type Correction struct {
+	DocumentCorrected DocumentCorrected `xml:"document-corrected"`
+	TypeOfCorrection  string            `xml:"type-of-correction"`
+	GazetteReference  *GazetteReference `xml:"gazette-reference"`
+	Text              string            `xml:"text"`
+}
+
+// DocumentCorrected is the element document-corrected
+//
+//	(document-id)
+type DocumentCorrected struct {
+	DocumentID DocumentID `xml:"document-id"`
+}
+
+// RelatedPublication is the element related-publication
+//
+//	(#PCDATA | document-id)*
+type RelatedPublication struct {
+	Text       string       `xml:",chardata"`
+	InnerXML   string       `xml:",innerxml"`
+	DocumentID []DocumentID `xml:"document-id"`
+}
+
+// St50Republication is the element st50-republication
+//
+//	(republication-code?,cancellation-date?,inid-codes?,standard-notes?,modified-parts?,
+//	correction-notice?)
+type St50Republication struct {
+	RepublicationCode string            `xml:"republication-code"`
+	CancellationDate  string            `xml:"cancellation-date"`
+	InidCodes         string            `xml:"inid-codes"`
+	StandardNotes     *StandardNotes    `xml:"standard-notes"`
+	ModifiedParts     *ModifiedParts    `xml:"modified-parts"`
+	CorrectionNotice  *CorrectionNotice `xml:"correction-notice"`
+}
+
+// StandardNotes is the element standard-notes
+//
+//	(note-language?,note-text?)
+type StandardNotes struct {
+	NoteLanguage string `xml:"note-language"`
+	NoteText     string `xml:"note-text"`
+}
+
+// ModifiedParts is the element modified-parts
+//
+//	(part-language?,part-text?)
+type ModifiedParts struct {
+	PartLanguage string `xml:"part-language"`
+	PartText     string `xml:"part-text"`
+}
+
+// CorrectionNotice is the element correction-notice
+//
+//	(date,gazette-reference)
+type CorrectionNotice struct {
+	Date             string           `xml:"date"`
+	GazetteReference GazetteReference `xml:"gazette-reference"`
+}
+
+// DateExhibitionFiled is the element date-exhibition-filed
+//
+//	(date)
+type DateExhibitionFiled struct {
+	Date string `xml:"date"`
+}
+
+// DatePctArticle2239Fulfilled is the element date-pct-article-22-39-fulfilled
+//
+//	(date)
+type DatePctArticle2239Fulfilled struct {
+	Date string `xml:"date"`
+}
+
+// PctApplicationNoLongerEffective is the element pct-application-no-longer-effective
+//
+//	((region | country),date)+
+type PctApplicationNoLongerEffective struct {
+	Region  []Region `xml:"region"`
+	Country []string `xml:"country"`
+	Date    []string `xml:"date"`
+}
+
+// PctOrRegionalFilingData is the element pct-or-regional-filing-data
+//
+//	(document-id,us-371c124-date?)
+type PctOrRegionalFilingData struct {
+	DocumentID    DocumentID     `xml:"document-id"`
+	Us371c124Date *Us371c124Date `xml:"us-371c124-date"`
+}
+
+// Us371c124Date is the element us-371c124-date
+//
+//	(date)
+type Us371c124Date struct {
+	Date string `xml:"date"`
+}
+
+// PctOrRegionalPublishingData is the element pct-or-regional-publishing-data
+//
+//	(document-id,gazette-reference?)
+type PctOrRegionalPublishingData struct {
+	DocumentID       DocumentID        `xml:"document-id"`
+	GazetteReference *GazetteReference `xml:"gazette-reference"`
+}
+
+// DeferPublicationOfSearchReport is the element defer-publication-of-search-report
+//
+//	(date,gazette-reference)
+type DeferPublicationOfSearchReport struct {
+	Date             string           `xml:"date"`
+	GazetteReference GazetteReference `xml:"gazette-reference"`
+}
+
+// DateApplicationRefused is the element date-application-refused
+//
+//	(date)
+type DateApplicationRefused struct {
+	Date string `xml:"date"`
+}
+
+// DateRightsReestablished is the element date-rights-reestablished
+//
+//	(date)
+type DateRightsReestablished struct {
+	Date string `xml:"date"`
+}
+
+// DateApplicationWithdrawnByApplicant is the element date-application-withdrawn-by-applicant
+//
+//	(date)
+type DateApplicationWithdrawnByApplicant struct {
+	Date string `xml:"date"`
+}
+
+// DateApplicationDeemedWithdrawn is the element date-application-deemed-withdrawn
+//
+//	(date)
+type DateApplicationDeemedWithdrawn struct {
+	Date string `xml:"date"`
+}
+
+// DateOfRevocation is the element date-of-revocation
+//
+//	(date)
+type DateOfRevocation struct {
+	Date string `xml:"date"`
+}
+
+// CmeaHavanaAgreement is the element cmea-havana-agreement
+//
+//	(document-id)
+type CmeaHavanaAgreement struct {
+	DocumentID DocumentID `xml:"document-id"`
+}
+
+// OfficeSpecificBibData is the element office-specific-bib-data
+//
+//	EMPTY
+type OfficeSpecificBibData struct {
+	ID        string `xml:"id,attr"`
+	File      string `xml:"file,attr"`
+	Lang      string `xml:"lang,attr"`
+	Office    string `xml:"office,attr"`
+	OfficeDtd string `xml:"office-dtd,attr"`
+	Status    string `xml:"status,attr"`
+}
+
+// OppositionData is the element opposition-data
+//
+//	(opponent | attorney-or-agent | date-opposition-filed | date-deemed-not-to-have-been-filed |
+//	date-inadmissable | date-rejected | date-terminated | opposition-not-filed)+
+type OppositionData struct {
+	Opponent                     []Opponent                     `xml:"opponent"`
+	AttorneyOrAgent              []AttorneyOrAgent              `xml:"attorney-or-agent"`
+	DateOppositionFiled          []DateOppositionFiled          `xml:"date-opposition-filed"`
+	DateDeemedNotToHaveBeenFiled []DateDeemedNotToHaveBeenFiled `xml:"date-deemed-not-to-have-been-filed"`
+	DateInadmissable             []DateInadmissable             `xml:"date-inadmissable"`
+	DateRejected                 []DateRejected                 `xml:"date-rejected"`
+	DateTerminated               []DateTerminated               `xml:"date-terminated"`
+	OppositionNotFiled           []OppositionNotFiled           `xml:"opposition-not-filed"`
+}
+
+// Opponent is the element opponent
+//
+//	(addressbook)
+type Opponent struct {
+	Addressbook Addressbook `xml:"addressbook"`
+}
+
+// DateOppositionFiled is the element date-opposition-filed
+//
+//	(date)
+type DateOppositionFiled struct {
+	Date string `xml:"date"`
+}
+
+// DateDeemedNotToHaveBeenFiled is the element date-deemed-not-to-have-been-filed
+//
+//	(date)
+type DateDeemedNotToHaveBeenFiled struct {
+	Date string `xml:"date"`
+}
+
+// DateInadmissable is the element date-inadmissable
+//
+//	(date)
+type DateInadmissable struct {
+	Date string `xml:"date"`
+}
+
+// DateRejected is the element date-rejected
+//
+//	(date)
+type DateRejected struct {
+	Date string `xml:"date"`
+}
+
+// DateTerminated is the element date-terminated
+//
+//	(date)
+type DateTerminated struct {
+	Date string `xml:"date"`
+}
+
+// OppositionNotFiled is the element opposition-not-filed
+//
+//	EMPTY
+type OppositionNotFiled struct{}
+
+// Pre is the element pre
+//
+//	(#PCDATA)
+type Pre struct {
+	Text        string `xml:",chardata"`
+	ID          string `xml:"id,attr"`
+	XmlSpace    string `xml:"space,attr"`
+	ListingType string `xml:"listing-type,attr"`
+}
+
+// TableExternalDoc is the element table-external-doc
+//
+//	(#PCDATA)
+type TableExternalDoc struct {
+	Text         string `xml:",chardata"`
+	ID           string `xml:"id,attr"`
+	File         string `xml:"file,attr"`
+	Lang         string `xml:"lang,attr"`
+	Carriers     string `xml:"carriers,attr"`
+	ExternalType string `xml:"external-type,attr"`
+	Status       string `xml:"status,attr"`
+}
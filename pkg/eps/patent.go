@@ -1,8 +1,12 @@
 package eps
 
-import "encoding/xml"
+import (
+	"bytes"
+	"encoding/xml"
+)
 
-// EpPatentDocument is a auto generated struct based on the example xml files
+// EpPatentDocument is a auto generated struct based on the example xml files.
+// The complete models generated from the DTDs can be found in the packages of model, e.g. v15.EpPatentDocument
 type EpPatentDocument struct {
 	XMLName    xml.Name `xml:"ep-patent-document"`
 	Text       string   `xml:",chardata"`
@@ -426,3 +430,9 @@ type EpPatentDocument struct {
 		} `xml:"p"`
 	} `xml:"ep-reference-list"`
 }
+
+// UnmarshalXML unmarshals the xml data of a patent document into v, e.g. a EpPatentDocument or v15.EpPatentDocument.
+// In contrast to xml.Unmarshal, html entities and stray '<' characters in the text are accepted.
+func UnmarshalXML(raw []byte, v any) error {
+	return newDecoder(bytes.NewReader(raw)).Decode(v)
+}
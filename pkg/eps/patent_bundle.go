@@ -0,0 +1,273 @@
+package eps
+
+import (
+	"archive/zip"
+	"bytes"
+	"errors"
+	"github.com/max-planck-innovation-competition/go-epo-eps/pkg/images"
+	log "github.com/sirupsen/logrus"
+	"io"
+	"path"
+	"strings"
+)
+
+var ErrNoMainXML = errors.New("no main xml in the bundle")
+
+// BundleRole is the role of a file of the zip bundle
+type BundleRole string
+
+const (
+	BundleMainXML BundleRole = "main-xml" // the ep-patent-document
+	BundleXML     BundleRole = "xml"      // other xml files, e.g. a table of contents
+	BundleImage   BundleRole = "image"    // drawings, chemical formulas, tables and maths as tif, png, jpg or gif
+	BundlePDF     BundleRole = "pdf"
+	BundleOther   BundleRole = "other"
+)
+
+// imageExtensions are the extensions of the image files
+var imageExtensions = map[string]bool{
+	".tif":  true,
+	".tiff": true,
+	".png":  true,
+	".jpg":  true,
+	".jpeg": true,
+	".gif":  true,
+}
+
+// BundleEntry is a file of the zip bundle
+type BundleEntry struct {
+	Name string // path in the zip file
+	Role BundleRole
+	Size int64 // uncompressed size
+	file *zip.File
+}
+
+// Open opens the file of the entry
+func (e BundleEntry) Open() (io.ReadCloser, error) {
+	return e.file.Open()
+}
+
+// Read returns the content of the entry
+func (e BundleEntry) Read() (res []byte, err error) {
+	r, err := e.file.Open()
+	if err != nil {
+		log.WithError(err).WithField("name", e.Name).Error("can not open bundle entry")
+		return
+	}
+	defer r.Close()
+	res, err = io.ReadAll(r)
+	if err != nil {
+		log.WithError(err).WithField("name", e.Name).Error("can not read bundle entry")
+	}
+	return
+}
+
+// Convert converts the image of the entry, e.g. a tif drawing into a png image or thumbnail
+func (e BundleEntry) Convert(opts images.Options) (res []byte, err error) {
+	r, err := e.file.Open()
+	if err != nil {
+		log.WithError(err).WithField("name", e.Name).Error("can not open bundle entry")
+		return
+	}
+	defer r.Close()
+	var buf bytes.Buffer
+	err = images.Convert(r, &buf, opts)
+	if err != nil {
+		log.WithError(err).WithField("name", e.Name).Error("can not convert bundle entry")
+		return
+	}
+	return buf.Bytes(), nil
+}
+
+// PatentBundle is the zip file of a publication, see GetPatentZIP
+type PatentBundle struct {
+	entries []BundleEntry
+	closer  io.Closer
+}
+
+// BundleFigure is a figure of the document together with the file of its image
+type BundleFigure struct {
+	Figure Figure
+	Entry  BundleEntry
+}
+
+// GetPatentBundle downloads the zip file of the patent and opens it in memory
+func GetPatentBundle(patentID string) (bundle *PatentBundle, err error) {
+	raw, err := GetPatentZIP(patentID)
+	if err != nil {
+		return
+	}
+	return OpenPatentBundle(raw)
+}
+
+// OpenPatentBundle opens the zip file in memory, e.g. the result of GetPatentZIP
+func OpenPatentBundle(raw []byte) (bundle *PatentBundle, err error) {
+	r, err := zip.NewReader(bytes.NewReader(raw), int64(len(raw)))
+	if err != nil {
+		log.WithError(err).Error("can not open zip file")
+		return
+	}
+	return newPatentBundle(r.File, nil)
+}
+
+// OpenPatentBundleFile opens the zip file from disk, the bundle has to be closed
+func OpenPatentBundleFile(filePath string) (bundle *PatentBundle, err error) {
+	r, err := zip.OpenReader(filePath)
+	if err != nil {
+		log.WithError(err).WithField("file", filePath).Error("can not open zip file")
+		return
+	}
+	bundle, err = newPatentBundle(r.File, r)
+	if err != nil {
+		_ = r.Close()
+	}
+	return
+}
+
+// newPatentBundle classifies the files of the zip file
+func newPatentBundle(files []*zip.File, closer io.Closer) (bundle *PatentBundle, err error) {
+	bundle = &PatentBundle{closer: closer}
+	mainFound := false
+	for _, f := range files {
+		if f.FileInfo().IsDir() {
+			continue
+		}
+		e := BundleEntry{Name: f.Name, Size: int64(f.UncompressedSize64), file: f}
+		ext := strings.ToLower(path.Ext(f.Name))
+		switch {
+		case ext == ".xml":
+			e.Role = BundleXML
+			if !mainFound {
+				var isMain bool
+				isMain, err = isPatentDocument(f)
+				if err != nil {
+					return nil, err
+				}
+				if isMain {
+					e.Role = BundleMainXML
+					mainFound = true
+				}
+			}
+		case ext == ".pdf":
+			e.Role = BundlePDF
+		case imageExtensions[ext]:
+			e.Role = BundleImage
+		default:
+			e.Role = BundleOther
+		}
+		bundle.entries = append(bundle.entries, e)
+	}
+	return
+}
+
+// isPatentDocument checks if the xml file contains an ep-patent-document
+func isPatentDocument(f *zip.File) (bool, error) {
+	r, err := f.Open()
+	if err != nil {
+		log.WithError(err).WithField("name", f.Name).Error("can not open bundle entry")
+		return false, err
+	}
+	defer r.Close()
+	// the root element follows the xml declaration and the doctype
+	head := make([]byte, 4096)
+	n, err := io.ReadFull(r, head)
+	if err != nil && err != io.ErrUnexpectedEOF && err != io.EOF {
+		log.WithError(err).WithField("name", f.Name).Error("can not read bundle entry")
+		return false, err
+	}
+	return bytes.Contains(head[:n], []byte("<ep-patent-document")), nil
+}
+
+// Close closes the zip file of a bundle opened from disk, a nil bundle is ignored
+func (b *PatentBundle) Close() error {
+	if b == nil || b.closer == nil {
+		return nil
+	}
+	return b.closer.Close()
+}
+
+// Entries returns the files of the bundle
+func (b *PatentBundle) Entries() []BundleEntry {
+	return b.entries
+}
+
+// EntriesByRole returns the files of the bundle with the role
+func (b *PatentBundle) EntriesByRole(role BundleRole) (res []BundleEntry) {
+	for _, e := range b.entries {
+		if e.Role == role {
+			res = append(res, e)
+		}
+	}
+	return
+}
+
+// MainXML returns the file of the ep-patent-document
+func (b *PatentBundle) MainXML() (BundleEntry, bool) {
+	entries := b.EntriesByRole(BundleMainXML)
+	if len(entries) == 0 {
+		return BundleEntry{}, false
+	}
+	return entries[0], true
+}
+
+// Entry returns the file with the name, the directories and the case are ignored
+func (b *PatentBundle) Entry(name string) (BundleEntry, bool) {
+	for _, e := range b.entries {
+		if strings.EqualFold(path.Base(e.Name), path.Base(name)) {
+			return e, true
+		}
+	}
+	return BundleEntry{}, false
+}
+
+// Document parses the main xml of the bundle with ProcessXMLSimple
+func (b *PatentBundle) Document() (EpPatentDocumentSimple, error) {
+	return b.DocumentWithOptions(ProcessOptions{})
+}
+
+// DocumentWithOptions parses the main xml of the bundle with the options
+func (b *PatentBundle) DocumentWithOptions(opts ProcessOptions) (doc EpPatentDocumentSimple, err error) {
+	main, ok := b.MainXML()
+	if !ok {
+		err = ErrNoMainXML
+		log.WithError(err).Error("can not parse bundle")
+		return
+	}
+	r, err := main.Open()
+	if err != nil {
+		log.WithError(err).WithField("name", main.Name).Error("can not open bundle entry")
+		return
+	}
+	defer r.Close()
+	return ProcessXMLSimpleReaderWithOptions(r, opts)
+}
+
+// Image returns the file of an image of the document, e.g. imgf0001.tif.
+// Images that are bundled in another format, e.g. imgf0001.png, are found as well.
+func (b *PatentBundle) Image(file string) (BundleEntry, bool) {
+	if e, ok := b.Entry(file); ok {
+		return e, true
+	}
+	stem := strings.TrimSuffix(path.Base(file), path.Ext(file))
+	for _, e := range b.EntriesByRole(BundleImage) {
+		base := path.Base(e.Name)
+		if strings.EqualFold(strings.TrimSuffix(base, path.Ext(base)), stem) {
+			return e, true
+		}
+	}
+	return BundleEntry{}, false
+}
+
+// ResolveFigures returns the figures of the document with the files of their images,
+// the figures without image in the bundle are returned as missing
+func (b *PatentBundle) ResolveFigures(doc *EpPatentDocumentSimple) (res []BundleFigure, missing []Figure) {
+	for _, f := range doc.Figures {
+		e, ok := b.Image(f.Image.File)
+		if !ok {
+			missing = append(missing, f)
+			continue
+		}
+		res = append(res, BundleFigure{Figure: f, Entry: e})
+	}
+	return
+}
@@ -0,0 +1,143 @@
+package eps
+
+import (
+	"archive/zip"
+	"bytes"
+	"github.com/max-planck-innovation-competition/go-epo-eps/pkg/images"
+	"github.com/stretchr/testify/assert"
+	"image"
+	"image/jpeg"
+	"image/png"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// newTestBundle creates a zip file with the xml of the test data and some images
+func newTestBundle(t *testing.T) []byte {
+	data, err := os.ReadFile("test-data/grant/v1-5-1-B1.xml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var drawing bytes.Buffer
+	if err = png.Encode(&drawing, image.NewGray(image.Rect(0, 0, 400, 200))); err != nil {
+		t.Fatal(err)
+	}
+	files := []struct {
+		name string
+		data []byte
+	}{
+		{"EP16849316NWB1/TOC.xml", []byte(`<?xml version="1.0"?><toc><file>EP16849316NWB1.xml</file></toc>`)},
+		{"EP16849316NWB1/EP16849316NWB1.xml", data},
+		{"EP16849316NWB1/EP16849316NWB1.pdf", []byte("%PDF-1.4")},
+		{"EP16849316NWB1/imgf0001.tif", []byte("II*\x00")},
+		{"EP16849316NWB1/IMGF0002.TIF", []byte("II*\x00")},
+		{"EP16849316NWB1/imgf0003.png", drawing.Bytes()},
+		{"EP16849316NWB1/ep-patent-document-v1-5.dtd", []byte("<!ELEMENT ep-patent-document ANY>")},
+	}
+	var buf bytes.Buffer
+	w := zip.NewWriter(&buf)
+	if _, err = w.Create("EP16849316NWB1/"); err != nil {
+		t.Fatal(err)
+	}
+	for _, f := range files {
+		fw, errCreate := w.Create(f.name)
+		if errCreate != nil {
+			t.Fatal(errCreate)
+		}
+		if _, err = fw.Write(f.data); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err = w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func TestOpenPatentBundle(t *testing.T) {
+	ass := assert.New(t)
+	bundle, err := OpenPatentBundle(newTestBundle(t))
+	if !ass.NoError(err) {
+		return
+	}
+	ass.NoError(bundle.Close())
+	ass.Len(bundle.Entries(), 7)
+	main, ok := bundle.MainXML()
+	ass.True(ok)
+	ass.Equal("EP16849316NWB1/EP16849316NWB1.xml", main.Name)
+	ass.Len(bundle.EntriesByRole(BundleXML), 1)
+	ass.Len(bundle.EntriesByRole(BundleImage), 3)
+	ass.Len(bundle.EntriesByRole(BundleOther), 1)
+	if pdfs := bundle.EntriesByRole(BundlePDF); ass.Len(pdfs, 1) {
+		data, errRead := pdfs[0].Read()
+		ass.NoError(errRead)
+		ass.Equal("%PDF-1.4", string(data))
+		ass.Equal(int64(8), pdfs[0].Size)
+	}
+	_, ok = bundle.Entry("imgf0002.tif")
+	ass.True(ok)
+
+	doc, err := bundle.Document()
+	ass.NoError(err)
+	ass.Equal("EP16849316B1", doc.ID)
+	ass.Len(doc.Figures, 7)
+
+	figures, missing := bundle.ResolveFigures(&doc)
+	if ass.Len(figures, 3) {
+		ass.Equal("f0001", figures[0].Figure.ID)
+		ass.Equal("EP16849316NWB1/imgf0001.tif", figures[0].Entry.Name)
+		ass.Equal("EP16849316NWB1/IMGF0002.TIF", figures[1].Entry.Name)
+		// images in another format
+		ass.Equal("EP16849316NWB1/imgf0003.png", figures[2].Entry.Name)
+
+		// thumbnails of the images
+		thumbnail, errConvert := figures[2].Entry.Convert(images.Options{Format: images.JPEG, MaxWidth: 100})
+		ass.NoError(errConvert)
+		img, errDecode := jpeg.Decode(bytes.NewReader(thumbnail))
+		ass.NoError(errDecode)
+		ass.Equal(image.Rect(0, 0, 100, 50), img.Bounds())
+		// invalid images
+		_, errConvert = figures[0].Entry.Convert(images.Options{})
+		ass.Error(errConvert)
+	}
+	ass.Len(missing, 4)
+}
+
+func TestOpenPatentBundleFile(t *testing.T) {
+	ass := assert.New(t)
+	file := filepath.Join(t.TempDir(), "EP16849316NWB1.zip")
+	ass.NoError(os.WriteFile(file, newTestBundle(t), 0o644))
+	bundle, err := OpenPatentBundleFile(file)
+	if !ass.NoError(err) {
+		return
+	}
+	defer bundle.Close()
+	doc, err := bundle.DocumentWithOptions(ProcessOptions{InlineTables: true})
+	ass.NoError(err)
+	ass.Equal("EP16849316B1", doc.ID)
+
+	_, err = OpenPatentBundleFile(filepath.Join(t.TempDir(), "missing.zip"))
+	ass.Error(err)
+}
+
+func TestOpenPatentBundleErrors(t *testing.T) {
+	ass := assert.New(t)
+	bundle, err := OpenPatentBundle([]byte("no zip"))
+	ass.Error(err)
+	ass.NoError(bundle.Close())
+
+	var buf bytes.Buffer
+	w := zip.NewWriter(&buf)
+	fw, err := w.Create("imgf0001.tif")
+	ass.NoError(err)
+	_, err = fw.Write([]byte("II*\x00"))
+	ass.NoError(err)
+	ass.NoError(w.Close())
+	bundle, err = OpenPatentBundle(buf.Bytes())
+	ass.NoError(err)
+	_, ok := bundle.MainXML()
+	ass.False(ok)
+	_, err = bundle.Document()
+	ass.ErrorIs(err, ErrNoMainXML)
+}
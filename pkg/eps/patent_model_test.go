@@ -0,0 +1,53 @@
+package eps
+
+import (
+	"github.com/max-planck-innovation-competition/go-epo-eps/pkg/eps/model/v10"
+	"github.com/max-planck-innovation-competition/go-epo-eps/pkg/eps/model/v11"
+	"github.com/max-planck-innovation-competition/go-epo-eps/pkg/eps/model/v13"
+	"github.com/max-planck-innovation-competition/go-epo-eps/pkg/eps/model/v14"
+	"github.com/max-planck-innovation-competition/go-epo-eps/pkg/eps/model/v15"
+	"github.com/stretchr/testify/assert"
+	"os"
+	"testing"
+)
+
+func TestUnmarshalXMLModels(t *testing.T) {
+	ass := assert.New(t)
+	// the dtd of version 1.2 is not available
+	files := map[string]any{
+		"test-data/application/v1-0-A1.xml":   &v10.EpPatentDocument{},
+		"test-data/application/v1-01-A1.xml":  &v10.EpPatentDocument{},
+		"test-data/grant/v1-1-B1.xml":         &v11.EpPatentDocument{},
+		"test-data/grant/v1-2-B2.xml":         &v13.EpPatentDocument{},
+		"test-data/grant/v1-3-B1.xml":         &v13.EpPatentDocument{},
+		"test-data/grant/v1-4-B2.xml":         &v14.EpPatentDocument{},
+		"test-data/application/v1-5-A1.xml":   &v15.EpPatentDocument{},
+		"test-data/application/v1-5-1-A1.xml": &v15.EpPatentDocument{},
+	}
+	for file, doc := range files {
+		data, err := os.ReadFile(file)
+		ass.NoError(err)
+		ass.NoError(UnmarshalXML(data, doc), file)
+	}
+}
+
+func TestUnmarshalXMLModelV15(t *testing.T) {
+	ass := assert.New(t)
+	data, err := os.ReadFile("test-data/application/v1-5-1-A1.xml")
+	ass.NoError(err)
+	var doc v15.EpPatentDocument
+	ass.NoError(UnmarshalXML(data, &doc))
+
+	ass.Equal("ep-patent-document-v1-5-1", doc.DtdVersion)
+	ass.NotNil(doc.SDOBI)
+	ass.Equal("3882239", doc.SDOBI.B100.B110.Text)
+	ass.Len(doc.SDOBI.B800.B840, 1)
+	ass.Len(doc.SDOBI.B800.B840[0].Ctry, 38)
+	ass.NotEmpty(doc.Abstract)
+	ass.NotNil(doc.Description)
+	ass.NotEmpty(doc.Description.P)
+	ass.NotEmpty(doc.Claims)
+	ass.NotEmpty(doc.Claims[0].Claim)
+	ass.NotEmpty(doc.EpReferenceList)
+	ass.NotEmpty(doc.SearchReportData)
+}
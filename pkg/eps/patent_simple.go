@@ -1,86 +1,154 @@
 package eps
 
 import (
-	log "github.com/sirupsen/logrus"
+	"errors"
 	"regexp"
+	"strings"
 	"time"
 )
 
 // EpPatentDocumentSimple is a simple representation of the xml data
 type EpPatentDocumentSimple struct {
-	ID                string
-	Aliases           []string
-	File              string
-	Lang              string
-	Country           Country
-	DocNumber         string
-	Kind              string
-	DatePubl          time.Time
-	Status            string
-	DtdVersion        string
-	Title             []Title
-	Abstract          []Abstract
-	Claims            []Claim
-	Description       []Description
-	Citations         []Citation
-	Inventors         []Inventor
-	Owners            []Owner
-	Representatives   []Representative
-	ContractingStates []Country
-	Classifications   []ClassificationItem
+	ID                string               `json:"id"`
+	Aliases           []string             `json:"aliases,omitempty"`
+	File              string               `json:"file,omitempty"`
+	Lang              string               `json:"lang,omitempty"`
+	Country           Country              `json:"country,omitempty"`
+	DocNumber         string               `json:"docNumber,omitempty"`
+	Kind              string               `json:"kind,omitempty"`
+	DatePubl          time.Time            `json:"datePubl,omitempty"`
+	Status            string               `json:"status,omitempty"`
+	DtdVersion        string               `json:"dtdVersion,omitempty"`
+	Title             []Title              `json:"title,omitempty"`
+	Abstract          []Abstract           `json:"abstract,omitempty"`
+	Claims            []Claim              `json:"claims,omitempty"`
+	AmendedClaims     []AmendedClaims      `json:"amendedClaims,omitempty"`
+	ClaimsStatements  []ClaimsStatement    `json:"claimsStatements,omitempty"`
+	Tables            []Table              `json:"tables,omitempty"` // tables of the texts
+	Chemistry         []Chemistry          `json:"chemistry,omitempty"`
+	BioDeposits       []BioDeposit         `json:"bioDeposits,omitempty"`
+	SequenceListTexts []SequenceListText   `json:"sequenceListTexts,omitempty"`
+	Figures           []Figure             `json:"figures,omitempty"`
+	Description       []Description        `json:"description,omitempty"`
+	Citations         []Citation           `json:"citations,omitempty"`
+	NplCitations      []NplCitation        `json:"nplCitations,omitempty"`
+	PriorArt          PriorArt             `json:"priorArt"`
+	SearchReports     []SearchReport       `json:"searchReports,omitempty"`
+	Inventors         []Inventor           `json:"inventors,omitempty"`
+	Owners            []Owner              `json:"owners,omitempty"`
+	Representatives   []Representative     `json:"representatives,omitempty"`
+	ContractingStates []Country            `json:"contractingStates,omitempty"`
+	DesignatedStates  []State              `json:"designatedStates,omitempty"`
+	ExtensionStates   []State              `json:"extensionStates,omitempty"`
+	ValidationStates  []State              `json:"validationStates,omitempty"`
+	Classifications   []ClassificationItem `json:"classifications,omitempty"`
+	Warnings          []ParseWarning       `json:"warnings,omitempty"` // problems found while parsing, see ProcessXMLSimpleWithOptions
 }
 
 type Country string
 
 type Title struct {
-	Text     string
-	Language string
+	Text     string `json:"text,omitempty"`
+	Language string `json:"language,omitempty"`
 }
 
 type Abstract struct {
-	Text     string
-	Language string
+	Text     string `json:"text,omitempty"`
+	Language string `json:"language,omitempty"`
 }
 
 type Claim struct {
-	Text     string
-	Language string
-	Id       string
+	Text     string      `json:"text,omitempty"`
+	Language string      `json:"language,omitempty"`
+	Id       string      `json:"id,omitempty"`
+	Items    []ClaimItem `json:"items,omitempty"` // the single claims of the set
 }
 
 type Description struct {
-	Text     string
-	Language string
+	Text       string      `json:"text,omitempty"`
+	Language   string      `json:"language,omitempty"`
+	Paragraphs []Paragraph `json:"paragraphs,omitempty"` // paragraphs and headings of the text
 }
 
+// Paragraph is a numbered paragraph (p) or a heading of the description
+type Paragraph struct {
+	ID      string `json:"id,omitempty"`  // e.g. p0001
+	Num     string `json:"num,omitempty"` // e.g. 0001, empty for headings
+	Heading bool   `json:"heading,omitempty"`
+	Text    string `json:"text,omitempty"`
+}
+
+// CitationPhase indicates in which phase a document has been cited
+type CitationPhase string
+
+const (
+	// CitedByApplicant is used for documents cited in the description
+	CitedByApplicant CitationPhase = "applicant"
+	// CitedBySearch is used for documents cited in the search (report)
+	CitedBySearch CitationPhase = "search"
+)
+
 type Citation struct {
-	Country   Country
-	DocNumber string
-	Kind      string
+	Country        Country       `json:"country,omitempty"`
+	DocNumber      string        `json:"docNumber,omitempty"`
+	Kind           string        `json:"kind,omitempty"`
+	Text           string        `json:"text,omitempty"`
+	Phase          CitationPhase `json:"phase,omitempty"`
+	Categories     []string      `json:"categories,omitempty"` // X, Y, A, ... (see DTD element category)
+	RelevantClaims string        `json:"relevantClaims,omitempty"`
+}
+
+// NplCitation is a citation of non-patent literature (nplcit)
+type NplCitation struct {
+	ID             string        `json:"id,omitempty"`
+	Type           string        `json:"type,omitempty"` // npl-type, e.g. s (serial), b (book), w (online)
+	Text           string        `json:"text,omitempty"` // only set if the citation is not structured
+	Authors        []string      `json:"authors,omitempty"`
+	Title          string        `json:"title,omitempty"`
+	Journal        string        `json:"journal,omitempty"`
+	BookTitle      string        `json:"bookTitle,omitempty"`
+	Year           string        `json:"year,omitempty"`
+	Volume         string        `json:"volume,omitempty"`
+	Issue          string        `json:"issue,omitempty"`
+	Pages          string        `json:"pages,omitempty"`
+	DOI            string        `json:"doi,omitempty"`
+	URL            string        `json:"url,omitempty"`
+	File           string        `json:"file,omitempty"` // e.g. XP number
+	Phase          CitationPhase `json:"phase,omitempty"`
+	Categories     []string      `json:"categories,omitempty"`
+	RelevantClaims string        `json:"relevantClaims,omitempty"`
+}
+
+// PriorArt is the list of prior art documents on the title page (B560)
+type PriorArt struct {
+	Citations                     []Citation    `json:"citations,omitempty"`                     // B561
+	NplCitations                  []NplCitation `json:"nplCitations,omitempty"`                  // B562
+	SearchReportDate              time.Time     `json:"searchReportDate,omitempty"`              // B565
+	SupplementarySearchReportDate time.Time     `json:"supplementarySearchReportDate,omitempty"` // B565EP
 }
 
 type Inventor struct {
-	Country Country
-	City    string
-	Street  string
-	Name    string
+	Country Country `json:"country,omitempty"`
+	City    string  `json:"city,omitempty"`
+	Street  string  `json:"street,omitempty"`
+	Name    string  `json:"name,omitempty"`
 }
 
 type Owner struct {
-	Country Country
-	IID     string
-	IRF     string
-	City    string
-	Street  string
-	Name    string
+	Country Country `json:"country,omitempty"`
+	IID     string  `json:"iid,omitempty"`
+	IRF     string  `json:"irf,omitempty"`
+	City    string  `json:"city,omitempty"`
+	Street  string  `json:"street,omitempty"`
+	Name    string  `json:"name,omitempty"`
 }
 
 type Representative struct {
-	Country Country
-	IID     string
-	City    string
-	Street  string
-	Name    string
+	Country Country `json:"country,omitempty"`
+	IID     string  `json:"iid,omitempty"`
+	City    string  `json:"city,omitempty"`
+	Street  string  `json:"street,omitempty"`
+	Name    string  `json:"name,omitempty"`
 }
 
 type ClassificationSystem string
@@ -89,27 +157,40 @@ const IPC ClassificationSystem = "IPC"
 const CPC ClassificationSystem = "CPC"
 
 type ClassificationItem struct {
-	Text                   string
-	System                 ClassificationSystem
-	Sequence               int
-	Section                string
-	Class                  string
-	SubClass               string
-	MainGroup              string
-	SubGroup               string
-	Version                string
-	ClassificationLevel    string
-	FirstLater             string
-	ClassificationValue    string // (inventive or non-inventive)
-	ActionDate             string // (inventive or non-inventive)
-	OriginalOrReclassified string
-	Source                 string
-	GeneratingOffice       string
+	Text                   string               `json:"text,omitempty"`
+	System                 ClassificationSystem `json:"system,omitempty"`
+	Sequence               int                  `json:"sequence,omitempty"`
+	Section                string               `json:"section,omitempty"`
+	Class                  string               `json:"class,omitempty"`
+	SubClass               string               `json:"subClass,omitempty"`
+	MainGroup              string               `json:"mainGroup,omitempty"`
+	SubGroup               string               `json:"subGroup,omitempty"`
+	Version                string               `json:"version,omitempty"`
+	ClassificationLevel    string               `json:"classificationLevel,omitempty"`
+	FirstLater             string               `json:"firstLater,omitempty"`
+	ClassificationValue    string               `json:"classificationValue,omitempty"` // (inventive or non-inventive)
+	ActionDate             string               `json:"actionDate,omitempty"`          // (inventive or non-inventive)
+	OriginalOrReclassified string               `json:"originalOrReclassified,omitempty"`
+	Source                 string               `json:"source,omitempty"`
+	GeneratingOffice       string               `json:"generatingOffice,omitempty"`
 }
 
+// Symbol returns the symbol of the classification, e.g. B65D 51/18, or the text if it can not be parsed
+func (c ClassificationItem) Symbol() string {
+	if c.Section == "" || c.MainGroup == "" {
+		return strings.Join(strings.Fields(c.Text), " ")
+	}
+	return c.Section + c.Class + c.SubClass + " " + c.MainGroup + "/" + c.SubGroup
+}
+
+// ErrClassificationPattern is returned if the text of a classification does not match the pattern
+var ErrClassificationPattern = errors.New("can not find classification pattern")
+
 var reClassification = regexp.MustCompile(`([ABCDEFGH])([0-9]{1,2})([A-Z]) *([0-9]{1,4})\/([0-9]{1,6}) *([0-9]{8})([CAS])([FL])([IN])([0-9]{8})([BRVD])([HMG])([A-Z]{2}) *`)
 
-func NewIpcrClassificationItemFromString(text string, sequence int) (c ClassificationItem) {
+// NewIpcrClassificationItemFromString parses a classification of the IPC reform (IPC8).
+// A text that does not match results in ErrClassificationPattern and an item with the text only.
+func NewIpcrClassificationItemFromString(text string, sequence int) (c ClassificationItem, err error) {
 	c = ClassificationItem{
 		System:   IPC,
 		Text:     text,
@@ -136,12 +217,8 @@ func NewIpcrClassificationItemFromString(text string, sequence int) (c Classific
 		43-50 For future use 8 blanks
 	*/
 	regexRes := reClassification.FindAllStringSubmatch(text, -1)
-	if len(regexRes) == 0 {
-		log.WithField("text", text).Warn("can not find IPC8 pattern")
-		return
-	}
-	if len(regexRes[0]) != 14 {
-		log.WithField("text", text).Warn("can not find IPC8 pattern")
+	if len(regexRes) == 0 || len(regexRes[0]) != 14 {
+		err = ErrClassificationPattern
 		return
 	}
 	// 1 Section A-H
@@ -174,5 +251,78 @@ func NewIpcrClassificationItemFromString(text string, sequence int) (c Classific
 	c.GeneratingOffice = regexRes[0][13]
 	// 43-50 For future use 8 blanks
 
-	return c
+	return c, nil
+}
+
+var reCpcClassification = regexp.MustCompile(`([A-HY])([0-9]{2})([A-Z]) *([0-9]{1,4})\/([0-9]{1,6}) *([0-9]{8})([CAS ])([FL])([IA])([0-9]{8})([BRVD])([A-Z])([A-Z]{2})`)
+
+// NewCpcClassificationItemFromString parses a classification of the CPC (B520EP).
+// The layout follows the IPC8 classifications, the classification level is blank
+// and the classification value is I (inventive) or A (additional).
+// A text that does not match results in ErrClassificationPattern and an item with the text only.
+func NewCpcClassificationItemFromString(text string, sequence int) (c ClassificationItem, err error) {
+	c = ClassificationItem{
+		System:   CPC,
+		Text:     text,
+		Sequence: sequence,
+	}
+	/*
+		e.g. C07D 271/113       20130101 LI20200617BCEP
+		e.g. Y02A  50/30        20180101 LA20200801RGEP
+	*/
+	regexRes := reCpcClassification.FindStringSubmatch(text)
+	if len(regexRes) != 14 {
+		err = ErrClassificationPattern
+		return
+	}
+	c.Section = regexRes[1]
+	c.Class = regexRes[2]
+	c.SubClass = regexRes[3]
+	c.MainGroup = regexRes[4]
+	c.SubGroup = regexRes[5]
+	c.Version = regexRes[6]
+	c.ClassificationLevel = strings.TrimSpace(regexRes[7])
+	c.FirstLater = regexRes[8]
+	c.ClassificationValue = regexRes[9]
+	c.ActionDate = regexRes[10]
+	c.OriginalOrReclassified = regexRes[11]
+	c.Source = regexRes[12]
+	c.GeneratingOffice = regexRes[13]
+	return c, nil
+}
+
+var reIpc7Classification = regexp.MustCompile(`^\s*([0-9]{1,2})?\s*([ABCDEFGH])\s*([0-9]{2})\s*([A-Z])\s*([0-9]{1,4})\s*\/\s*([0-9]{1,6})`)
+
+// NewIpc7ClassificationItemFromString parses a classification of the 7th or an earlier edition of the IPC.
+// The position is F for the main classification (B511) and L for the further classifications.
+// A text that does not match results in ErrClassificationPattern and an item with the text only.
+func NewIpc7ClassificationItemFromString(text string, sequence int, firstLater string) (c ClassificationItem, err error) {
+	c = ClassificationItem{
+		System:     IPC,
+		Text:       text,
+		Sequence:   sequence,
+		FirstLater: firstLater,
+	}
+	/*
+		e.g. 7C 07C  29/44   A
+		1 Edition of the IPC, e.g. 7 (optional)
+		2 Section A-H
+		3 to 4 Class 01-99
+		5 Subclass A-Z
+		6 Main Group 1-9999
+		7 Separating character / ("Slash")
+		8 Subgroup 00-999999
+	*/
+	regexRes := reIpc7Classification.FindStringSubmatch(text)
+	if len(regexRes) != 7 {
+		err = ErrClassificationPattern
+		return
+	}
+	c.Version = regexRes[1]
+	c.Section = regexRes[2]
+	c.Class = regexRes[3]
+	c.SubClass = regexRes[4]
+	c.MainGroup = regexRes[5]
+	c.SubGroup = regexRes[6]
+	return c, nil
 }
@@ -0,0 +1,84 @@
+package eps
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// ClaimItem is a single claim (claim) of a set of claims
+type ClaimItem struct {
+	Id   string `json:"id,omitempty"`
+	Num  string `json:"num,omitempty"` // e.g. 0001, empty if the claims are numbered incorrectly by the applicant
+	Text string `json:"text,omitempty"`
+}
+
+// Number returns the number of the claim or 0 if it is not numbered
+func (c ClaimItem) Number() int {
+	n, err := strconv.Atoi(strings.TrimSpace(c.Num))
+	if err != nil {
+		return 0
+	}
+	return n
+}
+
+var (
+	// claimReferencePattern matches the references to other claims in english, german and french,
+	// e.g. claim 1, claims 1 to 3, Ansprüche 1 bis 3 or revendications 1 à 3
+	claimReferencePattern = regexp.MustCompile(`(?i)\b(?:claims?|anspr(?:uch|üche|ueche)|revendications?)\s+(\d+(?:\s*(?:,|-|–|to|or|and|bis|oder|und|à|ou|et)\s*\d+)*)`)
+	// claimRangePattern matches a number or a range of numbers of a reference
+	claimRangePattern = regexp.MustCompile(`(\d+)(?:\s*(?:-|–|to|bis|à)\s*(\d+))?`)
+)
+
+// References returns the numbers of the claims the claim refers to, e.g. 1, 2 and 3 for "according to any one of claims 1 to 3".
+// The references are found in the text, because the claims are rarely marked up with claim-ref.
+func (c ClaimItem) References() (res []int) {
+	seen := map[int]bool{}
+	for _, m := range claimReferencePattern.FindAllStringSubmatch(c.Text, -1) {
+		for _, r := range claimRangePattern.FindAllStringSubmatch(m[1], -1) {
+			from, _ := strconv.Atoi(r[1])
+			to := from
+			if r[2] != "" {
+				to, _ = strconv.Atoi(r[2])
+			}
+			// ranges are limited to avoid huge lists for wrong numbers
+			for n := from; n <= to && n-from < 1000; n++ {
+				if !seen[n] && n != c.Number() {
+					seen[n] = true
+					res = append(res, n)
+				}
+			}
+		}
+	}
+	return
+}
+
+// AmendedClaims is a set of amended claims (amended-claims), e.g. amended under Art. 19.1 PCT
+type AmendedClaims struct {
+	Id         string            `json:"id,omitempty"`
+	Language   string            `json:"language,omitempty"`
+	Type       string            `json:"type,omitempty"` // amend-claim-type, PCT or EPC
+	Status     string            `json:"status,omitempty"`
+	Heading    string            `json:"heading,omitempty"` // e.g. Amended claims under Art. 19.1 PCT
+	Text       string            `json:"text,omitempty"`    // text of the claims without the heading and the statements
+	Items      []ClaimItem       `json:"items,omitempty"`
+	Statements []ClaimsStatement `json:"statements,omitempty"` // statements within the amended claims
+}
+
+// ClaimsStatement is a statement on the amendment of the claims (amended-claims-statement),
+// e.g. Statement under Art. 19.1 PCT
+type ClaimsStatement struct {
+	Id       string `json:"id,omitempty"`
+	Language string `json:"language,omitempty"`
+	Heading  string `json:"heading,omitempty"`
+	Text     string `json:"text,omitempty"` // text of the statements without the headings
+}
+
+// AmendedClaimsIn returns the amended claims in the language or in a fallback language
+func (p *EpPatentDocumentSimple) AmendedClaimsIn(lang string) (a AmendedClaims, ok bool) {
+	i := selectLanguage(p.languages(lang), len(p.AmendedClaims), func(i int) string { return p.AmendedClaims[i].Language })
+	if i < 0 {
+		return
+	}
+	return p.AmendedClaims[i], true
+}
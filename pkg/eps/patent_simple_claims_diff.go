@@ -0,0 +1,317 @@
+package eps
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	log "github.com/sirupsen/logrus"
+	"html"
+	"sort"
+	"strings"
+)
+
+// ClaimChange is the change of a claim between two publications
+type ClaimChange string
+
+const (
+	ClaimUnchanged ClaimChange = "unchanged"
+	ClaimModified  ClaimChange = "modified"
+	ClaimAdded     ClaimChange = "added"
+	ClaimRemoved   ClaimChange = "removed"
+)
+
+// ErrClaimsLanguage is returned if the claims of the publications are not available in the same language
+var ErrClaimsLanguage = errors.New("claims are not available in the same language")
+
+// WordOperation is the operation of a part of a word-level diff
+type WordOperation string
+
+const (
+	WordEqual  WordOperation = "equal"
+	WordInsert WordOperation = "insert"
+	WordDelete WordOperation = "delete"
+)
+
+const (
+	// minClaimSimilarity is the similarity from which claims with different numbers are aligned
+	minClaimSimilarity = 0.6
+	// sameNumberBonus prefers claims with the same number, if they are about as similar as others
+	sameNumberBonus = 0.1
+	// maxDiffCells limits the size of the lcs table of a word-level diff,
+	// larger claims are diffed as a whole
+	maxDiffCells = 1 << 20
+)
+
+// WordDiff is a part of the word-level diff of a claim
+type WordDiff struct {
+	Operation WordOperation `json:"operation"`
+	Text      string        `json:"text"`
+}
+
+// ClaimDiff is the difference of a claim between two publications
+type ClaimDiff struct {
+	Change     ClaimChange `json:"change"`
+	Before     *ClaimItem  `json:"before,omitempty"` // not set for added claims
+	After      *ClaimItem  `json:"after,omitempty"`  // not set for removed claims
+	Similarity float64     `json:"similarity"`       // similarity of the words between 0 and 1
+	Words      []WordDiff  `json:"words,omitempty"`  // only set for modified claims
+}
+
+// ClaimsDiff is the difference between the claims of two publications, e.g. an A1 and a B1 document
+type ClaimsDiff struct {
+	BeforeID string      `json:"beforeId"`
+	AfterID  string      `json:"afterId"`
+	Language string      `json:"language"`
+	Claims   []ClaimDiff `json:"claims"`
+}
+
+// DiffClaims compares the claims of two publications of the same application in the language.
+// The claims are aligned by their number and the similarity of their words,
+// e.g. claim 2 of the application is aligned with claim 1 of the patent,
+// if it has been merged into claim 1 during the prosecution.
+// If the claims of the publications fall back to different languages, ErrClaimsLanguage is returned.
+func DiffClaims(before, after *EpPatentDocumentSimple, lang string) (d ClaimsDiff, err error) {
+	d.BeforeID = before.ID
+	d.AfterID = after.ID
+	beforeSets := before.ClaimsIn(lang)
+	afterSets := after.ClaimsIn(lang)
+	switch {
+	case len(beforeSets) > 0 && len(afterSets) > 0 && beforeSets[0].Language != afterSets[0].Language:
+		err = ErrClaimsLanguage
+		log.WithError(err).
+			WithField("before", before.ID).WithField("beforeLanguage", beforeSets[0].Language).
+			WithField("after", after.ID).WithField("afterLanguage", afterSets[0].Language).
+			Error("can not compare claims")
+		return
+	case len(afterSets) > 0:
+		d.Language = afterSets[0].Language
+	case len(beforeSets) > 0:
+		d.Language = beforeSets[0].Language
+	}
+	oldClaims := claimSetItems(beforeSets)
+	newClaims := claimSetItems(afterSets)
+
+	oldWords := make([][]string, len(oldClaims))
+	for i, c := range oldClaims {
+		oldWords[i] = strings.Fields(c.Text)
+	}
+	newWords := make([][]string, len(newClaims))
+	for i, c := range newClaims {
+		newWords[i] = strings.Fields(c.Text)
+	}
+
+	// candidate pairs ordered by similarity, claims with the same number are preferred
+	type pair struct {
+		old, new   int
+		similarity float64
+		score      float64
+	}
+	var pairs []pair
+	for i := range oldClaims {
+		for j := range newClaims {
+			similarity := wordSimilarity(oldWords[i], newWords[j])
+			sameNumber := oldClaims[i].Number() != 0 && oldClaims[i].Number() == newClaims[j].Number()
+			if similarity < minClaimSimilarity && !(sameNumber && similarity > 0) {
+				continue
+			}
+			score := similarity
+			if sameNumber {
+				score += sameNumberBonus
+			}
+			pairs = append(pairs, pair{old: i, new: j, similarity: similarity, score: score})
+		}
+	}
+	sort.SliceStable(pairs, func(a, b int) bool {
+		return pairs[a].score > pairs[b].score
+	})
+	oldMatch := make([]int, len(oldClaims))
+	newMatch := make([]int, len(newClaims))
+	similarities := make([]float64, len(newClaims))
+	for i := range oldMatch {
+		oldMatch[i] = -1
+	}
+	for j := range newMatch {
+		newMatch[j] = -1
+	}
+	for _, p := range pairs {
+		if oldMatch[p.old] >= 0 || newMatch[p.new] >= 0 {
+			continue
+		}
+		oldMatch[p.old] = p.new
+		newMatch[p.new] = p.old
+		similarities[p.new] = p.similarity
+	}
+
+	// the claims are listed in the order of the new publication,
+	// removed claims are inserted before the next aligned claim of the old publication
+	removed := func(until int) {
+		for i := 0; i < until; i++ {
+			if oldMatch[i] == -1 {
+				oldMatch[i] = -2
+				d.Claims = append(d.Claims, ClaimDiff{Change: ClaimRemoved, Before: &oldClaims[i]})
+			}
+		}
+	}
+	for j := range newClaims {
+		i := newMatch[j]
+		if i < 0 {
+			d.Claims = append(d.Claims, ClaimDiff{Change: ClaimAdded, After: &newClaims[j]})
+			continue
+		}
+		removed(i)
+		c := ClaimDiff{Change: ClaimUnchanged, Before: &oldClaims[i], After: &newClaims[j], Similarity: similarities[j]}
+		if strings.Join(oldWords[i], " ") != strings.Join(newWords[j], " ") {
+			c.Change = ClaimModified
+			c.Words = diffWords(oldWords[i], newWords[j])
+		}
+		d.Claims = append(d.Claims, c)
+	}
+	removed(len(oldClaims))
+	return
+}
+
+// claimSetItems returns the single claims of the claim sets
+func claimSetItems(claims []Claim) (res []ClaimItem) {
+	for _, c := range claims {
+		res = append(res, c.Items...)
+	}
+	return
+}
+
+// Count returns the number of claims with the change
+func (d ClaimsDiff) Count(change ClaimChange) (n int) {
+	for _, c := range d.Claims {
+		if c.Change == change {
+			n++
+		}
+	}
+	return
+}
+
+// JSON returns the diff as json
+func (d ClaimsDiff) JSON() ([]byte, error) {
+	return json.MarshalIndent(d, "", "  ")
+}
+
+// HTML returns the diff as html fragment.
+// Deleted words are marked with <del>, inserted words with <ins>.
+func (d ClaimsDiff) HTML() string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "<div class=\"claims-diff\" data-before=\"%s\" data-after=\"%s\">\n", html.EscapeString(d.BeforeID), html.EscapeString(d.AfterID))
+	for _, c := range d.Claims {
+		fmt.Fprintf(&sb, "<div class=\"claim claim-%s\">\n<h3>%s</h3>\n<p>", c.Change, html.EscapeString(c.title()))
+		switch c.Change {
+		case ClaimAdded:
+			fmt.Fprintf(&sb, "<ins>%s</ins>", html.EscapeString(c.After.Text))
+		case ClaimRemoved:
+			fmt.Fprintf(&sb, "<del>%s</del>", html.EscapeString(c.Before.Text))
+		case ClaimUnchanged:
+			sb.WriteString(html.EscapeString(c.After.Text))
+		case ClaimModified:
+			for i, w := range c.Words {
+				if i > 0 {
+					sb.WriteString(" ")
+				}
+				text := html.EscapeString(w.Text)
+				switch w.Operation {
+				case WordInsert:
+					fmt.Fprintf(&sb, "<ins>%s</ins>", text)
+				case WordDelete:
+					fmt.Fprintf(&sb, "<del>%s</del>", text)
+				default:
+					sb.WriteString(text)
+				}
+			}
+		}
+		sb.WriteString("</p>\n</div>\n")
+	}
+	sb.WriteString("</div>\n")
+	return sb.String()
+}
+
+// title returns the heading of the claim in the html diff, e.g. Claim 2 → 1 (modified)
+func (c ClaimDiff) title() string {
+	switch {
+	case c.Before == nil:
+		return fmt.Sprintf("Claim %d (%s)", c.After.Number(), c.Change)
+	case c.After == nil:
+		return fmt.Sprintf("Claim %d (%s)", c.Before.Number(), c.Change)
+	case c.Before.Number() != c.After.Number():
+		return fmt.Sprintf("Claim %d → %d (%s)", c.Before.Number(), c.After.Number(), c.Change)
+	}
+	return fmt.Sprintf("Claim %d (%s)", c.After.Number(), c.Change)
+}
+
+// wordSimilarity returns the dice coefficient of the words
+func wordSimilarity(a, b []string) float64 {
+	if len(a)+len(b) == 0 {
+		return 1
+	}
+	counts := map[string]int{}
+	for _, w := range a {
+		counts[strings.ToLower(w)]++
+	}
+	common := 0
+	for _, w := range b {
+		w = strings.ToLower(w)
+		if counts[w] > 0 {
+			counts[w]--
+			common++
+		}
+	}
+	return 2 * float64(common) / float64(len(a)+len(b))
+}
+
+// diffWords returns the word-level diff based on the longest common subsequence.
+// If the lcs table would exceed maxDiffCells, the claim is replaced as a whole.
+func diffWords(a, b []string) (res []WordDiff) {
+	if (len(a)+1)*(len(b)+1) > maxDiffCells {
+		return []WordDiff{
+			{Operation: WordDelete, Text: strings.Join(a, " ")},
+			{Operation: WordInsert, Text: strings.Join(b, " ")},
+		}
+	}
+	// lcs[i][j] is the length of the longest common subsequence of a[i:] and b[j:]
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+	add := func(op WordOperation, word string) {
+		if n := len(res); n > 0 && res[n-1].Operation == op {
+			res[n-1].Text += " " + word
+			return
+		}
+		res = append(res, WordDiff{Operation: op, Text: word})
+	}
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		switch {
+		case a[i] == b[j]:
+			add(WordEqual, a[i])
+			i++
+			j++
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			add(WordDelete, a[i])
+			i++
+		default:
+			add(WordInsert, b[j])
+			j++
+		}
+	}
+	for ; i < len(a); i++ {
+		add(WordDelete, a[i])
+	}
+	for ; j < len(b); j++ {
+		add(WordInsert, b[j])
+	}
+	return
+}
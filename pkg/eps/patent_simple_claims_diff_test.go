@@ -0,0 +1,116 @@
+package eps
+
+import (
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"os"
+	"strings"
+	"testing"
+)
+
+// claimsDoc creates a document with english claims
+func claimsDoc(id string, texts ...string) *EpPatentDocumentSimple {
+	claims := Claim{Language: "en"}
+	for i, text := range texts {
+		claims.Items = append(claims.Items, ClaimItem{Num: "000" + string(rune('1'+i)), Text: text})
+	}
+	return &EpPatentDocumentSimple{ID: id, Lang: "en", Claims: []Claim{claims}}
+}
+
+func TestDiffClaims(t *testing.T) {
+	ass := assert.New(t)
+	application := claimsDoc("EP1A1",
+		"A device comprising a housing.",
+		"The device of claim 1, wherein the housing is made of metal.",
+		"The device of claim 1, further comprising a lid.",
+		"A method of using the device.",
+	)
+	// claim 2 has been merged into claim 1, claim 4 has been removed
+	patent := claimsDoc("EP1B1",
+		"A device comprising a housing made of metal.",
+		"The device of claim 1, further comprising a lid.",
+		"The device of claim 1, further comprising a handle made of wood.",
+	)
+	d, err := DiffClaims(application, patent, "en")
+	ass.NoError(err)
+	ass.Equal("EP1A1", d.BeforeID)
+	ass.Equal("EP1B1", d.AfterID)
+	ass.Equal("en", d.Language)
+
+	var changes []ClaimChange
+	for _, c := range d.Claims {
+		changes = append(changes, c.Change)
+	}
+	ass.Equal([]ClaimChange{ClaimModified, ClaimRemoved, ClaimUnchanged, ClaimAdded, ClaimRemoved}, changes)
+	ass.Equal(1, d.Claims[0].Before.Number())
+	ass.Equal(2, d.Claims[1].Before.Number())
+	// the unchanged claim is aligned by its words
+	ass.Equal(3, d.Claims[2].Before.Number())
+	ass.Equal(2, d.Claims[2].After.Number())
+	ass.Equal(1.0, d.Claims[2].Similarity)
+	ass.Equal(3, d.Claims[3].After.Number())
+	ass.Equal(4, d.Claims[4].Before.Number())
+	ass.Equal(2, d.Count(ClaimRemoved))
+
+	ass.Equal([]WordDiff{
+		{Operation: WordEqual, Text: "A device comprising a"},
+		{Operation: WordDelete, Text: "housing."},
+		{Operation: WordInsert, Text: "housing made of metal."},
+	}, d.Claims[0].Words)
+
+	data, err := d.JSON()
+	ass.NoError(err)
+	var decoded ClaimsDiff
+	ass.NoError(json.Unmarshal(data, &decoded))
+	ass.Equal(d, decoded)
+
+	out := d.HTML()
+	ass.Contains(out, `<div class="claim claim-modified">`)
+	ass.Contains(out, "<h3>Claim 3 → 2 (unchanged)</h3>")
+	ass.Contains(out, "<del>housing.</del> <ins>housing made of metal.</ins>")
+	ass.Contains(out, "<del>A method of using the device.</del>")
+}
+
+func TestDiffClaimsTestData(t *testing.T) {
+	ass := assert.New(t)
+	data, err := os.ReadFile("test-data/grant/v1-5-B1.xml")
+	ass.NoError(err)
+	doc, err := ProcessXMLSimple(data)
+	ass.NoError(err)
+	d, err := DiffClaims(&doc, &doc, "de")
+	ass.NoError(err)
+	ass.Equal("de", d.Language)
+	ass.Len(d.Claims, 12)
+	ass.Equal(12, d.Count(ClaimUnchanged))
+}
+
+func TestDiffClaimsLanguage(t *testing.T) {
+	ass := assert.New(t)
+	application := claimsDoc("EP1A1", "A device comprising a housing.")
+	patent := claimsDoc("EP1B1", "Vorrichtung mit einem Gehäuse.")
+	patent.Claims[0].Language = "de"
+	patent.Lang = "de"
+	// the requested language is not available in both publications
+	_, err := DiffClaims(application, patent, "fr")
+	ass.ErrorIs(err, ErrClaimsLanguage)
+
+	// no claims on one side
+	d, err := DiffClaims(&EpPatentDocumentSimple{ID: "EP1A1"}, patent, "fr")
+	ass.NoError(err)
+	ass.Equal("de", d.Language)
+	ass.Equal(1, d.Count(ClaimAdded))
+}
+
+func TestDiffWordsLarge(t *testing.T) {
+	ass := assert.New(t)
+	// the lcs table of large claims exceeds maxDiffCells, they are replaced as a whole
+	a := strings.Fields(strings.Repeat("a device ", 1024))
+	b := strings.Fields(strings.Repeat("a method ", 1024))
+	ass.Equal([]WordDiff{
+		{Operation: WordDelete, Text: strings.Join(a, " ")},
+		{Operation: WordInsert, Text: strings.Join(b, " ")},
+	}, diffWords(a, b))
+
+	// small claims are diffed word by word
+	ass.Len(diffWords(a[:4], b[:4]), 6)
+}
@@ -0,0 +1,59 @@
+package eps
+
+import "time"
+
+// Chemistry is a chemical formula or structure (chemistry) of the texts
+type Chemistry struct {
+	ID          string `json:"id,omitempty"` // e.g. chem0001 or chema01 in the abstract
+	Num         string `json:"num,omitempty"`
+	Field       string `json:"field,omitempty"` // field of the text that contains the formula, e.g. Description
+	Language    string `json:"language,omitempty"`
+	Image       string `json:"image,omitempty"`       // file of the image, e.g. imgb0001.tif
+	ChemFile    string `json:"chemFile,omitempty"`    // file of the chemical markup (chem), e.g. a mol file
+	ChemType    string `json:"chemType,omitempty"`    // type of the chemical markup, e.g. mol
+	FormulaText string `json:"formulaText,omitempty"` // simple formulae as text (formula-text), e.g. H2SO4
+}
+
+// BioDeposit is a deposit of biological material, e.g. a strain deposited at the DSMZ
+type BioDeposit struct {
+	ID              string    `json:"id,omitempty"`
+	Num             string    `json:"num,omitempty"`
+	Field           string    `json:"field,omitempty"` // field of the text that contains the deposit, e.g. Description or B830 in the bibliographic data
+	Language        string    `json:"language,omitempty"`
+	URL             string    `json:"url,omitempty"`
+	DNum            string    `json:"dNum,omitempty"`
+	Depositary      string    `json:"depositary,omitempty"`      // e.g. Deutsche Sammlung von Mikroorganismen und Zellkulturen
+	AccessionNumber string    `json:"accessionNumber,omitempty"` // e.g. DSM 12345
+	Date            time.Time `json:"date,omitempty"`
+	Term            string    `json:"term,omitempty"` // period of time during which samples can be furnished
+	Text            string    `json:"text,omitempty"` // descriptive text (dtext)
+}
+
+// SequenceListText is the free text of a sequence listing (sequence-list-text)
+type SequenceListText struct {
+	ID       string `json:"id,omitempty"`
+	Field    string `json:"field,omitempty"`
+	Language string `json:"language,omitempty"`
+	Heading  string `json:"heading,omitempty"`
+	Text     string `json:"text,omitempty"`
+}
+
+// HasBioDeposits checks if the document refers to deposited biological material
+func (p *EpPatentDocumentSimple) HasBioDeposits() bool {
+	return len(p.BioDeposits) > 0
+}
+
+// HasSequenceListing checks if the document contains the text of a sequence listing
+func (p *EpPatentDocumentSimple) HasSequenceListing() bool {
+	return len(p.SequenceListTexts) > 0
+}
+
+// AccessionNumbers returns the accession numbers of the deposited biological material
+func (p *EpPatentDocumentSimple) AccessionNumbers() (res []string) {
+	for _, d := range p.BioDeposits {
+		if d.AccessionNumber != "" {
+			res = append(res, d.AccessionNumber)
+		}
+	}
+	return
+}
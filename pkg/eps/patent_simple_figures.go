@@ -0,0 +1,56 @@
+package eps
+
+import "strings"
+
+// Figure is a drawing (figure) of the document
+type Figure struct {
+	ID         string            `json:"id,omitempty"`       // e.g. f0001
+	Num        string            `json:"num,omitempty"`      // e.g. 1 or 5A,5B
+	Labels     string            `json:"labels,omitempty"`   // figure-labels
+	Language   string            `json:"language,omitempty"` // language of the drawings
+	Image      Image             `json:"image"`
+	References []FigureReference `json:"references,omitempty"` // references (figref) to the figure in the texts
+}
+
+// Image is an image (img) of the document, e.g. imgf0001.tif in the zip file of the publication
+type Image struct {
+	ID          string  `json:"id,omitempty"`
+	File        string  `json:"file,omitempty"`        // e.g. imgf0001.tif
+	Format      string  `json:"format,omitempty"`      // img-format, e.g. tif
+	Content     string  `json:"content,omitempty"`     // img-content, e.g. drawing
+	Width       float64 `json:"width,omitempty"`       // wi in mm
+	Height      float64 `json:"height,omitempty"`      // he in mm
+	Orientation string  `json:"orientation,omitempty"` // portrait or landscape
+}
+
+// FigureReference is a reference (figref) to a figure in the texts
+type FigureReference struct {
+	Field        string `json:"field,omitempty"` // e.g. Description
+	Language     string `json:"language,omitempty"`
+	ParagraphID  string `json:"paragraphId,omitempty"`  // id of the paragraph or the claim, e.g. p0007 or c-en-0001
+	ParagraphNum string `json:"paragraphNum,omitempty"` // e.g. 0007
+	Text         string `json:"text,omitempty"`         // e.g. Figure 1
+}
+
+// Paragraphs returns the ids of the paragraphs that refer to the figure
+func (f Figure) Paragraphs() (res []string) {
+	seen := map[string]bool{}
+	for _, r := range f.References {
+		if r.ParagraphID == "" || seen[r.ParagraphID] {
+			continue
+		}
+		seen[r.ParagraphID] = true
+		res = append(res, r.ParagraphID)
+	}
+	return
+}
+
+// FigureByFile returns the figure of the image file, e.g. imgf0001.tif, the case is ignored
+func (p *EpPatentDocumentSimple) FigureByFile(file string) (Figure, bool) {
+	for _, f := range p.Figures {
+		if strings.EqualFold(f.Image.File, file) {
+			return f, true
+		}
+	}
+	return Figure{}, false
+}
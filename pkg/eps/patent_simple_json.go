@@ -0,0 +1,244 @@
+package eps
+
+import (
+	"bufio"
+	_ "embed"
+	"encoding/json"
+	"errors"
+	log "github.com/sirupsen/logrus"
+	"io"
+	"strings"
+	"time"
+)
+
+// JSONSchemaVersion is the version of the json representation of EpPatentDocumentSimple.
+// The major version changes if fields are removed or changed, the minor version if fields are added.
+const JSONSchemaVersion = "1.0.0"
+
+// ErrSchemaVersion is returned if a json document has another major schema version
+var ErrSchemaVersion = errors.New("unsupported schema version")
+
+// layoutJSONDate is the format of the dates of the json representation
+const layoutJSONDate = "2006-01-02"
+
+//go:embed schema/ep-patent-document-simple.schema.json
+var jsonSchema []byte
+
+// JSONSchema returns the JSON Schema of the json representation of EpPatentDocumentSimple
+func JSONSchema() []byte {
+	return append([]byte(nil), jsonSchema...)
+}
+
+// formatJSONDate formats the date, the zero time results in an empty string
+func formatJSONDate(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.Format(layoutJSONDate)
+}
+
+// parseJSONDate parses the date, an empty string results in the zero time
+func parseJSONDate(s string) (time.Time, error) {
+	if s == "" {
+		return time.Time{}, nil
+	}
+	return time.Parse(layoutJSONDate, s)
+}
+
+// MarshalJSON adds the schema version and writes the date of publication as 2006-01-02
+func (p EpPatentDocumentSimple) MarshalJSON() ([]byte, error) {
+	type alias EpPatentDocumentSimple
+	return json.Marshal(struct {
+		SchemaVersion string `json:"schemaVersion"`
+		alias
+		DatePubl string `json:"datePubl,omitempty"`
+	}{JSONSchemaVersion, alias(p), formatJSONDate(p.DatePubl)})
+}
+
+// UnmarshalJSON reads documents of the same major schema version
+func (p *EpPatentDocumentSimple) UnmarshalJSON(data []byte) (err error) {
+	type alias EpPatentDocumentSimple
+	aux := struct {
+		*alias
+		SchemaVersion string `json:"schemaVersion"`
+		DatePubl      string `json:"datePubl"`
+	}{alias: (*alias)(p)}
+	err = json.Unmarshal(data, &aux)
+	if err != nil {
+		return
+	}
+	if aux.SchemaVersion != "" && majorVersion(aux.SchemaVersion) != majorVersion(JSONSchemaVersion) {
+		return ErrSchemaVersion
+	}
+	p.DatePubl, err = parseJSONDate(aux.DatePubl)
+	return
+}
+
+// majorVersion returns the major version, e.g. 1 for 1.0.0
+func majorVersion(version string) string {
+	major, _, _ := strings.Cut(version, ".")
+	return major
+}
+
+// MarshalJSON writes the dates as 2006-01-02
+func (a PriorArt) MarshalJSON() ([]byte, error) {
+	type alias PriorArt
+	return json.Marshal(struct {
+		alias
+		SearchReportDate              string `json:"searchReportDate,omitempty"`
+		SupplementarySearchReportDate string `json:"supplementarySearchReportDate,omitempty"`
+	}{alias(a), formatJSONDate(a.SearchReportDate), formatJSONDate(a.SupplementarySearchReportDate)})
+}
+
+// UnmarshalJSON reads the dates as 2006-01-02
+func (a *PriorArt) UnmarshalJSON(data []byte) (err error) {
+	type alias PriorArt
+	aux := struct {
+		*alias
+		SearchReportDate              string `json:"searchReportDate"`
+		SupplementarySearchReportDate string `json:"supplementarySearchReportDate"`
+	}{alias: (*alias)(a)}
+	if err = json.Unmarshal(data, &aux); err != nil {
+		return
+	}
+	if a.SearchReportDate, err = parseJSONDate(aux.SearchReportDate); err != nil {
+		return
+	}
+	a.SupplementarySearchReportDate, err = parseJSONDate(aux.SupplementarySearchReportDate)
+	return
+}
+
+// MarshalJSON writes the date as 2006-01-02
+func (b BioDeposit) MarshalJSON() ([]byte, error) {
+	type alias BioDeposit
+	return json.Marshal(struct {
+		alias
+		Date string `json:"date,omitempty"`
+	}{alias(b), formatJSONDate(b.Date)})
+}
+
+// UnmarshalJSON reads the date as 2006-01-02
+func (b *BioDeposit) UnmarshalJSON(data []byte) (err error) {
+	type alias BioDeposit
+	aux := struct {
+		*alias
+		Date string `json:"date"`
+	}{alias: (*alias)(b)}
+	if err = json.Unmarshal(data, &aux); err != nil {
+		return
+	}
+	b.Date, err = parseJSONDate(aux.Date)
+	return
+}
+
+// MarshalJSON writes the dates as 2006-01-02
+func (r SearchReport) MarshalJSON() ([]byte, error) {
+	type alias SearchReport
+	return json.Marshal(struct {
+		alias
+		DateProduced           string `json:"dateProduced,omitempty"`
+		DateSearchCompleted    string `json:"dateSearchCompleted,omitempty"`
+		DateSearchReportMailed string `json:"dateSearchReportMailed,omitempty"`
+	}{alias(r), formatJSONDate(r.DateProduced), formatJSONDate(r.DateSearchCompleted), formatJSONDate(r.DateSearchReportMailed)})
+}
+
+// UnmarshalJSON reads the dates as 2006-01-02
+func (r *SearchReport) UnmarshalJSON(data []byte) (err error) {
+	type alias SearchReport
+	aux := struct {
+		*alias
+		DateProduced           string `json:"dateProduced"`
+		DateSearchCompleted    string `json:"dateSearchCompleted"`
+		DateSearchReportMailed string `json:"dateSearchReportMailed"`
+	}{alias: (*alias)(r)}
+	if err = json.Unmarshal(data, &aux); err != nil {
+		return
+	}
+	if r.DateProduced, err = parseJSONDate(aux.DateProduced); err != nil {
+		return
+	}
+	if r.DateSearchCompleted, err = parseJSONDate(aux.DateSearchCompleted); err != nil {
+		return
+	}
+	r.DateSearchReportMailed, err = parseJSONDate(aux.DateSearchReportMailed)
+	return
+}
+
+// MarshalJSON writes the dates as 2006-01-02
+func (s State) MarshalJSON() ([]byte, error) {
+	type alias State
+	return json.Marshal(struct {
+		alias
+		Date           string `json:"date,omitempty"`
+		WithdrawalDate string `json:"withdrawalDate,omitempty"`
+	}{alias(s), formatJSONDate(s.Date), formatJSONDate(s.WithdrawalDate)})
+}
+
+// UnmarshalJSON reads the dates as 2006-01-02
+func (s *State) UnmarshalJSON(data []byte) (err error) {
+	type alias State
+	aux := struct {
+		*alias
+		Date           string `json:"date"`
+		WithdrawalDate string `json:"withdrawalDate"`
+	}{alias: (*alias)(s)}
+	if err = json.Unmarshal(data, &aux); err != nil {
+		return
+	}
+	if s.Date, err = parseJSONDate(aux.Date); err != nil {
+		return
+	}
+	s.WithdrawalDate, err = parseJSONDate(aux.WithdrawalDate)
+	return
+}
+
+// JSONLWriter writes documents as JSON Lines, one document per line
+type JSONLWriter struct {
+	w   *bufio.Writer
+	enc *json.Encoder
+}
+
+// NewJSONLWriter creates a buffered writer, Flush must be called after the last document
+func NewJSONLWriter(w io.Writer) *JSONLWriter {
+	bw := bufio.NewWriter(w)
+	enc := json.NewEncoder(bw)
+	// the texts contain < and & frequently
+	enc.SetEscapeHTML(false)
+	return &JSONLWriter{w: bw, enc: enc}
+}
+
+// Write writes the document as one line
+func (j *JSONLWriter) Write(doc *EpPatentDocumentSimple) (err error) {
+	err = j.enc.Encode(doc)
+	if err != nil {
+		log.WithError(err).WithField("id", doc.ID).Error("can not write document")
+		return
+	}
+	return
+}
+
+// Flush writes the buffered documents
+func (j *JSONLWriter) Flush() error {
+	return j.w.Flush()
+}
+
+// ReadJSONL reads the documents of JSON Lines and calls the function for each document.
+// Reading stops at the first error of the function.
+func ReadJSONL(r io.Reader, fn func(doc EpPatentDocumentSimple) error) (err error) {
+	dec := json.NewDecoder(r)
+	for {
+		var doc EpPatentDocumentSimple
+		err = dec.Decode(&doc)
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			log.WithError(err).Error("can not read document")
+			return
+		}
+		err = fn(doc)
+		if err != nil {
+			return
+		}
+	}
+}
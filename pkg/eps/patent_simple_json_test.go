@@ -0,0 +1,198 @@
+package eps
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+// jsonSchemaFile is the published JSON Schema, regenerate it with UPDATE_SCHEMA=true go test -run TestJSONSchema
+const jsonSchemaFile = "schema/ep-patent-document-simple.schema.json"
+
+// jsonSchemaGenerator creates the definitions of the structs from their json tags
+type jsonSchemaGenerator struct {
+	defs map[string]any
+}
+
+// schema returns the schema of the type, structs are referenced by their definition
+func (g *jsonSchemaGenerator) schema(t reflect.Type) map[string]any {
+	if t == reflect.TypeOf(time.Time{}) {
+		return map[string]any{"type": "string", "format": "date"}
+	}
+	switch t.Kind() {
+	case reflect.Pointer:
+		return g.schema(t.Elem())
+	case reflect.String:
+		return map[string]any{"type": "string"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return map[string]any{"type": "integer"}
+	case reflect.Float32, reflect.Float64:
+		return map[string]any{"type": "number"}
+	case reflect.Bool:
+		return map[string]any{"type": "boolean"}
+	case reflect.Slice, reflect.Array:
+		return map[string]any{"type": "array", "items": g.schema(t.Elem())}
+	case reflect.Map:
+		return map[string]any{"type": "object", "additionalProperties": g.schema(t.Elem())}
+	case reflect.Struct:
+		if _, ok := g.defs[t.Name()]; !ok {
+			// reserve the name for recursive types
+			g.defs[t.Name()] = nil
+			g.defs[t.Name()] = g.object(t)
+		}
+		return map[string]any{"$ref": "#/$defs/" + t.Name()}
+	}
+	panic("unsupported type " + t.String())
+}
+
+// object returns the schema of the struct with the properties of its json tags
+func (g *jsonSchemaGenerator) object(t reflect.Type) map[string]any {
+	properties := map[string]any{}
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		name, _, _ := strings.Cut(f.Tag.Get("json"), ",")
+		if !f.IsExported() || name == "-" {
+			continue
+		}
+		if f.Anonymous && name == "" {
+			for k, v := range g.object(f.Type)["properties"].(map[string]any) {
+				properties[k] = v
+			}
+			continue
+		}
+		if name == "" {
+			name = f.Name
+		}
+		properties[name] = g.schema(f.Type)
+	}
+	return map[string]any{"type": "object", "properties": properties}
+}
+
+// generateJSONSchema creates the JSON Schema of EpPatentDocumentSimple
+func generateJSONSchema() ([]byte, error) {
+	g := &jsonSchemaGenerator{defs: map[string]any{}}
+	root := g.object(reflect.TypeOf(EpPatentDocumentSimple{}))
+	root["properties"].(map[string]any)["schemaVersion"] = map[string]any{
+		"type":    "string",
+		"pattern": `^` + majorVersion(JSONSchemaVersion) + `\.`,
+	}
+	root["$schema"] = "https://json-schema.org/draft/2020-12/schema"
+	root["$id"] = "https://github.com/max-planck-innovation-competition/go-epo-eps/pkg/eps/" + jsonSchemaFile
+	root["title"] = "EpPatentDocumentSimple"
+	root["description"] = "Simplified ep-patent-document of the European Publication Server, schema version " + JSONSchemaVersion +
+		". Dates are formatted as 2006-01-02, empty fields are omitted."
+	root["required"] = []string{"id", "schemaVersion"}
+	root["$defs"] = g.defs
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", "  ")
+	err := enc.Encode(root)
+	return buf.Bytes(), err
+}
+
+func TestJSONSchema(t *testing.T) {
+	ass := assert.New(t)
+	generated, err := generateJSONSchema()
+	ass.NoError(err)
+	if os.Getenv("UPDATE_SCHEMA") == "true" {
+		ass.NoError(os.WriteFile(jsonSchemaFile, generated, 0644))
+		return
+	}
+	ass.Equal(string(generated), string(JSONSchema()), "the schema is outdated, run UPDATE_SCHEMA=true go test -run TestJSONSchema")
+
+	var schema map[string]any
+	ass.NoError(json.Unmarshal(JSONSchema(), &schema))
+	defs := schema["$defs"].(map[string]any)
+	ass.Contains(defs, "ClaimItem")
+	ass.Contains(defs, "TableCell")
+	ass.Equal(map[string]any{"type": "string", "format": "date"}, schema["properties"].(map[string]any)["datePubl"])
+}
+
+func TestMarshalJSON(t *testing.T) {
+	ass := assert.New(t)
+	doc := EpPatentDocumentSimple{
+		ID:       "EP3383757B1",
+		DatePubl: time.Date(2021, 7, 7, 0, 0, 0, 0, time.UTC),
+		Title:    []Title{{Text: "SCREW-TYPE CLOSURE SYSTEMS", Language: "en"}},
+		DesignatedStates: []State{
+			{Country: "DE", Date: time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC)},
+		},
+	}
+	data, err := json.Marshal(doc)
+	ass.NoError(err)
+	ass.Equal(`{"schemaVersion":"1.0.0","id":"EP3383757B1","title":[{"text":"SCREW-TYPE CLOSURE SYSTEMS","language":"en"}],`+
+		`"priorArt":{},"designatedStates":[{"country":"DE","date":"2021-01-02"}],"datePubl":"2021-07-07"}`, string(data))
+
+	var res EpPatentDocumentSimple
+	ass.NoError(json.Unmarshal(data, &res))
+	ass.Equal(doc, res)
+
+	// other major versions are rejected
+	err = json.Unmarshal([]byte(`{"schemaVersion":"2.0.0","id":"EP3383757B1"}`), &res)
+	ass.True(errors.Is(err, ErrSchemaVersion))
+	ass.NoError(json.Unmarshal([]byte(`{"schemaVersion":"1.7.0","id":"EP3383757B1"}`), &res))
+
+	err = json.Unmarshal([]byte(`{"id":"EP3383757B1","datePubl":"07.07.2021"}`), &res)
+	ass.Error(err)
+}
+
+func TestJSONL(t *testing.T) {
+	ass := assert.New(t)
+	files, err := filepath.Glob("test-data/*/*.xml")
+	ass.NoError(err)
+	var docs []EpPatentDocumentSimple
+	var buf bytes.Buffer
+	w := NewJSONLWriter(&buf)
+	for _, file := range files {
+		data, err := os.ReadFile(file)
+		ass.NoError(err)
+		doc, err := ProcessXMLSimple(data)
+		ass.NoError(err)
+		ass.NoError(w.Write(&doc))
+		docs = append(docs, doc)
+	}
+	ass.NoError(w.Flush())
+	ass.Equal(len(files), bytes.Count(buf.Bytes(), []byte("\n")))
+
+	var res []EpPatentDocumentSimple
+	err = ReadJSONL(&buf, func(doc EpPatentDocumentSimple) error {
+		res = append(res, doc)
+		return nil
+	})
+	ass.NoError(err)
+	ass.Len(res, len(docs))
+	for i := range res {
+		// the json data of the documents is the same, empty slices are omitted
+		expected, err := json.Marshal(docs[i])
+		ass.NoError(err)
+		actual, err := json.Marshal(res[i])
+		ass.NoError(err)
+		ass.JSONEq(string(expected), string(actual), files[i])
+		ass.Equal(docs[i].DatePubl, res[i].DatePubl)
+	}
+
+	// the error of the function stops reading
+	stop := errors.New("stop")
+	w = NewJSONLWriter(&buf)
+	ass.NoError(w.Write(&docs[0]))
+	ass.NoError(w.Write(&docs[1]))
+	ass.NoError(w.Flush())
+	count := 0
+	err = ReadJSONL(&buf, func(doc EpPatentDocumentSimple) error {
+		count++
+		return stop
+	})
+	ass.Equal(stop, err)
+	ass.Equal(1, count)
+
+	ass.Error(ReadJSONL(strings.NewReader("{\"id\":\"EP1\"}\n{\"id\":"), func(doc EpPatentDocumentSimple) error { return nil }))
+}
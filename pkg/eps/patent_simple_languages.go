@@ -0,0 +1,98 @@
+package eps
+
+import (
+	"strings"
+)
+
+// fallbackLanguages is the order in which the languages are tried,
+// if the text is not available in the requested language.
+// The language of the document and the first available text are the last fallbacks.
+var fallbackLanguages = []string{"en", "de", "fr"}
+
+// languages returns the requested language followed by the fallback languages
+func (p *EpPatentDocumentSimple) languages(lang string) (res []string) {
+	res = append(res, strings.ToLower(strings.TrimSpace(lang)))
+	res = append(res, fallbackLanguages...)
+	return append(res, p.Lang)
+}
+
+// selectLanguage returns the index of the first language of the order, which is available.
+// If none of them is available, the first text is selected.
+func selectLanguage(order []string, n int, language func(i int) string) int {
+	if n == 0 {
+		return -1
+	}
+	for _, lang := range order {
+		for i := 0; i < n; i++ {
+			if lang != "" && language(i) == lang {
+				return i
+			}
+		}
+	}
+	return 0
+}
+
+// TitleIn returns the title in the language or in a fallback language
+func (p *EpPatentDocumentSimple) TitleIn(lang string) (t Title, ok bool) {
+	i := selectLanguage(p.languages(lang), len(p.Title), func(i int) string { return p.Title[i].Language })
+	if i < 0 {
+		return
+	}
+	return p.Title[i], true
+}
+
+// AbstractIn returns the abstract in the language or in a fallback language
+func (p *EpPatentDocumentSimple) AbstractIn(lang string) (a Abstract, ok bool) {
+	i := selectLanguage(p.languages(lang), len(p.Abstract), func(i int) string { return p.Abstract[i].Language })
+	if i < 0 {
+		return
+	}
+	return p.Abstract[i], true
+}
+
+// DescriptionIn returns the description in the language or in a fallback language
+func (p *EpPatentDocumentSimple) DescriptionIn(lang string) (d Description, ok bool) {
+	i := selectLanguage(p.languages(lang), len(p.Description), func(i int) string { return p.Description[i].Language })
+	if i < 0 {
+		return
+	}
+	return p.Description[i], true
+}
+
+// ClaimsIn returns the claims in the language or in a fallback language
+func (p *EpPatentDocumentSimple) ClaimsIn(lang string) (res []Claim) {
+	i := selectLanguage(p.languages(lang), len(p.Claims), func(i int) string { return p.Claims[i].Language })
+	if i < 0 {
+		return
+	}
+	for _, c := range p.Claims {
+		if c.Language == p.Claims[i].Language {
+			res = append(res, c)
+		}
+	}
+	return
+}
+
+// Languages returns the languages of the titles, abstracts, descriptions and claims
+func (p *EpPatentDocumentSimple) Languages() (res []string) {
+	seen := map[string]bool{}
+	add := func(lang string) {
+		if lang != "" && !seen[lang] {
+			seen[lang] = true
+			res = append(res, lang)
+		}
+	}
+	for _, t := range p.Title {
+		add(t.Language)
+	}
+	for _, a := range p.Abstract {
+		add(a.Language)
+	}
+	for _, d := range p.Description {
+		add(d.Language)
+	}
+	for _, c := range p.Claims {
+		add(c.Language)
+	}
+	return
+}
@@ -0,0 +1,74 @@
+package eps
+
+import (
+	"github.com/stretchr/testify/assert"
+	"os"
+	"testing"
+)
+
+func TestLanguageFallback(t *testing.T) {
+	ass := assert.New(t)
+	doc := EpPatentDocumentSimple{
+		Lang: "fr",
+		Title: []Title{
+			{Language: "de", Text: "Titel"},
+			{Language: "fr", Text: "Titre"},
+		},
+		Abstract: []Abstract{{Language: "it", Text: "Riassunto"}},
+		Claims: []Claim{
+			{Language: "fr", Id: "claims01", Text: "revendication"},
+			{Language: "en", Id: "claims02", Text: "claim"},
+		},
+	}
+	title, ok := doc.TitleIn("fr")
+	ass.True(ok)
+	ass.Equal("Titre", title.Text)
+	// en is not available, de is the next fallback
+	title, _ = doc.TitleIn("es")
+	ass.Equal("Titel", title.Text)
+	// the first abstract is used if no fallback language is available
+	abstract, ok := doc.AbstractIn("en")
+	ass.True(ok)
+	ass.Equal("Riassunto", abstract.Text)
+	_, ok = doc.DescriptionIn("en")
+	ass.False(ok)
+	claims := doc.ClaimsIn("de")
+	ass.Len(claims, 1)
+	ass.Equal("claims02", claims[0].Id)
+	ass.Equal([]string{"de", "fr", "it", "en"}, doc.Languages())
+}
+
+func TestProcessXMLSimpleMultilingual(t *testing.T) {
+	ass := assert.New(t)
+	data := []byte(`<ep-patent-document id="EP1234567A1" lang="de" country="EP" doc-number="1234567" kind="A1" date-publ="20200101" dtd-version="ep-patent-document-v1-5">
+<abstract id="abst" lang="de"><p>Zusammenfassung</p></abstract>
+<abstract id="abst2" lang="en"><p>Abstract</p></abstract>
+<abstract id="abst3" lang="EN"><p>continued</p></abstract>
+<description id="desc" lang="de"><p>Beschreibung</p></description>
+<description id="desc2" lang="en"><p>Description</p></description>
+</ep-patent-document>`)
+	doc, err := ProcessXMLSimple(data)
+	ass.NoError(err)
+	ass.Equal([]Abstract{
+		{Language: "de", Text: "Zusammenfassung"},
+		{Language: "en", Text: "Abstract\ncontinued"},
+	}, doc.Abstract)
+	ass.Len(doc.Description, 2)
+	description, _ := doc.DescriptionIn("en")
+	ass.Equal("Description", description.Text)
+	abstract, _ := doc.AbstractIn("fr")
+	ass.Equal("en", abstract.Language)
+}
+
+func TestLanguageFallbackTestData(t *testing.T) {
+	ass := assert.New(t)
+	data, err := os.ReadFile("test-data/grant/v1-5-B1.xml")
+	ass.NoError(err)
+	doc, err := ProcessXMLSimple(data)
+	ass.NoError(err)
+	title, _ := doc.TitleIn("fr")
+	ass.Equal("fr", title.Language)
+	ass.Equal("de", doc.ClaimsIn("de")[0].Language)
+	_, ok := doc.AbstractIn("en")
+	ass.False(ok)
+}
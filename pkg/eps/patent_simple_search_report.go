@@ -0,0 +1,120 @@
+package eps
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// SearchReport is a simple representation of the search report data (search-report-data)
+type SearchReport struct {
+	ID                     string                 `json:"id,omitempty"`
+	Lang                   string                 `json:"lang,omitempty"`
+	Office                 string                 `json:"office,omitempty"`
+	Type                   string                 `json:"type,omitempty"`
+	DateProduced           time.Time              `json:"dateProduced,omitempty"`
+	Pages                  []string               `json:"pages,omitempty"` // file names of the scanned pages (doc-page)
+	ApplicationNumber      string                 `json:"applicationNumber,omitempty"`
+	ApplicantName          string                 `json:"applicantName,omitempty"`
+	InventionTitle         string                 `json:"inventionTitle,omitempty"`
+	Classifications        []string               `json:"classifications,omitempty"` // classification of the application
+	FieldsSearched         []string               `json:"fieldsSearched,omitempty"`  // technical fields searched (minimum documentation)
+	Citations              []SearchReportCitation `json:"citations,omitempty"`
+	Examiner               string                 `json:"examiner,omitempty"`
+	DateSearchCompleted    time.Time              `json:"dateSearchCompleted,omitempty"`
+	DateSearchReportMailed time.Time              `json:"dateSearchReportMailed,omitempty"`
+	UnityOfInvention       string                 `json:"unityOfInvention,omitempty"`
+}
+
+// SearchReportCitation is a document cited in the search report (citation).
+// Either Patent or Npl is set.
+type SearchReportCitation struct {
+	ID        string              `json:"id,omitempty"`
+	Phase     CitationPhase       `json:"phase,omitempty"`
+	Patent    *Citation           `json:"patent,omitempty"`
+	Npl       *NplCitation        `json:"npl,omitempty"`
+	Relevance []CitationRelevance `json:"relevance,omitempty"`
+}
+
+// CitationRelevance is a category (X, Y, A, ...) and the claims it applies to
+type CitationRelevance struct {
+	Category string   `json:"category,omitempty"`
+	Claims   string   `json:"claims,omitempty"`   // e.g. 1-5,7
+	Passages []string `json:"passages,omitempty"` // relevant passages of the cited document
+}
+
+// ClaimNumbers expands the claims string into the claim numbers
+// e.g. "1-3, 7" results in [1 2 3 7]
+func (r CitationRelevance) ClaimNumbers() (res []int) {
+	return ParseClaimNumbers(r.Claims)
+}
+
+// maxClaimRange is the maximum number of claims of a range, larger ranges are ignored
+const maxClaimRange = 1000
+
+// claimRangeSeparator matches the separator of a range with the spaces around it, e.g. 1 - 3
+var claimRangeSeparator = regexp.MustCompile(`\s*[-–]\s*`)
+
+// ParseClaimNumbers expands a claim range string like "1-3, 7" into the claim numbers.
+// Parts that are not a number or a range and ranges of more than 1000 claims are ignored.
+func ParseClaimNumbers(claims string) (res []int) {
+	claims = claimRangeSeparator.ReplaceAllString(claims, "-")
+	parts := strings.FieldsFunc(claims, func(r rune) bool {
+		return r == ',' || r == ';' || r == ' '
+	})
+	for _, part := range parts {
+		bounds := strings.SplitN(part, "-", 2)
+		from, err := strconv.Atoi(strings.TrimSpace(bounds[0]))
+		if err != nil {
+			continue
+		}
+		to := from
+		if len(bounds) == 2 {
+			to, err = strconv.Atoi(strings.TrimSpace(bounds[1]))
+			if err != nil || to < from || to-from >= maxClaimRange {
+				continue
+			}
+		}
+		for i := from; i <= to; i++ {
+			res = append(res, i)
+		}
+	}
+	return
+}
+
+// CitationsWithCategory returns the citations that have been cited with the given category (e.g. X)
+func (s SearchReport) CitationsWithCategory(category string) (res []SearchReportCitation) {
+	category = strings.ToUpper(strings.TrimSpace(category))
+	for _, c := range s.Citations {
+		for _, r := range c.Relevance {
+			if r.Category == category {
+				res = append(res, c)
+				break
+			}
+		}
+	}
+	return
+}
+
+// CitationsAgainstClaim returns the citations that are relevant for the given claim number
+func (s SearchReport) CitationsAgainstClaim(claim int) (res []SearchReportCitation) {
+	for _, c := range s.Citations {
+		if c.relevantFor(claim) {
+			res = append(res, c)
+		}
+	}
+	return
+}
+
+// relevantFor checks if one of the relevance entries covers the claim
+func (c SearchReportCitation) relevantFor(claim int) bool {
+	for _, r := range c.Relevance {
+		for _, n := range r.ClaimNumbers() {
+			if n == claim {
+				return true
+			}
+		}
+	}
+	return false
+}
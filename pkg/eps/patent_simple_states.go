@@ -0,0 +1,76 @@
+package eps
+
+import (
+	"strings"
+	"time"
+)
+
+// StateKind indicates how a state is covered by the EP document
+type StateKind string
+
+const (
+	// DesignatedState is a designated contracting state (B840)
+	DesignatedState StateKind = "designated"
+	// ExtensionState is a state to which the application / patent is extended (B844EP)
+	ExtensionState StateKind = "extension"
+	// ValidationState is a state in which the application / patent is validated (B848EP)
+	ValidationState StateKind = "validation"
+)
+
+// State is a country covered by the EP document
+type State struct {
+	Country        Country   `json:"country,omitempty"`
+	Kind           StateKind `json:"kind,omitempty"`
+	Date           time.Time `json:"date,omitempty"`           // payment date, not set for designated states
+	WithdrawalDate time.Time `json:"withdrawalDate,omitempty"` // only set for withdrawn extension states (B846EP)
+}
+
+// IsDesignated checks if the country is a designated contracting state
+func (p *EpPatentDocumentSimple) IsDesignated(country Country) bool {
+	return containsState(p.DesignatedStates, country)
+}
+
+// IsExtended checks if the document is extended to the country.
+// Withdrawn extensions are not taken into account.
+func (p *EpPatentDocumentSimple) IsExtended(country Country) bool {
+	for _, s := range p.ExtensionStates {
+		if s.Country == normalizeCountry(country) && s.WithdrawalDate.IsZero() {
+			return true
+		}
+	}
+	return false
+}
+
+// IsValidated checks if the document is validated in the country
+func (p *EpPatentDocumentSimple) IsValidated(country Country) bool {
+	return containsState(p.ValidationStates, country)
+}
+
+// Covers checks if the country is a designated, extension or validation state
+func (p *EpPatentDocumentSimple) Covers(country Country) bool {
+	return p.IsDesignated(country) || p.IsExtended(country) || p.IsValidated(country)
+}
+
+// States returns the designated, extension and validation states
+func (p *EpPatentDocumentSimple) States() (res []State) {
+	res = append(res, p.DesignatedStates...)
+	res = append(res, p.ExtensionStates...)
+	res = append(res, p.ValidationStates...)
+	return
+}
+
+// containsState checks if the country is part of the states
+func containsState(states []State, country Country) bool {
+	country = normalizeCountry(country)
+	for _, s := range states {
+		if s.Country == country {
+			return true
+		}
+	}
+	return false
+}
+
+// normalizeCountry transforms the country code to upper case
+func normalizeCountry(country Country) Country {
+	return Country(strings.ToUpper(strings.TrimSpace(string(country))))
+}
@@ -0,0 +1,73 @@
+package eps
+
+import (
+	"github.com/stretchr/testify/assert"
+	"os"
+	"testing"
+)
+
+func TestEpPatentDocumentSimple_States(t *testing.T) {
+	ass := assert.New(t)
+	data, err := os.ReadFile("./test-data/application/v1-5-1-A1.xml")
+	ass.NoError(err)
+	patDoc, err := ProcessXMLSimple(data)
+	ass.NoError(err)
+
+	// designated states
+	ass.Equal(38, len(patDoc.DesignatedStates))
+	ass.Equal(len(patDoc.ContractingStates), len(patDoc.DesignatedStates))
+	ass.Equal(State{Country: "AL", Kind: DesignatedState}, patDoc.DesignatedStates[0])
+
+	// extension states
+	ass.Equal(2, len(patDoc.ExtensionStates))
+	ass.Equal(Country("BA"), patDoc.ExtensionStates[0].Country)
+	ass.Equal(ExtensionState, patDoc.ExtensionStates[0].Kind)
+	ass.Equal("20210611", patDoc.ExtensionStates[0].Date.Format(layoutDatePubl))
+	ass.True(patDoc.ExtensionStates[0].WithdrawalDate.IsZero())
+
+	// validation states
+	ass.Equal(4, len(patDoc.ValidationStates))
+	ass.Equal(Country("KH"), patDoc.ValidationStates[0].Country)
+	ass.Equal(ValidationState, patDoc.ValidationStates[0].Kind)
+	ass.Equal("20210611", patDoc.ValidationStates[0].Date.Format(layoutDatePubl))
+
+	// helpers
+	ass.True(patDoc.IsDesignated("DE"))
+	ass.True(patDoc.IsDesignated("de"))
+	ass.False(patDoc.IsDesignated("BA"))
+	ass.True(patDoc.IsExtended("BA"))
+	ass.False(patDoc.IsExtended("DE"))
+	ass.True(patDoc.IsValidated("MA"))
+	ass.False(patDoc.IsValidated("DE"))
+	ass.True(patDoc.Covers("MD"))
+	ass.False(patDoc.Covers("US"))
+	ass.Equal(44, len(patDoc.States()))
+}
+
+func TestEpPatentDocumentSimple_StatesWithoutDate(t *testing.T) {
+	ass := assert.New(t)
+	data, err := os.ReadFile("./test-data/application/v1-0-A1.xml")
+	ass.NoError(err)
+	patDoc, err := ProcessXMLSimple(data)
+	ass.NoError(err)
+
+	ass.Equal(6, len(patDoc.ExtensionStates))
+	ass.Equal(Country("AL"), patDoc.ExtensionStates[0].Country)
+	ass.True(patDoc.ExtensionStates[0].Date.IsZero())
+	ass.Empty(patDoc.ValidationStates)
+}
+
+func TestEpPatentDocumentSimple_IsExtendedWithdrawn(t *testing.T) {
+	ass := assert.New(t)
+	data := []byte(`<ep-patent-document id="EP1A1" lang="en" country="EP" doc-number="1" kind="A1" date-publ="20200101">
+<SDOBI><B800><B840><ctry>DE</ctry></B840><B844EP><B845EP><ctry>AL</ctry><date>20010509</date><B846EP><date>20020101</date></B846EP></B845EP></B844EP></B800></SDOBI>
+</ep-patent-document>`)
+	patDoc, err := ProcessXMLSimple(data)
+	ass.NoError(err)
+
+	ass.Equal(1, len(patDoc.ExtensionStates))
+	ass.Equal("20010509", patDoc.ExtensionStates[0].Date.Format(layoutDatePubl))
+	ass.Equal("20020101", patDoc.ExtensionStates[0].WithdrawalDate.Format(layoutDatePubl))
+	ass.False(patDoc.IsExtended("AL"))
+	ass.False(patDoc.Covers("AL"))
+}
@@ -0,0 +1,212 @@
+package eps
+
+import (
+	"bytes"
+	"encoding/csv"
+	"strings"
+	"unicode/utf8"
+)
+
+// Table is a table (tables) of the description, claims or abstract
+type Table struct {
+	ID       string       `json:"id,omitempty"`       // e.g. tabl0001
+	Num      string       `json:"num,omitempty"`      // e.g. 0001
+	Field    string       `json:"field,omitempty"`    // field of the text that contains the table, e.g. Description
+	Language string       `json:"language,omitempty"` // language of the text that contains the table
+	Title    string       `json:"title,omitempty"`    // e.g. [Table 1]
+	Image    string       `json:"image,omitempty"`    // file of the image, if the table is only available as image
+	Groups   []TableGroup `json:"groups,omitempty"`
+}
+
+// TableGroup is a part of a table with its own columns (tgroup)
+type TableGroup struct {
+	Cols   int        `json:"cols,omitempty"`
+	Header []TableRow `json:"header,omitempty"` // thead
+	Body   []TableRow `json:"body,omitempty"`   // tbody
+}
+
+// TableRow is a row of a table
+type TableRow struct {
+	Cells []TableCell `json:"cells,omitempty"`
+}
+
+// TableCell is a cell (entry) of a table
+type TableCell struct {
+	Text    string   `json:"text,omitempty"`
+	Images  []string `json:"images,omitempty"` // files of the images in the cell, e.g. chemical formulas
+	Column  int      `json:"column,omitempty"` // index of the first column of the cell
+	ColSpan int      `json:"colSpan,omitempty"`
+	RowSpan int      `json:"rowSpan,omitempty"`
+	Align   string   `json:"align,omitempty"` // left, right, center, justify or char
+}
+
+// TablePlaceholder returns the reference to the table, which replaces the table
+// in the texts unless ProcessOptions.InlineTables is set, e.g. [table:tabl0001]
+func TablePlaceholder(id string) string {
+	return "[table:" + id + "]"
+}
+
+// display returns the text of the cell or the files of its images
+func (c TableCell) display() string {
+	if c.Text == "" && len(c.Images) > 0 {
+		return strings.Join(c.Images, " ")
+	}
+	return c.Text
+}
+
+// Grid returns the header and body rows with one string per column.
+// Cells that span several columns or rows are written in their first column and row.
+func (g TableGroup) Grid() (header, body [][]string) {
+	width := g.Cols
+	for _, rows := range [][]TableRow{g.Header, g.Body} {
+		for _, r := range rows {
+			for _, c := range r.Cells {
+				width = max(width, c.Column+max(c.ColSpan, 1))
+			}
+		}
+	}
+	grid := func(rows []TableRow) (res [][]string) {
+		for _, r := range rows {
+			line := make([]string, width)
+			for _, c := range r.Cells {
+				line[c.Column] = c.display()
+			}
+			res = append(res, line)
+		}
+		return
+	}
+	return grid(g.Header), grid(g.Body)
+}
+
+// CSV renders the rows of the table as csv, the groups are separated by an empty line
+func (t Table) CSV() (string, error) {
+	var buf bytes.Buffer
+	for i, g := range t.Groups {
+		if i > 0 {
+			buf.WriteString("\n")
+		}
+		w := csv.NewWriter(&buf)
+		header, body := g.Grid()
+		if err := w.WriteAll(append(header, body...)); err != nil {
+			return "", err
+		}
+	}
+	return buf.String(), nil
+}
+
+// Markdown renders the table as markdown, the header rows are joined into one header
+func (t Table) Markdown() string {
+	var sb strings.Builder
+	if t.Title != "" {
+		sb.WriteString("**" + markdownCell(t.Title) + "**\n\n")
+	}
+	for i, g := range t.Groups {
+		if i > 0 {
+			sb.WriteString("\n")
+		}
+		header, body := g.Grid()
+		if len(header) == 0 && len(body) == 0 {
+			continue
+		}
+		width := len(append(header, body...)[0])
+		merged := mergeHeader(header, width)
+		writeMarkdownRow(&sb, merged)
+		separator := make([]string, width)
+		for col := range separator {
+			separator[col] = "---"
+		}
+		writeMarkdownRow(&sb, separator)
+		for _, row := range body {
+			writeMarkdownRow(&sb, row)
+		}
+	}
+	if t.Image != "" && len(t.Groups) == 0 {
+		sb.WriteString("![" + markdownCell(t.ID) + "](" + t.Image + ")\n")
+	}
+	return sb.String()
+}
+
+// Text renders the table as plain text with aligned columns, the header rows are joined into one header
+func (t Table) Text() string {
+	var sb strings.Builder
+	if t.Title != "" {
+		sb.WriteString(strings.Join(strings.Fields(t.Title), " ") + "\n")
+	}
+	for i, g := range t.Groups {
+		if i > 0 {
+			sb.WriteString("\n")
+		}
+		header, body := g.Grid()
+		if len(header) == 0 && len(body) == 0 {
+			continue
+		}
+		width := len(append(header, body...)[0])
+		rows := body
+		if len(header) > 0 {
+			rows = append([][]string{mergeHeader(header, width)}, body...)
+		}
+		widths := make([]int, width)
+		for r, row := range rows {
+			for col, text := range row {
+				rows[r][col] = strings.Join(strings.Fields(text), " ")
+				widths[col] = max(widths[col], utf8.RuneCountInString(rows[r][col]))
+			}
+		}
+		for r, row := range rows {
+			line := make([]string, width)
+			for col, text := range row {
+				line[col] = text + strings.Repeat(" ", widths[col]-utf8.RuneCountInString(text))
+			}
+			sb.WriteString(strings.TrimRight(strings.Join(line, " | "), " ") + "\n")
+			if r == 0 && len(header) > 0 {
+				separator := make([]string, width)
+				for col := range separator {
+					separator[col] = strings.Repeat("-", widths[col])
+				}
+				sb.WriteString(strings.Join(separator, "-+-") + "\n")
+			}
+		}
+	}
+	if t.Image != "" && len(t.Groups) == 0 {
+		sb.WriteString("[" + t.ID + ": " + t.Image + "]\n")
+	}
+	return sb.String()
+}
+
+// mergeHeader joins the header rows into one row
+func mergeHeader(header [][]string, width int) []string {
+	merged := make([]string, width)
+	for _, row := range header {
+		for col, text := range row {
+			if text != "" {
+				merged[col] = strings.TrimSpace(merged[col] + " " + text)
+			}
+		}
+	}
+	return merged
+}
+
+// writeMarkdownRow writes a row of a markdown table
+func writeMarkdownRow(sb *strings.Builder, cells []string) {
+	sb.WriteString("|")
+	for _, c := range cells {
+		sb.WriteString(" " + markdownCell(c) + " |")
+	}
+	sb.WriteString("\n")
+}
+
+// markdownCell escapes the text of a markdown table cell
+func markdownCell(text string) string {
+	text = strings.Join(strings.Fields(text), " ")
+	return strings.ReplaceAll(text, "|", `\|`)
+}
+
+// TablesIn returns the tables of the field, e.g. Description
+func (p *EpPatentDocumentSimple) TablesIn(field string) (res []Table) {
+	for _, t := range p.Tables {
+		if t.Field == field {
+			res = append(res, t)
+		}
+	}
+	return
+}
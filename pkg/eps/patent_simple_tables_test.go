@@ -0,0 +1,101 @@
+package eps
+
+import (
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+)
+
+const tablesTestDocument = `<?xml version="1.0" encoding="UTF-8"?>
+<ep-patent-document id="EP00000001A1" file="EP00000001NWA1.xml" lang="en" country="EP" doc-number="00000001" kind="A1" date-publ="20200101" status="n" dtd-version="ep-patent-document-v1-5-1">
+<description id="desc" lang="en">
+<p id="p0001" num="0001">The results are shown in Table 1.
+<tables id="tabl0001" num="0001">
+<table frame="all">
+<title>Table 1 | Results</title>
+<tgroup cols="3">
+<colspec colnum="1" colname="col1"/>
+<colspec colnum="2" colname="col2"/>
+<colspec colnum="3" colname="col3"/>
+<thead>
+<row><entry morerows="1">Sample</entry><entry namest="col2" nameend="col3" align="center">Yield</entry></row>
+<row><entry colname="col2">A</entry><entry>B</entry></row>
+</thead>
+<tbody>
+<row><entry morerows="1">1</entry><entry>10 %</entry><entry>20, 30 %</entry></row>
+<row><entry>x | y</entry><entry>40 %</entry></row>
+</tbody>
+</tgroup>
+</table>
+</tables>
+The yield is high.</p>
+</description>
+</ep-patent-document>`
+
+func TestTableSpans(t *testing.T) {
+	ass := assert.New(t)
+	doc, err := ProcessXMLSimple([]byte(tablesTestDocument))
+	ass.NoError(err)
+	if !ass.Len(doc.Tables, 1) {
+		return
+	}
+	g := doc.Tables[0].Groups[0]
+	ass.Equal(TableCell{Text: "Sample", Column: 0, ColSpan: 1, RowSpan: 2}, g.Header[0].Cells[0])
+	ass.Equal(TableCell{Text: "Yield", Column: 1, ColSpan: 2, RowSpan: 1, Align: "center"}, g.Header[0].Cells[1])
+	ass.Equal(1, g.Header[1].Cells[0].Column)
+	ass.Equal(2, g.Header[1].Cells[1].Column)
+	// the first column of the second row is occupied by the cell above
+	ass.Equal(1, g.Body[1].Cells[0].Column)
+	ass.Equal(2, g.Body[1].Cells[1].Column)
+}
+
+func TestTableCSV(t *testing.T) {
+	ass := assert.New(t)
+	doc, err := ProcessXMLSimple([]byte(tablesTestDocument))
+	ass.NoError(err)
+	csv, err := doc.Tables[0].CSV()
+	ass.NoError(err)
+	ass.Equal("Sample,Yield,\n,A,B\n1,10 %,\"20, 30 %\"\n,x | y,40 %\n", csv)
+}
+
+func TestTableMarkdown(t *testing.T) {
+	ass := assert.New(t)
+	doc, err := ProcessXMLSimple([]byte(tablesTestDocument))
+	ass.NoError(err)
+	ass.Equal("**Table 1 \\| Results**\n\n"+
+		"| Sample | Yield A | B |\n"+
+		"| --- | --- | --- |\n"+
+		"| 1 | 10 % | 20, 30 % |\n"+
+		"|  | x \\| y | 40 % |\n", doc.Tables[0].Markdown())
+
+	// tables without header
+	table := Table{Groups: []TableGroup{{Cols: 2, Body: []TableRow{{Cells: []TableCell{{Text: "a"}, {Text: "b", Column: 1}}}}}}}
+	ass.Equal("|  |  |\n| --- | --- |\n| a | b |\n", table.Markdown())
+	// tables that are only available as image
+	table = Table{ID: "tabl0002", Image: "imgf0002.tif"}
+	ass.Equal("![tabl0002](imgf0002.tif)\n", table.Markdown())
+}
+
+func TestTablePlaceholder(t *testing.T) {
+	ass := assert.New(t)
+	doc, err := ProcessXMLSimple([]byte(tablesTestDocument))
+	ass.NoError(err)
+	ass.Equal("The results are shown in Table 1. [table:tabl0001] The yield is high.", strings.Join(strings.Fields(doc.Description[0].Text), " "))
+
+	doc, err = ProcessXMLSimpleWithOptions([]byte(tablesTestDocument), ProcessOptions{InlineTables: true})
+	ass.NoError(err)
+	ass.Contains(doc.Description[0].Text, "x | y")
+}
+
+func TestTableText(t *testing.T) {
+	ass := assert.New(t)
+	doc, err := ProcessXMLSimple([]byte(tablesTestDocument))
+	ass.NoError(err)
+	ass.Equal("Table 1 | Results\n"+
+		"Sample | Yield A | B\n"+
+		"-------+---------+---------\n"+
+		"1      | 10 %    | 20, 30 %\n"+
+		"       | x | y   | 40 %\n", doc.Tables[0].Text())
+	table := Table{ID: "tabl0002", Image: "imgf0002.tif"}
+	ass.Equal("[tabl0002: imgf0002.tif]\n", table.Text())
+}
@@ -2,9 +2,11 @@ package eps
 
 import (
 	"bytes"
+	"encoding/xml"
 	"errors"
-	"github.com/PuerkitoBio/goquery"
+	"github.com/max-planck-innovation-competition/go-epo-eps/pkg/mathml"
 	log "github.com/sirupsen/logrus"
+	"io"
 	"strconv"
 	"strings"
 	"time"
@@ -16,46 +18,357 @@ const (
 
 var ErrEmptyID = errors.New("empty id")
 
+// capturedElements are the elements that are kept in memory as a subtree while streaming
+var capturedElements = map[string]bool{
+	"B540":               true, // titles
+	"B510":               true, // IPC7 classifications
+	"B510EP":             true, // classifications
+	"B520EP":             true, // CPC classifications
+	"B560":               true, // prior art
+	"B721":               true, // inventors
+	"B731":               true, // owners
+	"B741":               true, // representatives
+	"B840":               true, // designated states
+	"B844EP":             true, // extension states
+	"B848EP":             true, // validation states
+	"patcit":             true,
+	"nplcit":             true,
+	"citation":           true,
+	"search-report-data": true,
+	"chemistry":          true,
+	"bio-deposit":        true,
+	"B830":               true, // deposit of biological material
+	"sequence-list-text": true,
+	"figref":             true,
+	"drawings":           true,
+}
+
 // ProcessXMLSimple transforms the raw response of the xml data into a simple patent
 func ProcessXMLSimple(raw []byte) (patentDoc EpPatentDocumentSimple, err error) {
-	// parse doc
-	doc, err := goquery.NewDocumentFromReader(bytes.NewReader(raw))
-	if err != nil {
-		log.WithError(err).Error("can not read document")
-		return
+	return ProcessXMLSimpleReader(bytes.NewReader(raw))
+}
+
+// ProcessXMLSimpleReader transforms the xml data of the reader into a simple patent.
+// The data is processed as a stream of xml tokens. Only the bibliographic elements
+// and citations are kept in memory, the text of the abstract, description and claims
+// is collected while streaming.
+func ProcessXMLSimpleReader(r io.Reader) (patentDoc EpPatentDocumentSimple, err error) {
+	return ProcessXMLSimpleReaderWithOptions(r, ProcessOptions{})
+}
+
+// textCollector collects the character data of an element and all its descendants
+type textCollector struct {
+	name     string
+	depth    int
+	attr     []xml.Attr
+	text     strings.Builder
+	children []*textCollector // claims, headings and statements of a set of claims
+	// exclusive collectors keep their text from the enclosing collectors, e.g. headings of the claims
+	exclusive bool
+	// paragraphs and headings of the description
+	paragraphs []Paragraph
+}
+
+// attrValue returns the value of the attribute of the collected element
+func (c *textCollector) attrValue(name string) string {
+	for _, a := range c.attr {
+		if a.Name.Local == name {
+			return a.Value
+		}
 	}
-	root := doc.Find("ep-patent-document")
-	if root == nil {
+	return ""
+}
+
+// childrenNamed returns the child collectors with the name
+func (c *textCollector) childrenNamed(name string) (res []*textCollector) {
+	for _, child := range c.children {
+		if child.name == name {
+			res = append(res, child)
+		}
+	}
+	return
+}
+
+// claimsElements are the elements with claims, headings and statements collected as children
+var claimsElements = map[string]bool{
+	"claims":                   true,
+	"amended-claims":           true,
+	"amended-claims-statement": true,
+}
+
+// simpleParser holds the state of the streaming parser
+type simpleParser struct {
+	doc      *EpPatentDocumentSimple
+	warnings *parseWarnings
+	version  DtdVersion // extraction rules of the dtd-version
+	depth    int
+	names    []string // names of the open elements
+	// captured subtree
+	capture      *xmlNode
+	captureDepth int
+	current      *xmlNode
+	nodes        nodeAllocator
+	// active and finished text collectors
+	collecting   []*textCollector
+	abstracts    []*textCollector
+	descriptions []*textCollector
+	claims       []*textCollector
+	amended      []*textCollector
+	statements   []*textCollector
+	// non-patent literature citations are filtered at the end
+	nplcits []*xmlNode
+	// IPC7 classifications are only used if there are no IPC8 classifications, CPC classifications follow them
+	ipc7 []ClassificationItem
+	cpc  []ClassificationItem
+	// MathML formulas are converted if the format is not MathRaw
+	math    MathFormat
+	formula []*mathml.Node
+	// tables are built while streaming and replaced by placeholders in the texts unless inlineTables is set
+	table        *tableBuilder
+	inlineTables bool
+	inTables     bool
+	// open paragraphs and references to figures, which are linked at the end
+	paragraphs []paragraph
+	figrefs    []figureRef
+	// open paragraph or heading of the description
+	block *descriptionBlock
+}
+
+// newDecoder creates a lenient xml decoder that knows the html entities
+func newDecoder(r io.Reader) *xml.Decoder {
+	d := xml.NewDecoder(newLenientXMLReader(r))
+	d.Strict = false
+	d.Entity = xml.HTMLEntity
+	return d
+}
+
+// parse reads the tokens of the document
+func (p *simpleParser) parse(r io.Reader) (err error) {
+	d := newDecoder(r)
+	rootFound := false
+	for {
+		// the depth is tracked by the parser, so the raw tokens are sufficient
+		token, errToken := d.RawToken()
+		if errToken == io.EOF {
+			break
+		}
+		if errToken != nil {
+			err = errToken
+			log.WithError(err).Error("can not read document")
+			return
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			if !rootFound {
+				if t.Name.Local != "ep-patent-document" {
+					continue
+				}
+				rootFound = true
+				err = p.processRoot(t)
+				if err != nil {
+					return
+				}
+				p.depth++
+				p.names = append(p.names, t.Name.Local)
+				continue
+			}
+			p.startElement(t)
+		case xml.EndElement:
+			if !rootFound {
+				continue
+			}
+			if p.depth == 1 {
+				// end of the root element
+				p.depth--
+				p.finish()
+				return
+			}
+			p.endElement()
+		case xml.CharData:
+			if !rootFound {
+				continue
+			}
+			p.charData(t)
+		}
+	}
+	if !rootFound {
+		err = ErrEmptyID
 		log.WithError(err).Error("can not find root element")
 		return
 	}
+	p.finish()
+	return
+}
 
-	patentDoc.ID, _ = root.Attr("id")
-	patentDoc.DocNumber, _ = root.Attr("doc-number")
-	patentDoc.Kind, _ = root.Attr("kind")
-	patentDoc.Status, _ = root.Attr("status")
-	patentDoc.DtdVersion, _ = root.Attr("dtd-version")
+// processRoot parses the attributes of the root element
+func (p *simpleParser) processRoot(t xml.StartElement) (err error) {
+	root := &xmlNode{Name: t.Name.Local, Attr: t.Attr}
+	patentDoc := p.doc
+	patentDoc.ID = root.attrValue("id")
+	patentDoc.DocNumber = root.attrValue("doc-number")
+	patentDoc.Kind = root.attrValue("kind")
+	patentDoc.Status = root.attrValue("status")
+	patentDoc.DtdVersion = root.attrValue("dtd-version")
+	// unknown versions, e.g. future ones, are parsed with the rules of the latest version
+	version, errVersion := LookupDtdVersion(patentDoc.DtdVersion)
+	if errVersion != nil {
+		version = LatestDtdVersion()
+		p.warnings.add(SeverityWarning, "DtdVersion", "/ep-patent-document/@dtd-version", patentDoc.DtdVersion, "unknown dtd version, parsed as "+version.Version)
+	}
+	p.version = version
 
 	if len(patentDoc.ID) == 0 {
 		err = ErrEmptyID
 		log.WithError(err).Error("empty id")
 		return
 	}
-	logger := log.WithField("id", patentDoc.ID)
 
 	// parse the date form the string
-	dateString, _ := root.Attr("date-publ")
+	dateString := root.attrValue("date-publ")
 	parsedDate, errDate := time.Parse(layoutDatePubl, dateString)
 	if errDate != nil {
-		logger.WithField("date", dateString).Warn("can not parse date")
+		p.warnings.add(SeverityWarning, "DatePubl", "/ep-patent-document/@date-publ", dateString, "can not parse date")
 	} else {
 		patentDoc.DatePubl = parsedDate
 	}
-	language, _ := root.Attr("lang")
-	patentDoc.Lang = strings.ToLower(strings.TrimSpace(language))
-	patentDoc.File, _ = root.Attr("file")
-	country, _ := root.Attr("country")
-	patentDoc.Country = Country(strings.ToUpper(strings.TrimSpace(country)))
+	patentDoc.Lang = strings.ToLower(strings.TrimSpace(root.attrValue("lang")))
+	patentDoc.File = root.attrValue("file")
+	patentDoc.Country = Country(strings.ToUpper(strings.TrimSpace(root.attrValue("country"))))
+	return
+}
+
+// startElement opens text collectors and captures
+func (p *simpleParser) startElement(t xml.StartElement) {
+	p.depth++
+	name := t.Name.Local
+	p.names = append(p.names, name)
+	if p.startMath(name, t.Attr) {
+		return
+	}
+	p.collect(name, t.Attr)
+	p.startParagraph(name, t.Attr)
+	p.startDescriptionBlock(name, t.Attr)
+	if p.table != nil {
+		p.table.start(name, t.Attr, p.depth)
+	} else if name == "tables" && p.capture == nil {
+		p.startTables(t.Attr)
+	}
+	if p.capture != nil {
+		n := p.nodes.node(name, copyAttr(t.Attr), "", p.current)
+		p.nodes.appendChild(p.current, n)
+		p.current = n
+		return
+	}
+	if capturedElements[name] {
+		// the ancestors are kept to know the path of the subtree
+		parent := p.nodes.ancestors(p.names[:len(p.names)-1])
+		p.capture = p.nodes.node(name, copyAttr(t.Attr), "", parent)
+		p.captureDepth = p.depth
+		p.current = p.capture
+	}
+}
+
+// collect opens a text collector for the texts of the document and the parts of the claims
+func (p *simpleParser) collect(name string, attr []xml.Attr) {
+	var parent *textCollector
+	if len(p.collecting) > 0 && claimsElements[p.collecting[len(p.collecting)-1].name] {
+		parent = p.collecting[len(p.collecting)-1]
+	}
+	isPart := parent != nil && (name == "claim" || name == "heading" || name == "amended-claims-statement")
+	if !isPart && collectorFields[name] == "" && name != "amended-claims-statement" {
+		return
+	}
+	c := &textCollector{name: name, depth: p.depth, attr: copyAttr(attr)}
+	switch {
+	case isPart:
+		c.exclusive = name != "claim"
+		parent.children = append(parent.children, c)
+	case name == "abstract":
+		p.abstracts = append(p.abstracts, c)
+	case name == "description":
+		p.descriptions = append(p.descriptions, c)
+	case name == "claims":
+		p.claims = append(p.claims, c)
+	case name == "amended-claims":
+		p.amended = append(p.amended, c)
+	case name == "amended-claims-statement":
+		p.statements = append(p.statements, c)
+	}
+	p.collecting = append(p.collecting, c)
+}
+
+// endElement closes text collectors and captures
+func (p *simpleParser) endElement() {
+	if len(p.formula) > 0 {
+		p.endMath()
+		p.names = p.names[:len(p.names)-1]
+		p.depth--
+		return
+	}
+	p.endParagraph()
+	p.endDescriptionBlock()
+	if p.table != nil {
+		p.endTables(p.names[len(p.names)-1])
+	}
+	if len(p.collecting) > 0 && p.collecting[len(p.collecting)-1].depth == p.depth {
+		p.collecting = p.collecting[:len(p.collecting)-1]
+	}
+	if p.capture != nil {
+		if p.depth == p.captureDepth {
+			captured := p.capture
+			p.capture = nil
+			p.current = nil
+			p.processElement(captured)
+		} else {
+			p.current = p.current.Parent
+		}
+	}
+	p.names = p.names[:len(p.names)-1]
+	p.depth--
+}
+
+// charData adds the character data to the text collectors and the captured subtree
+func (p *simpleParser) charData(data []byte) {
+	if len(p.formula) > 0 {
+		p.mathData(data)
+		return
+	}
+	p.writeText(data)
+	if p.table != nil {
+		p.table.charData(data)
+	}
+	if p.capture != nil {
+		p.nodes.appendChild(p.current, p.nodes.node("", nil, string(data), p.current))
+	}
+}
+
+// writeText adds the character data to the text collectors
+func (p *simpleParser) writeText(data []byte) {
+	if p.inTables {
+		return
+	}
+	for i := len(p.collecting) - 1; i >= 0; i-- {
+		p.collecting[i].text.Write(data)
+		if p.collecting[i].exclusive {
+			break
+		}
+	}
+}
+
+// copyAttr copies the attributes of a token
+func copyAttr(attr []xml.Attr) []xml.Attr {
+	if len(attr) == 0 {
+		return nil
+	}
+	res := make([]xml.Attr, len(attr))
+	copy(res, attr)
+	return res
+}
+
+// processElement processes a captured subtree
+func (p *simpleParser) processElement(n *xmlNode) {
+	patentDoc := p.doc
+	switch n.Name {
 	// title
 	/*
 		<B540>
@@ -65,194 +378,342 @@ func ProcessXMLSimple(raw []byte) (patentDoc EpPatentDocumentSimple, err error)
 			<B542>PROCEDURE AND ...</B542>
 		</B540>
 	*/
-	titles := root.Find("B540")
-	titles.Children().Each(func(i int, c *goquery.Selection) {
-		if c.Is("B541") && c.Next() != nil && c.Next().Is("B542") {
-			patentDoc.Title = append(patentDoc.Title, Title{
-				Language: strings.ToLower(strings.TrimSpace(c.Text())),
-				Text:     strings.TrimSpace(c.Next().Text()),
-			})
+	case "B540":
+		for _, c := range n.elements() {
+			if c.is("B541") && c.nextElement().is("B542") {
+				patentDoc.Title = append(patentDoc.Title, Title{
+					Language: strings.ToLower(strings.TrimSpace(c.text())),
+					Text:     strings.TrimSpace(c.nextElement().text()),
+				})
+			}
 		}
-	})
-	// abstract
-	abstract := root.Find("abstract")
-	langAbstract, _ := abstract.Attr("lang")
-	if langAbstract == "" || len(abstract.Text()) == 0 {
-		logger.Warn("no abstract")
-	} else {
-		patentDoc.Abstract = append(
-			patentDoc.Abstract,
-			Abstract{
-				Text:     strings.TrimSpace(abstract.Text()),
-				Language: strings.ToLower(strings.TrimSpace(langAbstract)),
-			},
-		)
-	}
-
-	// description
-	description := root.Find("description")
-	langDescription, _ := description.Attr("lang")
-	if langDescription == "" || len(description.Text()) == 0 {
-		logger.Warn("no description")
-	} else {
-		patentDoc.Description = append(
-			patentDoc.Description,
-			Description{
-				Text:     strings.TrimSpace(description.Text()),
-				Language: strings.ToLower(strings.TrimSpace(langDescription)),
-			})
-	}
-
-	// claims
-	claims := root.Find("claims")
-	// iterate over all claims
-	claims.Each(func(i int, c *goquery.Selection) {
-		langClaims, _ := c.Attr("lang")
-		id, _ := c.Attr("id")
-		patentDoc.Claims = append(patentDoc.Claims, Claim{
-			Text:     strings.TrimSpace(c.Text()),
-			Language: strings.TrimSpace(strings.ToLower(strings.TrimSpace(langClaims))),
-			Id:       id,
-		})
-	})
-	// citations
+	// IPC7 classifications
 	/*
-		<patcit id="ref-pcit0001" dnum="US20120281566A">
-			<document-id>
-				<country>US</country>
-				<doc-number>20120281566</doc-number>
-				<kind>A</kind>
-			</document-id>
-		</patcit>
-		<crossref idref="pcit0001">[0006]</crossref>
+		<B510>
+			<B516>7</B516>
+			<B511> 7C 07C  29/44   A</B511>
+			<B512> 7C 07C  31/38   B</B512>
+		</B510>
 	*/
-	citations := root.Find("patcit")
-	citations.Each(func(i int, c *goquery.Selection) {
-		docId := c.Find("document-id")
-		if docId.Size() > 0 {
-			patentDoc.Citations = append(patentDoc.Citations, Citation{
-				Country:   Country(strings.ToUpper(strings.TrimSpace(docId.Find("country").Text()))),
-				DocNumber: strings.TrimSpace(docId.Find("doc-number").Text()),
-				Kind:      strings.TrimSpace(docId.Find("kind").Text()),
-			})
+	case "B510":
+		if !p.version.IPC7 {
+			p.warnings.add(SeverityInfo, "Classifications", n.path(), "", "IPC7 classifications are not extracted in "+p.version.Version)
+			break
 		}
-	})
+		for _, c := range n.elements() {
+			// main (B511) and further (B512) classifications are inventive,
+			// additional information and indexing codes (B513-B515) are not
+			firstLater, value := "L", "N"
+			switch c.Name {
+			case "B511":
+				firstLater, value = "F", "I"
+			case "B512":
+				value = "I"
+			case "B513", "B514", "B515":
+			default:
+				continue
+			}
+			text := strings.TrimSpace(c.text())
+			item, errItem := NewIpc7ClassificationItemFromString(text, len(p.ipc7)+1, firstLater)
+			item.ClassificationValue = value
+			if errItem != nil {
+				p.warnings.add(SeverityWarning, "Classifications", c.path(), text, "can not find IPC7 pattern")
+			}
+			p.ipc7 = append(p.ipc7, item)
+		}
+	// Classifications
+	/*
+		<B510EP>
+			<classification-ipcr sequence="1">
+				<text>B60T 17/22 20060101AFI20200403BHEP</text>
+			</classification-ipcr>
+		</B510EP>
+	*/
+	case "B510EP":
+		for _, c := range n.find("classification-ipcr") {
+			seq, ex := c.attr("sequence")
+			seqInt, warn := strconv.Atoi(seq)
+			if !ex {
+				p.warnings.add(SeverityWarning, "Classifications", c.path()+"/@sequence", "", "sequence does not exist")
+			} else if warn != nil {
+				p.warnings.add(SeverityWarning, "Classifications", c.path()+"/@sequence", seq, "can not parse sequence")
+			}
+			// do not use trim here
+			text := c.pathText("text")
+			item, errItem := NewIpcrClassificationItemFromString(text, seqInt)
+			if errItem != nil {
+				p.warnings.add(SeverityWarning, "Classifications", c.path()+"/text", text, "can not find IPC8 pattern")
+			}
+			patentDoc.Classifications = append(patentDoc.Classifications, item)
+		}
+	// CPC classifications
+	/*
+		<B520EP>
+			<classifications-cpc>
+				<classification-cpc sequence="1">
+					<text>C07D 271/113       20130101 LI20200617BCEP        </text>
+				</classification-cpc>
+			</classifications-cpc>
+		</B520EP>
+	*/
+	case "B520EP":
+		if !p.version.CPC {
+			p.warnings.add(SeverityInfo, "Classifications", n.path(), "", "CPC classifications are not extracted in "+p.version.Version)
+			break
+		}
+		for _, c := range n.find("classification-cpc") {
+			seq := c.attrValue("sequence")
+			seqInt, warn := strconv.Atoi(seq)
+			if warn != nil {
+				p.warnings.add(SeverityWarning, "Classifications", c.path()+"/@sequence", seq, "can not parse sequence")
+			}
+			text := c.pathText("text")
+			item, errItem := NewCpcClassificationItemFromString(text, seqInt)
+			if errItem != nil {
+				p.warnings.add(SeverityWarning, "Classifications", c.path()+"/text", text, "can not find CPC pattern")
+			}
+			p.cpc = append(p.cpc, item)
+		}
+	// prior art documents of the title page
+	case "B560":
+		processPriorArt(n, &patentDoc.PriorArt, p.warnings)
 	// inventors
 	/*
-		<B720>
-			<B721>
-				<snm>MARTIN, Brian Alexander</snm>
-				<adr>
-					<str>c/o Sony Europe IP Europe Jays Close, Viables</str>
-					<city>Basingstoke, Hampshire RG22 4SB</city>
-					<ctry>GB</ctry>
-				</adr>
-			</B721>
-
-
+		<B721>
+			<snm>MARTIN, Brian Alexander</snm>
+			<adr>
+				<str>c/o Sony Europe IP Europe Jays Close, Viables</str>
+				<city>Basingstoke, Hampshire RG22 4SB</city>
+				<ctry>GB</ctry>
+			</adr>
+		</B721>
 	*/
-	inventors := root.Find("B721")
-	inventors.Each(func(i int, c *goquery.Selection) {
+	case "B721":
 		patentDoc.Inventors = append(patentDoc.Inventors, Inventor{
-			Country: Country(strings.ToUpper(strings.TrimSpace(c.Find("adr ctry").Text()))),
-			City:    strings.TrimSpace(c.Find("adr city").Text()),
-			Street:  strings.TrimSpace(c.Find("adr str").Text()),
-			Name:    strings.TrimSpace(c.Find("snm").Text()),
+			Country: Country(strings.ToUpper(strings.TrimSpace(n.pathText("adr", "ctry")))),
+			City:    strings.TrimSpace(n.pathText("adr", "city")),
+			Street:  strings.TrimSpace(n.pathText("adr", "str")),
+			Name:    strings.TrimSpace(n.pathText("snm")),
 		})
-	})
 	// owners
 	/*
-		<B720>
-			<B721>
-				<snm>MARTIN, Brian Alexander</snm>
-				<adr>
-					<str>c/o Sony Europe IP Europe Jays Close, Viables</str>
-					<city>Basingstoke, Hampshire RG22 4SB</city>
-					<ctry>GB</ctry>
-				</adr>
-			</B721>
-
-
+		<B731>
+			<snm>Sony Group Corporation</snm>
+			<iid>101736919</iid>
+			<irf>P125873EP</irf>
+			<adr>...</adr>
+		</B731>
 	*/
-	owners := root.Find("B731")
-	owners.Each(func(i int, c *goquery.Selection) {
+	case "B731":
 		patentDoc.Owners = append(patentDoc.Owners, Owner{
-			Country: Country(strings.ToUpper(strings.TrimSpace(c.Find("adr ctry").Text()))),
-			IID:     strings.TrimSpace(c.Find("iid").Text()),
-			IRF:     strings.TrimSpace(c.Find("irf").Text()),
-			City:    strings.TrimSpace(c.Find("adr city").Text()),
-			Street:  strings.TrimSpace(c.Find("adr str").Text()),
-			Name:    strings.TrimSpace(c.Find("snm").Text()),
+			Country: Country(strings.ToUpper(strings.TrimSpace(n.pathText("adr", "ctry")))),
+			IID:     strings.TrimSpace(n.pathText("iid")),
+			IRF:     strings.TrimSpace(n.pathText("irf")),
+			City:    strings.TrimSpace(n.pathText("adr", "city")),
+			Street:  strings.TrimSpace(n.pathText("adr", "str")),
+			Name:    strings.TrimSpace(n.pathText("snm")),
 		})
-	})
 	// representatives
 	/*
-		<B740>
-			<B741>
-				<snm>D Young & Co LLP</snm>
-				<iid>101533551</iid>
-				<adr>
-					<str>120 Holborn</str>
-					<city>London EC1N 2DY</city>
-					<ctry>GB</ctry>
-				</adr>
-			</B741>
-		</B740>
+		<B741>
+			<snm>D Young & Co LLP</snm>
+			<iid>101533551</iid>
+			<adr>...</adr>
+		</B741>
 	*/
-	representatives := root.Find("B741")
-	representatives.Each(func(i int, c *goquery.Selection) {
+	case "B741":
 		patentDoc.Representatives = append(patentDoc.Representatives, Representative{
-			Country: Country(strings.ToUpper(strings.TrimSpace(c.Find("adr ctry").Text()))),
-			IID:     strings.TrimSpace(c.Find("iid").Text()),
-			City:    strings.TrimSpace(c.Find("adr city").Text()),
-			Street:  strings.TrimSpace(c.Find("adr str").Text()),
-			Name:    strings.TrimSpace(c.Find("snm").Text()),
+			Country: Country(strings.ToUpper(strings.TrimSpace(n.pathText("adr", "ctry")))),
+			IID:     strings.TrimSpace(n.pathText("iid")),
+			City:    strings.TrimSpace(n.pathText("adr", "city")),
+			Street:  strings.TrimSpace(n.pathText("adr", "str")),
+			Name:    strings.TrimSpace(n.pathText("snm")),
 		})
-	})
 	// ContractingStates
 	/*
-		<B800>
-			<B840>
-				<ctry>AL</ctry>
-				<ctry>AT</ctry>
-
+		<B840>
+			<ctry>AL</ctry>
+			<ctry>AT</ctry>
+		</B840>
 	*/
-	countries := root.Find("B840 ctry")
-	countries.Each(func(i int, c *goquery.Selection) {
-		patentDoc.ContractingStates = append(patentDoc.ContractingStates, Country(strings.ToUpper(strings.TrimSpace(c.Text()))))
-	})
-	// Classifications
+	case "B840":
+		for _, c := range n.find("ctry") {
+			country := Country(strings.ToUpper(strings.TrimSpace(c.text())))
+			patentDoc.ContractingStates = append(patentDoc.ContractingStates, country)
+			patentDoc.DesignatedStates = append(patentDoc.DesignatedStates, State{
+				Country: country,
+				Kind:    DesignatedState,
+			})
+		}
+	// extension and validation states
 	/*
-		<B510EP>
-			<classification-ipcr sequence="1">
-				<text>B60T 17/22 20060101AFI20200403BHEP</text>
-			</classification-ipcr>
-		</B510EP>
+		<B844EP>
+			<B845EP><ctry>BA</ctry><date>20210611</date></B845EP>
+		</B844EP>
+		<B848EP>
+			<B849EP><ctry>MA</ctry><date>20210611</date></B849EP>
+		</B848EP>
 	*/
-	classes := root.Find("B510EP classification-ipcr")
-	classes.Each(func(i int, c *goquery.Selection) {
-		seq, ex := c.Attr("sequence")
-		if !ex {
-			log.Warn("classification ipcr: seq does not exist")
+	case "B844EP":
+		for _, c := range n.find("B845EP") {
+			patentDoc.ExtensionStates = append(patentDoc.ExtensionStates, newState(c, ExtensionState, p.warnings))
 		}
-		seqInt, warn := strconv.Atoi(seq)
-		if warn != nil {
-			log.Warn("classification ipcr: can not parse seq string", warn)
+	case "B848EP":
+		for _, c := range n.find("B849EP") {
+			patentDoc.ValidationStates = append(patentDoc.ValidationStates, newState(c, ValidationState, p.warnings))
 		}
-		// do not use trim here
-		item := NewIpcrClassificationItemFromString(c.Find("text").Text(), seqInt)
-		patentDoc.Classifications = append(patentDoc.Classifications, item)
-	})
-	// todo: cpc and co
+	// citations
+	case "patcit", "nplcit", "citation", "search-report-data":
+		p.processCitations(n)
+		if n.Name == "search-report-data" {
+			patentDoc.SearchReports = append(patentDoc.SearchReports, newSearchReport(n, p.warnings))
+		}
+	// chemistry and biological material
+	case "chemistry", "bio-deposit":
+		p.processEntities(n)
+	case "B830":
+		patentDoc.BioDeposits = append(patentDoc.BioDeposits, newB830BioDeposit(n, p.warnings))
+	case "sequence-list-text":
+		s := newSequenceListText(n)
+		s.Field, s.Language = p.textField()
+		patentDoc.SequenceListTexts = append(patentDoc.SequenceListTexts, s)
+		p.processCitations(n)
+		p.processEntities(n)
+		p.processFigrefs(n)
+	// figures
+	case "figref":
+		p.processFigrefs(n)
+	case "drawings":
+		p.processDrawings(n)
+	}
+}
 
-	// generate aliases
-	patentDoc.GenerateAliases()
+// processCitations collects the patent and non-patent literature citations of the subtree
+/*
+	<patcit id="ref-pcit0001" dnum="US20120281566A">
+		<document-id>
+			<country>US</country>
+			<doc-number>20120281566</doc-number>
+			<kind>A</kind>
+		</document-id>
+	</patcit>
+	<crossref idref="pcit0001">[0006]</crossref>
+*/
+func (p *simpleParser) processCitations(n *xmlNode) {
+	var citations []*xmlNode
+	if n.is("patcit", "nplcit") {
+		citations = append(citations, n)
+	}
+	citations = append(citations, n.find("patcit", "nplcit")...)
+	for _, c := range citations {
+		if c.is("nplcit") {
+			p.nplcits = append(p.nplcits, c)
+			continue
+		}
+		if c.findFirst("document-id") != nil {
+			p.doc.Citations = append(p.doc.Citations, newCitation(c))
+		}
+	}
+}
 
-	// check if id is empty
-	if patentDoc.ID == "" {
-		err = ErrEmptyID
-		log.WithError(err).Error("empty id")
+// finish processes the collected texts
+func (p *simpleParser) finish() {
+	patentDoc := p.doc
+	if len(patentDoc.Classifications) == 0 {
+		patentDoc.Classifications = p.ipc7
+	}
+	patentDoc.Classifications = append(patentDoc.Classifications, p.cpc...)
+	// abstracts
+	abstracts := groupCollectors(p.abstracts)
+	if len(abstracts) == 0 {
+		// the specifications of granted patents do not contain the abstract
+		severity := SeverityWarning
+		if strings.HasPrefix(patentDoc.Kind, "B") {
+			severity = SeverityInfo
+		}
+		p.warnings.add(severity, "Abstract", "/ep-patent-document/abstract", "", "no abstract")
+	}
+	for _, t := range abstracts {
+		patentDoc.Abstract = append(patentDoc.Abstract, Abstract{
+			Text:     t.text,
+			Language: t.lang,
+		})
+	}
+	// descriptions
+	descriptions := groupCollectors(p.descriptions)
+	if len(descriptions) == 0 {
+		p.warnings.add(SeverityWarning, "Description", "/ep-patent-document/description", "", "no description")
+	}
+	for _, t := range descriptions {
+		patentDoc.Description = append(patentDoc.Description, Description{
+			Text:       t.text,
+			Language:   t.lang,
+			Paragraphs: t.paragraphs,
+		})
 	}
+	// claims
+	for _, c := range p.claims {
+		patentDoc.Claims = append(patentDoc.Claims, Claim{
+			Text:     strings.TrimSpace(c.text.String()),
+			Language: strings.ToLower(strings.TrimSpace(c.attrValue("lang"))),
+			Id:       c.attrValue("id"),
+			Items:    claimItems(c),
+		})
+	}
+	// amended claims and their statements
+	for _, c := range p.amended {
+		patentDoc.AmendedClaims = append(patentDoc.AmendedClaims, newAmendedClaims(c))
+	}
+	for _, c := range p.statements {
+		patentDoc.ClaimsStatements = append(patentDoc.ClaimsStatements, newClaimsStatement(c))
+	}
+	// non-patent literature citations
+	patentDoc.NplCitations = processNplCitations(p.nplcits)
+	// references to the figures
+	p.linkFigures()
+}
+
+// languageText is the text of a language
+type languageText struct {
+	lang       string
+	text       string
+	paragraphs []Paragraph
+}
 
+// groupCollectors joins the texts of the collectors by language in the order of their first occurrence.
+// Collectors without language or text are skipped.
+func groupCollectors(collectors []*textCollector) (res []languageText) {
+	index := map[string]int{}
+	for _, c := range collectors {
+		lang := strings.ToLower(strings.TrimSpace(c.attrValue("lang")))
+		text := strings.TrimSpace(c.text.String())
+		if lang == "" || text == "" {
+			continue
+		}
+		if i, ok := index[lang]; ok {
+			res[i].text += "\n" + text
+			res[i].paragraphs = append(res[i].paragraphs, c.paragraphs...)
+			continue
+		}
+		index[lang] = len(res)
+		res = append(res, languageText{lang: lang, text: text, paragraphs: c.paragraphs})
+	}
+	return
+}
+
+// newState transforms a B845EP or B849EP element into a State
+func newState(n *xmlNode, kind StateKind, w *parseWarnings) (state State) {
+	state.Country = Country(strings.ToUpper(strings.TrimSpace(n.child("ctry").text())))
+	state.Kind = kind
+	field := "ExtensionStates"
+	if kind == ValidationState {
+		field = "ValidationStates"
+	}
+	if date := n.child("date"); date != nil {
+		state.Date = w.date(field, date)
+	}
+	if withdrawal := n.child("B846EP"); withdrawal != nil {
+		state.WithdrawalDate = w.childDate(field, withdrawal)
+	}
 	return
 }
@@ -0,0 +1,254 @@
+package eps
+
+import (
+	"regexp"
+	"strings"
+)
+
+// e.g. EP-A1- 0 444 678, WO-A-01/18005, CN-U- 202 128 028
+var rePriorArtPatent = regexp.MustCompile(`^([A-Z]{2})\s*-\s*([A-Z][0-9]?)\s*-\s*(.+)$`)
+
+// e.g. 10.1016/j.cell.2009.01.042
+var reDOI = regexp.MustCompile(`10\.[0-9]{4,9}/[^\s"<>]+`)
+
+// citationPhase returns the phase in which the patcit or nplcit has been cited
+func citationPhase(n *xmlNode) CitationPhase {
+	citation := n.ancestor("citation")
+	if citation == nil {
+		if n.hasAncestor("search-report-data") {
+			return CitedBySearch
+		}
+		return CitedByApplicant
+	}
+	phase := strings.ToLower(strings.TrimSpace(citation.attrValue("srep-phase")))
+	if phase == "" {
+		return CitedBySearch
+	}
+	return CitationPhase(phase)
+}
+
+// citationRelevance returns the categories and the relevant claims of a patcit or nplcit
+/*
+	<citation id="sr-cit0001">
+		<patcit id="sr-pcit0001" dnum="US2012281566A1">...</patcit>
+		<category>X</category>
+		<rel-claims>1-15</rel-claims>
+	</citation>
+*/
+func citationRelevance(n *xmlNode) (categories []string, relClaims string) {
+	scope := n.ancestor("citation")
+	if scope == nil {
+		scope = n
+	}
+	for _, c := range scope.find("category") {
+		category := strings.ToUpper(strings.TrimSpace(c.text()))
+		if category == "" || containsString(categories, category) {
+			continue
+		}
+		categories = append(categories, category)
+	}
+	var claims []string
+	for _, c := range scope.find("rel-claims") {
+		if text := strings.TrimSpace(c.text()); text != "" {
+			claims = append(claims, text)
+		}
+	}
+	relClaims = strings.Join(claims, ", ")
+	return
+}
+
+// newCitation transforms a patcit element with a document-id into a Citation
+/*
+	<patcit id="ref-pcit0001" dnum="US20120281566A">
+		<document-id>
+			<country>US</country>
+			<doc-number>20120281566</doc-number>
+			<kind>A</kind>
+		</document-id>
+	</patcit>
+*/
+func newCitation(n *xmlNode) (c Citation) {
+	c.Country = Country(strings.ToUpper(strings.TrimSpace(n.pathText("document-id", "country"))))
+	c.DocNumber = strings.TrimSpace(n.pathText("document-id", "doc-number"))
+	c.Kind = strings.TrimSpace(n.pathText("document-id", "kind"))
+	c.Phase = citationPhase(n)
+	c.Categories, c.RelevantClaims = citationRelevance(n)
+	return
+}
+
+// newNplCitation transforms a nplcit element into a NplCitation
+/*
+	<nplcit id="ref-ncit0001" npl-type="s">
+		<article>
+			<author><name>M. Suzuki et al.</name></author>
+			<atl>An optical-heterodyne alignment technique ...</atl>
+			<serial>
+				<sertitle>Journal of Vacuum Science &amp; Technology: Part B</sertitle>
+				<pubdate><sdate>19950000</sdate><edate/></pubdate>
+				<vid>7</vid>
+				<ino>6</ino>
+			</serial>
+			<location><pp><ppf>1971</ppf><ppl>1976</ppl></pp></location>
+		</article>
+	</nplcit>
+*/
+func newNplCitation(n *xmlNode) (c NplCitation) {
+	c.ID = n.attrValue("id")
+	c.Type = n.attrValue("npl-type")
+	c.URL = n.attrValue("url")
+	c.File = n.attrValue("file")
+	c.Phase = citationPhase(n)
+	c.Categories, c.RelevantClaims = citationRelevance(n)
+
+	if texts := n.childrenNamed("text"); len(texts) > 0 {
+		var sb strings.Builder
+		for _, t := range texts {
+			sb.WriteString(t.text())
+		}
+		c.Text = strings.TrimSpace(sb.String())
+		c.DOI = reDOI.FindString(c.Text)
+		return
+	}
+
+	for _, a := range n.find("author") {
+		if name := nameGroup(a); name != "" {
+			c.Authors = append(c.Authors, name)
+		}
+	}
+	c.Title = strings.TrimSpace(n.findFirst("atl", "online-title").text())
+	c.Journal = strings.TrimSpace(n.findFirst("sertitle", "hosttitle").text())
+	c.BookTitle = strings.TrimSpace(n.findFirst("book-title").text())
+	if date := strings.TrimSpace(n.findFirst("sdate").text()); len(date) >= 4 {
+		c.Year = date[:4]
+	}
+	c.Volume = strings.TrimSpace(n.findFirst("vid").text())
+	c.Issue = strings.TrimSpace(n.findFirst("ino").text())
+	first := strings.TrimSpace(n.findFirst("ppf").text())
+	last := strings.TrimSpace(n.findFirst("ppl").text())
+	if first != "" && last != "" {
+		c.Pages = first + "-" + last
+	} else {
+		c.Pages = first + last
+	}
+	c.DOI = strings.TrimSpace(n.findFirst("doi").text())
+	if c.URL == "" {
+		c.URL = strings.TrimSpace(n.findFirst("avail").text())
+	}
+	return
+}
+
+// processNplCitations transforms the nplcit elements of the document.
+// If a citation is listed in the reference list (id prefixed with "ref-"),
+// the structured reference list entry is used instead of the inline citation.
+func processNplCitations(citations []*xmlNode) (res []NplCitation) {
+	ids := map[string]bool{}
+	for _, c := range citations {
+		if id, ok := c.attr("id"); ok {
+			ids[id] = true
+		}
+	}
+	for _, c := range citations {
+		id := c.attrValue("id")
+		if id != "" && ids["ref-"+id] {
+			continue
+		}
+		res = append(res, newNplCitation(c))
+	}
+	return
+}
+
+// newPriorArtCitation parses the text of a B561 element
+// e.g. EP-A1- 0 444 678, ok is false if the text is not a patent citation
+func newPriorArtCitation(text string) (c Citation, ok bool) {
+	c.Text = strings.TrimSpace(text)
+	c.Phase = CitedBySearch
+	regexRes := rePriorArtPatent.FindStringSubmatch(c.Text)
+	if len(regexRes) != 4 {
+		return
+	}
+	c.Country = Country(regexRes[1])
+	c.Kind = regexRes[2]
+	c.DocNumber = strings.Join(strings.Fields(regexRes[3]), "")
+	return c, true
+}
+
+// processPriorArt adds the list of prior art documents (B560) to p
+/*
+	<B560>
+		<B561><text>EP-A1- 0 444 678</text></B561>
+		<B562><text>KANG, SOON BANG ET AL: "An improved synthesis of levofloxacin" ...</text></B562>
+		<B565EP><date>20050511</date></B565EP>
+	</B560>
+*/
+func processPriorArt(n *xmlNode, p *PriorArt, w *parseWarnings) {
+	// B563 and B564 refer to the preceding citation
+	var last func(category, claims string)
+	for _, c := range n.elements() {
+		switch c.Name {
+		case "B561":
+			citation, ok := newPriorArtCitation(c.pathText("text"))
+			if !ok {
+				w.add(SeverityWarning, "PriorArt", c.path()+"/text", citation.Text, "can not parse prior art citation")
+			}
+			p.Citations = append(p.Citations, citation)
+			idx := len(p.Citations) - 1
+			last = func(category, claims string) {
+				if category != "" {
+					p.Citations[idx].Categories = append(p.Citations[idx].Categories, category)
+				}
+				if claims != "" {
+					p.Citations[idx].RelevantClaims = claims
+				}
+			}
+		case "B562":
+			text := strings.TrimSpace(c.pathText("text"))
+			p.NplCitations = append(p.NplCitations, NplCitation{
+				Text:  text,
+				DOI:   reDOI.FindString(text),
+				Phase: CitedBySearch,
+			})
+			idx := len(p.NplCitations) - 1
+			last = func(category, claims string) {
+				if category != "" {
+					p.NplCitations[idx].Categories = append(p.NplCitations[idx].Categories, category)
+				}
+				if claims != "" {
+					p.NplCitations[idx].RelevantClaims = claims
+				}
+			}
+		case "B563":
+			if last != nil {
+				last(strings.ToUpper(strings.TrimSpace(c.text())), "")
+			}
+		case "B564":
+			if last != nil {
+				last("", strings.TrimSpace(c.text()))
+			}
+		case "B565":
+			p.SearchReportDate = w.childDate("PriorArt", c)
+		case "B565EP":
+			p.SupplementarySearchReportDate = w.childDate("PriorArt", c)
+		}
+	}
+}
+
+// nameGroup returns the name of a name group (name | last-name, first-name)
+func nameGroup(n *xmlNode) string {
+	name := strings.TrimSpace(n.findFirst("name").text())
+	if name != "" {
+		return name
+	}
+	lastName := strings.TrimSpace(n.findFirst("last-name", "orgname").text())
+	firstName := strings.TrimSpace(n.findFirst("first-name").text())
+	return strings.TrimSpace(firstName + " " + lastName)
+}
+
+// containsString checks if the slice contains the string
+func containsString(slice []string, s string) bool {
+	for _, item := range slice {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
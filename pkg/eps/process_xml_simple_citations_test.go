@@ -0,0 +1,92 @@
+package eps
+
+import (
+	"github.com/stretchr/testify/assert"
+	"os"
+	"testing"
+)
+
+func TestProcessXMLSimplePriorArt(t *testing.T) {
+	ass := assert.New(t)
+	data, err := os.ReadFile("test-data/grant/v1-4-B2.xml")
+	ass.NoError(err)
+	patDoc, err := ProcessXMLSimple(data)
+	ass.NoError(err)
+
+	ass.Equal(6, len(patDoc.PriorArt.Citations))
+	ass.Equal("EP-A1- 0 444 678", patDoc.PriorArt.Citations[0].Text)
+	ass.Equal(Country("EP"), patDoc.PriorArt.Citations[0].Country)
+	ass.Equal("0444678", patDoc.PriorArt.Citations[0].DocNumber)
+	ass.Equal("A1", patDoc.PriorArt.Citations[0].Kind)
+	ass.Equal(CitedBySearch, patDoc.PriorArt.Citations[0].Phase)
+	ass.Equal(Country("WO"), patDoc.PriorArt.Citations[1].Country)
+	ass.Equal("01/18005", patDoc.PriorArt.Citations[1].DocNumber)
+	ass.Equal("A", patDoc.PriorArt.Citations[1].Kind)
+
+	ass.Equal(1, len(patDoc.PriorArt.NplCitations))
+	ass.Contains(patDoc.PriorArt.NplCitations[0].Text, "An improved synthesis of levofloxacin")
+	ass.Equal(CitedBySearch, patDoc.PriorArt.NplCitations[0].Phase)
+
+	ass.True(patDoc.PriorArt.SearchReportDate.IsZero())
+	ass.Equal("20050511", patDoc.PriorArt.SupplementarySearchReportDate.Format(layoutDatePubl))
+}
+
+func TestProcessXMLSimpleNplCitations(t *testing.T) {
+	ass := assert.New(t)
+	data, err := os.ReadFile("test-data/grant/v1-4-B1.xml")
+	ass.NoError(err)
+	patDoc, err := ProcessXMLSimple(data)
+	ass.NoError(err)
+
+	// the inline citation is replaced by the reference list entry
+	ass.Equal(1, len(patDoc.NplCitations))
+	c := patDoc.NplCitations[0]
+	ass.Equal("ref-ncit0001", c.ID)
+	ass.Equal("s", c.Type)
+	ass.Empty(c.Text)
+	ass.Equal([]string{"M. Suzuki et al."}, c.Authors)
+	ass.Equal("An optical-heterodyne alignment technique for quarter-micron x-ray lithography", c.Title)
+	ass.Equal("Journal of Vacuum Science & Technology: Part B", c.Journal)
+	ass.Equal("7", c.Volume)
+	ass.Equal("6", c.Issue)
+	ass.Equal("1971-1976", c.Pages)
+	ass.Equal(CitedByApplicant, c.Phase)
+}
+
+func TestProcessXMLSimpleNplCitationsYear(t *testing.T) {
+	ass := assert.New(t)
+	data, err := os.ReadFile("test-data/grant/v1-4-B2.xml")
+	ass.NoError(err)
+	patDoc, err := ProcessXMLSimple(data)
+	ass.NoError(err)
+
+	ass.Equal(1, len(patDoc.NplCitations))
+	ass.Equal("1995", patDoc.NplCitations[0].Year)
+	ass.Equal("Chem. Pharm. Bull.", patDoc.NplCitations[0].Journal)
+}
+
+func TestProcessXMLSimpleSearchReportCitations(t *testing.T) {
+	ass := assert.New(t)
+	data := []byte(`<?xml version="1.0" encoding="UTF-8"?>
+<ep-patent-document id="EP12345678A1" file="12345678.xml" lang="en" country="EP" doc-number="1234567" kind="A1" date-publ="20200101" status="n" dtd-version="ep-patent-document-v1-5">
+<search-report-data id="srep" lang="en" srep-office="EP" date-produced="20191201">
+<srep-for-pub><srep-citations>
+<citation id="sr-cit0001"><patcit id="sr-pcit0001" dnum="US2012281566A1"><document-id><country>US</country><doc-number>2012281566</doc-number><kind>A1</kind></document-id></patcit><category>X</category><rel-claims>1-15</rel-claims></citation>
+<citation id="sr-cit0002" srep-phase="examination"><nplcit id="sr-ncit0001" npl-type="s"><text>SMITH J: "Something", NATURE, 2010, doi:10.1038/nature0001</text></nplcit><category>Y</category><category>A</category><rel-claims>3</rel-claims></citation>
+</srep-citations></srep-for-pub>
+</search-report-data>
+</ep-patent-document>`)
+	patDoc, err := ProcessXMLSimple(data)
+	ass.NoError(err)
+
+	ass.Equal(1, len(patDoc.Citations))
+	ass.Equal(CitedBySearch, patDoc.Citations[0].Phase)
+	ass.Equal([]string{"X"}, patDoc.Citations[0].Categories)
+	ass.Equal("1-15", patDoc.Citations[0].RelevantClaims)
+
+	ass.Equal(1, len(patDoc.NplCitations))
+	ass.Equal(CitationPhase("examination"), patDoc.NplCitations[0].Phase)
+	ass.Equal([]string{"Y", "A"}, patDoc.NplCitations[0].Categories)
+	ass.Equal("3", patDoc.NplCitations[0].RelevantClaims)
+	ass.Equal("10.1038/nature0001", patDoc.NplCitations[0].DOI)
+}
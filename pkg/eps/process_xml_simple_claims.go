@@ -0,0 +1,75 @@
+package eps
+
+import (
+	"strings"
+)
+
+// claimItems returns the single claims of a set of claims
+/*
+	<claims id="claims01" lang="en">
+		<claim id="c-en-0001" num="0001">
+			<claim-text>Here is the first claim ... </claim-text>
+		</claim>
+	</claims>
+*/
+func claimItems(c *textCollector) (res []ClaimItem) {
+	for _, claim := range c.childrenNamed("claim") {
+		res = append(res, ClaimItem{
+			Id:   claim.attrValue("id"),
+			Num:  strings.TrimSpace(claim.attrValue("num")),
+			Text: strings.TrimSpace(claim.text.String()),
+		})
+	}
+	return
+}
+
+// newAmendedClaims transforms an amended-claims element
+/*
+	<amended-claims id="aclaims" lang="en" amend-claim-type="PCT">
+		<heading>Amended claims under Art. 19.1 PCT</heading>
+		<claim id="ac-en-0001" num="0001"><claim-text>...</claim-text></claim>
+		<amended-claims-statement id="asclaims" lang="en">
+			<claims-statement>
+				<heading>Statement under Art. 19.1 PCT</heading>
+				<p>In the claim 12 of the claims, ...</p>
+			</claims-statement>
+		</amended-claims-statement>
+	</amended-claims>
+*/
+func newAmendedClaims(c *textCollector) (a AmendedClaims) {
+	a.Id = c.attrValue("id")
+	a.Language = strings.ToLower(strings.TrimSpace(c.attrValue("lang")))
+	a.Type = strings.TrimSpace(c.attrValue("amend-claim-type"))
+	a.Status = c.attrValue("status")
+	a.Heading = joinHeadings(c)
+	a.Text = strings.TrimSpace(c.text.String())
+	a.Items = claimItems(c)
+	for _, s := range c.childrenNamed("amended-claims-statement") {
+		statement := newClaimsStatement(s)
+		if statement.Language == "" {
+			statement.Language = a.Language
+		}
+		a.Statements = append(a.Statements, statement)
+	}
+	return
+}
+
+// newClaimsStatement transforms an amended-claims-statement element
+func newClaimsStatement(c *textCollector) (s ClaimsStatement) {
+	s.Id = c.attrValue("id")
+	s.Language = strings.ToLower(strings.TrimSpace(c.attrValue("lang")))
+	s.Heading = joinHeadings(c)
+	s.Text = strings.TrimSpace(c.text.String())
+	return
+}
+
+// joinHeadings returns the text of the headings of the collector
+func joinHeadings(c *textCollector) string {
+	var headings []string
+	for _, h := range c.childrenNamed("heading") {
+		if text := strings.TrimSpace(h.text.String()); text != "" {
+			headings = append(headings, text)
+		}
+	}
+	return strings.Join(headings, "\n")
+}
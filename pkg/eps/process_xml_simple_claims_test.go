@@ -0,0 +1,92 @@
+package eps
+
+import (
+	"github.com/stretchr/testify/assert"
+	"os"
+	"testing"
+)
+
+func TestProcessXMLSimpleClaimItems(t *testing.T) {
+	ass := assert.New(t)
+	data, err := os.ReadFile("test-data/grant/v1-5-B1.xml")
+	ass.NoError(err)
+	doc, err := ProcessXMLSimple(data)
+	ass.NoError(err)
+	ass.Len(doc.Claims, 3)
+	ass.Len(doc.Claims[0].Items, 12)
+	ass.Equal("c-en-01-0001", doc.Claims[0].Items[0].Id)
+	ass.Equal("0001", doc.Claims[0].Items[0].Num)
+	ass.Equal(1, doc.Claims[0].Items[0].Number())
+	ass.Empty(doc.AmendedClaims)
+	ass.Empty(doc.ClaimsStatements)
+}
+
+func TestProcessXMLSimpleAmendedClaims(t *testing.T) {
+	ass := assert.New(t)
+	data := []byte(`<ep-patent-document id="EP1367825A1" lang="en" country="EP" doc-number="1367825" kind="A1" date-publ="20031203" dtd-version="ep-patent-document-v1-5">
+<abstract id="abst" lang="en"><p>Abstract</p></abstract>
+<description id="desc" lang="en"><p>Description</p></description>
+<claims id="claims01" lang="en">
+	<claim id="c-en-0001" num="0001"><claim-text>An electroless plating method.</claim-text></claim>
+</claims>
+<amended-claims id="aclaims" lang="en" amend-claim-type="PCT">
+	<heading>Amended claims under Art. 19.1 PCT</heading>
+	<claim id="ac-en-0001" num="0001"><claim-text>An electroless plating method comprising <b>a step</b>.</claim-text></claim>
+	<claim id="ac-en-0002" num="0002"><claim-text>The method of claim 1.</claim-text></claim>
+	<amended-claims-statement id="asclaims">
+		<claims-statement>
+			<heading>Statement under Art. 19.1 PCT</heading>
+			<p>In the claim 1 of the claims, a step is added.</p>
+		</claims-statement>
+	</amended-claims-statement>
+</amended-claims>
+<amended-claims-statement id="asclaims02" lang="de">
+	<claims-statement><heading>Erklärung gemäss Artikel 19.1 PCT</heading><p>Anspruch 1 wurde geändert.</p></claims-statement>
+</amended-claims-statement>
+</ep-patent-document>`)
+	doc, err := ProcessXMLSimple(data)
+	ass.NoError(err)
+
+	// the amended claims are not part of the claims
+	ass.Len(doc.Claims, 1)
+	ass.Len(doc.Claims[0].Items, 1)
+	ass.Equal("An electroless plating method.", doc.Claims[0].Text)
+
+	ass.Len(doc.AmendedClaims, 1)
+	amended := doc.AmendedClaims[0]
+	ass.Equal("aclaims", amended.Id)
+	ass.Equal("en", amended.Language)
+	ass.Equal("PCT", amended.Type)
+	ass.Equal("Amended claims under Art. 19.1 PCT", amended.Heading)
+	ass.NotContains(amended.Text, "Amended claims")
+	ass.NotContains(amended.Text, "Statement")
+	ass.Equal([]ClaimItem{
+		{Id: "ac-en-0001", Num: "0001", Text: "An electroless plating method comprising a step."},
+		{Id: "ac-en-0002", Num: "0002", Text: "The method of claim 1."},
+	}, amended.Items)
+	ass.Equal([]ClaimsStatement{{
+		Id:       "asclaims",
+		Language: "en",
+		Heading:  "Statement under Art. 19.1 PCT",
+		Text:     "In the claim 1 of the claims, a step is added.",
+	}}, amended.Statements)
+
+	ass.Equal([]ClaimsStatement{{
+		Id:       "asclaims02",
+		Language: "de",
+		Heading:  "Erklärung gemäss Artikel 19.1 PCT",
+		Text:     "Anspruch 1 wurde geändert.",
+	}}, doc.ClaimsStatements)
+
+	a, ok := doc.AmendedClaimsIn("de")
+	ass.True(ok)
+	ass.Equal("aclaims", a.Id)
+}
+
+func TestClaimItemReferences(t *testing.T) {
+	ass := assert.New(t)
+	ass.Equal([]int{1, 2, 3}, ClaimItem{Num: "0004", Text: "A process according to one or more of claims 1 to 3"}.References())
+	ass.Equal([]int{1, 5}, ClaimItem{Num: "0006", Text: "Verfahren nach Anspruch 1 oder 5"}.References())
+	ass.Equal([]int{2, 4}, ClaimItem{Num: "0007", Text: "Procédé selon l'une des revendications 2, 4 et 7"}.References())
+	ass.Empty(ClaimItem{Num: "0001", Text: "A container-closure system comprising"}.References())
+}
@@ -0,0 +1,142 @@
+package eps
+
+import (
+	"strings"
+	"unicode"
+)
+
+// textField returns the field and the language of the text that is collected at the moment
+func (p *simpleParser) textField() (field, lang string) {
+	if len(p.collecting) == 0 {
+		return
+	}
+	outer := p.collecting[0]
+	return collectorFields[outer.name], strings.ToLower(strings.TrimSpace(outer.attrValue("lang")))
+}
+
+// processEntities collects the chemistry and bio-deposit elements of the subtree
+func (p *simpleParser) processEntities(n *xmlNode) {
+	var entities []*xmlNode
+	if n.is("chemistry", "bio-deposit") {
+		entities = append(entities, n)
+	}
+	entities = append(entities, n.find("chemistry", "bio-deposit")...)
+	field, lang := p.textField()
+	for _, e := range entities {
+		if e.is("chemistry") {
+			c := newChemistry(e)
+			c.Field, c.Language = field, lang
+			p.doc.Chemistry = append(p.doc.Chemistry, c)
+			continue
+		}
+		d := newBioDeposit(e, p.warnings)
+		d.Field, d.Language = field, lang
+		p.doc.BioDeposits = append(p.doc.BioDeposits, d)
+	}
+}
+
+// newChemistry transforms a chemistry element
+/*
+	<chemistry id="chem0001" num="0001">
+		<img id="ib0001" file="imgb0001.tif" wi="59" he="27" img-content="chem" img-format="tif"/>
+	</chemistry>
+	<chemistry id="chem0002" num="0002"><formula-text>H<sub>2</sub>SO<sub>4</sub></formula-text></chemistry>
+*/
+func newChemistry(n *xmlNode) (c Chemistry) {
+	c.ID = n.attrValue("id")
+	c.Num = n.attrValue("num")
+	if img := n.child("img"); img != nil {
+		c.Image = img.attrValue("file")
+	}
+	if chem := n.child("chem"); chem != nil {
+		c.ChemFile = chem.attrValue("file")
+		c.ChemType = chem.attrValue("chem-type")
+	}
+	c.FormulaText = collapseSpaces(n.pathText("formula-text"))
+	return
+}
+
+// newBioDeposit transforms a bio-deposit element
+/*
+	<bio-deposit id="dep0001" num="0001">
+		<depositary>Deutsche Sammlung von Mikroorganismen und Zellkulturen</depositary>
+		<bio-accno>DSM 12345</bio-accno>
+		<date>20200101</date>
+	</bio-deposit>
+*/
+func newBioDeposit(n *xmlNode, w *parseWarnings) (d BioDeposit) {
+	d.ID = n.attrValue("id")
+	d.Num = n.attrValue("num")
+	d.URL = n.attrValue("url")
+	d.DNum = n.attrValue("dnum")
+	d.Depositary = collapseSpaces(n.pathText("depositary"))
+	d.AccessionNumber = collapseSpaces(n.pathText("bio-accno"))
+	if n.child("date") != nil {
+		d.Date = w.childDate("BioDeposits", n)
+	}
+	d.Term = collapseSpaces(n.pathText("term"))
+	d.Text = collapseSpaces(n.pathText("dtext"))
+	return
+}
+
+// newB830BioDeposit transforms the deposit of the bibliographic data
+/*
+	<B830>
+		<B831>DSMZ</B831>
+		<B832>DSM 12345</B832>
+		<B833><date>20200101</date></B833>
+	</B830>
+*/
+func newB830BioDeposit(n *xmlNode, w *parseWarnings) (d BioDeposit) {
+	d.Field = "B830"
+	d.Depositary = collapseSpaces(n.pathText("B831"))
+	d.AccessionNumber = collapseSpaces(n.pathText("B832"))
+	if b833 := n.child("B833"); b833 != nil {
+		d.Date = w.childDate("BioDeposits", b833)
+	}
+	return
+}
+
+// newSequenceListText transforms a sequence-list-text element
+/*
+	<sequence-list-text id="seql0001">
+		<heading>SEQUENCE LISTING</heading>
+		<p id="p0050" num="0050">...</p>
+	</sequence-list-text>
+*/
+func newSequenceListText(n *xmlNode) (s SequenceListText) {
+	s.ID = n.attrValue("id")
+	var headings, paragraphs []string
+	for _, c := range n.childrenNamed("heading", "p") {
+		text := collapseSpaces(c.text())
+		if text == "" {
+			continue
+		}
+		if c.is("heading") {
+			headings = append(headings, text)
+		} else {
+			paragraphs = append(paragraphs, text)
+		}
+	}
+	s.Heading = strings.Join(headings, "\n")
+	s.Text = strings.Join(paragraphs, "\n")
+	return
+}
+
+// collapseSpaces replaces the whitespace of the text by single spaces
+func collapseSpaces(text string) string {
+	// most texts are already collapsed and are returned without allocation
+	collapsed, space := true, true
+	for _, r := range text {
+		isSpace := unicode.IsSpace(r)
+		if isSpace && (r != ' ' || space) {
+			collapsed = false
+			break
+		}
+		space = isSpace
+	}
+	if collapsed && (!space || text == "") {
+		return text
+	}
+	return strings.Join(strings.Fields(text), " ")
+}
@@ -0,0 +1,108 @@
+package eps
+
+import (
+	"github.com/stretchr/testify/assert"
+	"os"
+	"testing"
+	"time"
+)
+
+const entitiesTestDocument = `<?xml version="1.0" encoding="UTF-8"?>
+<ep-patent-document id="EP00000002B1" file="EP00000002NWB1.xml" lang="en" country="EP" doc-number="00000002" kind="B1" date-publ="20200101" status="n" dtd-version="ep-patent-document-v1-5-1">
+<SDOBI lang="en">
+<B800><B830><B831>DSMZ</B831><B832>DSM 32456</B832><B833><date>20170412</date></B833></B830></B800>
+</SDOBI>
+<description id="desc" lang="en">
+<p id="p0001" num="0001">The strain
+<bio-deposit id="dep0001" num="0001" dnum="DSM32456"><depositary>Deutsche Sammlung von Mikroorganismen
+und Zellkulturen</depositary><bio-accno>DSM 32456</bio-accno><date>20170412</date><term>30 years</term><dtext>Bacillus subtilis</dtext></bio-deposit>
+produces sulfuric acid
+<chemistry id="chem0001" num="0001"><formula-text>H<sub>2</sub>SO<sub>4</sub></formula-text></chemistry>.</p>
+<p id="p0002" num="0002"><chemistry id="chem0002" num="0002"><chem id="cm0002" file="chem0002.mol" chem-type="mol"/><img id="ib0002" file="imgb0002.tif"/></chemistry></p>
+<sequence-list-text id="seql0001">
+<heading>SEQUENCE LISTING</heading>
+<p id="p0003" num="0003">&lt;110&gt; Example GmbH</p>
+<p id="p0004" num="0004">&lt;210&gt; 1 &lt;211&gt; 12 &lt;212&gt; DNA</p>
+</sequence-list-text>
+</description>
+<bio-deposit num="0002"><depositary>ATCC</depositary><bio-accno>PTA-1234</bio-accno><date>2017</date></bio-deposit>
+</ep-patent-document>`
+
+func TestProcessXMLSimpleEntities(t *testing.T) {
+	ass := assert.New(t)
+	doc, err := ProcessXMLSimple([]byte(entitiesTestDocument))
+	ass.NoError(err)
+
+	ass.True(doc.HasBioDeposits())
+	ass.Equal([]string{"DSM 32456", "DSM 32456", "PTA-1234"}, doc.AccessionNumbers())
+	if ass.Len(doc.BioDeposits, 3) {
+		ass.Equal(BioDeposit{
+			Field:           "B830",
+			Depositary:      "DSMZ",
+			AccessionNumber: "DSM 32456",
+			Date:            time.Date(2017, 4, 12, 0, 0, 0, 0, time.UTC),
+		}, doc.BioDeposits[0])
+		ass.Equal(BioDeposit{
+			ID:              "dep0001",
+			Num:             "0001",
+			Field:           "Description",
+			Language:        "en",
+			DNum:            "DSM32456",
+			Depositary:      "Deutsche Sammlung von Mikroorganismen und Zellkulturen",
+			AccessionNumber: "DSM 32456",
+			Date:            time.Date(2017, 4, 12, 0, 0, 0, 0, time.UTC),
+			Term:            "30 years",
+			Text:            "Bacillus subtilis",
+		}, doc.BioDeposits[1])
+		// invalid dates result in a warning
+		ass.True(doc.BioDeposits[2].Date.IsZero())
+		ass.Equal("", doc.BioDeposits[2].Field)
+	}
+	ass.Contains(doc.Warnings, ParseWarning{
+		Field:    "BioDeposits",
+		Path:     "/ep-patent-document/bio-deposit/date",
+		Value:    "2017",
+		Severity: SeverityWarning,
+		Message:  "can not parse date",
+	})
+
+	if ass.Len(doc.Chemistry, 2) {
+		ass.Equal(Chemistry{ID: "chem0001", Num: "0001", Field: "Description", Language: "en", FormulaText: "H2SO4"}, doc.Chemistry[0])
+		ass.Equal(Chemistry{ID: "chem0002", Num: "0002", Field: "Description", Language: "en", Image: "imgb0002.tif", ChemFile: "chem0002.mol", ChemType: "mol"}, doc.Chemistry[1])
+	}
+
+	ass.True(doc.HasSequenceListing())
+	ass.Equal([]SequenceListText{{
+		ID:       "seql0001",
+		Field:    "Description",
+		Language: "en",
+		Heading:  "SEQUENCE LISTING",
+		Text:     "<110> Example GmbH\n<210> 1 <211> 12 <212> DNA",
+	}}, doc.SequenceListTexts)
+	// the texts are kept in the description
+	ass.Contains(doc.Description[0].Text, "Bacillus subtilis")
+	ass.Contains(doc.Description[0].Text, "<110> Example GmbH")
+}
+
+func TestProcessXMLSimpleChemistry(t *testing.T) {
+	ass := assert.New(t)
+	data, err := os.ReadFile("test-data/application/v1-5-1-A1.xml")
+	ass.NoError(err)
+	doc, err := ProcessXMLSimple(data)
+	ass.NoError(err)
+	ass.Len(doc.Chemistry, 47)
+	ass.Equal(Chemistry{ID: "chema01", Num: "0001", Field: "Abstract", Language: "en", Image: "imga0001.tif"}, doc.Chemistry[0])
+	ass.False(doc.HasBioDeposits())
+	ass.False(doc.HasSequenceListing())
+
+	// formulas in tables
+	var inTables int
+	for _, c := range doc.Chemistry {
+		if c.ID == "chem0006" {
+			inTables++
+			ass.Equal("imgb0006.tif", c.Image)
+			ass.Equal("Description", c.Field)
+		}
+	}
+	ass.Equal(1, inTables)
+}
@@ -0,0 +1,119 @@
+package eps
+
+import (
+	"encoding/xml"
+	"strconv"
+	"strings"
+)
+
+// paragraph is an open paragraph or claim of the texts
+type paragraph struct {
+	depth int
+	id    string
+	num   string
+}
+
+// figureRef is a reference to one or several figures, the figures are linked at the end of the document
+type figureRef struct {
+	idrefs    []string
+	reference FigureReference
+}
+
+// startParagraph keeps the open paragraphs and claims of the texts
+func (p *simpleParser) startParagraph(name string, attr []xml.Attr) {
+	if (name != "p" && name != "claim") || len(p.collecting) == 0 {
+		return
+	}
+	n := &xmlNode{Attr: attr}
+	p.paragraphs = append(p.paragraphs, paragraph{depth: p.depth, id: n.attrValue("id"), num: n.attrValue("num")})
+}
+
+// endParagraph closes the paragraph of the current element
+func (p *simpleParser) endParagraph() {
+	if len(p.paragraphs) > 0 && p.paragraphs[len(p.paragraphs)-1].depth == p.depth {
+		p.paragraphs = p.paragraphs[:len(p.paragraphs)-1]
+	}
+}
+
+// processFigrefs collects the references to figures of the subtree
+/*
+	<p id="p0007" num="0007"><figref idref="f0001">Figure 1</figref> is an exploded view ...</p>
+*/
+func (p *simpleParser) processFigrefs(n *xmlNode) {
+	var figrefs []*xmlNode
+	if n.is("figref") {
+		figrefs = append(figrefs, n)
+	}
+	figrefs = append(figrefs, n.find("figref")...)
+	field, lang := p.textField()
+	var par paragraph
+	if len(p.paragraphs) > 0 {
+		par = p.paragraphs[len(p.paragraphs)-1]
+	}
+	for _, f := range figrefs {
+		p.figrefs = append(p.figrefs, figureRef{
+			idrefs: strings.Fields(f.attrValue("idref")),
+			reference: FigureReference{
+				Field:        field,
+				Language:     lang,
+				ParagraphID:  par.id,
+				ParagraphNum: par.num,
+				Text:         collapseSpaces(f.text()),
+			},
+		})
+	}
+}
+
+// processDrawings adds the figures of the drawings
+/*
+	<drawings id="draw" lang="en">
+		<figure id="f0001" num="1"><img id="if0001" file="imgf0001.tif" wi="143" he="198" img-content="drawing" img-format="tif"/></figure>
+	</drawings>
+*/
+func (p *simpleParser) processDrawings(n *xmlNode) {
+	lang := strings.ToLower(strings.TrimSpace(n.attrValue("lang")))
+	for _, f := range n.childrenNamed("figure") {
+		p.doc.Figures = append(p.doc.Figures, Figure{
+			ID:       f.attrValue("id"),
+			Num:      strings.TrimSpace(f.attrValue("num")),
+			Labels:   f.attrValue("figure-labels"),
+			Language: lang,
+			Image:    newImage(f.child("img")),
+		})
+	}
+}
+
+// newImage transforms an img element
+func newImage(n *xmlNode) (img Image) {
+	if n == nil {
+		return
+	}
+	img.ID = n.attrValue("id")
+	img.File = n.attrValue("file")
+	img.Format = n.attrValue("img-format")
+	img.Content = n.attrValue("img-content")
+	img.Width, _ = strconv.ParseFloat(strings.TrimSpace(n.attrValue("wi")), 64)
+	img.Height, _ = strconv.ParseFloat(strings.TrimSpace(n.attrValue("he")), 64)
+	img.Orientation = n.attrValue("orientation")
+	return
+}
+
+// linkFigures adds the references in the texts to the figures
+func (p *simpleParser) linkFigures() {
+	figures := map[string]int{}
+	for i, f := range p.doc.Figures {
+		if f.ID != "" {
+			figures[f.ID] = i
+		}
+	}
+	for _, ref := range p.figrefs {
+		for _, id := range ref.idrefs {
+			i, ok := figures[id]
+			if !ok {
+				p.warnings.add(SeverityInfo, "Figures", "/ep-patent-document/drawings", id, "reference to an unknown figure")
+				continue
+			}
+			p.doc.Figures[i].References = append(p.doc.Figures[i].References, ref.reference)
+		}
+	}
+}
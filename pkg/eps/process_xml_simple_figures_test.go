@@ -0,0 +1,70 @@
+package eps
+
+import (
+	"github.com/stretchr/testify/assert"
+	"os"
+	"testing"
+)
+
+func TestProcessXMLSimpleFigures(t *testing.T) {
+	ass := assert.New(t)
+	data, err := os.ReadFile("test-data/grant/v1-5-1-B1.xml")
+	ass.NoError(err)
+	doc, err := ProcessXMLSimple(data)
+	ass.NoError(err)
+	if !ass.Len(doc.Figures, 7) {
+		return
+	}
+	figure := doc.Figures[0]
+	ass.Equal("f0001", figure.ID)
+	ass.Equal("1", figure.Num)
+	ass.Equal("en", figure.Language)
+	ass.Equal(Image{ID: "if0001", File: "imgf0001.tif", Format: "tif", Content: "drawing", Width: 143, Height: 198}, figure.Image)
+	ass.Equal(FigureReference{Field: "Description", Language: "en", ParagraphID: "p0007", ParagraphNum: "0007", Text: "Figure 1"}, figure.References[0])
+	ass.Equal([]string{"p0007", "p0008", "p0009", "p0010"}, figure.Paragraphs())
+	// figures with several parts
+	ass.Equal("5A,5B", doc.Figures[4].Num)
+	ass.Equal([]string{"p0007", "p0014"}, doc.Figures[4].Paragraphs())
+
+	figure, ok := doc.FigureByFile("IMGF0007.TIF")
+	ass.True(ok)
+	ass.Equal("f0007", figure.ID)
+	_, ok = doc.FigureByFile("imgf0008.tif")
+	ass.False(ok)
+}
+
+func TestProcessXMLSimpleFigureReferences(t *testing.T) {
+	ass := assert.New(t)
+	data := `<ep-patent-document id="EP00000003A1" lang="en" country="EP" doc-number="00000003" kind="A1" date-publ="20200101" dtd-version="ep-patent-document-v1-5-1">
+<description lang="en"><p id="p0001" num="0001">As shown in <figref idref="f0001 f0002">Figs. 1 and 2</figref> and <figref idref="f0009">Fig. 9</figref>.</p></description>
+<claims id="claims01" lang="en"><claim id="c-en-0001" num="0001"><claim-text>A device according to <figref idref="f0002">Fig. 2</figref>.</claim-text></claim></claims>
+<drawings id="draw" lang="en">
+<figure id="f0001" num="1"><img id="if0001" file="imgf0001.tif" wi="100.5" he="50" img-content="drawing" img-format="tif" orientation="landscape"/></figure>
+<figure id="f0002" num="2" figure-labels="Fig. 2"><img id="if0002" file="imgf0002.tif" wi="100" he="50" img-content="drawing" img-format="tif"/></figure>
+</drawings>
+</ep-patent-document>`
+	doc, err := ProcessXMLSimple([]byte(data))
+	ass.NoError(err)
+	if !ass.Len(doc.Figures, 2) {
+		return
+	}
+	ass.Equal(100.5, doc.Figures[0].Image.Width)
+	ass.Equal("landscape", doc.Figures[0].Image.Orientation)
+	ass.Equal("Fig. 2", doc.Figures[1].Labels)
+	// references to several figures
+	ass.Equal([]FigureReference{{Field: "Description", Language: "en", ParagraphID: "p0001", ParagraphNum: "0001", Text: "Figs. 1 and 2"}}, doc.Figures[0].References)
+	ass.Equal([]FigureReference{
+		{Field: "Description", Language: "en", ParagraphID: "p0001", ParagraphNum: "0001", Text: "Figs. 1 and 2"},
+		{Field: "Claims", Language: "en", ParagraphID: "c-en-0001", ParagraphNum: "0001", Text: "Fig. 2"},
+	}, doc.Figures[1].References)
+	// the text is not changed
+	ass.Contains(doc.Description[0].Text, "As shown in Figs. 1 and 2 and Fig. 9.")
+	// references to unknown figures
+	ass.Contains(doc.Warnings, ParseWarning{
+		Field:    "Figures",
+		Path:     "/ep-patent-document/drawings",
+		Value:    "f0009",
+		Severity: SeverityInfo,
+		Message:  "reference to an unknown figure",
+	})
+}
@@ -0,0 +1,165 @@
+package eps
+
+import (
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"io"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"testing/iotest"
+)
+
+// The streaming parser replaced a html based parser (goquery).
+// The output of the goquery parser for the test data is frozen in test-data/goquery,
+// the texts are normalized with normalizeWhitespace.
+
+// knownGoqueryDivergences lists the fields in which the html based parser
+// differs from the streaming parser, because it does not parse xml:
+//   - self-closing elements like <atl/> are not closed
+//   - the content of <title> is kept as raw text
+//   - stray '<' characters are parsed as tags
+//   - abstracts and descriptions in several languages are joined
+//
+// It also does not apply the rules of the dtd versions, e.g. IPC7 and CPC classifications.
+var knownGoqueryDivergences = map[string][]string{
+	"v1-0-A1.xml":     {"Classifications"},
+	"v1-0-A2.xml":     {"Classifications"},
+	"v1-1-A1.xml":     {"Classifications"},
+	"v1-1-B1.xml":     {"Classifications"},
+	"v1-1-B2.xml":     {"Classifications"},
+	"v1-2-B1.xml":     {"NplCitations"},
+	"v1-2-B2.xml":     {"Description", "NplCitations"},
+	"v1-5-1-A1.xml":   {"Classifications", "NplCitations"},
+	"v1-5-1-A1-1.xml": {"Classifications"},
+	"v1-5-1-A1-2.xml": {"Classifications", "Description"},
+	"v1-5-1-A2.xml":   {"Classifications", "NplCitations"},
+	"v1-5-1-B2.xml":   {"Classifications"},
+}
+
+// corpus returns the files of the test-data directory
+func corpus(t testing.TB) (files []string) {
+	files, err := filepath.Glob("test-data/*/*.xml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return
+}
+
+// goqueryFixture returns the frozen output of the goquery parser for the file
+func goqueryFixture(file string) string {
+	dir, name := filepath.Split(file)
+	return filepath.Join("test-data", "goquery", filepath.Base(dir), strings.TrimSuffix(name, ".xml")+".json")
+}
+
+// clearNewFields clears the fields that were added after the goquery parser, which leaves them empty
+func clearNewFields(doc *EpPatentDocumentSimple) {
+	doc.Warnings = nil          // parse warnings are only logged by the goquery parser
+	doc.Tables = nil            // tables
+	doc.Chemistry = nil         // chemistry, biological deposits and sequence list texts
+	doc.BioDeposits = nil       //
+	doc.SequenceListTexts = nil //
+	doc.Figures = nil           // figures
+	for i := range doc.Description {
+		doc.Description[i].Paragraphs = nil // paragraphs of the description
+	}
+}
+
+// normalizeWhitespace collapses the whitespace of the texts,
+// because the html parser moves whitespace within tables
+func normalizeWhitespace(doc *EpPatentDocumentSimple) {
+	normalize := func(s string) string {
+		return strings.Join(strings.Fields(s), " ")
+	}
+	for i := range doc.Abstract {
+		doc.Abstract[i].Text = normalize(doc.Abstract[i].Text)
+	}
+	for i := range doc.Description {
+		doc.Description[i].Text = normalize(doc.Description[i].Text)
+	}
+	for i := range doc.Claims {
+		doc.Claims[i].Text = normalize(doc.Claims[i].Text)
+		for j := range doc.Claims[i].Items {
+			doc.Claims[i].Items[j].Text = normalize(doc.Claims[i].Items[j].Text)
+		}
+	}
+}
+
+func TestProcessXMLSimpleEqualsGoquery(t *testing.T) {
+	ass := assert.New(t)
+	for _, file := range corpus(t) {
+		data, err := os.ReadFile(file)
+		ass.NoError(err)
+		// the goquery parser keeps the text of the tables
+		doc, err := ProcessXMLSimpleWithOptions(data, ProcessOptions{InlineTables: true})
+		ass.NoError(err, file)
+		clearNewFields(&doc)
+		normalizeWhitespace(&doc)
+		// both documents pass through json, which omits empty slices
+		data, err = json.Marshal(doc)
+		ass.NoError(err, file)
+		var streamed, parsed EpPatentDocumentSimple
+		ass.NoError(json.Unmarshal(data, &streamed), file)
+		fixture, err := os.ReadFile(goqueryFixture(file))
+		ass.NoError(err, file)
+		ass.NoError(json.Unmarshal(fixture, &parsed), file)
+		skip := knownGoqueryDivergences[filepath.Base(file)]
+		s := reflect.ValueOf(streamed)
+		p := reflect.ValueOf(parsed)
+		for i := 0; i < s.NumField(); i++ {
+			name := s.Type().Field(i).Name
+			if containsString(skip, name) {
+				continue
+			}
+			ass.Equal(p.Field(i).Interface(), s.Field(i).Interface(), file+": "+name)
+		}
+	}
+}
+
+func TestProcessXMLSimpleMalformedTag(t *testing.T) {
+	ass := assert.New(t)
+	data, err := os.ReadFile("test-data/application/v1-5-1-A1-2.xml")
+	ass.NoError(err)
+	patDoc, err := ProcessXMLSimple(data)
+	ass.NoError(err)
+	// <heading id="h0011"><First Embodiment</heading>
+	ass.Contains(patDoc.Description[0].Text, "<First Embodiment")
+}
+
+func TestLenientXMLReader(t *testing.T) {
+	ass := assert.New(t)
+	data := `<p>a < b <b>c</b> <!-- <DP n="1"> --> <![CDATA[x < y]]> <d<e</d> R&D &amp; &#x3C; &# &x;</p>`
+	expected := `<p>a &lt; b <b>c</b> <!-- <DP n="1"> --> <![CDATA[x < y]]> &lt;d&lt;e</d> R&amp;D &amp; &#x3C; &amp;# &x;</p>`
+	res, err := io.ReadAll(newLenientXMLReader(strings.NewReader(data)))
+	ass.NoError(err)
+	ass.Equal(expected, string(res))
+	// the lookahead is filled from a reader that returns single bytes
+	res, err = io.ReadAll(newLenientXMLReader(iotest.OneByteReader(strings.NewReader(data))))
+	ass.NoError(err)
+	ass.Equal(expected, string(res))
+}
+
+func BenchmarkProcessXMLSimple(b *testing.B) {
+	var docs [][]byte
+	var size int64
+	for _, file := range corpus(b) {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			b.Fatal(err)
+		}
+		docs = append(docs, data)
+		size += int64(len(data))
+	}
+	b.SetBytes(size)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for _, data := range docs {
+			if _, err := ProcessXMLSimple(data); err != nil {
+				b.Fatal(err)
+			}
+		}
+	}
+}
@@ -0,0 +1,56 @@
+package eps
+
+import (
+	"encoding/xml"
+	"github.com/max-planck-innovation-competition/go-epo-eps/pkg/mathml"
+)
+
+// MathFormat is the format of the MathML formulas (maths) in the texts
+type MathFormat string
+
+const (
+	// MathRaw keeps the text of the MathML elements, e.g. QcKA(Tc−Ta)
+	MathRaw MathFormat = ""
+	// MathText converts the formulas into a readable linear text, e.g. Qc = KA(Tc − Ta)
+	MathText MathFormat = "text"
+	// MathLaTeX converts the formulas into LaTeX, e.g. $\mathrm{Qc}=\mathrm{KA}\left(\mathrm{Tc}-\mathrm{Ta}\right)$
+	MathLaTeX MathFormat = "latex"
+)
+
+// startMath opens a MathML element of a collected text and reports whether the element belongs to a formula
+func (p *simpleParser) startMath(name string, attr []xml.Attr) bool {
+	if p.math == MathRaw || len(p.collecting) == 0 || (len(p.formula) == 0 && name != "math") {
+		return false
+	}
+	n := &mathml.Node{Name: name, Attr: copyAttr(attr)}
+	if len(p.formula) > 0 {
+		parent := p.formula[len(p.formula)-1]
+		parent.Children = append(parent.Children, n)
+	}
+	p.formula = append(p.formula, n)
+	return true
+}
+
+// endMath closes a MathML element, the converted formula is added to the texts
+func (p *simpleParser) endMath() {
+	root := p.formula[0]
+	p.formula = p.formula[:len(p.formula)-1]
+	if len(p.formula) > 0 {
+		return
+	}
+	var text string
+	switch p.math {
+	case MathLaTeX:
+		text = root.LaTeX()
+	default:
+		text = root.LinearText()
+	}
+	// the formula is added like character data, e.g. to the cells of tables and the captured subtrees
+	p.charData([]byte(" " + text + " "))
+}
+
+// mathData adds the character data to the current MathML element
+func (p *simpleParser) mathData(data []byte) {
+	parent := p.formula[len(p.formula)-1]
+	parent.Children = append(parent.Children, &mathml.Node{Text: string(data)})
+}
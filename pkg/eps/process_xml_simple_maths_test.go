@@ -0,0 +1,50 @@
+package eps
+
+import (
+	"github.com/stretchr/testify/assert"
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestProcessXMLSimpleMaths(t *testing.T) {
+	ass := assert.New(t)
+	data, err := os.ReadFile("test-data/application/v1-5-1-A1-2.xml")
+	ass.NoError(err)
+
+	// by default the text of the MathML elements is kept
+	doc, err := ProcessXMLSimple(data)
+	ass.NoError(err)
+	ass.Contains(doc.Description[0].Text, "Qc\n                    =")
+
+	doc, err = ProcessXMLSimpleWithOptions(data, ProcessOptions{Math: MathText})
+	ass.NoError(err)
+	ass.Contains(doc.Description[0].Text, "Qc = KA(Tc − Ta) = Gr(Hi − Ho)")
+
+	doc, err = ProcessXMLSimpleWithOptions(data, ProcessOptions{Math: MathLaTeX})
+	ass.NoError(err)
+	ass.Contains(doc.Description[0].Text, `$$\mathrm{Qc}=\mathrm{KA}\left(\mathrm{Tc}-\mathrm{Ta}\right)=\mathrm{Gr}\left(\mathrm{Hi}-\mathrm{Ho}\right)$$`)
+	// the other texts are not changed
+	raw, err := ProcessXMLSimple(data)
+	ass.NoError(err)
+	ass.Equal(raw.Claims, doc.Claims)
+	ass.Equal(raw.Abstract, doc.Abstract)
+}
+
+func TestProcessXMLSimpleMathsInTables(t *testing.T) {
+	ass := assert.New(t)
+	data := []byte(strings.Replace(tablesTestDocument, "<entry>40 %</entry>",
+		`<entry><maths id="math0001" num="0001"><math display="inline"><mi>y</mi><mo>=</mo><mn>40</mn><mo>%</mo></math></maths></entry>`, 1))
+	for format, expected := range map[MathFormat]string{
+		MathRaw:   "y=40%",
+		MathText:  "y = 40%",
+		MathLaTeX: "$y=40\\%$",
+	} {
+		doc, err := ProcessXMLSimpleWithOptions(data, ProcessOptions{Math: format})
+		ass.NoError(err)
+		if !ass.Len(doc.Tables, 1, format) {
+			continue
+		}
+		ass.Equal(expected, doc.Tables[0].Groups[0].Body[1].Cells[1].Text, format)
+	}
+}
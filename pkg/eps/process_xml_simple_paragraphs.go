@@ -0,0 +1,54 @@
+package eps
+
+import (
+	"encoding/xml"
+	"strings"
+)
+
+// descriptionBlock is the open paragraph or heading of the description,
+// its text is the text of the collector from the start of the block
+type descriptionBlock struct {
+	depth     int
+	collector *textCollector
+	start     int
+	paragraph Paragraph
+}
+
+// startDescriptionBlock opens a paragraph or heading of the description, nested paragraphs belong to the outer paragraph
+/*
+	<description id="desc" lang="en">
+		<heading id="h0001">Field of the Invention</heading>
+		<p id="p0001" num="0001">The present invention pertains to ...</p>
+	</description>
+*/
+func (p *simpleParser) startDescriptionBlock(name string, attr []xml.Attr) {
+	if (name != "p" && name != "heading") || p.block != nil || len(p.collecting) == 0 {
+		return
+	}
+	c := p.collecting[len(p.collecting)-1]
+	if c.name != "description" {
+		return
+	}
+	n := &xmlNode{Attr: attr}
+	p.block = &descriptionBlock{
+		depth:     p.depth,
+		collector: c,
+		start:     c.text.Len(),
+		paragraph: Paragraph{
+			ID:      n.attrValue("id"),
+			Num:     strings.TrimSpace(n.attrValue("num")),
+			Heading: name == "heading",
+		},
+	}
+}
+
+// endDescriptionBlock adds the paragraph or heading of the current element to the description
+func (p *simpleParser) endDescriptionBlock() {
+	if p.block == nil || p.block.depth != p.depth {
+		return
+	}
+	b := p.block
+	p.block = nil
+	b.paragraph.Text = strings.TrimSpace(b.collector.text.String()[b.start:])
+	b.collector.paragraphs = append(b.collector.paragraphs, b.paragraph)
+}
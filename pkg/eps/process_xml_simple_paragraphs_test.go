@@ -0,0 +1,24 @@
+package eps
+
+import (
+	"github.com/stretchr/testify/assert"
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestParagraphs(t *testing.T) {
+	ass := assert.New(t)
+	data, err := os.ReadFile("./test-data/grant/v1-5-1-B1.xml")
+	ass.NoError(err)
+	doc, err := ProcessXMLSimple(data)
+	ass.NoError(err)
+	if !ass.Len(doc.Description, 1) || !ass.Greater(len(doc.Description[0].Paragraphs), 2) {
+		return
+	}
+	paragraphs := doc.Description[0].Paragraphs
+	ass.Equal(Paragraph{ID: "h0001", Heading: true, Text: "Field of the Invention"}, paragraphs[0])
+	ass.Equal("p0001", paragraphs[1].ID)
+	ass.Equal("0001", paragraphs[1].Num)
+	ass.True(strings.HasPrefix(paragraphs[1].Text, "The present invention pertains to"))
+}
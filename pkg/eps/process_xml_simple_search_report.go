@@ -0,0 +1,154 @@
+package eps
+
+import (
+	"strings"
+)
+
+// newSearchReport transforms a search-report-data element into a SearchReport
+/*
+	<search-report-data id="srep" lang="en" srep-office="EP" date-produced="20191201">
+		<srep-info>...</srep-info>
+		<srep-for-pub>
+			<srep-fields-searched>...</srep-fields-searched>
+			<srep-citations>
+				<citation id="sr-cit0001">
+					<patcit id="sr-pcit0001" dnum="US2012281566A1">...</patcit>
+					<category>X</category>
+					<rel-claims>1-15</rel-claims>
+				</citation>
+			</srep-citations>
+			<srep-admin>...</srep-admin>
+		</srep-for-pub>
+	</search-report-data>
+*/
+func newSearchReport(n *xmlNode, w *parseWarnings) (r SearchReport) {
+	r.ID = n.attrValue("id")
+	r.Lang = strings.ToLower(strings.TrimSpace(n.attrValue("lang")))
+	r.Office = n.attrValue("srep-office")
+	r.Type = n.attrValue("srep-type")
+	if dateProduced := n.attrValue("date-produced"); strings.TrimSpace(dateProduced) != "" {
+		r.DateProduced = w.dateValue("SearchReports", n.path()+"/@date-produced", dateProduced)
+	}
+	// scanned search reports only consist of page images
+	for _, p := range n.find("doc-page") {
+		if file, ok := p.attr("file"); ok {
+			r.Pages = append(r.Pages, file)
+		}
+	}
+
+	// search report info
+	info := n.findFirst("srep-info")
+	if numbers := info.findPath("application-reference", "doc-number"); len(numbers) > 0 {
+		r.ApplicationNumber = strings.TrimSpace(numbers[0].text())
+	}
+	if applicant := info.findFirst("applicant-name"); applicant != nil {
+		r.ApplicantName = nameGroup(applicant)
+	}
+	r.InventionTitle = strings.TrimSpace(info.findFirst("srep-invention-title").text())
+
+	// unity of invention
+	var unity []string
+	for _, u := range n.find("srep-unity-of-invention", "unity-of-invention") {
+		paragraphs := []*xmlNode{u}
+		if u.is("srep-unity-of-invention") {
+			paragraphs = u.find("p")
+		}
+		for _, p := range paragraphs {
+			if text := strings.TrimSpace(p.text()); text != "" {
+				unity = append(unity, text)
+			}
+		}
+	}
+	r.UnityOfInvention = strings.Join(unity, "\n")
+
+	// classification of the application and the fields searched
+	pub := n.findFirst("srep-for-pub")
+	for _, c := range pub.childrenNamed("classifications-ipcr") {
+		for _, text := range c.findPath("classification-ipcr", "text") {
+			r.Classifications = append(r.Classifications, strings.TrimSpace(text.text()))
+		}
+	}
+	for _, text := range pub.findPath("srep-fields-searched", "classification-ipcr", "text") {
+		r.FieldsSearched = append(r.FieldsSearched, strings.TrimSpace(text.text()))
+	}
+
+	// citations
+	for _, c := range pub.findPath("srep-citations", "citation") {
+		r.Citations = append(r.Citations, newSearchReportCitation(c))
+	}
+
+	// admin
+	admin := pub.findFirst("srep-admin")
+	if examiner := admin.findFirst("primary-examiner"); examiner != nil {
+		r.Examiner = nameGroup(examiner)
+	}
+	if completed := admin.findFirst("date-search-completed"); completed != nil {
+		r.DateSearchCompleted = w.childDate("SearchReports", completed)
+	}
+	if mailed := admin.findFirst("date-search-report-mailed"); mailed != nil {
+		r.DateSearchReportMailed = w.childDate("SearchReports", mailed)
+	}
+	return
+}
+
+// newSearchReportCitation transforms a citation element of the search report
+/*
+	<citation id="sr-cit0002">
+		<patcit id="sr-pcit0002" dnum="EP1234567A1">...</patcit>
+		<rel-passage>
+			<passage>* paragraph [0012] *</passage>
+			<category>Y</category>
+			<rel-claims>2-4</rel-claims>
+		</rel-passage>
+	</citation>
+*/
+func newSearchReportCitation(n *xmlNode) (c SearchReportCitation) {
+	c.ID = n.attrValue("id")
+	c.Phase = CitedBySearch
+	if phase := n.attrValue("srep-phase"); strings.TrimSpace(phase) != "" {
+		c.Phase = CitationPhase(strings.ToLower(strings.TrimSpace(phase)))
+	}
+	if patcit := n.child("patcit"); patcit != nil {
+		citation := newCitation(patcit)
+		if citation.DocNumber == "" {
+			citation.Text = strings.TrimSpace(patcit.text())
+		}
+		c.Patent = &citation
+	}
+	if nplcit := n.child("nplcit"); nplcit != nil {
+		citation := newNplCitation(nplcit)
+		c.Npl = &citation
+	}
+
+	// categories without claims get the claims of the following rel-claims element
+	var pending []int
+	var passages []string
+	lastWasPassage := false
+	for _, e := range n.find("passage", "category", "rel-claims") {
+		text := strings.TrimSpace(e.text())
+		switch e.Name {
+		case "passage":
+			if !lastWasPassage {
+				passages = nil
+			}
+			passages = append(passages, text)
+			lastWasPassage = true
+			continue
+		case "category":
+			for _, category := range strings.FieldsFunc(text, func(r rune) bool { return r == ',' || r == ' ' }) {
+				c.Relevance = append(c.Relevance, CitationRelevance{
+					Category: strings.ToUpper(category),
+					Passages: passages,
+				})
+				pending = append(pending, len(c.Relevance)-1)
+			}
+		case "rel-claims":
+			for _, idx := range pending {
+				c.Relevance[idx].Claims = text
+			}
+			pending = nil
+		}
+		lastWasPassage = false
+	}
+	return
+}
@@ -0,0 +1,102 @@
+package eps
+
+import (
+	"github.com/stretchr/testify/assert"
+	"os"
+	"testing"
+)
+
+const testSearchReportXML = `<?xml version="1.0" encoding="UTF-8"?>
+<ep-patent-document id="EP19123456A1" file="19123456.xml" lang="en" country="EP" doc-number="3600000" kind="A1" date-publ="20200205" status="n" dtd-version="ep-patent-document-v1-5">
+<search-report-data id="srep" lang="en" srep-office="EP" date-produced="20191220" srep-type="ep-sr">
+<srep-info>
+<application-reference><document-id><country>EP</country><doc-number>19123456</doc-number></document-id></application-reference>
+<applicant-name><name>ACME Corp.</name></applicant-name>
+<srep-unity-of-invention><p>The search division considers that the application does not comply with the requirements of unity of invention.</p><srep-search-fees/></srep-unity-of-invention>
+<srep-invention-title><invention-title>Widget</invention-title></srep-invention-title>
+</srep-info>
+<srep-for-pub>
+<classifications-ipcr><classification-ipcr sequence="1"><text>H04W 76/28</text></classification-ipcr></classifications-ipcr>
+<srep-fields-searched><minimum-documentation><classifications-ipcr><classification-ipcr><text>H04W</text></classification-ipcr><classification-ipcr><text>H04L</text></classification-ipcr></classifications-ipcr></minimum-documentation></srep-fields-searched>
+<srep-citations>
+<citation id="sr-cit0001"><patcit id="sr-pcit0001" dnum="US2012281566A1"><document-id><country>US</country><doc-number>2012281566</doc-number><kind>A1</kind></document-id></patcit><category>X</category><rel-claims>1-3, 7</rel-claims><category>A</category><rel-claims>4-6</rel-claims></citation>
+<citation id="sr-cit0002"><patcit id="sr-pcit0002" dnum="EP1234567A1"><document-id><country>EP</country><doc-number>1234567</doc-number><kind>A1</kind></document-id><rel-passage><passage>* paragraph [0012] *</passage><passage>* figure 3 *</passage><category>Y</category><rel-claims>2-4</rel-claims></rel-passage></patcit></citation>
+<citation id="sr-cit0003"><nplcit id="sr-ncit0001" npl-type="s"><text>SMITH J: "On widgets", NATURE, 2010</text></nplcit><category>A</category><rel-claims>1</rel-claims></citation>
+</srep-citations>
+<srep-admin><examiners><primary-examiner><last-name>Doe</last-name><first-name>Jane</first-name></primary-examiner></examiners><date-search-completed><date>20191210</date></date-search-completed></srep-admin>
+</srep-for-pub>
+</search-report-data>
+</ep-patent-document>`
+
+func TestProcessXMLSimpleSearchReport(t *testing.T) {
+	ass := assert.New(t)
+	patDoc, err := ProcessXMLSimple([]byte(testSearchReportXML))
+	ass.NoError(err)
+
+	ass.Equal(1, len(patDoc.SearchReports))
+	r := patDoc.SearchReports[0]
+	ass.Equal("srep", r.ID)
+	ass.Equal("en", r.Lang)
+	ass.Equal("EP", r.Office)
+	ass.Equal("ep-sr", r.Type)
+	ass.Equal("20191220", r.DateProduced.Format(layoutDatePubl))
+	ass.Equal("19123456", r.ApplicationNumber)
+	ass.Equal("ACME Corp.", r.ApplicantName)
+	ass.Equal("Widget", r.InventionTitle)
+	ass.Contains(r.UnityOfInvention, "unity of invention")
+	ass.Equal([]string{"H04W 76/28"}, r.Classifications)
+	ass.Equal([]string{"H04W", "H04L"}, r.FieldsSearched)
+	ass.Equal("Jane Doe", r.Examiner)
+	ass.Equal("20191210", r.DateSearchCompleted.Format(layoutDatePubl))
+	ass.True(r.DateSearchReportMailed.IsZero())
+
+	// citations
+	ass.Equal(3, len(r.Citations))
+	ass.Equal("sr-cit0001", r.Citations[0].ID)
+	ass.Equal(CitedBySearch, r.Citations[0].Phase)
+	ass.NotNil(r.Citations[0].Patent)
+	ass.Nil(r.Citations[0].Npl)
+	ass.Equal("2012281566", r.Citations[0].Patent.DocNumber)
+	ass.Equal([]CitationRelevance{
+		{Category: "X", Claims: "1-3, 7"},
+		{Category: "A", Claims: "4-6"},
+	}, r.Citations[0].Relevance)
+	ass.Equal([]CitationRelevance{
+		{Category: "Y", Claims: "2-4", Passages: []string{"* paragraph [0012] *", "* figure 3 *"}},
+	}, r.Citations[1].Relevance)
+	ass.NotNil(r.Citations[2].Npl)
+	ass.Equal("SMITH J: \"On widgets\", NATURE, 2010", r.Citations[2].Npl.Text)
+
+	// helpers
+	ass.Equal(1, len(r.CitationsWithCategory("x")))
+	ass.Equal(2, len(r.CitationsWithCategory("A")))
+	against := r.CitationsAgainstClaim(3)
+	ass.Equal(2, len(against))
+	ass.Equal("sr-cit0001", against[0].ID)
+	ass.Equal("sr-cit0002", against[1].ID)
+}
+
+func TestProcessXMLSimpleSearchReportPages(t *testing.T) {
+	ass := assert.New(t)
+	data, err := os.ReadFile("test-data/application/v1-5-A1.xml")
+	ass.NoError(err)
+	patDoc, err := ProcessXMLSimple(data)
+	ass.NoError(err)
+
+	ass.Equal(1, len(patDoc.SearchReports))
+	ass.Equal([]string{"srep0001.tif", "srep0002.tif", "srep0003.tif"}, patDoc.SearchReports[0].Pages)
+	ass.True(patDoc.SearchReports[0].DateProduced.IsZero())
+	ass.Empty(patDoc.SearchReports[0].Citations)
+}
+
+func TestParseClaimNumbers(t *testing.T) {
+	ass := assert.New(t)
+	ass.Equal([]int{1, 2, 3, 7}, ParseClaimNumbers("1-3, 7"))
+	ass.Equal([]int{4}, ParseClaimNumbers("4"))
+	ass.Equal([]int{1, 2}, ParseClaimNumbers("1,2;x"))
+	ass.Empty(ParseClaimNumbers(""))
+	ass.Equal([]int{1, 2, 3, 5}, ParseClaimNumbers("1 - 3 ,5"))
+	ass.Equal([]int{2, 3}, ParseClaimNumbers("2–3"))
+	// large ranges are ignored
+	ass.Equal([]int{4}, ParseClaimNumbers("1-99999999, 4"))
+}
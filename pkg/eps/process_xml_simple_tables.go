@@ -0,0 +1,200 @@
+package eps
+
+import (
+	"encoding/xml"
+	"strconv"
+	"strings"
+)
+
+// collectorFields maps the names of the text elements to the fields of the document
+var collectorFields = map[string]string{
+	"abstract":       "Abstract",
+	"description":    "Description",
+	"claims":         "Claims",
+	"amended-claims": "AmendedClaims",
+}
+
+// maxPreallocatedCells limits the cells allocated in advance for a row, the cols are not trusted
+const maxPreallocatedCells = 8
+
+// tableBuilder builds a Table from the tokens of a tables element while streaming
+/*
+	<tables id="tabl0001" num="0001">
+		<table frame="all">
+			<title>[Table 1]</title>
+			<tgroup cols="2">
+				<colspec colnum="1" colname="col1" colwidth="20mm"/>
+				<colspec colnum="2" colname="col2" colwidth="133mm"/>
+				<thead>
+					<row><entry namest="col1" nameend="col2" align="left">Abbreviations</entry></row>
+				</thead>
+				<tbody>
+					<row><entry>BN</entry><entry>bicarbonate buffer with NP-40</entry></row>
+				</tbody>
+			</tgroup>
+		</table>
+	</tables>
+*/
+type tableBuilder struct {
+	table Table
+	depth int // depth of the tables element
+	// columns of the colspecs of the current tgroup
+	columns  map[string]int
+	colspecs int
+	// rows of the current thead or tbody and the columns occupied by cells of previous rows (morerows)
+	rows     *[]TableRow
+	occupied map[int]map[int]bool
+	col      int
+	// current entry or title and its text, the buffer of the text is reused
+	cell   TableCell
+	inCell bool
+	title  bool
+	text   []byte
+}
+
+// start processes the start of an element at the depth
+func (b *tableBuilder) start(name string, attr []xml.Attr, depth int) {
+	n := xmlNode{Name: name, Attr: attr}
+	switch {
+	case name == "img" && b.inCell:
+		b.cell.Images = append(b.cell.Images, n.attrValue("file"))
+	case name == "img" && depth == b.depth+1:
+		b.table.Image = n.attrValue("file")
+	case name == "title" && depth == b.depth+2:
+		b.title = true
+		b.text = b.text[:0]
+	case name == "tgroup" && depth == b.depth+2:
+		g := TableGroup{}
+		g.Cols, _ = atoi(n.attrValue("cols"))
+		b.table.Groups = append(b.table.Groups, g)
+		b.columns = map[string]int{}
+		b.colspecs = 0
+	case len(b.table.Groups) == 0 || depth < b.depth+3:
+		// the following elements are part of a tgroup
+	case name == "colspec" && depth == b.depth+3:
+		// the columns of the cells are resolved with the colspecs
+		col := b.colspecs
+		if num, ok := atoi(n.attrValue("colnum")); ok && num > 0 {
+			col = num - 1
+		}
+		if colname := n.attrValue("colname"); colname != "" {
+			b.columns[colname] = col
+		}
+		b.colspecs++
+	case (name == "thead" || name == "tbody") && depth == b.depth+3:
+		g := &b.table.Groups[len(b.table.Groups)-1]
+		b.rows = &g.Body
+		if name == "thead" {
+			b.rows = &g.Header
+		}
+		b.occupied = map[int]map[int]bool{}
+	case name == "row" && depth == b.depth+4 && b.rows != nil:
+		// the cells of most rows fit into the columns of the tgroup
+		cols := b.table.Groups[len(b.table.Groups)-1].Cols
+		*b.rows = append(*b.rows, TableRow{Cells: make([]TableCell, 0, min(max(cols, 1), maxPreallocatedCells))})
+		b.col = 0
+	case name == "entry" && depth == b.depth+5 && b.rows != nil && len(*b.rows) > 0:
+		b.startEntry(&n)
+	}
+}
+
+// startEntry starts a cell, the cells of previous rows with morerows are skipped
+func (b *tableBuilder) startEntry(n *xmlNode) {
+	r := len(*b.rows) - 1
+	start, hasStart := b.columns[n.attrValue("namest")]
+	if !hasStart {
+		start, hasStart = b.columns[n.attrValue("colname")]
+	}
+	if hasStart {
+		b.col = start
+	}
+	for !hasStart && b.occupied[r][b.col] {
+		b.col++
+	}
+	span := 1
+	if end, ok := b.columns[n.attrValue("nameend")]; ok && end >= b.col {
+		span = end - b.col + 1
+	}
+	rowSpan := 1
+	if more, ok := atoi(n.attrValue("morerows")); ok && more > 0 {
+		rowSpan = more + 1
+	}
+	for i := 1; i < rowSpan; i++ {
+		if b.occupied[r+i] == nil {
+			b.occupied[r+i] = map[int]bool{}
+		}
+		for c := b.col; c < b.col+span; c++ {
+			b.occupied[r+i][c] = true
+		}
+	}
+	b.cell = TableCell{
+		Column:  b.col,
+		ColSpan: span,
+		RowSpan: rowSpan,
+		Align:   n.attrValue("align"),
+	}
+	b.inCell = true
+	b.text = b.text[:0]
+}
+
+// end processes the end of an element at the depth
+func (b *tableBuilder) end(name string, depth int) {
+	switch {
+	case name == "title" && depth == b.depth+2 && b.title:
+		b.table.Title = collapseSpaces(string(b.text))
+		b.title = false
+	case (name == "thead" || name == "tbody") && depth == b.depth+3:
+		b.rows = nil
+	case name == "entry" && depth == b.depth+5 && b.inCell:
+		b.cell.Text = collapseSpaces(string(b.text))
+		row := &(*b.rows)[len(*b.rows)-1]
+		row.Cells = append(row.Cells, b.cell)
+		b.col += b.cell.ColSpan
+		b.inCell = false
+	}
+}
+
+// charData adds the character data to the current entry or title
+func (b *tableBuilder) charData(data []byte) {
+	if b.inCell || b.title {
+		b.text = append(b.text, data...)
+	}
+}
+
+// atoi parses the number of an attribute, empty values are not a number
+func atoi(s string) (int, bool) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return 0, false
+	}
+	i, err := strconv.Atoi(s)
+	return i, err == nil
+}
+
+// startTables starts building the table and writes its placeholder into the texts,
+// the text of the table is hidden unless the tables are kept inline
+func (p *simpleParser) startTables(attr []xml.Attr) {
+	n := &xmlNode{Attr: attr}
+	p.table = &tableBuilder{table: Table{ID: n.attrValue("id"), Num: n.attrValue("num")}, depth: p.depth}
+	if p.table.table.ID == "" {
+		p.table.table.ID = "table" + strconv.Itoa(len(p.doc.Tables)+1)
+	}
+	if p.inlineTables || len(p.collecting) == 0 {
+		return
+	}
+	p.writeText([]byte(" " + TablePlaceholder(p.table.table.ID) + " "))
+	p.inTables = true
+}
+
+// endTables adds the table to the document at the end of the tables element
+func (p *simpleParser) endTables(name string) {
+	if p.depth != p.table.depth {
+		p.table.end(name, p.depth)
+		return
+	}
+	t := p.table.table
+	t.Field, t.Language = p.textField()
+	p.doc.Tables = append(p.doc.Tables, t)
+	p.table = nil
+	p.inTables = false
+}
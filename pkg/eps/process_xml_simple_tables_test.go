@@ -0,0 +1,68 @@
+package eps
+
+import (
+	"github.com/stretchr/testify/assert"
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestProcessXMLSimpleTables(t *testing.T) {
+	ass := assert.New(t)
+	data, err := os.ReadFile("test-data/grant/v1-2-B2.xml")
+	ass.NoError(err)
+	doc, err := ProcessXMLSimple(data)
+	ass.NoError(err)
+	ass.Len(doc.Tables, 5)
+	table := doc.Tables[0]
+	ass.Equal("tabl0001", table.ID)
+	ass.Equal("0001", table.Num)
+	ass.Equal("Description", table.Field)
+	ass.Equal("en", table.Language)
+	if ass.Len(table.Groups, 1) {
+		g := table.Groups[0]
+		ass.Equal(2, g.Cols)
+		if ass.Len(g.Header, 1) {
+			ass.Equal([]TableCell{{Text: "Abbreviations", Column: 0, ColSpan: 2, RowSpan: 1, Align: "left"}}, g.Header[0].Cells)
+		}
+		ass.Equal("BN", g.Body[0].Cells[0].Text)
+		ass.Equal(1, g.Body[0].Cells[1].Column)
+		ass.Equal("bicarbonate buffer with NP-40", g.Body[0].Cells[1].Text)
+	}
+	ass.Len(doc.TablesIn("Description"), 5)
+	ass.Empty(doc.TablesIn("Claims"))
+	// by default the text of the tables is replaced by placeholders
+	ass.NotContains(doc.Description[0].Text, "bicarbonate buffer with NP-40")
+
+	// the images of the cells are kept
+	data, err = os.ReadFile("test-data/application/v1-5-1-A1.xml")
+	ass.NoError(err)
+	doc, err = ProcessXMLSimple(data)
+	ass.NoError(err)
+	table = doc.Tables[0]
+	ass.Equal("[Table 1]", table.Title)
+	ass.Empty(table.Groups[0].Header)
+	ass.Equal("Preparation Process a-1", table.Groups[0].Body[0].Cells[0].Text)
+	ass.Equal([]string{"imgb0006.tif"}, table.Groups[0].Body[1].Cells[0].Images)
+}
+
+func TestProcessXMLSimpleTablePlaceholders(t *testing.T) {
+	ass := assert.New(t)
+	data, err := os.ReadFile("test-data/grant/v1-2-B2.xml")
+	ass.NoError(err)
+	doc, err := ProcessXMLSimple(data)
+	ass.NoError(err)
+	ass.Len(doc.Tables, 5)
+	text := doc.Description[0].Text
+	ass.NotContains(text, "bicarbonate buffer with NP-40")
+	ass.Contains(text, "The following abbreviations are used.")
+	for _, table := range doc.Tables {
+		ass.Equal(1, strings.Count(text, TablePlaceholder(table.ID)), table.ID)
+	}
+	// the tables are not changed by the inline option
+	inline, err := ProcessXMLSimpleWithOptions(data, ProcessOptions{InlineTables: true})
+	ass.NoError(err)
+	ass.Contains(inline.Description[0].Text, "bicarbonate buffer with NP-40")
+	ass.Equal(inline.Tables, doc.Tables)
+	ass.Equal(inline.Claims, doc.Claims)
+}
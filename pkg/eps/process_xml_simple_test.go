@@ -17,6 +17,8 @@ v 1.3	O  O  O  O
 v 1.4   O  O  O  O
 v 1.5   O  O  O  O
 v 1.5.1 O  O  O  O
+
+X: no test data available
 */
 
 // v 1.0
@@ -95,7 +97,19 @@ func TestProcessXMLSimple10A1(t *testing.T) {
 	}
 
 	// classifications
-	ass.Empty(patDoc.Classifications)
+	// IPC7 classifications (B510)
+	ass.Equal(2, len(patDoc.Classifications))
+	ass.Equal("7C 07C  29/44   A", patDoc.Classifications[0].Text)
+	ass.Equal(IPC, patDoc.Classifications[0].System)
+	ass.Equal(1, patDoc.Classifications[0].Sequence)
+	ass.Equal("C", patDoc.Classifications[0].Section)
+	ass.Equal("07", patDoc.Classifications[0].Class)
+	ass.Equal("C", patDoc.Classifications[0].SubClass)
+	ass.Equal("29", patDoc.Classifications[0].MainGroup)
+	ass.Equal("44", patDoc.Classifications[0].SubGroup)
+	ass.Equal("7", patDoc.Classifications[0].Version)
+	ass.Equal("F", patDoc.Classifications[0].FirstLater)
+	ass.Equal("I", patDoc.Classifications[0].ClassificationValue)
 }
 
 func TestProcessXMLSimple10A2(t *testing.T) {
@@ -163,7 +177,19 @@ func TestProcessXMLSimple10A2(t *testing.T) {
 	}
 
 	// classifications
-	ass.Empty(patDoc.Classifications)
+	// IPC7 classifications (B510)
+	ass.Equal(1, len(patDoc.Classifications))
+	ass.Equal("7G 06F  17/60   A", patDoc.Classifications[0].Text)
+	ass.Equal(IPC, patDoc.Classifications[0].System)
+	ass.Equal(1, patDoc.Classifications[0].Sequence)
+	ass.Equal("G", patDoc.Classifications[0].Section)
+	ass.Equal("06", patDoc.Classifications[0].Class)
+	ass.Equal("F", patDoc.Classifications[0].SubClass)
+	ass.Equal("17", patDoc.Classifications[0].MainGroup)
+	ass.Equal("60", patDoc.Classifications[0].SubGroup)
+	ass.Equal("7", patDoc.Classifications[0].Version)
+	ass.Equal("F", patDoc.Classifications[0].FirstLater)
+	ass.Equal("I", patDoc.Classifications[0].ClassificationValue)
 }
 
 func TestProcessXMLSimple10B1(t *testing.T) {
@@ -213,7 +239,7 @@ func TestProcessXMLSimple10B1(t *testing.T) {
 
 	// description
 	ass.NotEmpty(patDoc.Description)
-	ass.Equal(79384, len(patDoc.Description[0].Text))
+	ass.Equal(78999, len(patDoc.Description[0].Text))
 	ass.Equal("en", patDoc.Description[0].Language)
 
 	// citations
@@ -625,7 +651,7 @@ func TestProcessXMLSimple11A1(t *testing.T) {
 
 	// description
 	ass.NotEmpty(patDoc.Description)
-	ass.Equal(52416, len(patDoc.Description[0].Text))
+	ass.Equal(52344, len(patDoc.Description[0].Text))
 	ass.Equal("de", patDoc.Description[0].Language)
 
 	// citations
@@ -665,7 +691,19 @@ func TestProcessXMLSimple11A1(t *testing.T) {
 	}
 
 	// classifications
-	ass.Empty(patDoc.Classifications)
+	// IPC7 classifications (B510)
+	ass.Equal(4, len(patDoc.Classifications))
+	ass.Equal("2C 07D 307/12 A", patDoc.Classifications[0].Text)
+	ass.Equal(IPC, patDoc.Classifications[0].System)
+	ass.Equal(1, patDoc.Classifications[0].Sequence)
+	ass.Equal("C", patDoc.Classifications[0].Section)
+	ass.Equal("07", patDoc.Classifications[0].Class)
+	ass.Equal("D", patDoc.Classifications[0].SubClass)
+	ass.Equal("307", patDoc.Classifications[0].MainGroup)
+	ass.Equal("12", patDoc.Classifications[0].SubGroup)
+	ass.Equal("2", patDoc.Classifications[0].Version)
+	ass.Equal("F", patDoc.Classifications[0].FirstLater)
+	ass.Equal("I", patDoc.Classifications[0].ClassificationValue)
 }
 
 func TestProcessXMLSimple11A2(t *testing.T) {
@@ -804,7 +842,7 @@ func TestProcessXMLSimple11B1(t *testing.T) {
 
 	// description
 	ass.NotEmpty(patDoc.Description)
-	ass.Equal(35365, len(patDoc.Description[0].Text))
+	ass.Equal(35092, len(patDoc.Description[0].Text))
 	ass.Equal("en", patDoc.Description[0].Language)
 
 	// citations
@@ -842,7 +880,19 @@ func TestProcessXMLSimple11B1(t *testing.T) {
 	}
 
 	// classifications
-	ass.Empty(patDoc.Classifications)
+	// IPC7 classifications (B510)
+	ass.Equal(3, len(patDoc.Classifications))
+	ass.Equal("7B 60L   7/26   A", patDoc.Classifications[0].Text)
+	ass.Equal(IPC, patDoc.Classifications[0].System)
+	ass.Equal(1, patDoc.Classifications[0].Sequence)
+	ass.Equal("B", patDoc.Classifications[0].Section)
+	ass.Equal("60", patDoc.Classifications[0].Class)
+	ass.Equal("L", patDoc.Classifications[0].SubClass)
+	ass.Equal("7", patDoc.Classifications[0].MainGroup)
+	ass.Equal("26", patDoc.Classifications[0].SubGroup)
+	ass.Equal("7", patDoc.Classifications[0].Version)
+	ass.Equal("F", patDoc.Classifications[0].FirstLater)
+	ass.Equal("I", patDoc.Classifications[0].ClassificationValue)
 }
 
 func TestProcessXMLSimple11B2(t *testing.T) {
@@ -935,7 +985,19 @@ func TestProcessXMLSimple11B2(t *testing.T) {
 	}
 
 	// classifications
-	ass.Empty(patDoc.Classifications)
+	// IPC7 classifications (B510)
+	ass.Equal(2, len(patDoc.Classifications))
+	ass.Equal("7B 22D  29/00   A", patDoc.Classifications[0].Text)
+	ass.Equal(IPC, patDoc.Classifications[0].System)
+	ass.Equal(1, patDoc.Classifications[0].Sequence)
+	ass.Equal("B", patDoc.Classifications[0].Section)
+	ass.Equal("22", patDoc.Classifications[0].Class)
+	ass.Equal("D", patDoc.Classifications[0].SubClass)
+	ass.Equal("29", patDoc.Classifications[0].MainGroup)
+	ass.Equal("00", patDoc.Classifications[0].SubGroup)
+	ass.Equal("7", patDoc.Classifications[0].Version)
+	ass.Equal("F", patDoc.Classifications[0].FirstLater)
+	ass.Equal("I", patDoc.Classifications[0].ClassificationValue)
 }
 
 // v 1.2
@@ -1204,7 +1266,7 @@ func TestProcessXMLSimple12B1(t *testing.T) {
 
 	// description
 	ass.NotEmpty(patDoc.Description)
-	ass.Equal(99270, len(patDoc.Description[0].Text))
+	ass.Equal(99060, len(patDoc.Description[0].Text))
 	ass.Equal("en", patDoc.Description[0].Language)
 
 	// citations
@@ -1351,7 +1413,8 @@ func TestProcessXMLSimple12B2(t *testing.T) {
 
 	// description
 	ass.NotEmpty(patDoc.Description)
-	ass.Equal(205082, len(patDoc.Description[0].Text))
+	// the html parser kept the markup of <title><b>Table 3</b></title> as text
+	ass.Equal(201951, len(patDoc.Description[0].Text))
 	ass.Equal("en", patDoc.Description[0].Language)
 
 	// citations
@@ -2983,7 +3046,7 @@ func TestProcessXMLSimple151A1(t *testing.T) {
 
 	// description
 	ass.NotEmpty(patDoc.Description)
-	ass.Equal(416344, len(patDoc.Description[0].Text))
+	ass.Equal(331628, len(patDoc.Description[0].Text))
 	ass.Equal("en", patDoc.Description[0].Language)
 
 	// Inventors
@@ -3022,7 +3085,8 @@ func TestProcessXMLSimple151A1(t *testing.T) {
 
 	// classifications
 	ass.NotEmpty(patDoc.Classifications)
-	ass.Equal(14, len(patDoc.Classifications))
+	// 14 IPC8 classifications (B510EP) followed by 15 CPC classifications (B520EP)
+	ass.Equal(29, len(patDoc.Classifications))
 	for i := 0; i <= 13; i++ {
 		ass.Equal(IPC, patDoc.Classifications[i].System)
 		ass.Equal(i+1, patDoc.Classifications[i].Sequence)
@@ -3044,6 +3108,12 @@ func TestProcessXMLSimple151A1(t *testing.T) {
 	ass.Equal("113", patDoc.Classifications[0].SubGroup)
 	ass.Equal("F", patDoc.Classifications[0].FirstLater)
 
+	ass.Equal("Y02A 50/30", patDoc.Classifications[16].Symbol())
+	ass.Equal(CPC, patDoc.Classifications[16].System)
+	ass.Equal(3, patDoc.Classifications[16].Sequence)
+	ass.Equal("A", patDoc.Classifications[16].ClassificationValue)
+	ass.Equal("G", patDoc.Classifications[16].Source)
+
 	ass.Equal("A61K  31/4245      20060101ALI20200522BHEP        ", patDoc.Classifications[1].Text)
 	ass.Equal("A", patDoc.Classifications[1].Section)
 	ass.Equal("61", patDoc.Classifications[1].Class)
@@ -3253,7 +3323,7 @@ func TestProcessXMLSimple151A2(t *testing.T) {
 
 	// description
 	ass.NotEmpty(patDoc.Description)
-	ass.Equal(1295579, len(patDoc.Description[0].Text))
+	ass.Equal(1039531, len(patDoc.Description[0].Text))
 	ass.Equal("en", patDoc.Description[0].Language)
 
 	// Inventors
@@ -3292,7 +3362,9 @@ func TestProcessXMLSimple151A2(t *testing.T) {
 	// classifications
 
 	ass.NotEmpty(patDoc.Classifications)
-	ass.Equal(2, len(patDoc.Classifications))
+	// 2 IPC8 classifications (B510EP) followed by 7 CPC classifications (B520EP)
+	ass.Equal(9, len(patDoc.Classifications))
+	ass.Equal(CPC, patDoc.Classifications[2].System)
 	for i := 0; i <= 1; i++ {
 		ass.Equal(IPC, patDoc.Classifications[i].System)
 		ass.Equal(i+1, patDoc.Classifications[i].Sequence)
@@ -3596,7 +3668,7 @@ func TestProcessXMLSimple151B2(t *testing.T) {
 
 	// classifications
 	ass.NotEmpty(patDoc.Classifications)
-	ass.Equal(1, len(patDoc.Classifications))
+	ass.Equal(2, len(patDoc.Classifications))
 
 	ass.Equal("A24C   5/20        20060101AFI20150420BHEP        ", patDoc.Classifications[0].Text)
 	ass.Equal(IPC, patDoc.Classifications[0].System)
@@ -3615,6 +3687,20 @@ func TestProcessXMLSimple151B2(t *testing.T) {
 	ass.Equal("H", patDoc.Classifications[0].Source)
 	ass.Equal("EP", patDoc.Classifications[0].GeneratingOffice)
 
+	// CPC classification (B520EP)
+	ass.Equal("A24C   5/20        20130101 FI20130212BHEP        ", patDoc.Classifications[1].Text)
+	ass.Equal(CPC, patDoc.Classifications[1].System)
+	ass.Equal(1, patDoc.Classifications[1].Sequence)
+	ass.Equal("A24C 5/20", patDoc.Classifications[1].Symbol())
+	ass.Equal("20130101", patDoc.Classifications[1].Version)
+	ass.Equal("", patDoc.Classifications[1].ClassificationLevel)
+	ass.Equal("F", patDoc.Classifications[1].FirstLater)
+	ass.Equal("I", patDoc.Classifications[1].ClassificationValue)
+	ass.Equal("20130212", patDoc.Classifications[1].ActionDate)
+	ass.Equal("B", patDoc.Classifications[1].OriginalOrReclassified)
+	ass.Equal("H", patDoc.Classifications[1].Source)
+	ass.Equal("EP", patDoc.Classifications[1].GeneratingOffice)
+
 	/*fmt.Println(patDoc.Title[0])
 	fmt.Println(patDoc.Claims[0])
 	fmt.Println(patDoc.Description[0])
@@ -0,0 +1,147 @@
+package eps
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+var ErrParseWarnings = errors.New("parse warnings")
+
+// Severity is the severity of a parse warning
+type Severity string
+
+const (
+	SeverityInfo    Severity = "info"
+	SeverityWarning Severity = "warning"
+	SeverityError   Severity = "error"
+)
+
+// rank orders the severities
+func (s Severity) rank() int {
+	switch s {
+	case SeverityError:
+		return 2
+	case SeverityWarning:
+		return 1
+	}
+	return 0
+}
+
+// ParseWarning is a problem found while parsing a document, e.g. a date that can not be parsed
+type ParseWarning struct {
+	Field    string   `json:"field,omitempty"` // field of EpPatentDocumentSimple, e.g. DatePubl
+	Path     string   `json:"path,omitempty"`  // element path, e.g. /ep-patent-document/SDOBI/B500/B510EP/classification-ipcr/text
+	Value    string   `json:"value,omitempty"` // raw value
+	Severity Severity `json:"severity,omitempty"`
+	Message  string   `json:"message,omitempty"`
+}
+
+// String returns the warning in a readable form
+func (w ParseWarning) String() string {
+	if w.Value == "" {
+		return fmt.Sprintf("%s: %s: %s: %s", w.Severity, w.Field, w.Path, w.Message)
+	}
+	return fmt.Sprintf("%s: %s: %s: %s (%q)", w.Severity, w.Field, w.Path, w.Message, w.Value)
+}
+
+// ParseError is returned in strict mode if the document has warnings
+type ParseError struct {
+	Warnings []ParseWarning
+}
+
+func (e *ParseError) Error() string {
+	messages := make([]string, 0, len(e.Warnings))
+	for _, w := range e.Warnings {
+		messages = append(messages, w.String())
+	}
+	return ErrParseWarnings.Error() + ": " + strings.Join(messages, "; ")
+}
+
+func (e *ParseError) Unwrap() error {
+	return ErrParseWarnings
+}
+
+// ProcessOptions configures the processing of the xml data
+type ProcessOptions struct {
+	// Strict returns a *ParseError if a warning with the severity warning or error is found
+	Strict bool
+	// Math is the format of the MathML formulas in the texts
+	Math MathFormat
+	// InlineTables keeps the text of the tables in the texts,
+	// by default the tables are replaced by a reference, e.g. [table:tabl0001]
+	InlineTables bool
+}
+
+// ProcessXMLSimpleWithOptions transforms the raw response of the xml data into a simple patent.
+// The problems found while parsing are returned in the Warnings field of the document.
+func ProcessXMLSimpleWithOptions(raw []byte, opts ProcessOptions) (patentDoc EpPatentDocumentSimple, err error) {
+	return ProcessXMLSimpleReaderWithOptions(bytes.NewReader(raw), opts)
+}
+
+// ProcessXMLSimpleReaderWithOptions transforms the xml data of the reader into a simple patent.
+// In strict mode, the document is returned together with a *ParseError, which matches ErrParseWarnings.
+func ProcessXMLSimpleReaderWithOptions(r io.Reader, opts ProcessOptions) (patentDoc EpPatentDocumentSimple, err error) {
+	p := simpleParser{doc: &patentDoc, warnings: &parseWarnings{}, math: opts.Math, inlineTables: opts.InlineTables}
+	err = p.parse(r)
+	patentDoc.Warnings = p.warnings.list
+	if err != nil {
+		return
+	}
+	// generate aliases
+	patentDoc.GenerateAliases()
+	if opts.Strict {
+		var strict []ParseWarning
+		for _, w := range patentDoc.Warnings {
+			if w.Severity.rank() >= SeverityWarning.rank() {
+				strict = append(strict, w)
+			}
+		}
+		if len(strict) > 0 {
+			err = &ParseError{Warnings: strict}
+		}
+	}
+	return
+}
+
+// parseWarnings collects the warnings of a document
+type parseWarnings struct {
+	list []ParseWarning
+}
+
+// add adds a warning, a nil collector ignores it
+func (w *parseWarnings) add(severity Severity, field, path, value, message string) {
+	if w == nil {
+		return
+	}
+	w.list = append(w.list, ParseWarning{
+		Field:    field,
+		Path:     path,
+		Value:    value,
+		Severity: severity,
+		Message:  message,
+	})
+}
+
+// dateValue parses a date string of the format YYYYMMDD
+func (w *parseWarnings) dateValue(field, path, dateString string) (t time.Time) {
+	dateString = strings.TrimSpace(dateString)
+	t, err := time.Parse(layoutDatePubl, dateString)
+	if err != nil {
+		w.add(SeverityWarning, field, path, dateString, "can not parse date")
+	}
+	return
+}
+
+// date parses the text of a date element
+func (w *parseWarnings) date(field string, n *xmlNode) time.Time {
+	return w.dateValue(field, n.path(), n.text())
+}
+
+// childDate parses the date child of the element
+func (w *parseWarnings) childDate(field string, n *xmlNode) time.Time {
+	return w.dateValue(field, n.path()+"/date", n.pathText("date"))
+}
@@ -0,0 +1,101 @@
+package eps
+
+import (
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"os"
+	"testing"
+)
+
+func TestProcessXMLSimpleWarnings(t *testing.T) {
+	ass := assert.New(t)
+	data, err := os.ReadFile("test-data/application/v1-4-A1-1.xml")
+	ass.NoError(err)
+	doc, err := ProcessXMLSimple(data)
+	ass.NoError(err)
+	ass.Len(doc.Warnings, 7)
+	ass.Equal(ParseWarning{
+		Field:    "Classifications",
+		Path:     "/ep-patent-document/SDOBI/B500/B510EP/classification-ipcr/text",
+		Value:    "B05B 7/ 00 A I ",
+		Severity: SeverityWarning,
+		Message:  "can not find IPC8 pattern",
+	}, doc.Warnings[0])
+	ass.Equal("Abstract", doc.Warnings[5].Field)
+	ass.Equal("Description", doc.Warnings[6].Field)
+
+	// the abstract is not part of the specification of a granted patent
+	data, err = os.ReadFile("test-data/grant/v1-5-B1.xml")
+	ass.NoError(err)
+	doc, err = ProcessXMLSimpleWithOptions(data, ProcessOptions{Strict: true})
+	ass.NoError(err)
+	ass.Len(doc.Warnings, 1)
+	ass.Equal(SeverityInfo, doc.Warnings[0].Severity)
+}
+
+func TestProcessXMLSimpleStrict(t *testing.T) {
+	ass := assert.New(t)
+	data, err := os.ReadFile("test-data/application/v1-5-A2.xml")
+	ass.NoError(err)
+	doc, err := ProcessXMLSimpleWithOptions(data, ProcessOptions{Strict: true})
+	ass.ErrorIs(err, ErrParseWarnings)
+	var parseErr *ParseError
+	ass.True(errors.As(err, &parseErr))
+	ass.Len(parseErr.Warnings, 2)
+	// the document is returned anyway
+	ass.Equal("EP19766069A2", doc.ID)
+
+	data, err = os.ReadFile("test-data/application/v1-5-A1.xml")
+	ass.NoError(err)
+	doc, err = ProcessXMLSimpleWithOptions(data, ProcessOptions{Strict: true})
+	ass.NoError(err)
+	ass.Empty(doc.Warnings)
+}
+
+func TestProcessXMLSimpleWarningsInvalidValues(t *testing.T) {
+	ass := assert.New(t)
+	data := []byte(`<ep-patent-document id="EP1234567A1" file="EP1234567NWA1.xml" lang="en" country="EP" doc-number="1234567" kind="A1" date-publ="2020-01-01" status="n" dtd-version="ep-patent-document-v1-5">
+<SDOBI lang="en">
+<B500><B510EP><classification-ipcr sequence="x"><text>invalid</text></classification-ipcr></B510EP>
+<B560><B561><text>unknown citation</text></B561><B565EP><date>2020</date></B565EP></B560></B500>
+</SDOBI>
+<abstract id="abst" lang="en"><p>Abstract</p></abstract>
+<description id="desc" lang="en"><p>Description</p></description>
+</ep-patent-document>`)
+	doc, err := ProcessXMLSimple(data)
+	ass.NoError(err)
+	var paths []string
+	for _, w := range doc.Warnings {
+		paths = append(paths, w.Path)
+	}
+	ass.Equal([]string{
+		"/ep-patent-document/@date-publ",
+		"/ep-patent-document/SDOBI/B500/B510EP/classification-ipcr/@sequence",
+		"/ep-patent-document/SDOBI/B500/B510EP/classification-ipcr/text",
+		"/ep-patent-document/SDOBI/B500/B560/B561/text",
+		"/ep-patent-document/SDOBI/B500/B560/B565EP/date",
+	}, paths)
+	ass.Equal("2020-01-01", doc.Warnings[0].Value)
+	ass.Equal("x", doc.Warnings[1].Value)
+}
+
+func TestClassificationPattern(t *testing.T) {
+	ass := assert.New(t)
+	item, err := NewIpcrClassificationItemFromString("B60T 17/22 20060101AFI20200403BHEP", 1)
+	ass.NoError(err)
+	ass.Equal("B60T 17/22", item.Symbol())
+	item, err = NewIpcrClassificationItemFromString("invalid", 1)
+	ass.ErrorIs(err, ErrClassificationPattern)
+	ass.Equal("invalid", item.Text)
+
+	item, err = NewIpc7ClassificationItemFromString("7C 07C  29/44   A", 1, "F")
+	ass.NoError(err)
+	ass.Equal("C07C 29/44", item.Symbol())
+	_, err = NewIpc7ClassificationItemFromString("invalid", 1, "F")
+	ass.ErrorIs(err, ErrClassificationPattern)
+
+	_, ok := newPriorArtCitation("EP-A1- 0 444 678")
+	ass.True(ok)
+	_, ok = newPriorArtCitation("unknown citation")
+	ass.False(ok)
+}
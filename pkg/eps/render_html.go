@@ -0,0 +1,236 @@
+package eps
+
+import (
+	"embed"
+	"encoding/base64"
+	"github.com/max-planck-innovation-competition/go-epo-eps/pkg/images"
+	log "github.com/sirupsen/logrus"
+	"html/template"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+//go:embed templates/patent.html.tmpl
+var htmlTemplateFS embed.FS
+
+// htmlTemplateFuncs are the functions of the html templates
+var htmlTemplateFuncs = template.FuncMap{
+	"formatDate":     func(t time.Time) string { return t.Format("2006-01-02") },
+	"classification": ClassificationItem.Symbol,
+}
+
+// DefaultHTMLTemplate returns a new copy of the default templates.
+// Single templates can be overridden with Parse, e.g. {{define "style"}}...{{end}},
+// the templates are document, style, header, abstract, description, claims, figures and figure.
+func DefaultHTMLTemplate() (*template.Template, error) {
+	t, err := template.New("patent").Funcs(htmlTemplateFuncs).ParseFS(htmlTemplateFS, "templates/patent.html.tmpl")
+	if err != nil {
+		log.WithError(err).Error("can not parse html templates")
+		return nil, err
+	}
+	return t, nil
+}
+
+// HTMLOptions are the options of the html rendering
+type HTMLOptions struct {
+	Language    string             // language of the texts, the language of the document by default
+	Bundle      *PatentBundle      // the figures are embedded as png images if the bundle is set
+	FigureWidth int                // maximum width of the embedded figures in pixels, 0 keeps the size
+	Template    *template.Template // the template document is executed, see DefaultHTMLTemplate
+}
+
+// HTMLDocument is the data of the html templates
+type HTMLDocument struct {
+	Doc               *EpPatentDocumentSimple
+	Language          string
+	Title             string
+	PublicationNumber string // e.g. EP 3353086 B1
+	Abstract          []string
+	Description       []HTMLParagraph
+	Claims            []HTMLClaim
+	Figures           []HTMLFigure // figures that are not referenced in the description
+}
+
+// HTMLParagraph is a paragraph or heading of the description with the figures it refers to first
+type HTMLParagraph struct {
+	Paragraph
+	Figures []HTMLFigure
+}
+
+// HTMLClaim is a claim with links to the claims it refers to
+type HTMLClaim struct {
+	ClaimItem
+	Anchor string        // id of the element, e.g. claims01-claim-1
+	HTML   template.HTML // escaped text with the links
+}
+
+// HTMLFigure is a figure with its image as data url
+type HTMLFigure struct {
+	Figure
+	Src template.URL // empty without bundle
+}
+
+// RenderHTML writes the document as self-contained html page
+func RenderHTML(w io.Writer, doc *EpPatentDocumentSimple, opts HTMLOptions) (err error) {
+	t := opts.Template
+	if t == nil {
+		t, err = DefaultHTMLTemplate()
+		if err != nil {
+			return
+		}
+	}
+	err = t.ExecuteTemplate(w, "document", newHTMLDocument(doc, opts))
+	if err != nil {
+		log.WithError(err).WithField("id", doc.ID).Error("can not render html")
+		return
+	}
+	return
+}
+
+// RenderHTMLFromXML parses the xml data and writes it as html page
+func RenderHTMLFromXML(w io.Writer, raw []byte, opts HTMLOptions) (err error) {
+	doc, err := ProcessXMLSimple(raw)
+	if err != nil {
+		return
+	}
+	return RenderHTML(w, &doc, opts)
+}
+
+// newHTMLDocument selects the texts of the language and places the figures
+func newHTMLDocument(doc *EpPatentDocumentSimple, opts HTMLOptions) (res HTMLDocument) {
+	lang := opts.Language
+	if lang == "" {
+		lang = doc.Lang
+	}
+	res.Doc = doc
+	res.Language = lang
+	res.PublicationNumber = strings.TrimSpace(string(doc.Country) + " " + doc.DocNumber + " " + doc.Kind)
+	if t, ok := doc.TitleIn(lang); ok {
+		res.Title = t.Text
+	}
+	if a, ok := doc.AbstractIn(lang); ok {
+		res.Abstract = splitLines(a.Text)
+	}
+	figures := htmlFigures(doc, opts)
+	placed := map[string]bool{}
+	if d, ok := doc.DescriptionIn(lang); ok {
+		// the figures are shown after the first paragraph that refers to them
+		firstRefs := map[string][]HTMLFigure{}
+		for _, f := range figures {
+			for _, r := range f.References {
+				if r.Field == "Description" && r.Language == d.Language && r.ParagraphID != "" {
+					firstRefs[r.ParagraphID] = append(firstRefs[r.ParagraphID], f)
+					break
+				}
+			}
+		}
+		paragraphs := d.Paragraphs
+		if len(paragraphs) == 0 {
+			// e.g. documents that were not parsed by ProcessXMLSimple
+			for _, line := range splitLines(d.Text) {
+				paragraphs = append(paragraphs, Paragraph{Text: line})
+			}
+		}
+		for _, p := range paragraphs {
+			hp := HTMLParagraph{Paragraph: p}
+			if p.ID != "" {
+				hp.Figures = firstRefs[p.ID]
+				for _, f := range hp.Figures {
+					placed[f.ID] = true
+				}
+			}
+			res.Description = append(res.Description, hp)
+		}
+	}
+	for _, f := range figures {
+		if !placed[f.ID] {
+			res.Figures = append(res.Figures, f)
+		}
+	}
+	res.Claims = htmlClaims(doc.ClaimsIn(lang))
+	return
+}
+
+// htmlFigures converts the images of the figures from the bundle into png data urls
+func htmlFigures(doc *EpPatentDocumentSimple, opts HTMLOptions) (res []HTMLFigure) {
+	for _, f := range doc.Figures {
+		hf := HTMLFigure{Figure: f}
+		if opts.Bundle != nil {
+			if e, ok := opts.Bundle.Image(f.Image.File); ok {
+				data, err := e.Convert(images.Options{Format: images.PNG, MaxWidth: opts.FigureWidth})
+				if err != nil {
+					log.WithError(err).WithField("file", f.Image.File).Warn("can not embed figure")
+				} else {
+					hf.Src = template.URL("data:image/png;base64," + base64.StdEncoding.EncodeToString(data))
+				}
+			}
+		}
+		res = append(res, hf)
+	}
+	return
+}
+
+// htmlClaims links the references of the claims to the claims of the set.
+// The anchors are prefixed with the id of the set, because the numbers are repeated in each set.
+func htmlClaims(claims []Claim) (res []HTMLClaim) {
+	for i, c := range claims {
+		prefix := c.Id
+		if prefix == "" {
+			prefix = "claims" + strconv.Itoa(i+1)
+		}
+		anchors := map[int]string{}
+		start := len(res)
+		for _, item := range c.Items {
+			anchor := item.Id
+			if n := item.Number(); n > 0 {
+				anchor = prefix + "-claim-" + strconv.Itoa(n)
+				anchors[n] = anchor
+			}
+			res = append(res, HTMLClaim{ClaimItem: item, Anchor: anchor})
+		}
+		for j := start; j < len(res); j++ {
+			res[j].HTML = linkClaimReferences(res[j].Text, anchors)
+		}
+	}
+	return
+}
+
+// linkClaimReferences escapes the text and links the numbers of the references to the anchors of the claims
+func linkClaimReferences(text string, anchors map[int]string) template.HTML {
+	var b strings.Builder
+	last := 0
+	for _, m := range claimReferencePattern.FindAllStringSubmatchIndex(text, -1) {
+		// m[2] and m[3] are the bounds of the numbers
+		for _, r := range claimRangePattern.FindAllStringSubmatchIndex(text[m[2]:m[3]], -1) {
+			for _, bounds := range [][2]int{{r[2], r[3]}, {r[4], r[5]}} {
+				if bounds[0] < 0 {
+					continue
+				}
+				start, end := m[2]+bounds[0], m[2]+bounds[1]
+				n, _ := strconv.Atoi(text[start:end])
+				anchor, ok := anchors[n]
+				if !ok {
+					continue
+				}
+				b.WriteString(template.HTMLEscapeString(text[last:start]))
+				b.WriteString(`<a href="#` + template.HTMLEscapeString(anchor) + `">` + text[start:end] + `</a>`)
+				last = end
+			}
+		}
+	}
+	b.WriteString(template.HTMLEscapeString(text[last:]))
+	return template.HTML(b.String())
+}
+
+// splitLines returns the non-empty lines of the text
+func splitLines(text string) (res []string) {
+	for _, line := range strings.Split(text, "\n") {
+		line = strings.TrimSpace(line)
+		if line != "" {
+			res = append(res, line)
+		}
+	}
+	return
+}
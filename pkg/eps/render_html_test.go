@@ -0,0 +1,110 @@
+package eps
+
+import (
+	"bytes"
+	"github.com/stretchr/testify/assert"
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestRenderHTML(t *testing.T) {
+	ass := assert.New(t)
+	data, err := os.ReadFile("./test-data/grant/v1-5-1-B1.xml")
+	ass.NoError(err)
+	var buf bytes.Buffer
+	err = RenderHTMLFromXML(&buf, data, HTMLOptions{})
+	if !ass.NoError(err) {
+		return
+	}
+	res := buf.String()
+	ass.True(strings.HasPrefix(res, "<!DOCTYPE html>\n<html lang=\"en\">"))
+	ass.Contains(res, "<title>EP 3383757 B1 - SCREW-TYPE CLOSURE SYSTEMS WITH MAGNETIC FEATURE</title>")
+	ass.Contains(res, "<dt>Date of publication</dt><dd>2021-07-07</dd>")
+	ass.Contains(res, "<dd>B65D 51/18; B65D 43/02;")
+	// numbered paragraphs and headings
+	ass.Contains(res, `<h3 id="h0001">Field of the Invention</h3>`)
+	ass.Contains(res, `<div class="paragraph" id="p0001"><span class="num">[0001]</span> <p>The present invention pertains to`)
+	// links to the claims
+	ass.Contains(res, `<div class="claim" id="claims01-claim-2"><span class="num">2.</span> <span class="text">The container-closure system of claim <a href="#claims01-claim-1">1</a> wherein:`)
+	// the figure is shown after the first paragraph that refers to it
+	figure := strings.Index(res, `<figure id="f0001">`)
+	ass.Greater(figure, strings.Index(res, `id="p0007"`))
+	ass.Less(figure, strings.Index(res, `id="p0008"`))
+	ass.NotContains(res, `id="drawings"`)
+
+	// other language
+	buf.Reset()
+	ass.NoError(RenderHTMLFromXML(&buf, data, HTMLOptions{Language: "de"}))
+	ass.Contains(buf.String(), "<h1>SCHRAUBVERSCHLUSSSYSTEME MIT MAGNETISCHEM MERKMAL</h1>")
+	ass.Contains(buf.String(), `nach Anspruch <a href="#claims02-claim-1">1</a>`)
+}
+
+func TestRenderHTMLBundle(t *testing.T) {
+	ass := assert.New(t)
+	bundle, err := OpenPatentBundle(newTestBundle(t))
+	if !ass.NoError(err) {
+		return
+	}
+	defer bundle.Close()
+	doc, err := bundle.Document()
+	ass.NoError(err)
+	var buf bytes.Buffer
+	ass.NoError(RenderHTML(&buf, &doc, HTMLOptions{Bundle: bundle, FigureWidth: 100}))
+	res := buf.String()
+	ass.Contains(res, `<figure id="f0003"><img src="data:image/png;base64,`)
+	// the invalid tif image is not embedded
+	ass.Contains(res, "<figcaption>Fig. 1 (imgf0001.tif)</figcaption>")
+}
+
+func TestRenderHTMLTemplate(t *testing.T) {
+	ass := assert.New(t)
+	tmpl, err := DefaultHTMLTemplate()
+	ass.NoError(err)
+	_, err = tmpl.Parse(`{{define "style"}}body { color: red; }{{end}}{{define "header"}}<h1>{{.PublicationNumber}}</h1>{{end}}`)
+	ass.NoError(err)
+	doc := EpPatentDocumentSimple{
+		Country:   "EP",
+		DocNumber: "1234567",
+		Kind:      "A1",
+		Lang:      "en",
+		Description: []Description{{
+			Language: "en",
+			Text:     "Background\n<b> & more",
+		}},
+	}
+	var buf bytes.Buffer
+	ass.NoError(RenderHTML(&buf, &doc, HTMLOptions{Template: tmpl}))
+	res := buf.String()
+	ass.Contains(res, "<style>body { color: red; }</style>")
+	ass.Contains(res, "<h1>EP 1234567 A1</h1>")
+	// the lines are used as paragraphs without numbers
+	ass.Contains(res, `<div class="paragraph"><p>&lt;b&gt; &amp; more</p></div>`)
+	// the default template is not changed
+	other, err := DefaultHTMLTemplate()
+	ass.NoError(err)
+	ass.NotNil(other.Lookup("header"))
+}
+
+func TestHTMLClaims(t *testing.T) {
+	ass := assert.New(t)
+	// the claims of the sets are numbered from 1, e.g. the claims for different contracting states
+	res := htmlClaims([]Claim{
+		{Id: "claims01", Items: []ClaimItem{{Num: "0001", Text: "A device."}, {Num: "0002", Text: "The device of claim 1."}}},
+		{Items: []ClaimItem{{Num: "0001", Text: "A method."}, {Num: "0002", Text: "The method of claim 1."}}},
+	})
+	if !ass.Len(res, 4) {
+		return
+	}
+	ass.Equal("claims01-claim-1", res[0].Anchor)
+	ass.Equal(`The device of claim <a href="#claims01-claim-1">1</a>.`, string(res[1].HTML))
+	ass.Equal("claims2-claim-1", res[2].Anchor)
+	ass.Equal(`The method of claim <a href="#claims2-claim-1">1</a>.`, string(res[3].HTML))
+}
+
+func TestLinkClaimReferences(t *testing.T) {
+	ass := assert.New(t)
+	anchors := map[int]string{1: "claim-1", 2: "claim-2", 3: "claim-3"}
+	ass.Equal(`A &lt;process&gt; according to one of claims <a href="#claim-1">1</a> to <a href="#claim-3">3</a> or claim 7`,
+		string(linkClaimReferences("A <process> according to one of claims 1 to 3 or claim 7", anchors)))
+}
@@ -0,0 +1,119 @@
+package eps
+
+import (
+	"bytes"
+	"errors"
+	"github.com/PuerkitoBio/goquery"
+	log "github.com/sirupsen/logrus"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// documentURLStrategy finds the link to the document on the html page of the publication server
+type documentURLStrategy struct {
+	name string
+	find func(doc *goquery.Document) (href string, found bool)
+}
+
+// documentURLStrategies are tried in order, the first strategies match the current markup of the pages
+var documentURLStrategies = map[PatentExportFormat][]documentURLStrategy{
+	HTML: {
+		{name: "iframe #documentCenter", find: attrOf("#documentCenter", "src")},
+		{name: "iframe", find: attrOf("iframe[src]", "src")},
+		{name: "link rel=alternate type=text/html", find: attrOf(`link[rel~="alternate"][type="text/html"]`, "href")},
+	},
+	PDF: {
+		{name: "tool bar link", find: attrOf("#body > div.epoToolBar.document > ul > li > a", "href")},
+		{name: "link rel=alternate type=application/pdf", find: attrOf(`link[rel~="alternate"][type="application/pdf"]`, "href")},
+		{name: "anchor ending in .pdf", find: pdfAnchor},
+		{name: "embedded pdf", find: attrOf(`embed[type="application/pdf"], object[type="application/pdf"]`, "src", "data")},
+	},
+}
+
+// attrOf returns a strategy that reads the first of the attributes of the first matching element
+func attrOf(selector string, attrs ...string) func(doc *goquery.Document) (string, bool) {
+	return func(doc *goquery.Document) (string, bool) {
+		first := doc.Find(selector).First()
+		for _, attr := range attrs {
+			if href, ok := first.Attr(attr); ok {
+				return href, true
+			}
+		}
+		return "", false
+	}
+}
+
+// pdfAnchor finds the first anchor whose path ends in .pdf, the query is ignored
+func pdfAnchor(doc *goquery.Document) (res string, found bool) {
+	doc.Find("a[href]").EachWithBreak(func(i int, s *goquery.Selection) bool {
+		href, _ := s.Attr("href")
+		u, err := url.Parse(strings.TrimSpace(href))
+		if err == nil && strings.HasSuffix(strings.ToLower(u.Path), ".pdf") {
+			res, found = href, true
+			return false
+		}
+		return true
+	})
+	return
+}
+
+// ResolveDocumentURL finds the link to the document of the format on the html page of the publication server.
+// Relative links are resolved against the base element of the page or the url of the page, which can be nil.
+// The error lists the strategies that were tried.
+func ResolveDocumentURL(page []byte, pageURL *url.URL, format PatentExportFormat) (res string, err error) {
+	doc, err := goquery.NewDocumentFromReader(bytes.NewReader(page))
+	if err != nil {
+		log.WithError(err).Error("can not read document")
+		return
+	}
+	base := pageURL
+	if href, ok := doc.Find("base[href]").First().Attr("href"); ok {
+		base, err = resolveURL(pageURL, href)
+		if err != nil {
+			// the base element is ignored
+			base = pageURL
+		}
+	}
+	var tried []string
+	for _, strategy := range documentURLStrategies[format] {
+		href, found := strategy.find(doc)
+		if !found {
+			tried = append(tried, strategy.name+": not found")
+			continue
+		}
+		u, errResolve := resolveURL(base, href)
+		if errResolve != nil {
+			tried = append(tried, strategy.name+": "+errResolve.Error())
+			continue
+		}
+		return u.String(), nil
+	}
+	if len(tried) == 0 {
+		tried = append(tried, "no strategies for the format")
+	}
+	contentErr := newUnexpectedContentError(format, "can not find the document url, tried "+strings.Join(tried, "; "), page)
+	contentErr.Err = ErrNoDocumentLink
+	err = contentErr
+	log.WithError(err).Error("can not resolve document url")
+	return
+}
+
+// resolveURL resolves the link against the base, the result is an absolute http or https url
+func resolveURL(base *url.URL, href string) (*url.URL, error) {
+	href = strings.TrimSpace(href)
+	if href == "" || strings.HasPrefix(href, "#") {
+		return nil, errors.New("empty url " + strconv.Quote(href))
+	}
+	u, err := url.Parse(href)
+	if err != nil {
+		return nil, err
+	}
+	if base != nil {
+		u = base.ResolveReference(u)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return nil, errors.New("no http url " + strconv.Quote(href))
+	}
+	return u, nil
+}
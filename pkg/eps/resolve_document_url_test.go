@@ -0,0 +1,62 @@
+package eps
+
+import (
+	"github.com/stretchr/testify/assert"
+	"net/url"
+	"testing"
+)
+
+func TestResolveDocumentURL(t *testing.T) {
+	ass := assert.New(t)
+	pageURL, err := url.Parse("https://data.epo.org/publication-server/rest/v1.2/patents/EP2921808NWB1/document.pdf")
+	ass.NoError(err)
+
+	// current markup of the pdf page with a relative link
+	page := []byte(`<html><body><div id="body"><div class="epoToolBar document"><ul>
+<li><a href="../document.pdf?download=true">PDF</a></li></ul></div></div></body></html>`)
+	res, err := ResolveDocumentURL(page, pageURL, PDF)
+	ass.NoError(err)
+	ass.Equal("https://data.epo.org/publication-server/rest/v1.2/patents/document.pdf?download=true", res)
+
+	// link element
+	page = []byte(`<html><head><link rel="alternate" type="application/pdf" href="/files/EP2921808B1.pdf"></head><body></body></html>`)
+	res, err = ResolveDocumentURL(page, pageURL, PDF)
+	ass.NoError(err)
+	ass.Equal("https://data.epo.org/files/EP2921808B1.pdf", res)
+
+	// anchor ending in .pdf with a base element
+	page = []byte(`<html><head><base href="https://example.org/docs/"></head><body>
+<a href="#top">Top</a><a href="EP2921808B1.PDF?x=1">Download</a></body></html>`)
+	res, err = ResolveDocumentURL(page, pageURL, PDF)
+	ass.NoError(err)
+	ass.Equal("https://example.org/docs/EP2921808B1.PDF?x=1", res)
+
+	// the html document in the iframe
+	page = []byte(`<html><body><iframe id="documentCenter" src="//data.epo.org/publication-server/document?iDocId=1&amp;iFormat=0"></iframe></body></html>`)
+	res, err = ResolveDocumentURL(page, pageURL, HTML)
+	ass.NoError(err)
+	ass.Equal("https://data.epo.org/publication-server/document?iDocId=1&iFormat=0", res)
+	// other iframe
+	page = []byte(`<html><body><iframe name="content" src="document.html"></iframe></body></html>`)
+	res, err = ResolveDocumentURL(page, pageURL, HTML)
+	ass.NoError(err)
+	ass.Equal("https://data.epo.org/publication-server/rest/v1.2/patents/EP2921808NWB1/document.html", res)
+
+	// relative links need the url of the page
+	_, err = ResolveDocumentURL(page, nil, HTML)
+	ass.ErrorIs(err, ErrUnexpectedContent)
+}
+
+func TestResolveDocumentURLNotFound(t *testing.T) {
+	ass := assert.New(t)
+	page := []byte(`<html><body><a href="javascript:print()">Print</a><p>Maintenance</p></body></html>`)
+	_, err := ResolveDocumentURL(page, nil, PDF)
+	ass.ErrorIs(err, ErrUnexpectedContent)
+	ass.ErrorIs(err, ErrNoDocumentLink)
+	ass.Contains(err.Error(), "tried tool bar link: not found; link rel=alternate type=application/pdf: not found; anchor ending in .pdf: not found; embedded pdf: not found")
+
+	page = []byte(`<html><body><iframe id="documentCenter" src="javascript:void(0)"></iframe></body></html>`)
+	_, err = ResolveDocumentURL(page, nil, HTML)
+	ass.ErrorIs(err, ErrUnexpectedContent)
+	ass.Contains(err.Error(), `iframe #documentCenter: no http url "javascript:void(0)"`)
+}
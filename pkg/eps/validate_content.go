@@ -0,0 +1,156 @@
+package eps
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"github.com/PuerkitoBio/goquery"
+	"github.com/max-planck-innovation-competition/go-epo-eps/pkg/pdf"
+	"io"
+	"strings"
+)
+
+// ErrUnexpectedContent is returned if a download does not have the content of its format,
+// e.g. an html error page instead of a zip file or a truncated xml file
+var ErrUnexpectedContent = errors.New("unexpected content")
+
+// ErrNoDocumentLink is returned if the html page of the publication server does not link to the document,
+// e.g. a maintenance page without the iframe #documentCenter
+var ErrNoDocumentLink = errors.New("no link to the document")
+
+// snippetLength is the maximum number of bytes of the snippet
+const snippetLength = 200
+
+// UnexpectedContentError describes the unexpected content of a download.
+// It matches ErrUnexpectedContent and its cause with errors.Is.
+type UnexpectedContentError struct {
+	Format  PatentExportFormat
+	Reason  string
+	Snippet string // start of the content with collapsed whitespace
+	Err     error  // cause of the error, e.g. ErrNoDocumentLink, can be nil
+}
+
+// Error implements the error interface
+func (e *UnexpectedContentError) Error() string {
+	return ErrUnexpectedContent.Error() + " for " + string(e.Format) + ": " + e.Reason + ": " + e.Snippet
+}
+
+// Is matches ErrUnexpectedContent
+func (e *UnexpectedContentError) Is(target error) bool {
+	return target == ErrUnexpectedContent
+}
+
+// Unwrap returns the cause of the error
+func (e *UnexpectedContentError) Unwrap() error {
+	return e.Err
+}
+
+// newUnexpectedContentError creates the error with the snippet of the data
+func newUnexpectedContentError(format PatentExportFormat, reason string, data []byte) *UnexpectedContentError {
+	return &UnexpectedContentError{Format: format, Reason: reason, Snippet: snippet(data)}
+}
+
+// snippet returns the start of the data as valid utf-8 with collapsed whitespace
+func snippet(data []byte) string {
+	data = data[:min(len(data), snippetLength)]
+	// the cut can split the last rune, the invalid bytes are dropped
+	s := strings.ToValidUTF8(string(data), "")
+	return strings.Join(strings.Fields(s), " ")
+}
+
+// ValidateContent checks if the data has the content of the format:
+// a well-formed xml document with the root element ep-patent-document,
+// a zip file with a readable central directory, a complete pdf file or an html page
+func ValidateContent(format PatentExportFormat, data []byte) error {
+	if len(bytes.TrimSpace(data)) == 0 {
+		return newUnexpectedContentError(format, "empty response", data)
+	}
+	switch format {
+	case XML:
+		return validateXMLContent(data)
+	case ZIP:
+		if _, err := zip.NewReader(bytes.NewReader(data), int64(len(data))); err != nil {
+			return newUnexpectedContentError(format, "no zip file: "+err.Error(), data)
+		}
+	case PDF:
+		if err := pdf.Validate(data); err != nil {
+			return newUnexpectedContentError(format, err.Error(), data)
+		}
+	case HTML:
+		if !isHTML(data) {
+			return newUnexpectedContentError(format, "no html page", data)
+		}
+	}
+	return nil
+}
+
+// validateXMLContent checks that the root element is ep-patent-document and that all elements are closed by matching end tags.
+// The decoder is strict apart from the html entities, the bare '<' and '&' characters of the EPO files are escaped like for the parser.
+func validateXMLContent(data []byte) error {
+	d := xml.NewDecoder(newLenientXMLReader(bytes.NewReader(data)))
+	d.Strict = true
+	d.Entity = xml.HTMLEntity
+	rootFound := false
+	depth := 0
+	for {
+		token, err := d.Token()
+		if err == io.EOF {
+			break
+		}
+		var syntaxErr *xml.SyntaxError
+		if errors.As(err, &syntaxErr) && syntaxErr.Msg == "unexpected EOF" {
+			// the elements are not closed at the end of the data
+			return newUnexpectedContentError(XML, "truncated xml", data)
+		}
+		if err != nil {
+			return newUnexpectedContentError(XML, "malformed xml: "+err.Error(), data)
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			if !rootFound {
+				if t.Name.Local != "ep-patent-document" {
+					return newUnexpectedContentError(XML, "unexpected root element "+t.Name.Local, data)
+				}
+				rootFound = true
+			}
+			depth++
+		case xml.EndElement:
+			depth--
+		}
+	}
+	if !rootFound {
+		return newUnexpectedContentError(XML, "no root element", data)
+	}
+	if depth != 0 {
+		return newUnexpectedContentError(XML, "truncated xml", data)
+	}
+	return nil
+}
+
+// validatePublicationPage checks that the data is an html page of the publication server
+// with a link to the document of the format, e.g. the iframe #documentCenter of the html format.
+// The links are found like by ResolveDocumentURL, pages without a link result in ErrNoDocumentLink.
+func validatePublicationPage(format PatentExportFormat, data []byte) error {
+	if err := ValidateContent(HTML, data); err != nil {
+		return err
+	}
+	doc, err := goquery.NewDocumentFromReader(bytes.NewReader(data))
+	if err != nil {
+		return newUnexpectedContentError(HTML, "no html page: "+err.Error(), data)
+	}
+	for _, strategy := range documentURLStrategies[format] {
+		if _, found := strategy.find(doc); found {
+			return nil
+		}
+	}
+	contentErr := newUnexpectedContentError(format, ErrNoDocumentLink.Error(), data)
+	contentErr.Err = ErrNoDocumentLink
+	return contentErr
+}
+
+// isHTML checks if the start of the data is an html page
+func isHTML(data []byte) bool {
+	head := bytes.ToLower(data[:min(len(data), 1024)])
+	return bytes.Contains(head, []byte("<html")) || bytes.Contains(head, []byte("<!doctype html"))
+}
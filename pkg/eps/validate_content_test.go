@@ -0,0 +1,102 @@
+package eps
+
+import (
+	"archive/zip"
+	"bytes"
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const testErrorPage = `<!DOCTYPE html>
+<html>
+  <head><title>European publication server</title></head>
+  <body>Service unavailable</body>
+</html>`
+
+func TestValidateContentXML(t *testing.T) {
+	ass := assert.New(t)
+	files, err := filepath.Glob("./test-data/*/*.xml")
+	ass.NoError(err)
+	ass.NotEmpty(files)
+	for _, file := range files {
+		data, err := os.ReadFile(file)
+		ass.NoError(err)
+		ass.NoError(ValidateContent(XML, data), file)
+	}
+
+	data, err := os.ReadFile("./test-data/grant/v1-5-1-B1.xml")
+	ass.NoError(err)
+	// truncated body
+	err = ValidateContent(XML, data[:len(data)/2])
+	ass.ErrorIs(err, ErrUnexpectedContent)
+	ass.Contains(err.Error(), "truncated xml")
+	// html error page
+	err = ValidateContent(XML, []byte(testErrorPage))
+	ass.ErrorIs(err, ErrUnexpectedContent)
+	// other root element
+	err = ValidateContent(XML, []byte(`<?xml version="1.0"?><error>forbidden</error>`))
+	ass.ErrorIs(err, ErrUnexpectedContent)
+	ass.Contains(err.Error(), "unexpected root element error")
+	// empty body
+	ass.ErrorIs(ValidateContent(XML, []byte("\n")), ErrUnexpectedContent)
+}
+
+func TestValidateContentZIP(t *testing.T) {
+	ass := assert.New(t)
+	var buf bytes.Buffer
+	w := zip.NewWriter(&buf)
+	f, err := w.Create("EP16849316NWB1.xml")
+	ass.NoError(err)
+	_, err = f.Write([]byte("<ep-patent-document/>"))
+	ass.NoError(err)
+	ass.NoError(w.Close())
+	ass.NoError(ValidateContent(ZIP, buf.Bytes()))
+	// truncated body without central directory
+	ass.ErrorIs(ValidateContent(ZIP, buf.Bytes()[:buf.Len()/2]), ErrUnexpectedContent)
+
+	err = ValidateContent(ZIP, []byte(testErrorPage))
+	var contentErr *UnexpectedContentError
+	if ass.True(errors.As(err, &contentErr)) {
+		ass.Equal(ZIP, contentErr.Format)
+		ass.True(strings.HasPrefix(contentErr.Snippet, "<!DOCTYPE html> <html> <head><title>"))
+	}
+}
+
+func TestValidateContentPDF(t *testing.T) {
+	ass := assert.New(t)
+	ass.NoError(ValidateContent(PDF, []byte("%PDF-1.4\n1 0 obj\n<< /Type /Catalog >>\nendobj\n%%EOF\n")))
+	ass.ErrorIs(ValidateContent(PDF, []byte("%PDF-1.4\n1 0 obj\n<< /Type")), ErrUnexpectedContent)
+	ass.ErrorIs(ValidateContent(PDF, []byte(testErrorPage)), ErrUnexpectedContent)
+}
+
+func TestValidateContentHTML(t *testing.T) {
+	ass := assert.New(t)
+	ass.NoError(ValidateContent(HTML, []byte(testErrorPage)))
+	ass.ErrorIs(ValidateContent(HTML, []byte("%PDF-1.4")), ErrUnexpectedContent)
+}
+
+func TestValidatePublicationPage(t *testing.T) {
+	ass := assert.New(t)
+	page := []byte(`<!DOCTYPE html><html><body><iframe id="documentCenter" src="document?iDocId=1"></iframe></body></html>`)
+	ass.NoError(validatePublicationPage(HTML, page))
+	// the pdf page has no pdf link
+	err := validatePublicationPage(PDF, page)
+	ass.ErrorIs(err, ErrNoDocumentLink)
+	ass.ErrorIs(err, ErrUnexpectedContent)
+	// error page
+	ass.ErrorIs(validatePublicationPage(HTML, []byte(testErrorPage)), ErrNoDocumentLink)
+	ass.NotErrorIs(validatePublicationPage(HTML, []byte("%PDF-1.4")), ErrNoDocumentLink)
+}
+
+func TestSnippet(t *testing.T) {
+	ass := assert.New(t)
+	ass.Equal("a b", snippet([]byte("  a\n\t b ")))
+	long := strings.Repeat("ä", snippetLength)
+	s := snippet([]byte(long))
+	// the split rune is dropped
+	ass.Equal(snippetLength/2, len([]rune(s)))
+}
@@ -0,0 +1,95 @@
+package eps
+
+import (
+	"bytes"
+	"encoding/xml"
+	"github.com/max-planck-innovation-competition/go-epo-eps/dtds"
+	"github.com/max-planck-innovation-competition/go-epo-eps/pkg/dtd"
+	log "github.com/sirupsen/logrus"
+	"io"
+	"sync"
+)
+
+// parsed dtds by file name
+var (
+	dtdCache      = map[string]*dtd.DTD{}
+	dtdCacheMutex sync.Mutex
+)
+
+// DTD returns the parsed dtd of the dtd-version, e.g. ep-patent-document-v1-5
+func DTD(dtdVersion string) (d *dtd.DTD, err error) {
+	v, err := LookupDtdVersion(dtdVersion)
+	if err != nil {
+		log.WithError(err).WithField("dtdVersion", dtdVersion).Error("can not find dtd")
+		return
+	}
+	file := v.DTD
+	if file == "" {
+		err = ErrNoDtdAvailable
+		log.WithField("dtdVersion", dtdVersion).Warn("no dtd available")
+		return
+	}
+	dtdCacheMutex.Lock()
+	defer dtdCacheMutex.Unlock()
+	if cached, ok := dtdCache[file]; ok {
+		return cached, nil
+	}
+	f, err := dtds.FS.Open(file)
+	if err != nil {
+		log.WithError(err).WithField("file", file).Error("can not open dtd")
+		return
+	}
+	defer f.Close()
+	d, err = dtd.Parse(f)
+	if err != nil {
+		log.WithError(err).WithField("file", file).Error("can not parse dtd")
+		return
+	}
+	dtdCache[file] = d
+	return
+}
+
+// ValidateXML validates the xml data against the dtd of its dtd-version attribute.
+// The structural violations are returned as diagnostics, e.g. unknown elements,
+// missing required children or bad attributes. A document that is not well-formed
+// results in a dtd.MalformedXML diagnostic. Like the parser, the validator accepts
+// unescaped < and & characters in the texts.
+// Documents of a dtd-version without dtd are not validated and result in ErrNoDtdAvailable.
+func ValidateXML(raw []byte) (diagnostics []dtd.Diagnostic, err error) {
+	dtdVersion, err := readDtdVersion(raw)
+	if err != nil {
+		return
+	}
+	d, err := DTD(dtdVersion)
+	if err != nil {
+		return
+	}
+	return d.ValidateDecoder(newDecoder(bytes.NewReader(raw)), "ep-patent-document")
+}
+
+// readDtdVersion reads the dtd-version attribute of the root element
+func readDtdVersion(raw []byte) (dtdVersion string, err error) {
+	d := newDecoder(bytes.NewReader(raw))
+	for {
+		token, errToken := d.RawToken()
+		if errToken == io.EOF {
+			break
+		}
+		if errToken != nil {
+			err = errToken
+			log.WithError(err).Error("can not read document")
+			return
+		}
+		if t, ok := token.(xml.StartElement); ok {
+			for _, a := range t.Attr {
+				if a.Name.Local == "dtd-version" {
+					return a.Value, nil
+				}
+			}
+			break
+		}
+	}
+	err = ErrUnknownDtdVersion
+	log.WithError(err).Error("document has no dtd-version")
+	return
+}
@@ -0,0 +1,75 @@
+package eps
+
+import (
+	"bytes"
+	"github.com/max-planck-innovation-competition/go-epo-eps/pkg/dtd"
+	"github.com/stretchr/testify/assert"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestValidateXML(t *testing.T) {
+	ass := assert.New(t)
+	files, err := filepath.Glob("test-data/*/*.xml")
+	ass.NoError(err)
+	ass.NotEmpty(files)
+	for _, file := range files {
+		data, err := os.ReadFile(file)
+		ass.NoError(err)
+		diagnostics, err := ValidateXML(data)
+		version, _ := readDtdVersion(data)
+		if v, _ := LookupDtdVersion(version); v.DTD == "" {
+			// the dtds of version 1.2 and 1.5.1 are not available
+			ass.ErrorIs(err, ErrNoDtdAvailable, file)
+			continue
+		}
+		ass.NoError(err, file)
+		ass.Empty(diagnostics, file)
+	}
+}
+
+func TestValidateXMLMalformed(t *testing.T) {
+	ass := assert.New(t)
+	data, err := os.ReadFile("test-data/application/v1-5-A1.xml")
+	ass.NoError(err)
+	// the end element does not match the start element
+	data = bytes.Replace(data, []byte("</B542>"), []byte("</B541>"), 1)
+	diagnostics, err := ValidateXML(data)
+	ass.NoError(err)
+	ass.Len(diagnostics, 1)
+	ass.Equal(dtd.MalformedXML, diagnostics[0].Kind)
+	ass.Equal("/ep-patent-document/SDOBI/B500/B540/B542", diagnostics[0].Path)
+}
+
+func TestValidateXMLUnescaped(t *testing.T) {
+	ass := assert.New(t)
+	// the unescaped & and < characters are accepted like by the parser
+	data, err := os.ReadFile("test-data/application/v1-5-A1.xml")
+	ass.NoError(err)
+	data = bytes.Replace(data, []byte("<B542>"), []byte("<B542>A & B < C "), 1)
+	diagnostics, err := ValidateXML(data)
+	ass.NoError(err)
+	ass.Empty(diagnostics)
+}
+
+func TestValidateXMLUnknownElements(t *testing.T) {
+	ass := assert.New(t)
+	data, err := os.ReadFile("test-data/application/v1-5-A1.xml")
+	ass.NoError(err)
+	data = bytes.Replace(data, []byte("<B540>"), []byte("<B530EP>X</B530EP><B540>"), 1)
+	diagnostics, err := ValidateXML(data)
+	ass.NoError(err)
+	ass.NotEmpty(diagnostics)
+	ass.Equal(dtd.UnknownElement, diagnostics[0].Kind)
+	ass.Equal("B530EP", diagnostics[0].Element)
+	ass.Equal("/ep-patent-document/SDOBI/B500/B530EP", diagnostics[0].Path)
+}
+
+func TestValidateXMLUnknownVersion(t *testing.T) {
+	ass := assert.New(t)
+	_, err := ValidateXML([]byte(`<ep-patent-document dtd-version="ep-patent-document-v9-9"/>`))
+	ass.ErrorIs(err, ErrUnknownDtdVersion)
+	_, err = ValidateXML([]byte(`<ep-patent-document/>`))
+	ass.ErrorIs(err, ErrUnknownDtdVersion)
+}
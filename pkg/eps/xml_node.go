@@ -0,0 +1,265 @@
+package eps
+
+import (
+	"encoding/xml"
+	"strings"
+)
+
+// xmlNode is a light weight element (or text node) captured from the xml token stream
+type xmlNode struct {
+	Name     string // empty for text nodes
+	Attr     []xml.Attr
+	Data     string // character data of text nodes
+	Parent   *xmlNode
+	Children []*xmlNode
+}
+
+// attr returns the value of the attribute
+func (n *xmlNode) attr(name string) (string, bool) {
+	if n == nil {
+		return "", false
+	}
+	for _, a := range n.Attr {
+		if a.Name.Local == name {
+			return a.Value, true
+		}
+	}
+	return "", false
+}
+
+// attrValue returns the value of the attribute or an empty string
+func (n *xmlNode) attrValue(name string) string {
+	v, _ := n.attr(name)
+	return v
+}
+
+// text returns the concatenated character data of the node and all descendants
+func (n *xmlNode) text() string {
+	if n == nil {
+		return ""
+	}
+	if n.Name == "" {
+		return n.Data
+	}
+	var sb strings.Builder
+	n.writeText(&sb)
+	return sb.String()
+}
+
+func (n *xmlNode) writeText(sb *strings.Builder) {
+	for _, c := range n.Children {
+		if c.Name == "" {
+			sb.WriteString(c.Data)
+		} else {
+			c.writeText(sb)
+		}
+	}
+}
+
+// is checks if the node is an element with one of the names
+func (n *xmlNode) is(names ...string) bool {
+	if n == nil || n.Name == "" {
+		return false
+	}
+	for _, name := range names {
+		if n.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// elements returns the child elements of the node
+func (n *xmlNode) elements() (res []*xmlNode) {
+	if n == nil {
+		return
+	}
+	for _, c := range n.Children {
+		if c.Name != "" {
+			res = append(res, c)
+		}
+	}
+	return
+}
+
+// childrenNamed returns the child elements with one of the names
+func (n *xmlNode) childrenNamed(names ...string) (res []*xmlNode) {
+	for _, c := range n.elements() {
+		if c.is(names...) {
+			res = append(res, c)
+		}
+	}
+	return
+}
+
+// child returns the first child element with one of the names or nil
+func (n *xmlNode) child(names ...string) *xmlNode {
+	for _, c := range n.elements() {
+		if c.is(names...) {
+			return c
+		}
+	}
+	return nil
+}
+
+// find returns the descendant elements with one of the names in document order
+func (n *xmlNode) find(names ...string) (res []*xmlNode) {
+	if n == nil {
+		return
+	}
+	for _, c := range n.Children {
+		if c.is(names...) {
+			res = append(res, c)
+		}
+		res = append(res, c.find(names...)...)
+	}
+	return
+}
+
+// findFirst returns the first descendant element with one of the names or nil
+func (n *xmlNode) findFirst(names ...string) *xmlNode {
+	if n == nil {
+		return nil
+	}
+	for _, c := range n.Children {
+		if c.is(names...) {
+			return c
+		}
+		if res := c.findFirst(names...); res != nil {
+			return res
+		}
+	}
+	return nil
+}
+
+// findPath returns the descendant elements matching the path like a css descendant selector,
+// e.g. findPath("adr", "ctry") behaves like the selector "adr ctry"
+func (n *xmlNode) findPath(path ...string) (res []*xmlNode) {
+	if n == nil || len(path) == 0 {
+		return
+	}
+	var walk func(node *xmlNode, matched int)
+	walk = func(node *xmlNode, matched int) {
+		for _, c := range node.Children {
+			if c.Name == "" {
+				continue
+			}
+			next := matched
+			if matched == len(path)-1 {
+				if c.Name == path[matched] {
+					res = append(res, c)
+				}
+			} else if c.Name == path[matched] {
+				next++
+			}
+			walk(c, next)
+		}
+	}
+	walk(n, 0)
+	return
+}
+
+// pathText returns the concatenated text of all elements matching the path
+func (n *xmlNode) pathText(path ...string) string {
+	var sb strings.Builder
+	for _, m := range n.findPath(path...) {
+		m.writeText(&sb)
+	}
+	return sb.String()
+}
+
+// hasAncestor checks if one of the ancestors has the name
+func (n *xmlNode) hasAncestor(name string) bool {
+	return n.ancestor(name) != nil
+}
+
+// ancestor returns the closest ancestor with the name or nil
+func (n *xmlNode) ancestor(name string) *xmlNode {
+	if n == nil {
+		return nil
+	}
+	for p := n.Parent; p != nil; p = p.Parent {
+		if p.Name == name {
+			return p
+		}
+	}
+	return nil
+}
+
+// nextElement returns the next sibling element or nil
+func (n *xmlNode) nextElement() *xmlNode {
+	if n == nil || n.Parent == nil {
+		return nil
+	}
+	found := false
+	for _, c := range n.Parent.Children {
+		if found && c.Name != "" {
+			return c
+		}
+		if c == n {
+			found = true
+		}
+	}
+	return nil
+}
+
+// path returns the element path of the node, e.g. /ep-patent-document/SDOBI/B500
+func (n *xmlNode) path() string {
+	if n == nil {
+		return ""
+	}
+	var names []string
+	for e := n; e != nil; e = e.Parent {
+		if e.Name != "" {
+			names = append(names, e.Name)
+		}
+	}
+	var sb strings.Builder
+	for i := len(names) - 1; i >= 0; i-- {
+		sb.WriteString("/")
+		sb.WriteString(names[i])
+	}
+	return sb.String()
+}
+
+// nodeBlockSize is the number of nodes and children allocated at once
+const nodeBlockSize = 512
+
+// nodeAllocator allocates the nodes of the captured subtrees and their children in blocks,
+// which saves most of the allocations of the many small subtrees
+type nodeAllocator struct {
+	nodes    []xmlNode
+	children []*xmlNode
+}
+
+// node returns a new node
+func (a *nodeAllocator) node(name string, attr []xml.Attr, data string, parent *xmlNode) *xmlNode {
+	if len(a.nodes) == 0 {
+		a.nodes = make([]xmlNode, nodeBlockSize)
+	}
+	n := &a.nodes[0]
+	a.nodes = a.nodes[1:]
+	n.Name, n.Attr, n.Data, n.Parent = name, attr, data, parent
+	return n
+}
+
+// appendChild appends the child to the parent, the first children share a block
+func (a *nodeAllocator) appendChild(parent, child *xmlNode) {
+	if parent.Children == nil {
+		if len(a.children) < 4 {
+			a.children = make([]*xmlNode, nodeBlockSize)
+		}
+		// the capacity is limited, so a growing slice is copied and does not overwrite others
+		parent.Children = a.children[:0:4]
+		a.children = a.children[4:]
+	}
+	parent.Children = append(parent.Children, child)
+}
+
+// ancestors creates the chain of ancestors of a captured subtree and returns the closest one
+func (a *nodeAllocator) ancestors(names []string) (parent *xmlNode) {
+	for _, name := range names {
+		parent = a.node(name, nil, "", parent)
+	}
+	return
+}
@@ -0,0 +1,203 @@
+package eps
+
+import (
+	"bytes"
+	"io"
+)
+
+const (
+	// lenientLookahead is the maximum number of bytes inspected to decide if a '<' starts a tag
+	lenientLookahead = 1024
+	// entityLookahead is the maximum number of bytes inspected to decide if a '&' starts an entity reference
+	entityLookahead = 32
+)
+
+var (
+	escapedLt    = []byte("&lt;")
+	escapedAmp   = []byte("&amp;")
+	commentStart = []byte("<!--")
+	commentEnd   = []byte("-->")
+	cdataStart   = []byte("<![CDATA[")
+	cdataEnd     = []byte("]]>")
+)
+
+// lenientXMLReader escapes '<' characters that do not start a tag, e.g.
+// <heading id="h0011"><First Embodiment</heading> or "a < b",
+// and '&' characters that do not start an entity reference, e.g. "R&D",
+// which can be found in some documents of the EPO and are rejected by encoding/xml.
+// Comments and CDATA sections are passed through unchanged.
+// It implements io.ByteReader, so the xml decoder reads from it without further buffering.
+type lenientXMLReader struct {
+	r        io.Reader
+	err      error
+	buf      []byte
+	pos, end int // unread bytes of the buffer
+	pending  []byte
+	until    []byte // terminator of the current comment or CDATA section
+	matched  int    // number of matched bytes of the terminator
+}
+
+// newLenientXMLReader wraps the reader
+func newLenientXMLReader(r io.Reader) *lenientXMLReader {
+	return &lenientXMLReader{r: r, buf: make([]byte, 4*lenientLookahead)}
+}
+
+// ReadByte implements io.ByteReader
+func (l *lenientXMLReader) ReadByte() (b byte, err error) {
+	// fast path for the bytes outside of comments and CDATA sections, which are not a '<' or '&'
+	if l.pos < l.end && l.until == nil && len(l.pending) == 0 {
+		if b = l.buf[l.pos]; b != '<' && b != '&' {
+			l.pos++
+			return
+		}
+	}
+	if len(l.pending) > 0 {
+		b = l.pending[0]
+		l.pending = l.pending[1:]
+		return
+	}
+	if l.pos == l.end && l.fill(1) == 0 {
+		return 0, l.err
+	}
+	b = l.buf[l.pos]
+	l.pos++
+	if l.until != nil {
+		// inside a comment or CDATA section: pass through until the terminator
+		// both terminators start with a repeated byte (--> and ]]>)
+		switch {
+		case b == l.until[l.matched]:
+			l.matched++
+		case b != l.until[0]:
+			l.matched = 0
+		case l.matched < 2:
+			l.matched = 1
+		}
+		if l.matched == len(l.until) {
+			l.until = nil
+			l.matched = 0
+		}
+		return
+	}
+	if b == '&' {
+		if !isEntityRef(l.buf[l.pos : l.pos+l.fill(entityLookahead)]) {
+			l.pending = escapedAmp[1:]
+		}
+		return
+	}
+	if b != '<' {
+		return
+	}
+	// the '<' has been read, the lookahead starts with the following byte
+	n := l.fill(lenientLookahead)
+	lookahead := l.buf[l.pos : l.pos+n]
+	switch {
+	case bytes.HasPrefix(lookahead, commentStart[1:]):
+		l.pending = commentStart[1:]
+		l.until = commentEnd
+		l.pos += len(l.pending)
+	case bytes.HasPrefix(lookahead, cdataStart[1:]):
+		l.pending = cdataStart[1:]
+		l.until = cdataEnd
+		l.pos += len(l.pending)
+	case !isTagStart(lookahead):
+		b = escapedLt[0]
+		l.pending = escapedLt[1:]
+	}
+	return
+}
+
+// fill reads until n bytes are buffered or the reader fails and returns the number of buffered bytes
+func (l *lenientXMLReader) fill(n int) int {
+	if l.end-l.pos < n && l.err == nil {
+		copy(l.buf, l.buf[l.pos:l.end])
+		l.end -= l.pos
+		l.pos = 0
+		for l.end < n && l.err == nil {
+			var read int
+			read, l.err = l.r.Read(l.buf[l.end:])
+			l.end += read
+		}
+	}
+	return min(l.end-l.pos, n)
+}
+
+// Read implements io.Reader
+func (l *lenientXMLReader) Read(p []byte) (n int, err error) {
+	for n < len(p) {
+		var b byte
+		b, err = l.ReadByte()
+		if err != nil {
+			if n > 0 {
+				err = nil
+			}
+			return
+		}
+		p[n] = b
+		n++
+	}
+	return
+}
+
+// isTagStart checks if the bytes following a '<' form a tag
+func isTagStart(b []byte) bool {
+	if len(b) == 0 {
+		return false
+	}
+	switch c := b[0]; {
+	case c == '!' || c == '?':
+		return true
+	case c == '/':
+		if len(b) < 2 || !isNameStart(b[1]) {
+			return false
+		}
+	case !isNameStart(c):
+		return false
+	}
+	// the tag has to be closed before the next tag starts
+	for _, c := range b[1:] {
+		switch c {
+		case '>':
+			return true
+		case '<':
+			return false
+		}
+	}
+	// no decision possible within the lookahead
+	return true
+}
+
+// isEntityRef checks if the bytes following a '&' form an entity or character reference, e.g. amp; or #x3C;
+func isEntityRef(b []byte) bool {
+	end := bytes.IndexByte(b, ';')
+	if end < 1 {
+		return false
+	}
+	name := b[:end]
+	if name[0] == '#' {
+		digits := name[1:]
+		hex := len(digits) > 0 && digits[0] == 'x'
+		if hex {
+			digits = digits[1:]
+		}
+		for _, c := range digits {
+			if !(c >= '0' && c <= '9' || hex && (c >= 'a' && c <= 'f' || c >= 'A' && c <= 'F')) {
+				return false
+			}
+		}
+		return len(digits) > 0
+	}
+	if !isNameStart(name[0]) {
+		return false
+	}
+	for _, c := range name[1:] {
+		if !isNameStart(c) && !(c >= '0' && c <= '9') && c != '-' && c != '.' {
+			return false
+		}
+	}
+	return true
+}
+
+// isNameStart checks if the byte can start a xml name
+func isNameStart(c byte) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c == '_' || c == ':' || c >= 0x80
+}
@@ -0,0 +1,209 @@
+// Package images converts the images of the publications, e.g. CCITT Group 4 compressed tif drawings,
+// into png or jpeg images and thumbnails
+package images
+
+import (
+	"bytes"
+	"errors"
+	log "github.com/sirupsen/logrus"
+	"golang.org/x/image/draw"
+	_ "golang.org/x/image/tiff" // tif images including CCITT Group 3 and 4 compression
+	"image"
+	"image/color"
+	_ "image/gif"
+	"image/jpeg"
+	"image/png"
+	"io"
+	"path"
+	"strings"
+)
+
+var ErrUnknownFormat = errors.New("unknown image format")
+
+// ErrImageTooLarge is returned for images with more than MaxPixels pixels
+var ErrImageTooLarge = errors.New("image too large")
+
+// MaxPixels is the maximum number of pixels of a decoded image,
+// e.g. a drawing of 7000 x 10000 pixels, which protects against images with forged dimensions
+const MaxPixels = 100_000_000
+
+// Format is the format of the converted images
+type Format string
+
+const (
+	PNG  Format = "png"
+	JPEG Format = "jpeg"
+)
+
+// DefaultJPEGQuality is the quality of jpeg images if no quality is set
+const DefaultJPEGQuality = 85
+
+// Options configure the conversion
+type Options struct {
+	Format Format // png if empty
+	// MaxWidth and MaxHeight limit the size of the image, e.g. for thumbnails.
+	// The aspect ratio is kept and images are never enlarged, 0 means no limit.
+	MaxWidth  int
+	MaxHeight int
+	Quality   int // quality of jpeg images between 1 and 100, DefaultJPEGQuality if 0
+}
+
+// MaxBytes is the maximum size of an image that is read from a stream that can not seek,
+// the stream is buffered because the directory of tif images is often at the end of the file
+const MaxBytes = 64 << 20
+
+// Decode decodes a tif, png, jpeg or gif image.
+// The dimensions are checked before the image is decoded, images with more than MaxPixels pixels result in ErrImageTooLarge.
+// Readers that can seek, e.g. a bytes.Reader, are rewound after reading the dimensions,
+// other readers are buffered up to MaxBytes, larger images result in ErrImageTooLarge.
+func Decode(r io.Reader) (img image.Image, err error) {
+	return decode(r, MaxBytes)
+}
+
+// decode decodes the image, streams that can not seek are buffered up to maxBytes
+func decode(r io.Reader, maxBytes int64) (img image.Image, err error) {
+	seeker, canSeek := r.(io.ReadSeeker)
+	var start int64
+	if canSeek {
+		// the reader is rewound to the current offset after reading the dimensions
+		if start, err = seeker.Seek(0, io.SeekCurrent); err != nil {
+			log.WithError(err).Error("can not decode image")
+			return
+		}
+	}
+	var header bytes.Buffer
+	var limited *io.LimitedReader
+	configReader := r
+	if !canSeek {
+		// the part that is read by DecodeConfig is decoded again
+		limited = &io.LimitedReader{R: r, N: maxBytes + 1}
+		configReader = io.TeeReader(limited, &header)
+	}
+	config, _, err := image.DecodeConfig(configReader)
+	if limited != nil && limited.N == 0 {
+		err = ErrImageTooLarge
+	}
+	if err != nil {
+		log.WithError(err).Error("can not decode image")
+		return
+	}
+	if config.Width <= 0 || config.Height <= 0 || int64(config.Width)*int64(config.Height) > MaxPixels {
+		err = ErrImageTooLarge
+		log.WithError(err).WithField("width", config.Width).WithField("height", config.Height).Error("can not decode image")
+		return
+	}
+	if canSeek {
+		if _, err = seeker.Seek(start, io.SeekStart); err != nil {
+			log.WithError(err).Error("can not decode image")
+			return
+		}
+		r = seeker
+	} else {
+		r = io.MultiReader(&header, limited)
+	}
+	img, format, err := image.Decode(r)
+	if limited != nil && limited.N == 0 {
+		img, err = nil, ErrImageTooLarge
+	}
+	if err != nil {
+		log.WithError(err).Error("can not decode image")
+		return
+	}
+	log.WithField("format", format).WithField("bounds", img.Bounds()).Debug("decoded image")
+	return
+}
+
+// Convert decodes the image of the reader and writes it in the format of the options
+func Convert(r io.Reader, w io.Writer, opts Options) (err error) {
+	img, err := Decode(r)
+	if err != nil {
+		return
+	}
+	return Encode(w, Resize(img, opts.MaxWidth, opts.MaxHeight), opts)
+}
+
+// ConvertBytes converts the image, e.g. the content of imgf0001.tif
+func ConvertBytes(raw []byte, opts Options) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := Convert(bytes.NewReader(raw), &buf, opts); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// Encode writes the image in the format of the options without resizing it
+func Encode(w io.Writer, img image.Image, opts Options) (err error) {
+	switch opts.Format {
+	case PNG, "":
+		encoder := png.Encoder{CompressionLevel: png.BestCompression}
+		err = encoder.Encode(w, img)
+	case JPEG:
+		quality := opts.Quality
+		if quality == 0 {
+			quality = DefaultJPEGQuality
+		}
+		err = jpeg.Encode(w, img, &jpeg.Options{Quality: quality})
+	default:
+		err = ErrUnknownFormat
+	}
+	if err != nil {
+		log.WithError(err).WithField("format", opts.Format).Error("can not encode image")
+	}
+	return
+}
+
+// Resize scales the image down to fit into the size, the aspect ratio is kept.
+// Images that are smaller than the size are returned unchanged, 0 means no limit.
+func Resize(img image.Image, maxWidth, maxHeight int) image.Image {
+	bounds := img.Bounds()
+	width, height := bounds.Dx(), bounds.Dy()
+	if width == 0 || height == 0 {
+		return img
+	}
+	scale := 1.0
+	if maxWidth > 0 && width > maxWidth {
+		scale = float64(maxWidth) / float64(width)
+	}
+	if maxHeight > 0 && float64(height)*scale > float64(maxHeight) {
+		scale = float64(maxHeight) / float64(height)
+	}
+	if scale == 1 {
+		return img
+	}
+	rect := image.Rect(0, 0, max(int(float64(width)*scale+0.5), 1), max(int(float64(height)*scale+0.5), 1))
+	var dst draw.Image
+	if isGray(img) {
+		// drawings stay gray, which keeps the png files small
+		dst = image.NewGray(rect)
+	} else {
+		dst = image.NewRGBA(rect)
+	}
+	draw.CatmullRom.Scale(dst, rect, img, bounds, draw.Src, nil)
+	return dst
+}
+
+// isGray checks if the image has only gray values, e.g. bilevel drawings
+func isGray(img image.Image) bool {
+	switch m := img.(type) {
+	case *image.Gray, *image.Gray16:
+		return true
+	case *image.Paletted:
+		for _, c := range m.Palette {
+			r, g, b, _ := c.RGBA()
+			if r != g || g != b {
+				return false
+			}
+		}
+		return true
+	}
+	return img.ColorModel() == color.GrayModel || img.ColorModel() == color.Gray16Model
+}
+
+// FileName returns the name of the converted image, e.g. imgf0001.png for imgf0001.tif
+func FileName(name string, format Format) string {
+	ext := ".png"
+	if format == JPEG {
+		ext = ".jpg"
+	}
+	return strings.TrimSuffix(name, path.Ext(name)) + ext
+}
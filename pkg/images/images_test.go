@@ -0,0 +1,171 @@
+package images
+
+import (
+	"bytes"
+	"encoding/binary"
+	"github.com/stretchr/testify/assert"
+	"hash/crc32"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"io"
+	"testing"
+	"testing/iotest"
+)
+
+// newG4Tiff creates a bilevel tif image of 8x2 pixels with CCITT Group 4 compression,
+// the pixels 2 to 5 of the first row are black
+func newG4Tiff() []byte {
+	// row 1: horizontal mode (001), 2 white (0111), 4 black (011), vertical mode V0 (1)
+	// row 2: pass mode (0001), vertical mode V0 (1)
+	// end of facsimile block: 2x 000000000001
+	strip := []byte{0x2e, 0xe3, 0x00, 0x10, 0x01}
+	type entry struct {
+		tag, kind uint16
+		value     uint32
+	}
+	const short, long = 3, 4
+	entries := []entry{
+		{256, short, 8},                 // width
+		{257, short, 2},                 // height
+		{258, short, 1},                 // bits per sample
+		{259, short, 4},                 // CCITT Group 4
+		{262, short, 0},                 // white is zero
+		{273, long, 8},                  // strip offset
+		{278, short, 2},                 // rows per strip
+		{279, long, uint32(len(strip))}, // strip byte count
+	}
+	var buf bytes.Buffer
+	buf.WriteString("II")
+	_ = binary.Write(&buf, binary.LittleEndian, uint16(42))
+	_ = binary.Write(&buf, binary.LittleEndian, uint32(8+len(strip)))
+	buf.Write(strip)
+	_ = binary.Write(&buf, binary.LittleEndian, uint16(len(entries)))
+	for _, e := range entries {
+		_ = binary.Write(&buf, binary.LittleEndian, e.tag)
+		_ = binary.Write(&buf, binary.LittleEndian, e.kind)
+		_ = binary.Write(&buf, binary.LittleEndian, uint32(1))
+		if e.kind == short {
+			_ = binary.Write(&buf, binary.LittleEndian, uint16(e.value))
+			_ = binary.Write(&buf, binary.LittleEndian, uint16(0))
+		} else {
+			_ = binary.Write(&buf, binary.LittleEndian, e.value)
+		}
+	}
+	_ = binary.Write(&buf, binary.LittleEndian, uint32(0))
+	return buf.Bytes()
+}
+
+// isBlack checks if the pixel is black
+func isBlack(img image.Image, x, y int) bool {
+	return color.GrayModel.Convert(img.At(x, y)).(color.Gray).Y < 128
+}
+
+func TestDecodeG4(t *testing.T) {
+	ass := assert.New(t)
+	img, err := Decode(bytes.NewReader(newG4Tiff()))
+	if !ass.NoError(err) {
+		return
+	}
+	ass.Equal(image.Rect(0, 0, 8, 2), img.Bounds())
+	for x := 0; x < 8; x++ {
+		ass.Equal(x >= 2 && x < 6, isBlack(img, x, 0), x)
+		ass.False(isBlack(img, x, 1), x)
+	}
+}
+
+func TestDecodeStream(t *testing.T) {
+	ass := assert.New(t)
+	data := newG4Tiff()
+	// the reader can not seek, the image is buffered
+	img, err := Decode(iotest.OneByteReader(bytes.NewReader(data)))
+	if ass.NoError(err) {
+		ass.Equal(image.Rect(0, 0, 8, 2), img.Bounds())
+	}
+	// the reader is rewound to its offset
+	r := bytes.NewReader(append([]byte("xx"), data...))
+	_, _ = r.Seek(2, io.SeekStart)
+	img, err = Decode(r)
+	if ass.NoError(err) {
+		ass.Equal(image.Rect(0, 0, 8, 2), img.Bounds())
+	}
+	// the directory of the tif image is behind the limit
+	_, err = decode(iotest.OneByteReader(bytes.NewReader(data)), 16)
+	ass.ErrorIs(err, ErrImageTooLarge)
+	_, err = decode(iotest.OneByteReader(bytes.NewReader(data)), int64(len(data)))
+	ass.NoError(err)
+}
+
+func TestDecodeTooLarge(t *testing.T) {
+	ass := assert.New(t)
+	var buf bytes.Buffer
+	ass.NoError(png.Encode(&buf, image.NewGray(image.Rect(0, 0, 1, 1))))
+	data := buf.Bytes()
+	// the dimensions of the IHDR chunk are forged, the checksum is updated
+	binary.BigEndian.PutUint32(data[16:], 100000)
+	binary.BigEndian.PutUint32(data[20:], 100000)
+	binary.BigEndian.PutUint32(data[29:], crc32.ChecksumIEEE(data[12:29]))
+	_, err := Decode(bytes.NewReader(data))
+	ass.ErrorIs(err, ErrImageTooLarge)
+	_, err = ConvertBytes(data, Options{})
+	ass.ErrorIs(err, ErrImageTooLarge)
+}
+
+func TestConvertPNG(t *testing.T) {
+	ass := assert.New(t)
+	res, err := ConvertBytes(newG4Tiff(), Options{})
+	if !ass.NoError(err) {
+		return
+	}
+	img, err := png.Decode(bytes.NewReader(res))
+	ass.NoError(err)
+	ass.Equal(image.Rect(0, 0, 8, 2), img.Bounds())
+	ass.True(isBlack(img, 3, 0))
+	ass.False(isBlack(img, 0, 0))
+}
+
+func TestConvertJPEG(t *testing.T) {
+	ass := assert.New(t)
+	res, err := ConvertBytes(newG4Tiff(), Options{Format: JPEG, Quality: 100})
+	if !ass.NoError(err) {
+		return
+	}
+	img, err := jpeg.Decode(bytes.NewReader(res))
+	ass.NoError(err)
+	ass.Equal(image.Rect(0, 0, 8, 2), img.Bounds())
+
+	_, err = ConvertBytes(newG4Tiff(), Options{Format: "webp"})
+	ass.ErrorIs(err, ErrUnknownFormat)
+	_, err = ConvertBytes([]byte("no image"), Options{})
+	ass.Error(err)
+}
+
+func TestResize(t *testing.T) {
+	ass := assert.New(t)
+	img := image.NewGray(image.Rect(0, 0, 400, 200))
+	// the aspect ratio is kept
+	ass.Equal(image.Rect(0, 0, 100, 50), Resize(img, 100, 100).Bounds())
+	ass.Equal(image.Rect(0, 0, 200, 100), Resize(img, 0, 100).Bounds())
+	ass.Equal(image.Rect(0, 0, 100, 50), Resize(img, 100, 0).Bounds())
+	// drawings stay gray
+	ass.IsType(&image.Gray{}, Resize(img, 100, 100))
+	ass.IsType(&image.RGBA{}, Resize(image.NewRGBA(img.Bounds()), 100, 100))
+	// images are not enlarged
+	ass.Same(img, Resize(img, 800, 800))
+	ass.Same(img, Resize(img, 0, 0))
+
+	// thumbnails of the drawings
+	res, err := ConvertBytes(newG4Tiff(), Options{MaxWidth: 4})
+	ass.NoError(err)
+	thumbnail, err := png.Decode(bytes.NewReader(res))
+	ass.NoError(err)
+	ass.Equal(image.Rect(0, 0, 4, 1), thumbnail.Bounds())
+}
+
+func TestFileName(t *testing.T) {
+	ass := assert.New(t)
+	ass.Equal("imgf0001.png", FileName("imgf0001.tif", PNG))
+	ass.Equal("EP1/imgf0001.jpg", FileName("EP1/imgf0001.tif", JPEG))
+	ass.Equal("imgf0001.png", FileName("imgf0001", ""))
+}
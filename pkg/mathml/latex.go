@@ -0,0 +1,330 @@
+package mathml
+
+import (
+	"strings"
+)
+
+// latexSymbols maps unicode characters to LaTeX commands
+var latexSymbols = map[string]string{
+	"−": "-", "×": `\times`, "÷": `\div`, "±": `\pm`, "∓": `\mp`, "·": `\cdot`, "⋅": `\cdot`, "∗": `\ast`, "∘": `\circ`,
+	"≤": `\leq`, "≥": `\geq`, "≠": `\neq`, "≈": `\approx`, "≡": `\equiv`, "∼": `\sim`, "≅": `\cong`, "∝": `\propto`,
+	"≪": `\ll`, "≫": `\gg`, "→": `\rightarrow`, "←": `\leftarrow`, "↔": `\leftrightarrow`, "⇒": `\Rightarrow`,
+	"⇐": `\Leftarrow`, "⇔": `\Leftrightarrow`, "∈": `\in`, "∉": `\notin`, "⊂": `\subset`, "⊆": `\subseteq`,
+	"∪": `\cup`, "∩": `\cap`, "∅": `\emptyset`, "∀": `\forall`, "∃": `\exists`, "¬": `\neg`, "∧": `\wedge`, "∨": `\vee`,
+	"∞": `\infty`, "∂": `\partial`, "∇": `\nabla`, "√": `\surd`, "∑": `\sum`, "∏": `\prod`, "∫": `\int`, "∮": `\oint`,
+	"°": `^{\circ}`, "′": `'`, "″": `''`, "…": `\ldots`, "⋯": `\cdots`, "⁢": "", "⁡": "", "⁣": "",
+	"α": `\alpha`, "β": `\beta`, "γ": `\gamma`, "δ": `\delta`, "ε": `\epsilon`, "ϵ": `\epsilon`, "ζ": `\zeta`, "η": `\eta`,
+	"θ": `\theta`, "ι": `\iota`, "κ": `\kappa`, "λ": `\lambda`, "μ": `\mu`, "ν": `\nu`, "ξ": `\xi`, "π": `\pi`,
+	"ρ": `\rho`, "σ": `\sigma`, "ς": `\varsigma`, "τ": `\tau`, "υ": `\upsilon`, "φ": `\phi`, "ϕ": `\phi`, "χ": `\chi`,
+	"ψ": `\psi`, "ω": `\omega`, "Γ": `\Gamma`, "Δ": `\Delta`, "Θ": `\Theta`, "Λ": `\Lambda`, "Ξ": `\Xi`, "Π": `\Pi`,
+	"Σ": `\Sigma`, "Υ": `\Upsilon`, "Φ": `\Phi`, "Ψ": `\Psi`, "Ω": `\Omega`,
+}
+
+// latexEscapes are the characters with a special meaning in LaTeX
+var latexEscapes = map[rune]string{
+	'\\': `\backslash `, '{': `\{`, '}': `\}`, '$': `\$`, '%': `\%`, '&': `\&`, '#': `\#`, '_': `\_`, '^': `\hat{}`, '~': `\sim `,
+}
+
+// latexFunctions are written as LaTeX operators, e.g. sin -> \sin
+var latexFunctions = map[string]bool{
+	"sin": true, "cos": true, "tan": true, "cot": true, "sec": true, "csc": true, "arcsin": true, "arccos": true,
+	"arctan": true, "sinh": true, "cosh": true, "tanh": true, "log": true, "ln": true, "lg": true, "exp": true,
+	"lim": true, "max": true, "min": true, "sup": true, "inf": true, "det": true, "dim": true, "deg": true,
+}
+
+// latexAccents maps the over scripts of mover to LaTeX accents
+var latexAccents = map[string]string{
+	"¯": `\overline`, "‾": `\overline`, "^": `\hat`, "ˆ": `\hat`, "~": `\tilde`, "˜": `\tilde`, "→": `\vec`,
+	"˙": `\dot`, "¨": `\ddot`, "⏞": `\overbrace`,
+}
+
+// largeOperators get their under and over scripts as limits
+var largeOperators = map[string]bool{
+	"∑": true, "∏": true, "∫": true, "∮": true, "⋃": true, "⋂": true, "lim": true, "max": true, "min": true,
+}
+
+// latexFences maps the fences of mfenced to LaTeX delimiters
+var latexFences = map[string]string{
+	"{": `\{`, "}": `\}`, "": ".", "|": "|", "‖": `\|`, "⟨": `\langle`, "⟩": `\rangle`, "〈": `\langle`, "〉": `\rangle`,
+}
+
+// LaTeX converts the formula into LaTeX.
+// The math element is wrapped in $ or $$ depending on its display attribute.
+func (n *Node) LaTeX() string {
+	if n.Name == "math" {
+		body := strings.TrimSpace(n.latex())
+		if n.isBlock() {
+			return "$$" + body + "$$"
+		}
+		return "$" + body + "$"
+	}
+	return strings.TrimSpace(n.latex())
+}
+
+// latex converts the node without the math delimiters
+func (n *Node) latex() string {
+	if n == nil {
+		return ""
+	}
+	switch n.Name {
+	case "":
+		return ""
+	case "mi":
+		return latexIdentifier(n.content(), n.AttrValue("mathvariant"))
+	case "mn":
+		return latexText(n.content())
+	case "mo":
+		return latexOperator(n.content())
+	case "mtext", "ms":
+		text := n.content()
+		if text == "" {
+			return ""
+		}
+		return `\text{` + latexText(text) + "}"
+	case "mspace":
+		return `\ `
+	case "mfrac":
+		args := n.arguments(2)
+		return `\frac{` + args[0].latex() + "}{" + args[1].latex() + "}"
+	case "msqrt":
+		return `\sqrt{` + n.latexChildren() + "}"
+	case "mroot":
+		args := n.arguments(2)
+		return `\sqrt[` + args[1].latex() + "]{" + args[0].latex() + "}"
+	case "msub":
+		args := n.arguments(2)
+		return latexBase(args[0]) + "_{" + args[1].latex() + "}"
+	case "msup":
+		args := n.arguments(2)
+		return latexBase(args[0]) + "^{" + args[1].latex() + "}"
+	case "msubsup":
+		args := n.arguments(3)
+		return latexBase(args[0]) + "_{" + args[1].latex() + "}^{" + args[2].latex() + "}"
+	case "munder":
+		args := n.arguments(2)
+		if args[0] != nil && largeOperators[args[0].content()] {
+			return latexBase(args[0]) + "_{" + args[1].latex() + "}"
+		}
+		return `\underset{` + args[1].latex() + "}{" + args[0].latex() + "}"
+	case "mover":
+		args := n.arguments(2)
+		if args[1] != nil {
+			if accent, ok := latexAccents[args[1].content()]; ok {
+				return accent + "{" + args[0].latex() + "}"
+			}
+		}
+		if args[0] != nil && largeOperators[args[0].content()] {
+			return latexBase(args[0]) + "^{" + args[1].latex() + "}"
+		}
+		return `\overset{` + args[1].latex() + "}{" + args[0].latex() + "}"
+	case "munderover":
+		args := n.arguments(3)
+		if args[0] != nil && largeOperators[args[0].content()] {
+			return latexBase(args[0]) + "_{" + args[1].latex() + "}^{" + args[2].latex() + "}"
+		}
+		return `\underset{` + args[1].latex() + `}{\overset{` + args[2].latex() + "}{" + args[0].latex() + "}}"
+	case "mmultiscripts":
+		return n.latexMultiscripts()
+	case "mfenced":
+		return n.latexFenced()
+	case "menclose":
+		if strings.Contains(n.AttrValue("notation"), "box") {
+			return `\boxed{` + n.latexChildren() + "}"
+		}
+		return n.latexChildren()
+	case "mtable":
+		var rows []string
+		for _, row := range n.elements() {
+			var cells []string
+			for i, cell := range row.elements() {
+				if row.Name == "mlabeledtr" && i == 0 {
+					// the label of the row
+					continue
+				}
+				cells = append(cells, cell.latex())
+			}
+			rows = append(rows, strings.Join(cells, " & "))
+		}
+		return `\begin{matrix}` + strings.Join(rows, ` \\ `) + `\end{matrix}`
+	case "semantics":
+		if elements := n.elements(); len(elements) > 0 {
+			return elements[0].latex()
+		}
+		return ""
+	case "annotation", "annotation-xml", "mphantom", "none", "mprescripts", "maligngroup", "malignmark":
+		return ""
+	}
+	// math, mrow, mstyle, mpadded, merror, mtd and unknown elements
+	return n.latexChildren()
+}
+
+// latexChildren converts the child elements
+func (n *Node) latexChildren() string {
+	var sb strings.Builder
+	for _, c := range n.elements() {
+		s := c.latex()
+		// separate commands from following letters, e.g. \alpha x
+		if sb.Len() > 0 && s != "" && isLetter(s[0]) && endsWithCommand(sb.String()) {
+			sb.WriteString(" ")
+		}
+		sb.WriteString(s)
+	}
+	return sb.String()
+}
+
+// latexMultiscripts converts the base with its post scripts, the pre scripts are put in front
+func (n *Node) latexMultiscripts() string {
+	elements := n.elements()
+	if len(elements) == 0 {
+		return ""
+	}
+	var post, pre []*Node
+	target := &post
+	for _, e := range elements[1:] {
+		if e.Name == "mprescripts" {
+			target = &pre
+			continue
+		}
+		*target = append(*target, e)
+	}
+	scripts := func(nodes []*Node) (res string) {
+		for i := 0; i+1 < len(nodes); i += 2 {
+			if sub := nodes[i].latex(); sub != "" {
+				res += "_{" + sub + "}"
+			}
+			if sup := nodes[i+1].latex(); sup != "" {
+				res += "^{" + sup + "}"
+			}
+		}
+		return
+	}
+	res := latexBase(elements[0]) + scripts(post)
+	if len(pre) > 0 {
+		res = "{}" + scripts(pre) + res
+	}
+	return res
+}
+
+// latexFenced converts mfenced, the children are separated by the separators
+func (n *Node) latexFenced() string {
+	open, ok := n.attr("open")
+	if !ok {
+		open = "("
+	}
+	closing, ok := n.attr("close")
+	if !ok {
+		closing = ")"
+	}
+	separators, ok := n.attr("separators")
+	if !ok {
+		separators = ","
+	}
+	seps := []rune(strings.Join(strings.Fields(separators), ""))
+	var sb strings.Builder
+	sb.WriteString(`\left` + latexFence(open))
+	for i, c := range n.elements() {
+		if i > 0 && len(seps) > 0 {
+			sb.WriteString(latexText(string(seps[min(i-1, len(seps)-1)])))
+		}
+		sb.WriteString(c.latex())
+	}
+	sb.WriteString(`\right` + latexFence(closing))
+	return sb.String()
+}
+
+// latexFence returns the LaTeX delimiter of a fence
+func latexFence(fence string) string {
+	if f, ok := latexFences[fence]; ok {
+		return f
+	}
+	return fence
+}
+
+// latexBase converts the base of a script, bases that are not atomic are grouped
+func latexBase(n *Node) string {
+	s := n.latex()
+	if len([]rune(s)) <= 1 || isCommand(s) {
+		return s
+	}
+	return "{" + s + "}"
+}
+
+// latexIdentifier converts an identifier, names with several letters are written upright
+func latexIdentifier(text, variant string) string {
+	if text == "" {
+		return ""
+	}
+	if latexFunctions[text] {
+		return `\` + text
+	}
+	if symbol, ok := latexSymbols[text]; ok {
+		return symbol
+	}
+	escaped := latexText(text)
+	switch {
+	case variant == "bold":
+		return `\mathbf{` + escaped + "}"
+	case variant == "normal" || len([]rune(text)) > 1:
+		return `\mathrm{` + escaped + "}"
+	}
+	return escaped
+}
+
+// latexOperator converts an operator
+func latexOperator(text string) string {
+	if symbol, ok := latexSymbols[text]; ok {
+		return symbol
+	}
+	if latexFunctions[text] {
+		return `\` + text
+	}
+	return latexText(text)
+}
+
+// latexText escapes the special characters and converts the symbols of the text
+func latexText(text string) string {
+	var sb strings.Builder
+	for _, r := range text {
+		if escaped, ok := latexEscapes[r]; ok {
+			sb.WriteString(escaped)
+			continue
+		}
+		if symbol, ok := latexSymbols[string(r)]; ok {
+			sb.WriteString(symbol)
+			// separate the command from the following text, e.g. \mu m
+			if isCommand(symbol) {
+				sb.WriteString(" ")
+			}
+			continue
+		}
+		sb.WriteRune(r)
+	}
+	return strings.TrimRight(sb.String(), " ")
+}
+
+// isCommand checks if the string is a single LaTeX command, e.g. \alpha
+func isCommand(s string) bool {
+	if !strings.HasPrefix(s, `\`) || len(s) < 2 {
+		return false
+	}
+	for i := 1; i < len(s); i++ {
+		if !isLetter(s[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// endsWithCommand checks if the string ends with a LaTeX command name, e.g. x\alpha
+func endsWithCommand(s string) bool {
+	i := len(s)
+	for i > 0 && isLetter(s[i-1]) {
+		i--
+	}
+	return i < len(s) && i > 0 && s[i-1] == '\\'
+}
+
+// isLetter checks if the byte is an ascii letter
+func isLetter(b byte) bool {
+	return b >= 'a' && b <= 'z' || b >= 'A' && b <= 'Z'
+}
@@ -0,0 +1,54 @@
+package mathml
+
+import (
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+)
+
+// volume is a formula of EP02779063B1 (test data v1-0-B1)
+const volume = `<math display="inline"><mrow><mi mathvariant="normal">V</mi><mo>=</mo><mstyle scriptlevel="+1"><mfrac><mn>4</mn><mn>3</mn></mfrac></mstyle><msup><mrow><mrow><mo>(</mo><mrow><mstyle scriptlevel="+1"><mfrac><mi mathvariant="normal">D</mi><mn>2</mn></mfrac></mstyle></mrow><mo>)</mo></mrow></mrow><mn>3</mn></msup><mo>.</mo></mrow></math>`
+
+// heat is a formula of EP18867314A1 (test data v1-5-1-A1-2)
+const heat = `<math display="block">
+	<mi>Qc</mi>
+	<mo>=</mo>
+	<mi>KA</mi>
+	<mfenced separators="">
+		<mi>Tc</mi>
+		<mo>−</mo>
+		<mi>Ta</mi>
+	</mfenced>
+</math>`
+
+func TestLaTeX(t *testing.T) {
+	ass := assert.New(t)
+	tests := map[string]string{
+		volume: `$\mathrm{V}=\frac{4}{3}{(\frac{\mathrm{D}}{2})}^{3}.$`,
+		heat:   `$$\mathrm{Qc}=\mathrm{KA}\left(\mathrm{Tc}-\mathrm{Ta}\right)$$`,
+		`<math><msub><mi>x</mi><mi>i</mi></msub><mo>×</mo><mi>α</mi><mi>x</mi></math>`:                                                             `$x_{i}\times\alpha x$`,
+		`<math><msqrt><mn>2</mn></msqrt><mroot><mi>x</mi><mn>3</mn></mroot></math>`:                                                                `$\sqrt{2}\sqrt[3]{x}$`,
+		`<math><munderover><mo>∑</mo><mrow><mi>i</mi><mo>=</mo><mn>1</mn></mrow><mi>n</mi></munderover></math>`:                                    `$\sum_{i=1}^{n}$`,
+		`<math><mover><mi>v</mi><mo>→</mo></mover><mo>≤</mo><msubsup><mi>a</mi><mn>0</mn><mn>2</mn></msubsup></math>`:                              `$\vec{v}\leq a_{0}^{2}$`,
+		`<math><mi>sin</mi><mi>θ</mi><mtext>for 5% mol</mtext></math>`:                                                                             `$\sin\theta\text{for 5\% mol}$`,
+		`<math><mfenced open="{" close=""><mi>a</mi><mi>b</mi></mfenced></math>`:                                                                   `$\left\{a,b\right.$`,
+		`<math><mtable><mtr><mtd><mn>1</mn></mtd><mtd><mn>0</mn></mtd></mtr><mtr><mtd><mn>0</mn></mtd><mtd><mn>1</mn></mtd></mtr></mtable></math>`: `$\begin{matrix}1 & 0 \\ 0 & 1\end{matrix}$`,
+	}
+	for input, expected := range tests {
+		res, err := LaTeX(strings.NewReader(input))
+		ass.NoError(err)
+		ass.Equal(expected, res, input)
+	}
+}
+
+func TestParse(t *testing.T) {
+	ass := assert.New(t)
+	// the math element is found within other elements and prefixes are ignored
+	n, err := Parse(strings.NewReader(`<maths id="math0001"><mml:math><mml:mi>x</mml:mi></mml:math><img file="imgb0001.tif"/></maths>`))
+	ass.NoError(err)
+	ass.Equal("math", n.Name)
+	ass.Equal("$x$", n.LaTeX())
+
+	_, err = Parse(strings.NewReader(`<p>no formula</p>`))
+	ass.ErrorIs(err, ErrNoMath)
+}
@@ -0,0 +1,144 @@
+// Package mathml converts MathML formulas into LaTeX and a readable linear text
+package mathml
+
+import (
+	"encoding/xml"
+	"errors"
+	log "github.com/sirupsen/logrus"
+	"io"
+	"strings"
+)
+
+var ErrNoMath = errors.New("no math element")
+
+// Node is an element or a text of a MathML formula
+type Node struct {
+	Name     string // local name of the element, empty for texts
+	Attr     []xml.Attr
+	Children []*Node
+	Text     string // only set for texts
+}
+
+// Parse parses the first math element of the reader.
+// The prefixes of the elements are ignored, e.g. mml:mi.
+func Parse(r io.Reader) (root *Node, err error) {
+	d := xml.NewDecoder(r)
+	d.Strict = false
+	d.Entity = xml.HTMLEntity
+	var stack []*Node
+	for {
+		token, errToken := d.RawToken()
+		if errToken == io.EOF {
+			break
+		}
+		if errToken != nil {
+			err = errToken
+			log.WithError(err).Error("can not parse mathml")
+			return
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			if len(stack) == 0 && t.Name.Local != "math" {
+				continue
+			}
+			n := &Node{Name: t.Name.Local, Attr: append([]xml.Attr(nil), t.Attr...)}
+			if len(stack) > 0 {
+				parent := stack[len(stack)-1]
+				parent.Children = append(parent.Children, n)
+			}
+			stack = append(stack, n)
+		case xml.EndElement:
+			if len(stack) == 0 {
+				continue
+			}
+			if len(stack) == 1 {
+				return stack[0], nil
+			}
+			stack = stack[:len(stack)-1]
+		case xml.CharData:
+			if len(stack) > 0 {
+				parent := stack[len(stack)-1]
+				parent.Children = append(parent.Children, &Node{Text: string(t)})
+			}
+		}
+	}
+	if len(stack) > 0 {
+		return stack[0], nil
+	}
+	err = ErrNoMath
+	log.WithError(err).Error("can not parse mathml")
+	return
+}
+
+// LaTeX parses the MathML formula and converts it into LaTeX
+func LaTeX(r io.Reader) (string, error) {
+	n, err := Parse(r)
+	if err != nil {
+		return "", err
+	}
+	return n.LaTeX(), nil
+}
+
+// Text parses the MathML formula and converts it into a linear text
+func Text(r io.Reader) (string, error) {
+	n, err := Parse(r)
+	if err != nil {
+		return "", err
+	}
+	return n.LinearText(), nil
+}
+
+// AttrValue returns the value of the attribute or an empty string
+func (n *Node) AttrValue(name string) string {
+	v, _ := n.attr(name)
+	return v
+}
+
+// attr returns the value of the attribute and whether it exists
+func (n *Node) attr(name string) (string, bool) {
+	for _, a := range n.Attr {
+		if a.Name.Local == name {
+			return a.Value, true
+		}
+	}
+	return "", false
+}
+
+// elements returns the child elements
+func (n *Node) elements() (res []*Node) {
+	for _, c := range n.Children {
+		if c.Name != "" {
+			res = append(res, c)
+		}
+	}
+	return
+}
+
+// content returns the trimmed text of the node and its descendants
+func (n *Node) content() string {
+	var sb strings.Builder
+	var write func(n *Node)
+	write = func(n *Node) {
+		if n.Name == "" {
+			sb.WriteString(n.Text)
+			return
+		}
+		for _, c := range n.Children {
+			write(c)
+		}
+	}
+	write(n)
+	return strings.TrimSpace(sb.String())
+}
+
+// arguments returns the first n child elements, missing arguments are nil
+func (n *Node) arguments(count int) []*Node {
+	res := make([]*Node, count)
+	copy(res, n.elements())
+	return res
+}
+
+// isBlock checks if the formula is displayed as block
+func (n *Node) isBlock() bool {
+	return n.AttrValue("display") == "block"
+}
@@ -0,0 +1,176 @@
+package mathml
+
+import (
+	"strings"
+	"unicode"
+)
+
+// spacedOperators are surrounded by spaces in the linear text
+var spacedOperators = map[string]bool{
+	"=": true, "+": true, "-": true, "−": true, "×": true, "÷": true, "±": true, "∓": true, "<": true, ">": true,
+	"≤": true, "≥": true, "≠": true, "≈": true, "≡": true, "∼": true, "≅": true, "∝": true, "→": true, "←": true,
+	"↔": true, "⇒": true, "⇔": true, "∈": true, "∉": true, "⊂": true, "⊆": true, "∪": true, "∩": true,
+}
+
+// invisibleOperators are not written in the linear text, e.g. invisible times
+var invisibleOperators = map[string]bool{
+	"⁡": true, "⁢": true, "⁣": true, "⁤": true,
+}
+
+// LinearText converts the formula into a readable linear text, e.g. Qc = KA(Tc − Ta)
+func (n *Node) LinearText() string {
+	return strings.Join(strings.Fields(n.text()), " ")
+}
+
+// text converts the node into the linear text
+func (n *Node) text() string {
+	if n == nil {
+		return ""
+	}
+	switch n.Name {
+	case "":
+		return ""
+	case "mi", "mn", "mtext", "ms":
+		return n.content()
+	case "mo":
+		op := n.content()
+		switch {
+		case invisibleOperators[op]:
+			return ""
+		case spacedOperators[op]:
+			return " " + op + " "
+		case op == ",":
+			return ", "
+		}
+		return op
+	case "mspace":
+		return " "
+	case "mfrac":
+		args := n.arguments(2)
+		return textGroup(args[0]) + "/" + textGroup(args[1])
+	case "msqrt":
+		return "sqrt(" + n.textChildren() + ")"
+	case "mroot":
+		args := n.arguments(2)
+		return "root(" + args[1].text() + ", " + args[0].text() + ")"
+	case "msub", "munder":
+		args := n.arguments(2)
+		return textGroup(args[0]) + "_" + textGroup(args[1])
+	case "msup", "mover":
+		args := n.arguments(2)
+		return textGroup(args[0]) + "^" + textGroup(args[1])
+	case "msubsup", "munderover":
+		args := n.arguments(3)
+		return textGroup(args[0]) + "_" + textGroup(args[1]) + "^" + textGroup(args[2])
+	case "mfenced":
+		return n.textFenced()
+	case "mtable":
+		var rows []string
+		for _, row := range n.elements() {
+			var cells []string
+			for i, cell := range row.elements() {
+				if row.Name == "mlabeledtr" && i == 0 {
+					continue
+				}
+				cells = append(cells, strings.TrimSpace(cell.text()))
+			}
+			rows = append(rows, strings.Join(cells, ", "))
+		}
+		return "[" + strings.Join(rows, "; ") + "]"
+	case "semantics":
+		if elements := n.elements(); len(elements) > 0 {
+			return elements[0].text()
+		}
+		return ""
+	case "annotation", "annotation-xml", "mphantom", "none", "mprescripts", "maligngroup", "malignmark":
+		return ""
+	}
+	// math, mrow, mstyle, mpadded, merror, menclose, mmultiscripts, mtd and unknown elements
+	return n.textChildren()
+}
+
+// textChildren converts the child elements
+func (n *Node) textChildren() string {
+	var sb strings.Builder
+	for _, c := range n.elements() {
+		s := c.text()
+		// separate words, e.g. x sqrt(2)
+		if sb.Len() > 0 && s != "" && isWordRune(lastRune(sb.String())) && isWordRune([]rune(s)[0]) {
+			sb.WriteString(" ")
+		}
+		sb.WriteString(s)
+	}
+	return sb.String()
+}
+
+// lastRune returns the last rune of the string
+func lastRune(s string) rune {
+	runes := []rune(s)
+	return runes[len(runes)-1]
+}
+
+// isWordRune checks if the rune is a letter or digit
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+// textFenced converts mfenced, the children are separated by the separators
+func (n *Node) textFenced() string {
+	open, ok := n.attr("open")
+	if !ok {
+		open = "("
+	}
+	closing, ok := n.attr("close")
+	if !ok {
+		closing = ")"
+	}
+	separators, ok := n.attr("separators")
+	if !ok {
+		separators = ","
+	}
+	seps := []rune(strings.Join(strings.Fields(separators), ""))
+	var sb strings.Builder
+	sb.WriteString(open)
+	for i, c := range n.elements() {
+		if i > 0 && len(seps) > 0 {
+			sb.WriteString(string(seps[min(i-1, len(seps)-1)]) + " ")
+		}
+		sb.WriteString(c.text())
+	}
+	sb.WriteString(closing)
+	return sb.String()
+}
+
+// textGroup returns the text of the node, texts that are not atomic are put in parentheses
+func textGroup(n *Node) string {
+	s := strings.Join(strings.Fields(n.text()), " ")
+	if isAtomic(s) {
+		return s
+	}
+	return "(" + s + ")"
+}
+
+// isAtomic checks if the text is a single identifier, number or group
+func isAtomic(s string) bool {
+	if s == "" {
+		return true
+	}
+	runes := []rune(s)
+	if (runes[0] == '(' && runes[len(runes)-1] == ')') || (runes[0] == '[' && runes[len(runes)-1] == ']') {
+		// the parentheses have to enclose the whole text
+		depth := 0
+		for i, r := range runes {
+			switch r {
+			case '(', '[':
+				depth++
+			case ')', ']':
+				depth--
+				if depth == 0 && i < len(runes)-1 {
+					return false
+				}
+			}
+		}
+		return true
+	}
+	return !strings.ContainsAny(s, " +-−*/=^_,")
+}
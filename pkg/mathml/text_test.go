@@ -0,0 +1,25 @@
+package mathml
+
+import (
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+)
+
+func TestText(t *testing.T) {
+	ass := assert.New(t)
+	tests := map[string]string{
+		volume: `V = 4/3(D/2)^3.`,
+		heat:   `Qc = KA(Tc − Ta)`,
+		`<math><msub><mi>x</mi><mi>i</mi></msub><mo>×</mo><mi>α</mi><mi>x</mi></math>`:                                                           `x_i × α x`,
+		`<math><mfrac><mrow><mi>a</mi><mo>+</mo><mi>b</mi></mrow><mn>2</mn></mfrac></math>`:                                                      `(a + b)/2`,
+		`<math><msqrt><msup><mi>a</mi><mn>2</mn></msup><mo>+</mo><msup><mi>b</mi><mn>2</mn></msup></msqrt></math>`:                               `sqrt(a^2 + b^2)`,
+		`<math><munderover><mo>∑</mo><mrow><mi>i</mi><mo>=</mo><mn>1</mn></mrow><mi>n</mi></munderover><msub><mi>x</mi><mi>i</mi></msub></math>`: `∑_(i = 1)^n x_i`,
+		`<math><mfenced><mi>a</mi><mi>b</mi></mfenced><mtable><mtr><mtd><mn>1</mn></mtd><mtd><mn>0</mn></mtd></mtr></mtable></math>`:             `(a, b)[1, 0]`,
+	}
+	for input, expected := range tests {
+		res, err := Text(strings.NewReader(input))
+		ass.NoError(err)
+		ass.Equal(expected, res, input)
+	}
+}
@@ -0,0 +1,257 @@
+package pdf
+
+import (
+	"bytes"
+	"compress/zlib"
+	"errors"
+	"io"
+	"regexp"
+	"strconv"
+)
+
+var (
+	errUnsupportedFilter = errors.New("unsupported pdf filter")
+	errStreamTooLarge    = errors.New("decoded pdf stream too large")
+)
+
+// maxDecodedStreamSize limits the size of a decoded stream, e.g. of a forged flate stream
+const maxDecodedStreamSize = 64 << 20
+
+var (
+	// objectStart matches the start of an indirect object, e.g. 12 0 obj
+	objectStart = regexp.MustCompile(`(\d+)\s+(\d+)\s+obj\b`)
+	// trailerStart matches the trailer dictionary
+	trailerStart = regexp.MustCompile(`trailer\s*<<`)
+)
+
+// document holds the objects of a pdf file.
+// The objects are found by scanning the file, so files with broken cross-reference tables can be read as well.
+type document struct {
+	data    []byte
+	objects map[int]object
+	order   []int // numbers of the objects in the order of the file
+	trailer dict
+}
+
+// load scans the indirect objects, the object streams and the trailers of the file
+func load(data []byte) (*document, error) {
+	d := &document{data: data, objects: map[int]object{}, trailer: dict{}}
+	var objectStreams []*stream
+	pos := 0
+	for pos < len(data) {
+		loc := objectStart.FindSubmatchIndex(data[pos:])
+		if loc == nil {
+			break
+		}
+		num, _ := strconv.Atoi(string(data[pos+loc[2] : pos+loc[3]]))
+		p := &parser{data: data, pos: pos + loc[1]}
+		o, err := p.parseObject()
+		if err != nil {
+			// continue after the keyword obj
+			pos += loc[1]
+			continue
+		}
+		if dictionary, ok := o.(dict); ok {
+			p.skipSpace()
+			if bytes.HasPrefix(data[p.pos:], []byte("stream")) {
+				s := &stream{dict: dictionary}
+				s.data, p.pos = streamData(data, p.pos+len("stream"), dictionary)
+				o = s
+				switch dictionary["Type"] {
+				case name("ObjStm"):
+					objectStreams = append(objectStreams, s)
+				case name("XRef"):
+					// cross-reference streams contain the trailer
+					d.mergeTrailer(dictionary)
+				}
+			}
+		}
+		if _, ok := d.objects[num]; !ok {
+			d.order = append(d.order, num)
+		}
+		// later objects are updates of earlier objects
+		d.objects[num] = o
+		pos = p.pos
+	}
+	for _, s := range objectStreams {
+		d.loadObjectStream(s)
+	}
+	for _, loc := range trailerStart.FindAllIndex(data, -1) {
+		p := &parser{data: data, pos: loc[1] - 2}
+		if t, err := p.parseObject(); err == nil {
+			if dictionary, ok := t.(dict); ok {
+				d.mergeTrailer(dictionary)
+			}
+		}
+	}
+	if len(d.objects) == 0 {
+		return nil, ErrNoObjects
+	}
+	return d, nil
+}
+
+// mergeTrailer adds the keys of the trailer, the keys of later trailers replace the keys of earlier trailers
+func (d *document) mergeTrailer(t dict) {
+	for k, v := range t {
+		d.trailer[k] = v
+	}
+}
+
+// streamData returns the data of the stream that starts after the keyword stream and the position after the data
+func streamData(data []byte, start int, dictionary dict) ([]byte, int) {
+	// the keyword is followed by CRLF or LF
+	if start < len(data) && data[start] == '\r' {
+		start++
+	}
+	if start < len(data) && data[start] == '\n' {
+		start++
+	}
+	// the length is compared with the rest of the data, a huge length would overflow the end
+	if length, ok := dictionary["Length"].(int64); ok && length >= 0 && length <= int64(len(data)-start) {
+		end := start + int(length)
+		rest := bytes.TrimLeft(data[end:], "\r\n \t")
+		if bytes.HasPrefix(rest, []byte("endstream")) {
+			return data[start:end], len(data) - len(rest) + len("endstream")
+		}
+	}
+	// the length is an indirect object or wrong
+	i := bytes.Index(data[start:], []byte("endstream"))
+	if i < 0 {
+		return data[start:], len(data)
+	}
+	end := start + i
+	content := data[start:end]
+	content = bytes.TrimSuffix(content, []byte("\n"))
+	content = bytes.TrimSuffix(content, []byte("\r"))
+	return content, end + len("endstream")
+}
+
+// loadObjectStream adds the objects of a compressed object stream
+func (d *document) loadObjectStream(s *stream) {
+	data, err := d.decode(s)
+	if err != nil {
+		return
+	}
+	n, _ := d.resolve(s.dict["N"]).(int64)
+	first, _ := d.resolve(s.dict["First"]).(int64)
+	header := &parser{data: data}
+	for i := int64(0); i < n; i++ {
+		num, errNum := header.parseObject()
+		offset, errOffset := header.parseObject()
+		if errNum != nil || errOffset != nil {
+			return
+		}
+		objNum, ok1 := num.(int64)
+		objOffset, ok2 := offset.(int64)
+		if !ok1 || !ok2 || first < 0 || objOffset < 0 || objOffset >= int64(len(data))-first {
+			return
+		}
+		if _, ok := d.objects[int(objNum)]; ok {
+			continue
+		}
+		p := &parser{data: data, pos: int(first + objOffset)}
+		o, errObject := p.parseObject()
+		if errObject != nil {
+			continue
+		}
+		d.objects[int(objNum)] = o
+		d.order = append(d.order, int(objNum))
+	}
+}
+
+// resolve returns the object of a reference
+func (d *document) resolve(o object) object {
+	for i := 0; i < 32; i++ {
+		r, ok := o.(ref)
+		if !ok {
+			return o
+		}
+		o = d.objects[r.num]
+	}
+	return nil
+}
+
+// dict returns the dictionary of the object or of the stream
+func (d *document) dict(o object) dict {
+	switch v := d.resolve(o).(type) {
+	case dict:
+		return v
+	case *stream:
+		return v.dict
+	}
+	return nil
+}
+
+// decode returns the decoded data of a stream, only the FlateDecode filter is supported
+func (d *document) decode(s *stream) ([]byte, error) {
+	var filters []object
+	switch f := d.resolve(s.dict["Filter"]).(type) {
+	case nil:
+	case name:
+		filters = append(filters, f)
+	case array:
+		filters = f
+	}
+	data := s.data
+	for _, f := range filters {
+		switch d.resolve(f) {
+		case name("FlateDecode"), name("Fl"):
+			r, err := zlib.NewReader(bytes.NewReader(data))
+			if err != nil {
+				return nil, err
+			}
+			decoded, err := io.ReadAll(io.LimitReader(r, maxDecodedStreamSize+1))
+			// truncated streams are used as far as possible
+			if err != nil && len(decoded) == 0 {
+				return nil, err
+			}
+			if len(decoded) > maxDecodedStreamSize {
+				return nil, errStreamTooLarge
+			}
+			data = decoded
+		default:
+			return nil, errUnsupportedFilter
+		}
+	}
+	return data, nil
+}
+
+// catalog returns the document catalog
+func (d *document) catalog() dict {
+	return d.dict(d.trailer["Root"])
+}
+
+// pages returns the references to the pages in the order of the page tree
+func (d *document) pages() (res []ref) {
+	root := d.catalog()
+	if root == nil {
+		return
+	}
+	seen := map[int]bool{}
+	var walk func(o object)
+	walk = func(o object) {
+		r, ok := o.(ref)
+		if ok {
+			if seen[r.num] {
+				return
+			}
+			seen[r.num] = true
+		}
+		node := d.dict(o)
+		if node == nil {
+			return
+		}
+		kids, isPages := d.resolve(node["Kids"]).(array)
+		if node["Type"] == name("Pages") || isPages {
+			for _, k := range kids {
+				walk(k)
+			}
+			return
+		}
+		if ok {
+			res = append(res, r)
+		}
+	}
+	walk(root["Pages"])
+	return
+}
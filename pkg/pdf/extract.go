@@ -0,0 +1,272 @@
+package pdf
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	log "github.com/sirupsen/logrus"
+	"sort"
+	"strconv"
+)
+
+var ErrPageNotFound = errors.New("page not found")
+
+// inheritedKeys are the attributes of a page that can be inherited from the page tree
+var inheritedKeys = []name{"Resources", "MediaBox", "CropBox", "Rotate"}
+
+// FrontPage returns a pdf file with the first page, e.g. the title page of a patent
+func FrontPage(data []byte) ([]byte, error) {
+	return ExtractPages(data, 1)
+}
+
+// ExtractPages returns a pdf file with the pages, the pages are numbered from 1.
+// References to other pages, e.g. links, are removed.
+// The catalog is not copied, so the new file does not claim PDF/A conformance.
+func ExtractPages(data []byte, pages ...int) (res []byte, err error) {
+	err = Validate(data)
+	if err != nil {
+		log.WithError(err).Error("invalid pdf file")
+		return
+	}
+	d, err := load(data)
+	if err != nil {
+		log.WithError(err).Error("can not read pdf file")
+		return
+	}
+	if _, ok := d.trailer["Encrypt"]; ok {
+		err = ErrEncrypted
+		log.WithError(err).Error("can not extract pages")
+		return
+	}
+	all := d.pages()
+	if len(pages) == 0 {
+		err = ErrPageNotFound
+		log.WithError(err).Error("can not extract pages")
+		return
+	}
+	w := newWriter(d)
+	// 1 is the catalog and 2 the page tree
+	w.next = 3
+	for _, page := range pages {
+		if page < 1 || page > len(all) {
+			err = ErrPageNotFound
+			log.WithError(err).WithField("page", page).WithField("pages", len(all)).Error("can not extract pages")
+			return
+		}
+		w.selected[all[page-1].num] = true
+	}
+	var kids array
+	for _, page := range pages {
+		kids = append(kids, w.copyRef(all[page-1]))
+	}
+	w.copyQueue()
+	w.out[1] = dict{"Type": name("Catalog"), "Pages": ref{num: 2}}
+	w.out[2] = dict{"Type": name("Pages"), "Kids": kids, "Count": int64(len(kids))}
+	trailer := dict{"Root": ref{num: 1}}
+	if info, ok := d.trailer["Info"].(ref); ok {
+		trailer["Info"] = w.copyRef(info)
+		w.copyQueue()
+	}
+	return w.write(trailer), nil
+}
+
+// writer copies the objects of the selected pages into a new file
+type writer struct {
+	doc      *document
+	selected map[int]bool // numbers of the selected pages
+	numbers  map[int]int  // old numbers to new numbers
+	queue    []int        // old numbers of the objects to copy
+	out      map[int]object
+	next     int
+}
+
+// newWriter creates a writer for the objects of the document
+func newWriter(d *document) *writer {
+	return &writer{doc: d, selected: map[int]bool{}, numbers: map[int]int{}, out: map[int]object{}}
+}
+
+// copyRef returns the new reference of an object, the object is copied later
+func (w *writer) copyRef(r ref) object {
+	if num, ok := w.numbers[r.num]; ok {
+		return ref{num: num}
+	}
+	target := w.doc.dict(r)
+	if t := target["Type"]; !w.selected[r.num] && (t == name("Page") || t == name("Pages")) {
+		// pages that are not extracted
+		return nil
+	}
+	if _, ok := w.doc.objects[r.num]; !ok {
+		return nil
+	}
+	w.numbers[r.num] = w.next
+	w.next++
+	w.queue = append(w.queue, r.num)
+	return ref{num: w.numbers[r.num]}
+}
+
+// copyQueue copies the objects of the queue and the objects they refer to
+func (w *writer) copyQueue() {
+	for len(w.queue) > 0 {
+		num := w.queue[0]
+		w.queue = w.queue[1:]
+		w.copyObject(num)
+	}
+}
+
+// copyObject copies an object with the new references
+func (w *writer) copyObject(num int) {
+	o := w.doc.objects[num]
+	if w.selected[num] {
+		page := dict{}
+		for k, v := range w.doc.dict(o) {
+			page[k] = v
+		}
+		// the attributes inherited from the page tree
+		parent := w.doc.dict(page["Parent"])
+		for i := 0; parent != nil && i < 32; i++ {
+			for _, k := range inheritedKeys {
+				if _, ok := page[k]; !ok && parent[k] != nil {
+					page[k] = parent[k]
+				}
+			}
+			parent = w.doc.dict(parent["Parent"])
+		}
+		delete(page, "Parent")
+		delete(page, "B")
+		copied := w.copyValue(page).(dict)
+		copied["Parent"] = ref{num: 2}
+		w.out[w.numbers[num]] = copied
+		return
+	}
+	w.out[w.numbers[num]] = w.copyValue(o)
+}
+
+// copyValue replaces the references of the value
+func (w *writer) copyValue(o object) object {
+	switch v := o.(type) {
+	case ref:
+		return w.copyRef(v)
+	case array:
+		res := make(array, len(v))
+		for i, e := range v {
+			res[i] = w.copyValue(e)
+		}
+		return res
+	case dict:
+		res := dict{}
+		for k, e := range v {
+			res[k] = w.copyValue(e)
+		}
+		return res
+	case *stream:
+		res := &stream{dict: w.copyValue(v.dict).(dict), data: v.data}
+		res.dict["Length"] = int64(len(v.data))
+		return res
+	}
+	return o
+}
+
+// write writes the objects with a cross-reference table
+func (w *writer) write(trailer dict) []byte {
+	var buf bytes.Buffer
+	buf.WriteString("%PDF-1.7\n%\xe2\xe3\xcf\xd3\n")
+	size := w.next
+	offsets := make([]int, size)
+	nums := make([]int, 0, len(w.out))
+	for num := range w.out {
+		nums = append(nums, num)
+	}
+	sort.Ints(nums)
+	for _, num := range nums {
+		offsets[num] = buf.Len()
+		buf.WriteString(strconv.Itoa(num) + " 0 obj\n")
+		writeObject(&buf, w.out[num])
+		buf.WriteString("\nendobj\n")
+	}
+	xref := buf.Len()
+	buf.WriteString("xref\n0 " + strconv.Itoa(size) + "\n")
+	buf.WriteString("0000000000 65535 f\r\n")
+	for num := 1; num < size; num++ {
+		if _, ok := w.out[num]; !ok {
+			buf.WriteString("0000000000 00000 f\r\n")
+			continue
+		}
+		buf.WriteString(padOffset(offsets[num]) + " 00000 n\r\n")
+	}
+	trailer["Size"] = int64(size)
+	buf.WriteString("trailer\n")
+	writeObject(&buf, trailer)
+	buf.WriteString("\nstartxref\n" + strconv.Itoa(xref) + "\n%%EOF\n")
+	return buf.Bytes()
+}
+
+// padOffset formats the offset with 10 digits
+func padOffset(offset int) string {
+	s := strconv.Itoa(offset)
+	for len(s) < 10 {
+		s = "0" + s
+	}
+	return s
+}
+
+// writeObject writes an object in the pdf syntax
+func writeObject(buf *bytes.Buffer, o object) {
+	switch v := o.(type) {
+	case nil:
+		buf.WriteString("null")
+	case bool:
+		buf.WriteString(strconv.FormatBool(v))
+	case int64:
+		buf.WriteString(strconv.FormatInt(v, 10))
+	case float64:
+		buf.WriteString(strconv.FormatFloat(v, 'f', -1, 64))
+	case name:
+		writeName(buf, v)
+	case pdfString:
+		buf.WriteString("<" + hex.EncodeToString([]byte(v)) + ">")
+	case ref:
+		buf.WriteString(strconv.Itoa(v.num) + " " + strconv.Itoa(v.gen) + " R")
+	case array:
+		buf.WriteString("[")
+		for i, e := range v {
+			if i > 0 {
+				buf.WriteString(" ")
+			}
+			writeObject(buf, e)
+		}
+		buf.WriteString("]")
+	case dict:
+		keys := make([]string, 0, len(v))
+		for k := range v {
+			keys = append(keys, string(k))
+		}
+		sort.Strings(keys)
+		buf.WriteString("<<")
+		for _, k := range keys {
+			writeName(buf, name(k))
+			buf.WriteString(" ")
+			writeObject(buf, v[name(k)])
+		}
+		buf.WriteString(">>")
+	case *stream:
+		writeObject(buf, v.dict)
+		buf.WriteString("\nstream\n")
+		buf.Write(v.data)
+		buf.WriteString("\nendstream")
+	case keyword:
+		buf.WriteString(string(v))
+	}
+}
+
+// writeName writes a name, the delimiters and the irregular characters are escaped with #xx
+func writeName(buf *bytes.Buffer, n name) {
+	buf.WriteString("/")
+	for i := 0; i < len(n); i++ {
+		c := n[i]
+		if c < 0x21 || c > 0x7e || c == '#' || isDelimiter(c) {
+			buf.WriteString("#" + hex.EncodeToString([]byte{c}))
+			continue
+		}
+		buf.WriteByte(c)
+	}
+}
@@ -0,0 +1,70 @@
+package pdf
+
+import (
+	"bytes"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestFrontPage(t *testing.T) {
+	ass := assert.New(t)
+	res, err := FrontPage(newTestPDF())
+	if !ass.NoError(err) {
+		return
+	}
+	info, err := Inspect(res)
+	ass.NoError(err)
+	ass.Equal(1, info.Pages)
+	ass.Equal("EPO (Publication Server)", info.Metadata.Producer)
+	// the catalog with the PDF/A metadata is not copied
+	ass.Nil(info.PDFA)
+	// the content of the other pages is not copied
+	ass.Contains(string(res), "(EP 2921808 B1) Tj")
+	ass.NotContains(string(res), "(Description) Tj")
+
+	d, err := load(res)
+	ass.NoError(err)
+	pages := d.pages()
+	if ass.Len(pages, 1) {
+		page := d.dict(pages[0])
+		// the attributes of the page tree are inherited
+		ass.Equal(array{int64(0), int64(0), int64(595), int64(842)}, d.resolve(page["MediaBox"]))
+		ass.NotNil(d.dict(d.dict(page["Resources"])["Font"])["F1"])
+		// the link to the second page is removed
+		annots := d.resolve(page["Annots"]).(array)
+		ass.Equal(array{nil, name("Fit")}, d.dict(annots[0])["Dest"])
+	}
+}
+
+func TestExtractPages(t *testing.T) {
+	ass := assert.New(t)
+	res, err := ExtractPages(newTestPDF(), 3, 2)
+	if !ass.NoError(err) {
+		return
+	}
+	info, err := Inspect(res)
+	ass.NoError(err)
+	ass.Equal(2, info.Pages)
+	ass.NotContains(string(res), "(EP 2921808 B1) Tj")
+	// the content stream of the pages is shared
+	ass.Equal(1, bytes.Count(res, []byte("(Description) Tj")))
+	d, err := load(res)
+	ass.NoError(err)
+	for _, p := range d.pages() {
+		ass.Equal(int64(90), d.dict(p)["Rotate"])
+	}
+
+	_, err = ExtractPages(newTestPDF(), 4)
+	ass.ErrorIs(err, ErrPageNotFound)
+	_, err = ExtractPages(newTestPDF())
+	ass.ErrorIs(err, ErrPageNotFound)
+	_, err = FrontPage([]byte("<html></html>"))
+	ass.ErrorIs(err, ErrHTMLPage)
+
+	// files with object streams
+	res, err = FrontPage(newCompressedTestPDF())
+	ass.NoError(err)
+	info, err = Inspect(res)
+	ass.NoError(err)
+	ass.Equal(1, info.Pages)
+}
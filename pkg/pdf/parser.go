@@ -0,0 +1,284 @@
+package pdf
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+)
+
+var errSyntax = errors.New("pdf syntax error")
+
+// the objects of a pdf file
+type (
+	object    interface{} // nil, bool, int64, float64, name, pdfString, array, dict, ref, *stream or keyword
+	name      string
+	pdfString string
+	array     []object
+	dict      map[name]object
+	keyword   string
+)
+
+// ref is a reference to an indirect object, e.g. 12 0 R
+type ref struct {
+	num, gen int
+}
+
+// stream is a dictionary followed by the encoded data
+type stream struct {
+	dict dict
+	data []byte
+}
+
+// isSpace checks if the byte is white-space
+func isSpace(c byte) bool {
+	switch c {
+	case 0, '\t', '\n', '\f', '\r', ' ':
+		return true
+	}
+	return false
+}
+
+// isDelimiter checks if the byte is a delimiter
+func isDelimiter(c byte) bool {
+	switch c {
+	case '(', ')', '<', '>', '[', ']', '{', '}', '/', '%':
+		return true
+	}
+	return false
+}
+
+// parser reads the objects of the data
+type parser struct {
+	data []byte
+	pos  int
+}
+
+// skipSpace skips white-space and comments
+func (p *parser) skipSpace() {
+	for p.pos < len(p.data) {
+		c := p.data[p.pos]
+		switch {
+		case isSpace(c):
+			p.pos++
+		case c == '%':
+			for p.pos < len(p.data) && p.data[p.pos] != '\n' && p.data[p.pos] != '\r' {
+				p.pos++
+			}
+		default:
+			return
+		}
+	}
+}
+
+// token reads a regular token, e.g. a number or a keyword
+func (p *parser) token() string {
+	start := p.pos
+	for p.pos < len(p.data) && !isSpace(p.data[p.pos]) && !isDelimiter(p.data[p.pos]) {
+		p.pos++
+	}
+	return string(p.data[start:p.pos])
+}
+
+// parseObject reads the next object, references are returned as ref
+func (p *parser) parseObject() (object, error) {
+	p.skipSpace()
+	if p.pos >= len(p.data) {
+		return nil, errSyntax
+	}
+	switch c := p.data[p.pos]; c {
+	case '/':
+		p.pos++
+		return p.parseName(), nil
+	case '(':
+		p.pos++
+		return p.parseLiteralString()
+	case '<':
+		if p.pos+1 < len(p.data) && p.data[p.pos+1] == '<' {
+			p.pos += 2
+			return p.parseDict()
+		}
+		p.pos++
+		return p.parseHexString()
+	case '[':
+		p.pos++
+		return p.parseArray()
+	case ']', '>', ')', '{', '}':
+		p.pos++
+		return keyword(c), nil
+	}
+	t := p.token()
+	if t == "" {
+		p.pos++
+		return nil, errSyntax
+	}
+	switch t {
+	case "true":
+		return true, nil
+	case "false":
+		return false, nil
+	case "null":
+		return nil, nil
+	}
+	if i, err := strconv.ParseInt(t, 10, 64); err == nil {
+		// e.g. 12 0 R
+		save := p.pos
+		p.skipSpace()
+		if gen, err := strconv.Atoi(p.token()); err == nil {
+			p.skipSpace()
+			if p.token() == "R" {
+				return ref{num: int(i), gen: gen}, nil
+			}
+		}
+		p.pos = save
+		return i, nil
+	}
+	if f, err := strconv.ParseFloat(t, 64); err == nil {
+		return f, nil
+	}
+	return keyword(t), nil
+}
+
+// parseName reads a name after the slash, e.g. /Type, #xx are decoded
+func (p *parser) parseName() name {
+	t := p.token()
+	if !strings.Contains(t, "#") {
+		return name(t)
+	}
+	var sb []byte
+	for i := 0; i < len(t); i++ {
+		if t[i] == '#' && i+2 < len(t) {
+			if b, err := strconv.ParseUint(t[i+1:i+3], 16, 8); err == nil {
+				sb = append(sb, byte(b))
+				i += 2
+				continue
+			}
+		}
+		sb = append(sb, t[i])
+	}
+	return name(sb)
+}
+
+// parseLiteralString reads a string in parentheses
+func (p *parser) parseLiteralString() (object, error) {
+	var sb []byte
+	depth := 1
+	for p.pos < len(p.data) {
+		c := p.data[p.pos]
+		p.pos++
+		switch c {
+		case '(':
+			depth++
+		case ')':
+			depth--
+			if depth == 0 {
+				return pdfString(sb), nil
+			}
+		case '\\':
+			if p.pos >= len(p.data) {
+				return nil, errSyntax
+			}
+			e := p.data[p.pos]
+			p.pos++
+			switch e {
+			case 'n':
+				c = '\n'
+			case 'r':
+				c = '\r'
+			case 't':
+				c = '\t'
+			case 'b':
+				c = '\b'
+			case 'f':
+				c = '\f'
+			case '\r':
+				// line continuation
+				if p.pos < len(p.data) && p.data[p.pos] == '\n' {
+					p.pos++
+				}
+				continue
+			case '\n':
+				continue
+			default:
+				if e >= '0' && e <= '7' {
+					v := int(e - '0')
+					for i := 0; i < 2 && p.pos < len(p.data) && p.data[p.pos] >= '0' && p.data[p.pos] <= '7'; i++ {
+						v = v*8 + int(p.data[p.pos]-'0')
+						p.pos++
+					}
+					c = byte(v)
+				} else {
+					c = e
+				}
+			}
+		}
+		sb = append(sb, c)
+	}
+	return nil, errSyntax
+}
+
+// parseHexString reads a hexadecimal string, e.g. <FEFF0041>
+func (p *parser) parseHexString() (object, error) {
+	var digits []byte
+	for p.pos < len(p.data) {
+		c := p.data[p.pos]
+		p.pos++
+		if c == '>' {
+			if len(digits)%2 == 1 {
+				digits = append(digits, '0')
+			}
+			res := make([]byte, len(digits)/2)
+			for i := range res {
+				b, err := strconv.ParseUint(string(digits[2*i:2*i+2]), 16, 8)
+				if err != nil {
+					return nil, errSyntax
+				}
+				res[i] = byte(b)
+			}
+			return pdfString(res), nil
+		}
+		if !isSpace(c) {
+			digits = append(digits, c)
+		}
+	}
+	return nil, errSyntax
+}
+
+// parseArray reads the objects until ]
+func (p *parser) parseArray() (object, error) {
+	var a array
+	for {
+		o, err := p.parseObject()
+		if err != nil {
+			return nil, err
+		}
+		if o == keyword("]") {
+			return a, nil
+		}
+		a = append(a, o)
+	}
+}
+
+// parseDict reads the keys and values until >>
+func (p *parser) parseDict() (object, error) {
+	d := dict{}
+	for {
+		p.skipSpace()
+		if p.pos+1 < len(p.data) && p.data[p.pos] == '>' && p.data[p.pos+1] == '>' {
+			p.pos += 2
+			return d, nil
+		}
+		key, err := p.parseObject()
+		if err != nil {
+			return nil, err
+		}
+		k, ok := key.(name)
+		if !ok {
+			return nil, errSyntax
+		}
+		value, err := p.parseObject()
+		if err != nil {
+			return nil, err
+		}
+		d[k] = value
+	}
+}
@@ -0,0 +1,225 @@
+// Package pdf inspects pdf files, e.g. the results of GetPatentPDF, and extracts single pages
+package pdf
+
+import (
+	"bytes"
+	"errors"
+	log "github.com/sirupsen/logrus"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf16"
+)
+
+var (
+	ErrNotPDF    = errors.New("no pdf file")
+	ErrHTMLPage  = errors.New("html page instead of a pdf file")
+	ErrTruncated = errors.New("truncated pdf file")
+	ErrNoObjects = errors.New("no objects in the pdf file")
+	ErrNoPages   = errors.New("no pages in the pdf file")
+	ErrEncrypted = errors.New("encrypted pdf file")
+)
+
+// headerWindow is the number of bytes that are searched for the header, the end of file marker is searched in the last bytes
+const headerWindow = 1024
+
+// Info is the result of the inspection of a pdf file
+type Info struct {
+	Version    string // e.g. 1.4
+	Pages      int
+	Size       int // size of the file in bytes
+	Encrypted  bool
+	Linearized bool  // optimized for the web
+	PDFA       *PDFA // nil if the file does not claim PDF/A conformance
+	Metadata   Metadata
+}
+
+// PDFA is the PDF/A conformance claimed by the file
+type PDFA struct {
+	Part         int    // e.g. 1 for PDF/A-1
+	Conformance  string // e.g. B
+	OutputIntent bool   // the file has a PDF/A output intent (GTS_PDFA1)
+}
+
+// String returns the name of the conformance level, e.g. PDF/A-1b
+func (a PDFA) String() string {
+	return "PDF/A-" + strconv.Itoa(a.Part) + strings.ToLower(a.Conformance)
+}
+
+// Metadata is the document information dictionary of the file
+type Metadata struct {
+	Title        string
+	Author       string
+	Subject      string
+	Keywords     string
+	Creator      string
+	Producer     string
+	CreationDate time.Time
+	ModDate      time.Time
+}
+
+// Validate checks if the data is a complete pdf file and not e.g. an html error page
+func Validate(data []byte) error {
+	head := data[:min(len(data), headerWindow)]
+	if !bytes.Contains(head, []byte("%PDF-")) {
+		lower := bytes.ToLower(bytes.TrimSpace(head))
+		if bytes.Contains(lower, []byte("<html")) || bytes.HasPrefix(lower, []byte("<!doctype html")) {
+			return ErrHTMLPage
+		}
+		return ErrNotPDF
+	}
+	tail := data[max(0, len(data)-headerWindow):]
+	if !bytes.Contains(tail, []byte("%%EOF")) {
+		return ErrTruncated
+	}
+	return nil
+}
+
+// versionPattern matches the version of the header, e.g. %PDF-1.4
+var versionPattern = regexp.MustCompile(`%PDF-(\d+\.\d+)`)
+
+// Inspect validates the pdf file and reads the number of pages, the PDF/A conformance and the metadata
+func Inspect(data []byte) (info Info, err error) {
+	err = Validate(data)
+	if err != nil {
+		log.WithError(err).Error("invalid pdf file")
+		return
+	}
+	info.Size = len(data)
+	if m := versionPattern.FindSubmatch(data[:min(len(data), headerWindow)]); m != nil {
+		info.Version = string(m[1])
+	}
+	d, err := load(data)
+	if err != nil {
+		log.WithError(err).Error("can not read pdf file")
+		return
+	}
+	root := d.catalog()
+	// the catalog can update the version of the header
+	if v, ok := d.resolve(root["Version"]).(name); ok && versionGreater(string(v), info.Version) {
+		info.Version = string(v)
+	}
+	info.Pages = len(d.pages())
+	if info.Pages == 0 {
+		err = ErrNoPages
+		log.WithError(err).Error("can not read pdf file")
+		return
+	}
+	_, info.Encrypted = d.trailer["Encrypt"]
+	if len(d.order) > 0 {
+		_, info.Linearized = d.dict(d.objects[d.order[0]])["Linearized"]
+	}
+	if info.Encrypted {
+		// the strings and streams are encrypted
+		return
+	}
+	info.Metadata = d.metadata()
+	info.PDFA = d.pdfa()
+	return
+}
+
+// versionGreater checks if the version a is greater than b, e.g. 1.7 > 1.4
+func versionGreater(a, b string) bool {
+	fa, errA := strconv.ParseFloat(a, 64)
+	fb, errB := strconv.ParseFloat(b, 64)
+	return errA == nil && (errB != nil || fa > fb)
+}
+
+// metadata reads the document information dictionary
+func (d *document) metadata() (m Metadata) {
+	info := d.dict(d.trailer["Info"])
+	text := func(key name) string {
+		s, _ := d.resolve(info[key]).(pdfString)
+		return decodeText(s)
+	}
+	m.Title = text("Title")
+	m.Author = text("Author")
+	m.Subject = text("Subject")
+	m.Keywords = text("Keywords")
+	m.Creator = text("Creator")
+	m.Producer = text("Producer")
+	m.CreationDate = parseDate(text("CreationDate"))
+	m.ModDate = parseDate(text("ModDate"))
+	return
+}
+
+var (
+	// pdfaPart and pdfaConformance match the PDF/A identification of the XMP metadata as attribute or element
+	pdfaPart        = regexp.MustCompile(`pdfaid:part(?:="|>)\s*(\d+)`)
+	pdfaConformance = regexp.MustCompile(`pdfaid:conformance(?:="|>)\s*([A-Za-z])`)
+)
+
+// pdfa reads the PDF/A identification of the XMP metadata and the output intents
+func (d *document) pdfa() *PDFA {
+	var a PDFA
+	root := d.catalog()
+	if s, ok := d.resolve(root["Metadata"]).(*stream); ok {
+		if xmp, err := d.decode(s); err == nil {
+			if m := pdfaPart.FindSubmatch(xmp); m != nil {
+				a.Part, _ = strconv.Atoi(string(m[1]))
+			}
+			if m := pdfaConformance.FindSubmatch(xmp); m != nil {
+				a.Conformance = strings.ToUpper(string(m[1]))
+			}
+		}
+	}
+	intents, _ := d.resolve(root["OutputIntents"]).(array)
+	for _, intent := range intents {
+		if d.dict(intent)["S"] == name("GTS_PDFA1") {
+			a.OutputIntent = true
+		}
+	}
+	if a.Part == 0 && !a.OutputIntent {
+		return nil
+	}
+	return &a
+}
+
+// decodeText decodes a text string, which is either UTF-16BE with byte order mark or PDFDocEncoding
+func decodeText(s pdfString) string {
+	b := []byte(s)
+	if len(b) >= 2 && b[0] == 0xfe && b[1] == 0xff {
+		u := make([]uint16, 0, len(b)/2)
+		for i := 2; i+1 < len(b); i += 2 {
+			u = append(u, uint16(b[i])<<8|uint16(b[i+1]))
+		}
+		return string(utf16.Decode(u))
+	}
+	if len(b) >= 3 && b[0] == 0xef && b[1] == 0xbb && b[2] == 0xbf {
+		return string(b[3:])
+	}
+	// the printable characters of PDFDocEncoding are equal to Latin-1
+	runes := make([]rune, len(b))
+	for i, c := range b {
+		runes[i] = rune(c)
+	}
+	return string(runes)
+}
+
+// datePattern matches a pdf date, e.g. D:20150923102030+02'00'
+var datePattern = regexp.MustCompile(`^D?:?(\d{4})(\d{2})?(\d{2})?(\d{2})?(\d{2})?(\d{2})?([+\-Z])?(\d{2})?'?(\d{2})?'?$`)
+
+// parseDate parses a pdf date, the zero time is returned for invalid dates
+func parseDate(s string) time.Time {
+	m := datePattern.FindStringSubmatch(strings.TrimSpace(s))
+	if m == nil {
+		return time.Time{}
+	}
+	number := func(i, def int) int {
+		if m[i] == "" {
+			return def
+		}
+		n, _ := strconv.Atoi(m[i])
+		return n
+	}
+	loc := time.UTC
+	if m[7] == "+" || m[7] == "-" {
+		offset := number(8, 0)*3600 + number(9, 0)*60
+		if m[7] == "-" {
+			offset = -offset
+		}
+		loc = time.FixedZone("", offset)
+	}
+	return time.Date(number(1, 0), time.Month(number(2, 1)), number(3, 1), number(4, 0), number(5, 0), number(6, 0), 0, loc)
+}
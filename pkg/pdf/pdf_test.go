@@ -0,0 +1,232 @@
+package pdf
+
+import (
+	"bytes"
+	"compress/zlib"
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"io"
+	"strconv"
+	"testing"
+	"time"
+)
+
+// testXMP is the XMP metadata of a PDF/A-1b file
+const testXMP = `<?xpacket begin="" id="W5M0MpCehiHzreSzNTczkc9d"?>
+<x:xmpmeta xmlns:x="adobe:ns:meta/"><rdf:RDF xmlns:rdf="http://www.w3.org/1999/02/22-rdf-syntax-ns#">
+<rdf:Description rdf:about="" xmlns:pdfaid="http://www.aiim.org/pdfa/ns/id/"><pdfaid:part>1</pdfaid:part><pdfaid:conformance>B</pdfaid:conformance></rdf:Description>
+</rdf:RDF></x:xmpmeta>
+<?xpacket end="w"?>`
+
+// buildPDF writes the objects, which are numbered from 1, with a cross-reference table
+func buildPDF(objects []string, trailer string) []byte {
+	var buf bytes.Buffer
+	buf.WriteString("%PDF-1.4\n%\xe2\xe3\xcf\xd3\n")
+	offsets := make([]int, len(objects))
+	for i, o := range objects {
+		offsets[i] = buf.Len()
+		buf.WriteString(strconv.Itoa(i+1) + " 0 obj\n" + o + "\nendobj\n")
+	}
+	xref := buf.Len()
+	buf.WriteString("xref\n0 " + strconv.Itoa(len(objects)+1) + "\n0000000000 65535 f\r\n")
+	for _, offset := range offsets {
+		buf.WriteString(padOffset(offset) + " 00000 n\r\n")
+	}
+	buf.WriteString("trailer\n<< /Size " + strconv.Itoa(len(objects)+1) + " " + trailer + " >>\nstartxref\n" + strconv.Itoa(xref) + "\n%%EOF\n")
+	return buf.Bytes()
+}
+
+// streamObject returns a stream with the data
+func streamObject(dict, data string) string {
+	return "<< " + dict + " /Length " + strconv.Itoa(len(data)) + " >>\nstream\n" + data + "\nendstream"
+}
+
+// newTestPDF creates a PDF/A file with three pages, the first page links to the second page
+func newTestPDF() []byte {
+	return buildPDF([]string{
+		// 1 catalog
+		"<< /Type /Catalog /Pages 2 0 R /Metadata 9 0 R /OutputIntents [<< /Type /OutputIntent /S /GTS_PDFA1 >>] >>",
+		// 2 page tree with inherited attributes
+		"<< /Type /Pages /Kids [3 0 R 4 0 R] /Count 3 /MediaBox [0 0 595 842] /Resources << /Font << /F1 10 0 R >> >> >>",
+		// 3 first page
+		"<< /Type /Page /Parent 2 0 R /Contents 6 0 R /Annots [<< /Type /Annot /Subtype /Link /Dest [5 0 R /Fit] >>] >>",
+		// 4 intermediate node
+		"<< /Type /Pages /Parent 2 0 R /Kids [5 0 R 11 0 R] /Count 2 /Rotate 90 >>",
+		// 5 second page
+		"<< /Type /Page /Parent 4 0 R /Contents 7 0 R >>",
+		// 6 content of the first page
+		streamObject("", "BT /F1 12 Tf 72 720 Td (EP 2921808 B1) Tj ET"),
+		// 7 content of the second page
+		streamObject("", "BT /F1 12 Tf 72 720 Td (Description) Tj ET"),
+		// 8 document information
+		"<< /Title <FEFF00C4006E006400650072> /Producer (EPO \\(Publication Server\\)) /CreationDate (D:20150923102030+02'00') >>",
+		// 9 XMP metadata
+		streamObject("/Type /Metadata /Subtype /XML", testXMP),
+		// 10 font
+		"<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica >>",
+		// 11 third page
+		"<< /Type /Page /Parent 4 0 R /Contents 7 0 R >>",
+	}, "/Root 1 0 R /Info 8 0 R")
+}
+
+// newCompressedTestPDF creates a file with an object stream and a cross-reference stream
+func newCompressedTestPDF() []byte {
+	objects := []string{
+		"<< /Type /Catalog /Pages 2 0 R >>",
+		"<< /Type /Pages /Kids [3 0 R] /Count 1 >>",
+		"<< /Type /Page /Parent 2 0 R /MediaBox [0 0 595 842] >>",
+	}
+	var header, body string
+	for i, o := range objects {
+		header += strconv.Itoa(i+1) + " " + strconv.Itoa(len(body)) + " "
+		body += o + "\n"
+	}
+	var compressed bytes.Buffer
+	w := zlib.NewWriter(&compressed)
+	_, _ = w.Write([]byte(header + body))
+	_ = w.Close()
+	var buf bytes.Buffer
+	buf.WriteString("%PDF-1.5\n")
+	objStm := buf.Len()
+	buf.WriteString("4 0 obj\n<< /Type /ObjStm /N 3 /First " + strconv.Itoa(len(header)) + " /Filter /FlateDecode /Length " + strconv.Itoa(compressed.Len()) + " >>\nstream\n")
+	buf.Write(compressed.Bytes())
+	buf.WriteString("\nendstream\nendobj\n")
+	xref := buf.Len()
+	// type 2 entries refer to the object stream 4
+	entries := []byte{0, 0, 0, 0, 0, 0xff}
+	for i := range objects {
+		entries = append(entries, 2, 0, 0, 0, 4, byte(i))
+	}
+	entries = append(entries, 1, byte(objStm>>24), byte(objStm>>16), byte(objStm>>8), byte(objStm), 0)
+	entries = append(entries, 1, byte(xref>>24), byte(xref>>16), byte(xref>>8), byte(xref), 0)
+	buf.WriteString("5 0 obj\n<< /Type /XRef /Size 6 /W [1 4 1] /Root 1 0 R /Length " + strconv.Itoa(len(entries)) + " >>\nstream\n")
+	buf.Write(entries)
+	buf.WriteString("\nendstream\nendobj\nstartxref\n" + strconv.Itoa(xref) + "\n%%EOF\n")
+	return buf.Bytes()
+}
+
+func TestValidate(t *testing.T) {
+	ass := assert.New(t)
+	ass.NoError(Validate(newTestPDF()))
+	ass.ErrorIs(Validate([]byte("<!DOCTYPE html>\n<html><body>Forbidden</body></html>")), ErrHTMLPage)
+	ass.ErrorIs(Validate([]byte("  <HTML><head></head></HTML>")), ErrHTMLPage)
+	ass.ErrorIs(Validate([]byte("PK\x03\x04")), ErrNotPDF)
+	ass.ErrorIs(Validate(nil), ErrNotPDF)
+	data := newTestPDF()
+	ass.ErrorIs(Validate(data[:len(data)/2]), ErrTruncated)
+}
+
+func TestInspect(t *testing.T) {
+	ass := assert.New(t)
+	info, err := Inspect(newTestPDF())
+	if !ass.NoError(err) {
+		return
+	}
+	ass.Equal("1.4", info.Version)
+	ass.Equal(3, info.Pages)
+	ass.False(info.Encrypted)
+	ass.False(info.Linearized)
+	if ass.NotNil(info.PDFA) {
+		ass.Equal(PDFA{Part: 1, Conformance: "B", OutputIntent: true}, *info.PDFA)
+		ass.Equal("PDF/A-1b", info.PDFA.String())
+	}
+	ass.Equal("Änder", info.Metadata.Title)
+	ass.Equal("EPO (Publication Server)", info.Metadata.Producer)
+	ass.True(time.Date(2015, 9, 23, 8, 20, 30, 0, time.UTC).Equal(info.Metadata.CreationDate))
+	ass.True(info.Metadata.ModDate.IsZero())
+
+	_, err = Inspect([]byte("<html></html>"))
+	ass.ErrorIs(err, ErrHTMLPage)
+	_, err = Inspect([]byte("%PDF-1.4\n%%EOF"))
+	ass.ErrorIs(err, ErrNoObjects)
+}
+
+func TestInspectCompressed(t *testing.T) {
+	ass := assert.New(t)
+	info, err := Inspect(newCompressedTestPDF())
+	if !ass.NoError(err) {
+		return
+	}
+	ass.Equal("1.5", info.Version)
+	ass.Equal(1, info.Pages)
+	ass.Nil(info.PDFA)
+}
+
+func TestInspectEncrypted(t *testing.T) {
+	ass := assert.New(t)
+	data := buildPDF([]string{
+		"<< /Type /Catalog /Pages 2 0 R >>",
+		"<< /Type /Pages /Kids [3 0 R] /Count 1 >>",
+		"<< /Type /Page /Parent 2 0 R >>",
+		"<< /Filter /Standard /V 1 /R 2 >>",
+	}, "/Root 1 0 R /Encrypt 4 0 R")
+	info, err := Inspect(data)
+	ass.NoError(err)
+	ass.True(info.Encrypted)
+	ass.Equal(1, info.Pages)
+	_, err = FrontPage(data)
+	ass.ErrorIs(err, ErrEncrypted)
+}
+
+// newForgedTestPDFs creates files with forged lengths and offsets of the streams
+func newForgedTestPDFs() [][]byte {
+	objects := []string{
+		"<< /Type /Catalog /Pages 2 0 R >>",
+		"<< /Type /Pages /Kids [3 0 R] /Count 1 >>",
+		"<< /Type /Page /Parent 2 0 R >>",
+	}
+	compressed := newCompressedTestPDF()
+	return [][]byte{
+		// the length overflows the end of the stream
+		buildPDF(append(objects, "<< /Length 9223372036854775807 >>\nstream\nBT ET\nendstream"), "/Root 1 0 R"),
+		// negative offsets of the object stream
+		bytes.Replace(compressed, []byte("/First "), []byte("/First -"), 1),
+		bytes.Replace(compressed, []byte("/N 3 /First"), []byte("/N 3 /First 9223372036854775807 /Fist"), 1),
+	}
+}
+
+func TestInspectForged(t *testing.T) {
+	ass := assert.New(t)
+	forged := newForgedTestPDFs()
+	info, err := Inspect(forged[0])
+	ass.NoError(err)
+	ass.Equal(1, info.Pages)
+	// the objects of the object streams are not read
+	for _, data := range forged[1:] {
+		_, err = Inspect(data)
+		ass.ErrorIs(err, ErrNoPages)
+	}
+
+	// the decoded data of a flate stream is limited
+	var compressed bytes.Buffer
+	w := zlib.NewWriter(&compressed)
+	_, _ = w.Write(make([]byte, maxDecodedStreamSize+1))
+	_ = w.Close()
+	_, err = (&document{}).decode(&stream{dict: dict{"Filter": name("FlateDecode")}, data: compressed.Bytes()})
+	ass.ErrorIs(err, errStreamTooLarge)
+}
+
+func FuzzInspect(f *testing.F) {
+	// the errors of the broken files would flood the output of the fuzzer
+	out := log.StandardLogger().Out
+	log.SetOutput(io.Discard)
+	f.Cleanup(func() { log.SetOutput(out) })
+	f.Add(newTestPDF())
+	f.Add(newCompressedTestPDF())
+	for _, data := range newForgedTestPDFs() {
+		f.Add(data)
+	}
+	f.Fuzz(func(t *testing.T, data []byte) {
+		// the files must not result in a panic
+		_, _ = Inspect(data)
+		_, _ = ExtractPages(data, 1, 2)
+	})
+}
+
+func TestParseDate(t *testing.T) {
+	ass := assert.New(t)
+	ass.True(time.Date(2015, 9, 23, 10, 20, 30, 0, time.UTC).Equal(parseDate("D:20150923102030Z")))
+	ass.True(time.Date(2015, 9, 23, 15, 20, 30, 0, time.UTC).Equal(parseDate("D:20150923102030-05'00'")))
+	ass.True(time.Date(2015, 1, 1, 0, 0, 0, 0, time.UTC).Equal(parseDate("D:2015")))
+	ass.True(parseDate("yesterday").IsZero())
+}
@@ -0,0 +1,185 @@
+package sqlexport
+
+import (
+	"embed"
+	"errors"
+	"fmt"
+	log "github.com/sirupsen/logrus"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+//go:embed migrations/*.sql
+var migrationsFS embed.FS
+
+// ErrUnknownMigration is returned if the database contains migrations of a newer version of the package
+var ErrUnknownMigration = errors.New("unknown migration")
+
+// Migration is a change of the schema, e.g. 0001_create_tables.sql
+type Migration struct {
+	Version int
+	Name    string
+	SQL     string
+}
+
+// Migrations returns the migrations of the schema ordered by version
+func Migrations() (res []Migration, err error) {
+	entries, err := migrationsFS.ReadDir("migrations")
+	if err != nil {
+		log.WithError(err).Error("can not read migrations")
+		return
+	}
+	for _, entry := range entries {
+		prefix, name, _ := strings.Cut(strings.TrimSuffix(entry.Name(), ".sql"), "_")
+		version, errVersion := strconv.Atoi(prefix)
+		if errVersion != nil {
+			err = fmt.Errorf("migration %s has no version: %w", entry.Name(), errVersion)
+			log.WithError(err).Error("can not read migrations")
+			return nil, err
+		}
+		data, errRead := migrationsFS.ReadFile(path.Join("migrations", entry.Name()))
+		if errRead != nil {
+			log.WithError(errRead).WithField("file", entry.Name()).Error("can not read migration")
+			return nil, errRead
+		}
+		res = append(res, Migration{Version: version, Name: name, SQL: string(data)})
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].Version < res[j].Version })
+	return
+}
+
+// Migrate applies the migrations that are not applied yet, each migration in its own transaction.
+// The applied migrations are recorded in the table schema_migrations.
+func (e *Exporter) Migrate() (err error) {
+	_, err = e.db.Exec(`CREATE TABLE IF NOT EXISTS schema_migrations (
+	version    INTEGER PRIMARY KEY,
+	name       TEXT NOT NULL,
+	applied_at TEXT NOT NULL
+)`)
+	if err != nil {
+		log.WithError(err).Error("can not create migrations table")
+		return
+	}
+	applied, err := e.Version()
+	if err != nil {
+		return
+	}
+	migrations, err := Migrations()
+	if err != nil {
+		return
+	}
+	if len(migrations) > 0 && applied > migrations[len(migrations)-1].Version {
+		err = ErrUnknownMigration
+		log.WithError(err).WithField("version", applied).Error("can not migrate database")
+		return
+	}
+	for _, m := range migrations {
+		if m.Version <= applied {
+			continue
+		}
+		err = e.apply(m)
+		if err != nil {
+			return
+		}
+	}
+	return
+}
+
+// apply applies the migration and records it
+func (e *Exporter) apply(m Migration) (err error) {
+	logger := log.WithField("version", m.Version).WithField("name", m.Name)
+	tx, err := e.db.Begin()
+	if err != nil {
+		logger.WithError(err).Error("can not begin migration")
+		return
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+	// the statements are executed one by one, drivers do not have to support several statements per call
+	for _, statement := range statements(m.SQL) {
+		_, err = tx.Exec(statement)
+		if err != nil {
+			logger.WithError(err).WithField("statement", statement).Error("can not apply migration")
+			return
+		}
+	}
+	_, err = tx.Exec("INSERT INTO schema_migrations (version, name, applied_at) VALUES (?, ?, ?)",
+		m.Version, m.Name, time.Now().UTC().Format(time.RFC3339))
+	if err != nil {
+		logger.WithError(err).Error("can not record migration")
+		return
+	}
+	err = tx.Commit()
+	if err != nil {
+		logger.WithError(err).Error("can not commit migration")
+		return
+	}
+	logger.Info("applied migration")
+	return
+}
+
+// statements splits the sql of a migration into its statements, the -- comments are removed.
+// Semicolons and -- in quoted strings and identifiers, e.g. DEFAULT 'a;b', do not split or start a comment.
+func statements(sql string) (res []string) {
+	var sb strings.Builder
+	add := func() {
+		if statement := trimLines(sb.String()); statement != "" {
+			res = append(res, statement)
+		}
+		sb.Reset()
+	}
+	var quote byte // the quote of the current string or identifier, 0 outside of quotes
+	for i := 0; i < len(sql); i++ {
+		c := sql[i]
+		switch {
+		case quote != 0:
+			// doubled quotes are escaped quotes, which end and restart the quotes
+			if c == quote {
+				quote = 0
+			}
+		case c == '\'' || c == '"':
+			quote = c
+		case c == '-' && strings.HasPrefix(sql[i:], "--"):
+			// the comment ends at the end of the line, which is kept
+			end := strings.IndexByte(sql[i:], '\n')
+			if end < 0 {
+				end = len(sql) - i
+			}
+			i += end - 1
+			continue
+		case c == ';':
+			add()
+			continue
+		}
+		sb.WriteByte(c)
+	}
+	add()
+	return
+}
+
+// trimLines removes the trailing whitespace and the empty lines of the statement
+func trimLines(statement string) string {
+	var lines []string
+	for _, line := range strings.Split(statement, "\n") {
+		if line = strings.TrimRight(line, " \t\r"); strings.TrimSpace(line) != "" {
+			lines = append(lines, line)
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+// Version returns the version of the last applied migration, 0 if no migration is applied
+func (e *Exporter) Version() (version int, err error) {
+	err = e.db.QueryRow("SELECT COALESCE(MAX(version), 0) FROM schema_migrations").Scan(&version)
+	if err != nil {
+		log.WithError(err).Error("can not read schema version")
+		return
+	}
+	return
+}
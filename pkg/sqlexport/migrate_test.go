@@ -0,0 +1,65 @@
+package sqlexport
+
+import (
+	"github.com/stretchr/testify/assert"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestMigrations(t *testing.T) {
+	ass := assert.New(t)
+	migrations, err := Migrations()
+	ass.NoError(err)
+	ass.NotEmpty(migrations)
+	ass.Equal(1, migrations[0].Version)
+	ass.Equal("create_tables", migrations[0].Name)
+	for i := 1; i < len(migrations); i++ {
+		ass.Less(migrations[i-1].Version, migrations[i].Version)
+	}
+}
+
+func TestStatements(t *testing.T) {
+	ass := assert.New(t)
+	sql := "-- tables; indexes\nCREATE TABLE a (\n\tid TEXT -- e.g. EP1; EP2\n);\n\nCREATE INDEX a_id ON a (id);\n"
+	ass.Equal([]string{"CREATE TABLE a (\n\tid TEXT\n)", "CREATE INDEX a_id ON a (id)"}, statements(sql))
+	// quoted semicolons and dashes
+	sql = "CREATE TABLE b (\n\tname TEXT DEFAULT 'a;b--c''d', -- comment's\n\t\"x;y\" TEXT\n);\nCREATE TABLE c (id TEXT)"
+	ass.Equal([]string{"CREATE TABLE b (\n\tname TEXT DEFAULT 'a;b--c''d',\n\t\"x;y\" TEXT\n)", "CREATE TABLE c (id TEXT)"}, statements(sql))
+
+	migrations, err := Migrations()
+	ass.NoError(err)
+	for _, m := range migrations {
+		for _, statement := range statements(m.SQL) {
+			ass.True(strings.HasPrefix(statement, "CREATE "), statement)
+		}
+	}
+}
+
+func TestMigrate(t *testing.T) {
+	ass := assert.New(t)
+	file := filepath.Join(t.TempDir(), "patents.db")
+	e, err := Open(file)
+	ass.NoError(err)
+	migrations, err := Migrations()
+	ass.NoError(err)
+	version, err := e.Version()
+	ass.NoError(err)
+	ass.Equal(migrations[len(migrations)-1].Version, version)
+	for _, table := range []string{"documents", "titles", "claims", "inventors", "owners", "representatives",
+		"classifications", "citations", "contracting_states"} {
+		ass.Equal(1, count(t, e, "SELECT COUNT(*) FROM sqlite_master WHERE type = 'table' AND name = ?", table), table)
+	}
+	ass.NoError(e.Close())
+
+	// the migrations are applied once
+	e, err = Open(file)
+	ass.NoError(err)
+	ass.Equal(len(migrations), count(t, e, "SELECT COUNT(*) FROM schema_migrations"))
+
+	// databases of newer versions are not changed
+	_, err = e.DB().Exec("INSERT INTO schema_migrations (version, name, applied_at) VALUES (9999, 'future', '')")
+	ass.NoError(err)
+	ass.ErrorIs(e.Migrate(), ErrUnknownMigration)
+	ass.NoError(e.Close())
+}
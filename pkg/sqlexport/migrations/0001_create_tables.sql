@@ -0,0 +1,105 @@
+-- normalized tables of the parsed documents, the rows of a document are replaced on upsert
+CREATE TABLE documents (
+	id          TEXT PRIMARY KEY, -- e.g. EP3383757B1
+	country     TEXT NOT NULL,
+	doc_number  TEXT NOT NULL,
+	kind        TEXT NOT NULL,
+	lang        TEXT NOT NULL,
+	date_publ   TEXT,             -- 2006-01-02, NULL if unknown
+	status      TEXT NOT NULL,
+	dtd_version TEXT NOT NULL,
+	file        TEXT NOT NULL
+);
+CREATE INDEX documents_date_publ ON documents (date_publ);
+CREATE INDEX documents_doc_number ON documents (country, doc_number);
+
+CREATE TABLE titles (
+	document_id TEXT    NOT NULL REFERENCES documents (id) ON DELETE CASCADE,
+	position    INTEGER NOT NULL,
+	language    TEXT    NOT NULL,
+	text        TEXT    NOT NULL,
+	PRIMARY KEY (document_id, position)
+);
+
+CREATE TABLE claims (
+	document_id TEXT    NOT NULL REFERENCES documents (id) ON DELETE CASCADE,
+	position    INTEGER NOT NULL,
+	language    TEXT    NOT NULL,
+	claim_id    TEXT    NOT NULL, -- e.g. c-en-01-0001
+	number      INTEGER NOT NULL, -- 0 if the claim is not numbered
+	text        TEXT    NOT NULL,
+	PRIMARY KEY (document_id, position)
+);
+
+CREATE TABLE inventors (
+	document_id TEXT    NOT NULL REFERENCES documents (id) ON DELETE CASCADE,
+	position    INTEGER NOT NULL,
+	name        TEXT    NOT NULL,
+	country     TEXT    NOT NULL,
+	city        TEXT    NOT NULL,
+	street      TEXT    NOT NULL,
+	PRIMARY KEY (document_id, position)
+);
+CREATE INDEX inventors_name ON inventors (name);
+
+CREATE TABLE owners (
+	document_id TEXT    NOT NULL REFERENCES documents (id) ON DELETE CASCADE,
+	position    INTEGER NOT NULL,
+	name        TEXT    NOT NULL,
+	country     TEXT    NOT NULL,
+	city        TEXT    NOT NULL,
+	street      TEXT    NOT NULL,
+	iid         TEXT    NOT NULL,
+	irf         TEXT    NOT NULL,
+	PRIMARY KEY (document_id, position)
+);
+CREATE INDEX owners_name ON owners (name);
+
+CREATE TABLE representatives (
+	document_id TEXT    NOT NULL REFERENCES documents (id) ON DELETE CASCADE,
+	position    INTEGER NOT NULL,
+	name        TEXT    NOT NULL,
+	country     TEXT    NOT NULL,
+	city        TEXT    NOT NULL,
+	street      TEXT    NOT NULL,
+	iid         TEXT    NOT NULL,
+	PRIMARY KEY (document_id, position)
+);
+
+CREATE TABLE classifications (
+	document_id TEXT    NOT NULL REFERENCES documents (id) ON DELETE CASCADE,
+	position    INTEGER NOT NULL,
+	system      TEXT    NOT NULL, -- IPC or CPC
+	symbol      TEXT    NOT NULL, -- e.g. B65D 51/18
+	section     TEXT    NOT NULL,
+	class       TEXT    NOT NULL,
+	sub_class   TEXT    NOT NULL,
+	main_group  TEXT    NOT NULL,
+	sub_group   TEXT    NOT NULL,
+	text        TEXT    NOT NULL,
+	PRIMARY KEY (document_id, position)
+);
+CREATE INDEX classifications_symbol ON classifications (symbol);
+
+CREATE TABLE citations (
+	document_id     TEXT    NOT NULL REFERENCES documents (id) ON DELETE CASCADE,
+	position        INTEGER NOT NULL,
+	type            TEXT    NOT NULL, -- patent or npl
+	phase           TEXT    NOT NULL, -- applicant or search
+	country         TEXT    NOT NULL,
+	doc_number      TEXT    NOT NULL,
+	kind            TEXT    NOT NULL,
+	text            TEXT    NOT NULL,
+	categories      TEXT    NOT NULL, -- e.g. X Y
+	relevant_claims TEXT    NOT NULL,
+	PRIMARY KEY (document_id, position)
+);
+CREATE INDEX citations_doc_number ON citations (country, doc_number);
+
+CREATE TABLE contracting_states (
+	document_id TEXT    NOT NULL REFERENCES documents (id) ON DELETE CASCADE,
+	position    INTEGER NOT NULL,
+	country     TEXT    NOT NULL,
+	PRIMARY KEY (document_id, position)
+);
+CREATE INDEX contracting_states_country ON contracting_states (country);
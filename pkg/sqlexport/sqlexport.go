@@ -0,0 +1,222 @@
+// Package sqlexport upserts parsed patent documents into normalized tables of a SQLite database.
+// Only SQLite is supported, the statements and migrations use its dialect, e.g. INSERT ... ON CONFLICT.
+package sqlexport
+
+import (
+	"database/sql"
+	"github.com/max-planck-innovation-competition/go-epo-eps/pkg/eps"
+	log "github.com/sirupsen/logrus"
+	_ "modernc.org/sqlite"
+	"net/url"
+	"strings"
+)
+
+// Exporter upserts documents into the tables of the database
+type Exporter struct {
+	db *sql.DB
+}
+
+// NewExporter creates an exporter for an opened SQLite database, the migrations are applied with Migrate
+func NewExporter(db *sql.DB) *Exporter {
+	return &Exporter{db: db}
+}
+
+// Open opens or creates the SQLite database file and applies the migrations
+func Open(file string) (*Exporter, error) {
+	// the pragmas are set for each connection,
+	// the file name is escaped, e.g. a ? or # of the name would start the query or fragment of the uri
+	dsn := url.URL{
+		Scheme:   "file",
+		Opaque:   (&url.URL{Path: file}).EscapedPath(),
+		RawQuery: url.Values{"_pragma": {"foreign_keys(1)", "busy_timeout(5000)"}}.Encode(),
+	}
+	db, err := sql.Open("sqlite", dsn.String())
+	if err != nil {
+		log.WithError(err).WithField("file", file).Error("can not open database")
+		return nil, err
+	}
+	e := NewExporter(db)
+	err = e.Migrate()
+	if err != nil {
+		_ = db.Close()
+		return nil, err
+	}
+	return e, nil
+}
+
+// DB returns the database, e.g. for queries
+func (e *Exporter) DB() *sql.DB {
+	return e.db
+}
+
+// Close closes the database
+func (e *Exporter) Close() error {
+	return e.db.Close()
+}
+
+// table is a table with the rows of a document, the rows are identified by the document and their position
+type table struct {
+	name    string
+	columns []string
+	rows    func(doc *eps.EpPatentDocumentSimple) [][]any
+}
+
+// documentTables are the tables with the rows of the documents
+var documentTables = []table{
+	{
+		name:    "titles",
+		columns: []string{"language", "text"},
+		rows: func(doc *eps.EpPatentDocumentSimple) (res [][]any) {
+			for _, t := range doc.Title {
+				res = append(res, []any{t.Language, t.Text})
+			}
+			return
+		},
+	},
+	{
+		name:    "claims",
+		columns: []string{"language", "claim_id", "number", "text"},
+		rows: func(doc *eps.EpPatentDocumentSimple) (res [][]any) {
+			for _, c := range doc.Claims {
+				for _, item := range c.Items {
+					res = append(res, []any{c.Language, item.Id, item.Number(), item.Text})
+				}
+			}
+			return
+		},
+	},
+	{
+		name:    "inventors",
+		columns: []string{"name", "country", "city", "street"},
+		rows: func(doc *eps.EpPatentDocumentSimple) (res [][]any) {
+			for _, i := range doc.Inventors {
+				res = append(res, []any{i.Name, string(i.Country), i.City, i.Street})
+			}
+			return
+		},
+	},
+	{
+		name:    "owners",
+		columns: []string{"name", "country", "city", "street", "iid", "irf"},
+		rows: func(doc *eps.EpPatentDocumentSimple) (res [][]any) {
+			for _, o := range doc.Owners {
+				res = append(res, []any{o.Name, string(o.Country), o.City, o.Street, o.IID, o.IRF})
+			}
+			return
+		},
+	},
+	{
+		name:    "representatives",
+		columns: []string{"name", "country", "city", "street", "iid"},
+		rows: func(doc *eps.EpPatentDocumentSimple) (res [][]any) {
+			for _, r := range doc.Representatives {
+				res = append(res, []any{r.Name, string(r.Country), r.City, r.Street, r.IID})
+			}
+			return
+		},
+	},
+	{
+		name:    "classifications",
+		columns: []string{"system", "symbol", "section", "class", "sub_class", "main_group", "sub_group", "text"},
+		rows: func(doc *eps.EpPatentDocumentSimple) (res [][]any) {
+			for _, c := range doc.Classifications {
+				res = append(res, []any{string(c.System), c.Symbol(), c.Section, c.Class, c.SubClass, c.MainGroup, c.SubGroup, c.Text})
+			}
+			return
+		},
+	},
+	{
+		name:    "citations",
+		columns: []string{"type", "phase", "country", "doc_number", "kind", "text", "categories", "relevant_claims"},
+		rows: func(doc *eps.EpPatentDocumentSimple) (res [][]any) {
+			for _, c := range doc.Citations {
+				res = append(res, []any{"patent", string(c.Phase), string(c.Country), c.DocNumber, c.Kind, c.Text,
+					strings.Join(c.Categories, " "), c.RelevantClaims})
+			}
+			for _, c := range doc.NplCitations {
+				text := c.Text
+				if text == "" {
+					text = c.Title
+				}
+				res = append(res, []any{"npl", string(c.Phase), "", "", "", text,
+					strings.Join(c.Categories, " "), c.RelevantClaims})
+			}
+			return
+		},
+	},
+	{
+		name:    "contracting_states",
+		columns: []string{"country"},
+		rows: func(doc *eps.EpPatentDocumentSimple) (res [][]any) {
+			for _, c := range doc.ContractingStates {
+				res = append(res, []any{string(c)})
+			}
+			return
+		},
+	},
+}
+
+// upsertDocumentQuery inserts or updates the row of the documents table
+const upsertDocumentQuery = `INSERT INTO documents (id, country, doc_number, kind, lang, date_publ, status, dtd_version, file)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)
+ON CONFLICT (id) DO UPDATE SET country = excluded.country, doc_number = excluded.doc_number, kind = excluded.kind,
+lang = excluded.lang, date_publ = excluded.date_publ, status = excluded.status, dtd_version = excluded.dtd_version,
+file = excluded.file`
+
+// Upsert inserts the documents or replaces the documents with the same id in one transaction
+func (e *Exporter) Upsert(docs ...eps.EpPatentDocumentSimple) (err error) {
+	tx, err := e.db.Begin()
+	if err != nil {
+		log.WithError(err).Error("can not begin transaction")
+		return
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+	for i := range docs {
+		err = e.upsert(tx, &docs[i])
+		if err != nil {
+			return
+		}
+	}
+	err = tx.Commit()
+	if err != nil {
+		log.WithError(err).Error("can not commit transaction")
+		return
+	}
+	return
+}
+
+// upsert writes the document and replaces its rows in the tables
+func (e *Exporter) upsert(tx *sql.Tx, doc *eps.EpPatentDocumentSimple) (err error) {
+	logger := log.WithField("id", doc.ID)
+	var datePubl any
+	if !doc.DatePubl.IsZero() {
+		datePubl = doc.DatePubl.Format("2006-01-02")
+	}
+	_, err = tx.Exec(upsertDocumentQuery, doc.ID, string(doc.Country), doc.DocNumber, doc.Kind,
+		doc.Lang, datePubl, doc.Status, doc.DtdVersion, doc.File)
+	if err != nil {
+		logger.WithError(err).Error("can not upsert document")
+		return
+	}
+	for _, t := range documentTables {
+		_, err = tx.Exec("DELETE FROM "+t.name+" WHERE document_id = ?", doc.ID)
+		if err != nil {
+			logger.WithError(err).WithField("table", t.name).Error("can not delete rows")
+			return
+		}
+		query := "INSERT INTO " + t.name + " (document_id, position, " + strings.Join(t.columns, ", ") +
+			") VALUES (?, ?" + strings.Repeat(", ?", len(t.columns)) + ")"
+		for position, row := range t.rows(doc) {
+			_, err = tx.Exec(query, append([]any{doc.ID, position}, row...)...)
+			if err != nil {
+				logger.WithError(err).WithField("table", t.name).Error("can not insert row")
+				return
+			}
+		}
+	}
+	return
+}
@@ -0,0 +1,91 @@
+package sqlexport
+
+import (
+	"github.com/max-planck-innovation-competition/go-epo-eps/pkg/eps"
+	"github.com/stretchr/testify/assert"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// testDocuments parses the test data of the eps package
+func testDocuments(t *testing.T) (docs []eps.EpPatentDocumentSimple) {
+	files, err := filepath.Glob("../eps/test-data/*/*.xml")
+	assert.NoError(t, err)
+	for _, file := range files {
+		data, err := os.ReadFile(file)
+		assert.NoError(t, err)
+		doc, err := eps.ProcessXMLSimple(data)
+		assert.NoError(t, err)
+		docs = append(docs, doc)
+	}
+	return
+}
+
+// count returns the number of rows of the query
+func count(t *testing.T, e *Exporter, query string, args ...any) (n int) {
+	assert.NoError(t, e.DB().QueryRow(query, args...).Scan(&n))
+	return
+}
+
+func TestOpenFileName(t *testing.T) {
+	ass := assert.New(t)
+	// the characters are not a query, fragment or escape of the uri
+	file := filepath.Join(t.TempDir(), "patents?mode=ro#1 100%.db")
+	e, err := Open(file)
+	if !ass.NoError(err) {
+		return
+	}
+	ass.NoError(e.Close())
+	_, err = os.Stat(file)
+	ass.NoError(err)
+}
+
+func TestUpsert(t *testing.T) {
+	ass := assert.New(t)
+	e, err := Open(filepath.Join(t.TempDir(), "patents.db"))
+	ass.NoError(err)
+	defer e.Close()
+
+	docs := testDocuments(t)
+	ass.NoError(e.Upsert(docs...))
+	ass.Equal(len(docs), count(t, e, "SELECT COUNT(*) FROM documents"))
+
+	var grant eps.EpPatentDocumentSimple
+	for _, doc := range docs {
+		if doc.ID == "EP16849316B1" {
+			grant = doc
+		}
+	}
+	ass.Equal("EP16849316B1", grant.ID)
+	var kind, datePubl string
+	ass.NoError(e.DB().QueryRow("SELECT kind, date_publ FROM documents WHERE id = ?", grant.ID).Scan(&kind, &datePubl))
+	ass.Equal("B1", kind)
+	ass.Equal("2021-07-07", datePubl)
+	claims := 0
+	for _, c := range grant.Claims {
+		claims += len(c.Items)
+	}
+	ass.Equal(claims, count(t, e, "SELECT COUNT(*) FROM claims WHERE document_id = ?", grant.ID))
+	ass.Equal(len(grant.ContractingStates), count(t, e, "SELECT COUNT(*) FROM contracting_states WHERE document_id = ?", grant.ID))
+	ass.Equal(1, count(t, e, "SELECT COUNT(*) FROM owners WHERE document_id = ? AND name = 'ELC Management LLC'", grant.ID))
+	ass.Equal(1, count(t, e, `SELECT COUNT(*) FROM documents d JOIN classifications c ON c.document_id = d.id
+		WHERE d.id = ? AND c.symbol = 'B65D 51/18'`, grant.ID))
+	ass.Equal(len(grant.Citations)+len(grant.NplCitations), count(t, e, "SELECT COUNT(*) FROM citations WHERE document_id = ?", grant.ID))
+	ass.Equal(len(grant.Claims), count(t, e, "SELECT COUNT(*) FROM claims WHERE document_id = ? AND number = 1", grant.ID))
+
+	// the rows of the document are replaced
+	grant.Kind = "B2"
+	grant.Claims = grant.Claims[:1]
+	grant.Claims[0].Items = grant.Claims[0].Items[:3]
+	grant.ContractingStates = nil
+	ass.NoError(e.Upsert(grant))
+	ass.Equal(len(docs), count(t, e, "SELECT COUNT(*) FROM documents"))
+	ass.Equal(1, count(t, e, "SELECT COUNT(*) FROM documents WHERE id = ? AND kind = 'B2'", grant.ID))
+	ass.Equal(3, count(t, e, "SELECT COUNT(*) FROM claims WHERE document_id = ?", grant.ID))
+	ass.Equal(0, count(t, e, "SELECT COUNT(*) FROM contracting_states WHERE document_id = ?", grant.ID))
+
+	// documents without date of publication
+	ass.NoError(e.Upsert(eps.EpPatentDocumentSimple{ID: "EP1"}))
+	ass.Equal(1, count(t, e, "SELECT COUNT(*) FROM documents WHERE id = 'EP1' AND date_publ IS NULL"))
+}